@@ -11,6 +11,7 @@ export interface RawEnv {
   REDIS_DB?: string;
   GRPC_USER_ENDPOINT?: string;
   GRPC_USER_VERSION?: string;
+  IDENTITY_KEY?: string;
   LINE_CHANNEL_ID?: string;
   LINE_CHANNEL_SECRET?: string;
   LINE_CHANNEL_ACCESS_TOKEN?: string;
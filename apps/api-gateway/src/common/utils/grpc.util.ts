@@ -1,5 +1,11 @@
+import { createHmac } from 'crypto';
+import { Metadata } from '@grpc/grpc-js';
 import { Timestamp__Output } from '@grpc/grpc-js/build/src/generated/google/protobuf/Timestamp';
 import { StringValue__Output } from '../../grpc-client/@types/generated/google/protobuf/StringValue';
+import { Struct__Output } from '../../grpc-client/@types/generated/google/protobuf/Struct';
+import { Value__Output } from '../../grpc-client/@types/generated/google/protobuf/Value';
+import { Identity } from '../../core/auth/types/auth.type';
+import { getOsEnv } from './env.util';
 
 export function toDate(timestamp: Timestamp__Output | null): Date | null {
   if (!timestamp) return null;
@@ -12,3 +18,83 @@ export function toStrVal(val: StringValue__Output | null): string | null {
   if (!val) return null;
   return val.value;
 }
+
+// Wraps an optional string for a google.protobuf.StringValue field, leaving
+// the field unset when there is no value.
+export function toStrValInput(
+  val: string | null | undefined,
+): { value: string } | undefined {
+  if (val === undefined || val === null) return undefined;
+  return { value: val };
+}
+
+export function fromStruct(
+  struct: Struct__Output | null,
+): Record<string, unknown> | null {
+  if (!struct) return null;
+  return Object.fromEntries(
+    Object.entries(struct.fields).map(([key, value]) => [
+      key,
+      fromValue(value),
+    ]),
+  );
+}
+
+function fromValue(value: Value__Output): unknown {
+  switch (value.kind) {
+    case 'numberValue':
+      return value.numberValue;
+    case 'stringValue':
+      return value.stringValue;
+    case 'boolValue':
+      return value.boolValue;
+    case 'structValue':
+      return fromStruct(value.structValue);
+    case 'listValue':
+      return (value.listValue?.values ?? []).map(fromValue);
+    default:
+      return null;
+  }
+}
+
+// How long identity tokens stay valid. They are signed for every call, so
+// they only need to outlive it.
+const IDENTITY_TTL_SECONDS = 5 * 60;
+
+// The identity the gateway signs for the calls it makes for itself rather
+// than on behalf of a user, such as resolving who signed in. No user holds
+// the role; the users service only answers ResolveLineUser to it.
+const GATEWAY_SUBJECT = 'api-gateway';
+const GATEWAY_ROLE = 'GATEWAY';
+
+// Signs the caller's identity for the backend services, which only believe
+// an x-user-identity token signed with the key they share with the gateway
+// (SECURITY_IDENTITY_KEY on their side). The format is the one of
+// IdentityKeys in libs/grpc: v1.<claims>.<HMAC-SHA256 of "v1.<claims>">,
+// both base64url without padding. identity.id is the internal user ID the
+// services compare against, never the LINE user ID.
+export function identityMetadata(identity: Identity): Metadata {
+  return signedIdentityMetadata(identity.id, identity.roles);
+}
+
+// Signs the gateway's own identity, see GATEWAY_SUBJECT.
+export function gatewayMetadata(): Metadata {
+  return signedIdentityMetadata(GATEWAY_SUBJECT, [GATEWAY_ROLE]);
+}
+
+function signedIdentityMetadata(subject: string, roles: string[]): Metadata {
+  const claims = {
+    sub: subject,
+    roles: roles.length > 0 ? roles : undefined,
+    exp: Math.floor(Date.now() / 1000) + IDENTITY_TTL_SECONDS,
+  };
+  const payload = Buffer.from(JSON.stringify(claims)).toString('base64url');
+  const signed = `v1.${payload}`;
+  const signature = createHmac('sha256', getOsEnv('IDENTITY_KEY'))
+    .update(signed)
+    .digest('base64url');
+
+  const metadata = new Metadata();
+  metadata.set('x-user-identity', `${signed}.${signature}`);
+  return metadata;
+}
@@ -3,10 +3,12 @@ import { ConfigModule } from '@nestjs/config';
 import { LineStrategy } from './strategies/line.strategy';
 import { LineAuthGuard } from './guards/line.guard';
 import { PassportModule } from '@nestjs/passport';
+import { GrpcClientModule } from 'src/grpc-client/grpc-client.module';
+import { IdentityResolver } from './identity.resolver';
 
 @Module({
-  imports: [ConfigModule, PassportModule],
-  providers: [LineStrategy, LineAuthGuard],
+  imports: [ConfigModule, PassportModule, GrpcClientModule],
+  providers: [LineStrategy, LineAuthGuard, IdentityResolver],
   exports: [LineAuthGuard],
 })
 export class AuthModule {}
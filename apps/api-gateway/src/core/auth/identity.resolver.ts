@@ -0,0 +1,33 @@
+import { Injectable, UnauthorizedException } from '@nestjs/common';
+import { UserClientService } from 'src/grpc-client/services/user-client.service';
+import { gatewayMetadata } from 'src/common/utils/grpc.util';
+import { NotFoundError } from 'src/common/errors';
+import { Identity } from './types/auth.type';
+
+// Resolves the LINE account a user signed in with to the internal user ID
+// and roles the backend services check, before any identity is signed.
+@Injectable()
+export class IdentityResolver {
+  constructor(private readonly user: UserClientService) {}
+
+  async resolveLineUser(lineUserId: string): Promise<Identity> {
+    try {
+      const resolved = await this.user.userService.resolveLineUser(
+        { lineUserId },
+        gatewayMetadata(),
+      );
+      return {
+        id: resolved.userId,
+        lineUserId,
+        roles: resolved.roles,
+      };
+    } catch (error) {
+      if (error instanceof NotFoundError) {
+        throw new UnauthorizedException(
+          'Unauthorized: register with LINE before signing in',
+        );
+      }
+      throw error;
+    }
+  }
+}
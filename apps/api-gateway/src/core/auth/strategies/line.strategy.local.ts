@@ -3,8 +3,9 @@ import { Injectable } from '@nestjs/common';
 import { PassportStrategy } from '@nestjs/passport';
 import { Request } from 'express';
 import { Strategy } from 'passport-local';
-import { catchError, EMPTY, from, tap } from 'rxjs';
+import { catchError, EMPTY, from, switchMap, tap } from 'rxjs';
 import { Identity } from '../types/auth.type';
+import { IdentityResolver } from '../identity.resolver';
 import { LineService } from 'src/core/line/line.service';
 import { InjectPinoLogger, PinoLogger } from 'nestjs-pino';
 
@@ -14,6 +15,7 @@ export class LineStrategy extends PassportStrategy(Strategy, 'line') {
     @InjectPinoLogger(LineStrategy.name)
     private readonly logger: PinoLogger,
     private readonly lineService: LineService,
+    private readonly identities: IdentityResolver,
   ) {
     super();
   }
@@ -23,7 +25,8 @@ export class LineStrategy extends PassportStrategy(Strategy, 'line') {
     if (idToken) {
       from(this.lineService.verifyIdToken(idToken))
         .pipe(
-          tap((user) => this.success(user)),
+          switchMap((payload) => from(this.validate(payload))),
+          tap((identity) => this.success(identity)),
           catchError((error) => {
             this.logger.error(`Authentication failed: ${error.message}`);
             this.fail('Invalid LINE ID Token', 401);
@@ -39,10 +42,7 @@ export class LineStrategy extends PassportStrategy(Strategy, 'line') {
     }
   }
 
-  validate(payload: VerifyIDToken): Identity {
-    return {
-      id: payload.sub,
-      roles: [],
-    };
+  validate(payload: VerifyIDToken): Promise<Identity> {
+    return this.identities.resolveLineUser(payload.sub);
   }
 }
@@ -6,10 +6,12 @@ import { LineStrategy } from './line.strategy';
 import { VerifyIDToken } from '@line/bot-sdk';
 import { UnauthorizedException } from '@nestjs/common';
 import { LineService } from 'src/core/line/line.service';
+import { IdentityResolver } from '../identity.resolver';
 
 describe('LineStrategy', () => {
   let lineStrategy: LineStrategy;
   let lineService: LineService;
+  let identities: IdentityResolver;
   let mockRequest: Partial<Request>;
   let mockSuccess: jest.Mock;
   let mockFail: jest.Mock;
@@ -40,11 +42,18 @@ describe('LineStrategy', () => {
             verifyIdToken: jest.fn(),
           },
         },
+        {
+          provide: IdentityResolver,
+          useValue: {
+            resolveLineUser: jest.fn(),
+          },
+        },
       ],
     }).compile();
 
     lineStrategy = module.get<LineStrategy>(LineStrategy);
     lineService = module.get<LineService>(LineService);
+    identities = module.get<IdentityResolver>(IdentityResolver);
 
     // Mock PassportStrategy methods
     mockSuccess = jest.fn();
@@ -115,26 +124,23 @@ describe('LineStrategy', () => {
   });
 
   describe('validate', () => {
-    it('should return payload with correct structure', () => {
+    it('should resolve the LINE user to the internal identity', async () => {
+      // Arrange
+      const identity = {
+        id: '5f0c6a3e-1d2b-4c8e-9a7f-0b1c2d3e4f50',
+        lineUserId: 'U1234567890',
+        roles: ['POSTER'],
+      };
+      jest
+        .spyOn(identities, 'resolveLineUser')
+        .mockReturnValue(Promise.resolve(identity));
+
       // Act
-      const result = lineStrategy.validate(mockUser);
+      const result = await lineStrategy.validate(mockUser);
 
       // Assert
-      expect(result).toEqual({
-        sub: 'U1234567890',
-        name: 'Test User',
-        picture: 'https://example.com/picture.jpg',
-        email: 'test@example.com',
-        scope: '',
-        client_id: '',
-        expires_in: 0,
-        iss: '',
-        aud: 0,
-        exp: 0,
-        iat: 0,
-        nonce: '',
-        amr: [],
-      });
+      expect(identities.resolveLineUser).toHaveBeenCalledWith('U1234567890');
+      expect(result).toEqual(identity);
     });
   });
 });
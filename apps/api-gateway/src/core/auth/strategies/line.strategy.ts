@@ -3,11 +3,12 @@ import { Injectable } from '@nestjs/common';
 import { PassportStrategy } from '@nestjs/passport';
 import { ExtractJwt, Strategy } from 'passport-jwt';
 import { Identity } from '../types/auth.type';
+import { IdentityResolver } from '../identity.resolver';
 import { getOsEnv } from 'src/common/utils/env.util';
 
 @Injectable()
 export class LineStrategy extends PassportStrategy(Strategy, 'line') {
-  constructor() {
+  constructor(private readonly identities: IdentityResolver) {
     super({
       jwtFromRequest: ExtractJwt.fromAuthHeaderAsBearerToken(),
       ignoreExpiration: true,
@@ -15,10 +16,7 @@ export class LineStrategy extends PassportStrategy(Strategy, 'line') {
     });
   }
 
-  validate(payload: VerifyIDToken): Identity {
-    return {
-      id: payload.sub,
-      roles: [],
-    };
+  validate(payload: VerifyIDToken): Promise<Identity> {
+    return this.identities.resolveLineUser(payload.sub);
   }
 }
@@ -1,4 +1,8 @@
+// Identity is the signed-in user as the backend services know them: id is
+// the internal user ID and roles the ones the users service holds, both
+// resolved from the LINE account the user signed in with.
 export type Identity = {
   id: string;
+  lineUserId: string;
   roles: string[];
 };
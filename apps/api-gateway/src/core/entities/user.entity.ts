@@ -64,6 +64,12 @@ export class User {
   @Field(() => JSONScalar, { nullable: true })
   preferences?: any;
 
+  @Field(() => String)
+  locale: string;
+
+  @Field(() => String)
+  timezone: string;
+
   @Field(() => EnumUserStatus)
   status: EnumUserStatus;
 
@@ -7,4 +7,9 @@ export class LineRegisterInput {
   password?: string;
   displayName: string;
   avatarUrl?: string;
+  locale?: string;
+  timezone?: string;
+  referralCode?: string;
+  channel?: string;
+  campaign?: string;
 }
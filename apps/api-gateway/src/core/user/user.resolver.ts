@@ -15,7 +15,7 @@ export class UserResolver {
 
   @Query(() => User, { name: 'profile' })
   async getProfile(@CurrentIdentity() user: Identity): Promise<User> {
-    return this.userService.getProfile(user.id);
+    return this.userService.getProfile(user);
   }
 
   @Mutation(() => UUIDScalar)
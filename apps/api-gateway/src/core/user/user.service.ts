@@ -4,8 +4,16 @@ import { UpdateProfileInput } from './dtos/update-profile.input';
 import { LineRegisterInput } from './dtos/line-register.input';
 import { UserProfile__Output } from '../../grpc-client/@types/generated/user/v1/UserProfile';
 import { EnumUserRole, EnumUserStatus, User } from '../entities/user.entity';
-import { toDate, toStrVal } from '../../common/utils/grpc.util';
+import {
+  fromStruct,
+  gatewayMetadata,
+  identityMetadata,
+  toDate,
+  toStrVal,
+  toStrValInput,
+} from '../../common/utils/grpc.util';
 import { NotFoundError } from '../../common/errors';
+import { Identity } from '../auth/types/auth.type';
 
 @Injectable()
 export class UserService {
@@ -30,16 +38,19 @@ export class UserService {
       avatarUrl: toStrVal(resp.avatarUrl),
       phoneNumber: toStrVal(resp.phoneNumber),
       address: toStrVal(resp.address),
-      preferences: resp.preferences,
+      preferences: fromStruct(resp.preferences),
+      locale: resp.locale,
+      timezone: resp.timezone,
       lastLoginAt: toDate(resp.lastLoginAt),
     };
     return res;
   }
 
-  async getProfile(userId: string) {
-    const result = await this.user.userService.getUserProfile({
-      userId,
-    });
+  async getProfile(identity: Identity) {
+    const result = await this.user.userService.getUserProfile(
+      { userId: identity.id },
+      identityMetadata(identity),
+    );
     const profile = this.toUser(result);
     if (!profile) {
       throw new NotFoundError('User not found');
@@ -48,13 +59,21 @@ export class UserService {
   }
 
   async lineRegister(input: LineRegisterInput) {
-    const result = await this.user.userService.LineRegister({
-      lineUserId: input.lineUserId,
-      displayName: input.displayName,
-      avatarUrl: { value: input.avatarUrl },
-      email: { value: input.email },
-      password: { value: input.password },
-    });
+    const result = await this.user.userService.LineRegister(
+      {
+        lineUserId: input.lineUserId,
+        displayName: input.displayName,
+        avatarUrl: { value: input.avatarUrl },
+        email: { value: input.email },
+        password: { value: input.password },
+        locale: toStrValInput(input.locale),
+        timezone: toStrValInput(input.timezone),
+        referralCode: toStrValInput(input.referralCode),
+        channel: toStrValInput(input.channel),
+        campaign: toStrValInput(input.campaign),
+      },
+      gatewayMetadata(),
+    );
     return result;
   }
 
@@ -1,6 +1,7 @@
 import { Injectable } from '@nestjs/common';
 import * as grpc from '@grpc/grpc-js';
 import * as protoLoader from '@grpc/proto-loader';
+import { dirname } from 'path';
 
 interface GrpcServiceConfig {
   protoPath: string;
@@ -23,6 +24,8 @@ export class GrpcClientFactory {
   private loadProtoFile(protoPath: string): grpc.GrpcObject {
     try {
       const packageDefinition = protoLoader.loadSync(protoPath, {
+        // Imports such as buf/validate/validate.proto resolve next to the file.
+        includeDirs: [dirname(protoPath)],
         keepCase: true,
         longs: String,
         enums: String,
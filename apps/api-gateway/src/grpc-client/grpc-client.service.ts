@@ -134,6 +134,34 @@ export abstract class GrpcClientService {
         return new NotFoundError(message, grpcMetadata, grpcError);
       case status.INVALID_ARGUMENT:
         return new BadRequestError(message, grpcMetadata, grpcError);
+      case status.FAILED_PRECONDITION:
+        return new BaseError(
+          message,
+          HttpStatus.UNPROCESSABLE_ENTITY,
+          'FAILED_PRECONDITION',
+          grpcMetadata,
+          true,
+          grpcError,
+        );
+      case status.ALREADY_EXISTS:
+      case status.ABORTED:
+        return new BaseError(
+          message,
+          HttpStatus.CONFLICT,
+          status[grpcError.code],
+          grpcMetadata,
+          true,
+          grpcError,
+        );
+      case status.UNIMPLEMENTED:
+        return new BaseError(
+          message,
+          HttpStatus.NOT_IMPLEMENTED,
+          'UNIMPLEMENTED',
+          grpcMetadata,
+          true,
+          grpcError,
+        );
       case status.UNAUTHENTICATED:
         return new UnauthorizedError(message, grpcMetadata, grpcError);
       case status.PERMISSION_DENIED:
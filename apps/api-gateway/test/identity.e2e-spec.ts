@@ -0,0 +1,217 @@
+import { Test, TestingModule } from '@nestjs/testing';
+import { UnauthorizedException } from '@nestjs/common';
+import { ConfigModule } from '@nestjs/config';
+import { LoggerModule } from 'nestjs-pino';
+import { VerifyIDToken } from '@line/bot-sdk';
+import * as grpc from '@grpc/grpc-js';
+import * as protoLoader from '@grpc/proto-loader';
+import { createHmac, timingSafeEqual } from 'crypto';
+import { dirname, join } from 'path';
+import grpcConfig from 'src/config/grpc.config';
+import { AuthModule } from 'src/core/auth/auth.module';
+import { UserModule } from 'src/core/user/user.module';
+import { LineStrategy } from 'src/core/auth/strategies/line.strategy';
+import { UserService } from 'src/core/user/user.service';
+
+const IDENTITY_KEY = 'IDENTITY_KEY_SHARED_WITH_THE_SERVICES_32B';
+const PROTO_PATH = join(__dirname, '..', 'proto', 'user.proto');
+
+type Claims = { sub: string; roles?: string[]; exp: number };
+
+type Account = {
+  userId: string;
+  lineUserId: string;
+  roles: string[];
+};
+
+const owner: Account = {
+  userId: '5f0c6a3e-1d2b-4c8e-9a7f-0b1c2d3e4f50',
+  lineUserId: 'U-owner',
+  roles: ['POSTER'],
+};
+const admin: Account = {
+  userId: '9a1b2c3d-4e5f-4a6b-8c7d-0e1f2a3b4c5d',
+  lineUserId: 'U-admin',
+  roles: ['ADMIN'],
+};
+
+// verifyIdentity checks an x-user-identity token the way IdentityKeys.Verify
+// in libs/grpc does, so the test fails if the gateway signs anything the
+// services would not believe.
+function verifyIdentity(metadata: grpc.Metadata): Claims | undefined {
+  const [token] = metadata.get('x-user-identity');
+  if (typeof token !== 'string' || !token.startsWith('v1.')) return undefined;
+  const dot = token.lastIndexOf('.');
+  const signed = token.slice(0, dot);
+  const signature = Buffer.from(token.slice(dot + 1), 'base64url');
+  const expected = createHmac('sha256', IDENTITY_KEY).update(signed).digest();
+  if (
+    signature.length !== expected.length ||
+    !timingSafeEqual(signature, expected)
+  ) {
+    return undefined;
+  }
+  const claims = JSON.parse(
+    Buffer.from(signed.slice('v1.'.length), 'base64url').toString(),
+  ) as Claims;
+  if (claims.exp < Math.floor(Date.now() / 1000)) return undefined;
+  return claims;
+}
+
+function hasRole(claims: Claims, role: string): boolean {
+  return (claims.roles ?? []).some((r) => r.toUpperCase() === role);
+}
+
+// startUserService serves the parts of UserService the gateway identity
+// goes through, with the access rules of the users service: only the
+// gateway resolves LINE accounts, and only the owner or an admin reads a
+// full profile.
+async function startUserService(accounts: Account[]) {
+  const definition = protoLoader.loadSync(PROTO_PATH, {
+    includeDirs: [dirname(PROTO_PATH)],
+    keepCase: true,
+    longs: String,
+    enums: String,
+    defaults: true,
+    oneofs: true,
+  });
+  // eslint-disable-next-line @typescript-eslint/no-explicit-any
+  const proto = grpc.loadPackageDefinition(definition).user as any;
+  const calls: Claims[] = [];
+
+  const server = new grpc.Server();
+  // eslint-disable-next-line @typescript-eslint/no-unsafe-argument, @typescript-eslint/no-unsafe-member-access
+  server.addService(proto.v1.UserService.service, {
+    ResolveLineUser: (
+      call: grpc.ServerUnaryCall<{ lineUserId: string }, unknown>,
+      callback: grpc.sendUnaryData<unknown>,
+    ) => {
+      const claims = verifyIdentity(call.metadata);
+      if (!claims) {
+        return callback({ code: grpc.status.UNAUTHENTICATED });
+      }
+      if (!hasRole(claims, 'GATEWAY')) {
+        return callback({ code: grpc.status.PERMISSION_DENIED });
+      }
+      const account = accounts.find(
+        (a) => a.lineUserId === call.request.lineUserId,
+      );
+      if (!account) {
+        return callback({ code: grpc.status.NOT_FOUND });
+      }
+      callback(null, { userId: account.userId, roles: account.roles });
+    },
+    GetUserProfile: (
+      call: grpc.ServerUnaryCall<{ userId: string }, unknown>,
+      callback: grpc.sendUnaryData<unknown>,
+    ) => {
+      const claims = verifyIdentity(call.metadata);
+      if (!claims) {
+        return callback({ code: grpc.status.UNAUTHENTICATED });
+      }
+      calls.push(claims);
+      if (claims.sub !== call.request.userId && !hasRole(claims, 'ADMIN')) {
+        return callback({ code: grpc.status.PERMISSION_DENIED });
+      }
+      const account = accounts.find((a) => a.userId === call.request.userId);
+      if (!account) {
+        return callback({ code: grpc.status.NOT_FOUND });
+      }
+      callback(null, {
+        userId: account.userId,
+        lineUserId: account.lineUserId,
+        displayName: account.lineUserId,
+        status: 'USER_STATUS_ACTIVE',
+        roles: account.roles,
+        createdAt: { seconds: '0', nanos: 0 },
+      });
+    },
+  });
+
+  const port = await new Promise<number>((resolve, reject) =>
+    server.bindAsync(
+      '127.0.0.1:0',
+      grpc.ServerCredentials.createInsecure(),
+      (err, boundPort) => (err ? reject(err) : resolve(boundPort)),
+    ),
+  );
+  return { server, port, calls };
+}
+
+describe('Gateway identity (e2e)', () => {
+  let userService: Awaited<ReturnType<typeof startUserService>>;
+  let module: TestingModule;
+  let lineStrategy: LineStrategy;
+  let users: UserService;
+
+  beforeAll(async () => {
+    userService = await startUserService([owner, admin]);
+    process.env.GRPC_USER_ENDPOINT = `127.0.0.1:${userService.port}`;
+    process.env.GRPC_USER_VERSION = 'v1';
+    process.env.IDENTITY_KEY = IDENTITY_KEY;
+    process.env.LINE_CHANNEL_SECRET = 'LINE_CHANNEL_SECRET';
+
+    module = await Test.createTestingModule({
+      imports: [
+        ConfigModule.forRoot({
+          isGlobal: true,
+          load: [grpcConfig],
+          ignoreEnvFile: true,
+        }),
+        LoggerModule.forRoot({ pinoHttp: { level: 'silent' } }),
+        AuthModule,
+        UserModule,
+      ],
+    }).compile();
+    await module.init();
+
+    lineStrategy = module.get(LineStrategy);
+    users = module.get(UserService);
+  });
+
+  afterAll(async () => {
+    await module.close();
+    userService.server.forceShutdown();
+  });
+
+  beforeEach(() => {
+    userService.calls.length = 0;
+  });
+
+  const signIn = (lineUserId: string) =>
+    lineStrategy.validate({ sub: lineUserId } as VerifyIDToken);
+
+  it('signs the owner in as their internal user and serves their profile', async () => {
+    const identity = await signIn(owner.lineUserId);
+
+    expect(identity).toEqual({
+      id: owner.userId,
+      lineUserId: owner.lineUserId,
+      roles: owner.roles,
+    });
+
+    const profile = await users.getProfile(identity);
+    expect(profile.id).toBe(owner.userId);
+    expect(userService.calls).toEqual([
+      expect.objectContaining({ sub: owner.userId, roles: owner.roles }),
+    ]);
+  });
+
+  it('carries the admin role through to the services', async () => {
+    const identity = await signIn(admin.lineUserId);
+
+    expect(identity.roles).toEqual(['ADMIN']);
+
+    await users.getProfile(identity);
+    expect(userService.calls).toEqual([
+      expect.objectContaining({ sub: admin.userId, roles: ['ADMIN'] }),
+    ]);
+  });
+
+  it('refuses LINE accounts that never registered', async () => {
+    await expect(signIn('U-unknown')).rejects.toBeInstanceOf(
+      UnauthorizedException,
+    );
+    expect(userService.calls).toEqual([]);
+  });
+});
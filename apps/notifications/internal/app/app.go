@@ -0,0 +1,24 @@
+package app
+
+import (
+	"github.com/google/wire"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/app/query"
+)
+
+type App struct {
+	GetNotificationQueryHandler         *query.GetNotificationQueryHandler
+	ListNotificationsQueryHandler       *query.ListNotificationsQueryHandler
+	PublishEventCommandHandler          *command.PublishEventCommandHandler
+	DispatchNotificationsCommandHandler *command.DispatchNotificationsCommandHandler
+	MarkNotificationReadCommandHandler  *command.MarkNotificationReadCommandHandler
+}
+
+var AppModuleSet = wire.NewSet(
+	query.NewGetNotificationQueryHandler,
+	query.NewListNotificationsQueryHandler,
+	command.NewPublishEventCommandHandler,
+	command.NewDispatchNotificationsCommandHandler,
+	command.NewMarkNotificationReadCommandHandler,
+	wire.Struct(new(App), "*"),
+)
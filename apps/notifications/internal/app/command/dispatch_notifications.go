@@ -0,0 +1,154 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/delivery"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/notification"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errNoDriver = errors.New("no driver is configured for the channel")
+
+// DispatchNotificationsCommand delivers one batch of due notifications. The
+// dispatcher sends it on every tick.
+type DispatchNotificationsCommand struct{}
+
+type DispatchNotificationsDto struct {
+	Claimed  int `json:"claimed"`
+	Sent     int `json:"sent"`
+	Retrying int `json:"retrying"`
+	Failed   int `json:"failed"`
+	Deferred int `json:"deferred"`
+}
+
+type DispatchNotificationsCommandHandler struct {
+	notificationRepo notification.NotificationRepository
+	drivers          delivery.Drivers
+	retry            notification.RetryPolicy
+	logger           *slog.Logger
+	config           *config.Config
+	tracer           trace.Tracer
+}
+
+func NewDispatchNotificationsCommandHandler(
+	notificationRepo notification.NotificationRepository,
+	drivers delivery.Drivers,
+	retry notification.RetryPolicy,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *DispatchNotificationsCommandHandler {
+	return &DispatchNotificationsCommandHandler{
+		notificationRepo: notificationRepo,
+		drivers:          drivers,
+		retry:            retry,
+		logger:           logger.With(slog.String("component", "DispatchNotificationsCommandHandler")),
+		config:           cfg,
+		tracer:           otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *DispatchNotificationsCommandHandler) Handle(ctx context.Context, cmd DispatchNotificationsCommand) (*DispatchNotificationsDto, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := h.tracer.Start(ctx, "DispatchNotificationsCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindInternal))
+	defer span.End()
+
+	dispatch := h.config.Notifications.Dispatch
+	ns, err := h.notificationRepo.ClaimDue(ctx, dispatch.Lease, dispatch.BatchSize)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to claim due notifications")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Error("Failed to claim due notifications", slog.Any("error", err))
+		return nil, err
+	}
+
+	dto := &DispatchNotificationsDto{Claimed: len(ns)}
+	for _, n := range ns {
+		// A notification that could not be saved keeps its lease and is
+		// picked up again once it runs out.
+		if err := h.dispatch(ctx, n, dto); err != nil {
+			logger.Error("Failed to dispatch notification", "notification_id", string(n.ID), slog.Any("error", err))
+		}
+	}
+
+	span.SetStatus(codes.Ok, "Notifications dispatched")
+	span.SetAttributes(
+		attribute.Int("dispatch.claimed", dto.Claimed),
+		attribute.Int("dispatch.sent", dto.Sent),
+		attribute.Int("dispatch.retrying", dto.Retrying),
+		attribute.Int("dispatch.failed", dto.Failed),
+		attribute.Int("dispatch.deferred", dto.Deferred),
+	)
+	if dto.Claimed > 0 {
+		logger.Info("Notifications dispatched.",
+			"claimed", dto.Claimed, "sent", dto.Sent, "retrying", dto.Retrying, "failed", dto.Failed, "deferred", dto.Deferred)
+	}
+	return dto, nil
+}
+
+func (h *DispatchNotificationsCommandHandler) dispatch(ctx context.Context, n *notification.Notification, dto *DispatchNotificationsDto) error {
+	limit := h.config.Notifications.RateLimit
+	sent, err := h.notificationRepo.CountSentSince(ctx, n.UserID, n.Channel, time.Now().Add(-limit.Window))
+	if err != nil {
+		return err
+	}
+	if sent >= limit.PerChannel {
+		// Check again once a slot of the window has likely freed up, rather
+		// than holding everything back for a whole window.
+		if err := n.Defer(time.Now().Add(limit.Window / time.Duration(limit.PerChannel))); err != nil {
+			return err
+		}
+		if err := h.notificationRepo.Save(ctx, n); err != nil {
+			return err
+		}
+		dto.Deferred++
+		return nil
+	}
+
+	driver, ok := h.drivers[n.Channel]
+	if !ok {
+		err = n.RecordFailure(errNoDriver, true, h.retry)
+	} else {
+		var ref string
+		ref, err = driver.Send(ctx, delivery.Message{
+			NotificationID: n.ID,
+			UserID:         n.UserID,
+			To:             n.Recipient,
+			Subject:        n.Subject,
+			Body:           n.Body,
+		})
+		if err == nil {
+			err = n.RecordSuccess(ref)
+		} else {
+			err = n.RecordFailure(err, errors.Is(err, delivery.ErrPermanent), h.retry)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if err := h.notificationRepo.Save(ctx, n); err != nil {
+		return err
+	}
+
+	switch n.Status {
+	case notification.StatusSent:
+		dto.Sent++
+	case notification.StatusFailed:
+		dto.Failed++
+	default:
+		dto.Retrying++
+	}
+	return nil
+}
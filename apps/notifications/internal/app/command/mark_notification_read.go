@@ -0,0 +1,104 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/notification"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type MarkNotificationReadCommand struct {
+	NotificationID ids.NotificationID `json:"notificationId" validate:"required,uuid"`
+	Actor          notification.Actor `json:"-"`
+}
+
+type MarkNotificationReadDto struct {
+	NotificationID string    `json:"notificationId"`
+	ReadAt         time.Time `json:"readAt"`
+}
+
+type MarkNotificationReadCommandHandler struct {
+	notificationRepo notification.NotificationRepository
+	logger           *slog.Logger
+	config           *config.Config
+	tracer           trace.Tracer
+}
+
+func NewMarkNotificationReadCommandHandler(
+	notificationRepo notification.NotificationRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *MarkNotificationReadCommandHandler {
+	return &MarkNotificationReadCommandHandler{
+		notificationRepo: notificationRepo,
+		logger:           logger.With(slog.String("component", "MarkNotificationReadCommandHandler")),
+		config:           cfg,
+		tracer:           otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *MarkNotificationReadCommandHandler) Handle(ctx context.Context, cmd MarkNotificationReadCommand) (*MarkNotificationReadDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("notification_id", string(cmd.NotificationID)))
+
+	ctx, span := h.tracer.Start(ctx, "MarkNotificationReadCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("notification.id", string(cmd.NotificationID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	if cmd.Actor.UserID == "" {
+		span.SetStatus(codes.Error, "Anonymous read receipt")
+		span.SetAttributes(attribute.String("error.type", string(notification.ErrSignInRequired.Code)))
+		return nil, notification.ErrSignInRequired
+	}
+
+	n, err := h.notificationRepo.FindByID(ctx, cmd.NotificationID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve notification")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve notification", slog.Any("error", err))
+		return nil, err
+	}
+	// Other users' notifications are reported as missing.
+	if n.UserID != cmd.Actor.UserID {
+		span.SetStatus(codes.Error, "Notification not visible to caller")
+		span.SetAttributes(attribute.String("error.type", string(notification.ErrNotificationNotFound.Code)))
+		logger.Warn("Hid notification from caller", "actor_id", string(cmd.Actor.UserID))
+		return nil, notification.ErrNotificationNotFound
+	}
+
+	alreadyRead := n.ReadAt != nil
+	if err := n.MarkRead(cmd.Actor); err != nil {
+		span.SetStatus(codes.Error, "Notification cannot be marked as read")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "domain_validation_error"))
+		logger.Warn("Notification cannot be marked as read", slog.Any("error", err))
+		return nil, err
+	}
+	if !alreadyRead {
+		if err := h.notificationRepo.Save(ctx, n); err != nil {
+			span.SetStatus(codes.Error, "Failed to save notification")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+			logger.Error("Failed to save notification", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	span.SetStatus(codes.Ok, "Notification marked as read")
+	logger.Info("Notification marked as read.")
+	return &MarkNotificationReadDto{NotificationID: string(n.ID), ReadAt: *n.ReadAt}, nil
+}
@@ -0,0 +1,167 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/notification"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/template"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type RecipientInput struct {
+	Email       *string `json:"email,omitempty" validate:"omitempty,email,max=254"`
+	PhoneNumber *string `json:"phoneNumber,omitempty" validate:"omitempty,e164"`
+	LineUserID  *string `json:"lineUserId,omitempty" validate:"omitempty,max=100"`
+	PushToken   *string `json:"pushToken,omitempty" validate:"omitempty,max=512"`
+}
+
+// PublishEventCommand tells the user an event is about. Publishing the same
+// event ID again is a no-op, so services can deliver events at least once.
+type PublishEventCommand struct {
+	EventID   ids.EventID    `json:"eventId" validate:"required,max=255"`
+	Type      string         `json:"type" validate:"required,max=100"`
+	UserID    ids.UserID     `json:"userId" validate:"required,uuid"`
+	Recipient RecipientInput `json:"recipient"`
+	// Data fills in the template; every key the template uses is required.
+	Data  map[string]any     `json:"data"`
+	Actor notification.Actor `json:"-"`
+}
+
+type PublishEventDto struct {
+	// NotificationIDs are the notifications this call created; empty when
+	// the event had already been published.
+	NotificationIDs []string `json:"notificationIds"`
+	Duplicate       bool     `json:"duplicate"`
+}
+
+type PublishEventCommandHandler struct {
+	notificationRepo notification.NotificationRepository
+	preferences      notification.PreferenceReader
+	catalogue        *template.Catalogue
+	logger           *slog.Logger
+	config           *config.Config
+	tracer           trace.Tracer
+}
+
+func NewPublishEventCommandHandler(
+	notificationRepo notification.NotificationRepository,
+	preferences notification.PreferenceReader,
+	catalogue *template.Catalogue,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *PublishEventCommandHandler {
+	return &PublishEventCommandHandler{
+		notificationRepo: notificationRepo,
+		preferences:      preferences,
+		catalogue:        catalogue,
+		logger:           logger.With(slog.String("component", "PublishEventCommandHandler")),
+		config:           cfg,
+		tracer:           otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *PublishEventCommandHandler) Handle(ctx context.Context, cmd PublishEventCommand) (*PublishEventDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(
+		slog.String("event_id", string(cmd.EventID)),
+		slog.String("event_type", cmd.Type),
+	)
+
+	ctx, span := h.tracer.Start(ctx, "PublishEventCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("event.id", string(cmd.EventID)),
+		attribute.String("event.type", cmd.Type),
+		attribute.String("user.id", string(cmd.UserID)),
+	)
+
+	// Services call without a user identity, or on behalf of the user the
+	// event is about; a request the gateway relayed for a signed-in user
+	// must not notify somebody else.
+	if cmd.Actor.UserID != "" && cmd.Actor.UserID != cmd.UserID && !cmd.Actor.IsAdmin {
+		span.SetStatus(codes.Error, "Event published on behalf of a user")
+		span.SetAttributes(attribute.String("error.type", string(notification.ErrPublishForbidden.Code)))
+		logger.Warn(notification.ErrPublishForbidden.Message, "actor_id", string(cmd.Actor.UserID))
+		return nil, notification.ErrPublishForbidden
+	}
+
+	key, err := notification.TemplateFor(cmd.Type)
+	if err != nil {
+		span.SetStatus(codes.Error, "Unknown event type")
+		span.SetAttributes(attribute.String("error.type", string(notification.ErrUnknownEventType.Code)))
+		logger.Warn("No notification is sent for event type.")
+		return nil, err
+	}
+
+	prefs, err := h.preferences.PreferencesOf(ctx, cmd.UserID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to read notification preferences")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "preference_lookup_error"))
+		logger.Error("Failed to read notification preferences", slog.Any("error", err))
+		return nil, err
+	}
+
+	content, locale, err := h.catalogue.Render(key, prefs.Locale, cmd.Data)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to render template")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "template_render_error"))
+		logger.Warn("Failed to render notification template", "template", key, slog.Any("error", err))
+		return nil, err
+	}
+
+	evt := notification.Event{
+		ID:     cmd.EventID,
+		Type:   cmd.Type,
+		UserID: cmd.UserID,
+		Recipient: notification.Recipient{
+			Email:       cmd.Recipient.Email,
+			PhoneNumber: cmd.Recipient.PhoneNumber,
+			LineUserID:  cmd.Recipient.LineUserID,
+			PushToken:   cmd.Recipient.PushToken,
+		},
+		Data: cmd.Data,
+	}
+
+	channels := notification.ChannelsFor(*prefs, evt.Recipient)
+	ns := make([]*notification.Notification, 0, len(channels))
+	for _, c := range channels {
+		addr, _ := evt.Recipient.AddressFor(c)
+		ns = append(ns, notification.NewNotification(evt, c, addr, locale, content))
+	}
+
+	created, err := h.notificationRepo.CreateMany(ctx, ns)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to save notifications")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Error("Failed to save notifications", slog.Any("error", err))
+		return nil, err
+	}
+
+	dto := &PublishEventDto{
+		NotificationIDs: make([]string, 0, len(created)),
+		Duplicate:       len(created) == 0,
+	}
+	for _, n := range created {
+		dto.NotificationIDs = append(dto.NotificationIDs, string(n.ID))
+	}
+
+	span.SetStatus(codes.Ok, "Event published")
+	span.SetAttributes(
+		attribute.Int("notification.count", len(created)),
+		attribute.Bool("event.duplicate", dto.Duplicate),
+	)
+	logger.Info("Event published.", "notifications", len(created), "locale", locale)
+	return dto, nil
+}
@@ -0,0 +1,126 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/notification"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GetNotificationQuery struct {
+	NotificationID ids.NotificationID `json:"notificationId" validate:"required,uuid"`
+	Actor          notification.Actor `json:"-"`
+}
+
+type AttemptDTO struct {
+	Number    int       `json:"number"`
+	Succeeded bool      `json:"succeeded"`
+	Error     *string   `json:"error,omitempty"`
+	At        time.Time `json:"at"`
+}
+
+// NotificationDTO is a notification with its delivery history, oldest
+// attempt first.
+type NotificationDTO struct {
+	ID            string               `json:"id"`
+	UserID        string               `json:"userId"`
+	EventID       string               `json:"eventId"`
+	EventType     string               `json:"eventType"`
+	Channel       notification.Channel `json:"channel"`
+	Locale        string               `json:"locale"`
+	Subject       string               `json:"subject"`
+	Body          string               `json:"body"`
+	Status        notification.Status  `json:"status"`
+	Attempts      []AttemptDTO         `json:"attempts"`
+	NextAttemptAt *time.Time           `json:"nextAttemptAt,omitempty"`
+	SentAt        *time.Time           `json:"sentAt,omitempty"`
+	ReadAt        *time.Time           `json:"readAt,omitempty"`
+	CreatedAt     time.Time            `json:"createdAt"`
+}
+
+type GetNotificationQueryHandler struct {
+	notificationRepo notification.NotificationRepository
+	logger           *slog.Logger
+	config           *config.Config
+	tracer           trace.Tracer
+}
+
+func NewGetNotificationQueryHandler(
+	notificationRepo notification.NotificationRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *GetNotificationQueryHandler {
+	return &GetNotificationQueryHandler{
+		notificationRepo: notificationRepo,
+		logger:           logger.With(slog.String("component", "GetNotificationQueryHandler")),
+		config:           cfg,
+		tracer:           otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *GetNotificationQueryHandler) Handle(ctx context.Context, qry GetNotificationQuery) (*NotificationDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("notification_id", string(qry.NotificationID)))
+
+	ctx, span := h.tracer.Start(ctx, "GetNotificationQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("notification.id", string(qry.NotificationID)))
+
+	n, err := h.notificationRepo.FindByID(ctx, qry.NotificationID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve notification")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve notification", slog.Any("error", err))
+		return nil, err
+	}
+
+	// Notifications are private to their recipient; those of others are
+	// reported as missing.
+	if !n.CanView(qry.Actor) {
+		span.SetStatus(codes.Error, "Notification not visible to caller")
+		span.SetAttributes(attribute.String("error.type", string(notification.ErrNotificationNotFound.Code)))
+		logger.Warn("Hid notification from caller", "actor_id", string(qry.Actor.UserID))
+		return nil, notification.ErrNotificationNotFound
+	}
+
+	span.SetStatus(codes.Ok, "Notification retrieved")
+	return newNotificationDTO(n), nil
+}
+
+func newNotificationDTO(n *notification.Notification) *NotificationDTO {
+	dto := &NotificationDTO{
+		ID:            string(n.ID),
+		UserID:        string(n.UserID),
+		EventID:       string(n.EventID),
+		EventType:     n.EventType,
+		Channel:       n.Channel,
+		Locale:        n.Locale,
+		Subject:       n.Subject,
+		Body:          n.Body,
+		Status:        n.Status,
+		Attempts:      make([]AttemptDTO, 0, len(n.Attempts)),
+		NextAttemptAt: n.NextAttemptAt,
+		SentAt:        n.SentAt,
+		ReadAt:        n.ReadAt,
+		CreatedAt:     n.CreatedAt,
+	}
+	for _, a := range n.Attempts {
+		dto.Attempts = append(dto.Attempts, AttemptDTO{
+			Number:    a.Number,
+			Succeeded: a.Succeeded(),
+			Error:     a.Error,
+			At:        a.At,
+		})
+	}
+	return dto
+}
@@ -0,0 +1,152 @@
+package query
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/notification"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListNotificationsQuery lists a user's in-app inbox, newest first.
+type ListNotificationsQuery struct {
+	UserID     ids.UserID         `json:"userId" validate:"required,uuid"`
+	UnreadOnly bool               `json:"unreadOnly"`
+	PageSize   int                `json:"-"`
+	PageToken  string             `json:"-"`
+	Actor      notification.Actor `json:"-"`
+}
+
+type ListNotificationsResultDTO struct {
+	Notifications []NotificationDTO `json:"notifications"`
+	NextPageToken string            `json:"nextPageToken,omitempty"`
+}
+
+type ListNotificationsQueryHandler struct {
+	notificationRepo notification.NotificationRepository
+	logger           *slog.Logger
+	config           *config.Config
+	tracer           trace.Tracer
+}
+
+func NewListNotificationsQueryHandler(
+	notificationRepo notification.NotificationRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ListNotificationsQueryHandler {
+	return &ListNotificationsQueryHandler{
+		notificationRepo: notificationRepo,
+		logger:           logger.With(slog.String("component", "ListNotificationsQueryHandler")),
+		config:           cfg,
+		tracer:           otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *ListNotificationsQueryHandler) Handle(ctx context.Context, qry ListNotificationsQuery) (*ListNotificationsResultDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(qry.UserID)))
+
+	ctx, span := h.tracer.Start(ctx, "ListNotificationsQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	if qry.Actor.UserID == "" {
+		span.SetStatus(codes.Error, "Anonymous inbox listing")
+		span.SetAttributes(attribute.String("error.type", string(notification.ErrSignInRequired.Code)))
+		return nil, notification.ErrSignInRequired
+	}
+	if qry.Actor.UserID != qry.UserID && !qry.Actor.IsAdmin {
+		span.SetStatus(codes.Error, "Inbox not visible to caller")
+		span.SetAttributes(attribute.String("error.type", string(notification.ErrInboxHidden.Code)))
+		logger.Warn(notification.ErrInboxHidden.Message, "actor_id", string(qry.Actor.UserID))
+		return nil, notification.ErrInboxHidden
+	}
+
+	if qry.PageSize < 0 {
+		span.SetStatus(codes.Error, "Invalid page size")
+		return nil, ErrInvalidPageSize
+	}
+	pageSize := qry.PageSize
+	if pageSize == 0 {
+		pageSize = defaultListPageSize
+	}
+	pageSize = min(pageSize, maxListPageSize)
+
+	fingerprint, err := qry.fingerprint()
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to fingerprint listing")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	criteria := notification.InboxCriteria{
+		UserID:     qry.UserID,
+		UnreadOnly: qry.UnreadOnly,
+		// One extra row tells us whether another page exists.
+		Limit: pageSize + 1,
+	}
+	if qry.PageToken != "" {
+		pt, err := decodePageToken(qry.PageToken, fingerprint)
+		if err != nil {
+			span.SetStatus(codes.Error, "Invalid page token")
+			logger.Warn("Rejected inbox page token", slog.Any("error", err))
+			return nil, ErrInvalidPageToken
+		}
+		criteria.After = &notification.ListCursor{CreatedAt: pt.CreatedAt, NotificationID: ids.NotificationID(pt.ID)}
+	}
+
+	span.SetAttributes(
+		attribute.String("user.id", string(qry.UserID)),
+		attribute.Int("query.page_size", pageSize),
+		attribute.Bool("query.unread_only", qry.UnreadOnly),
+		attribute.Bool("query.has_page_token", qry.PageToken != ""),
+	)
+
+	ns, err := h.notificationRepo.ListInbox(ctx, criteria)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list inbox")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to list inbox in repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	resp := &ListNotificationsResultDTO{Notifications: make([]NotificationDTO, 0, min(len(ns), pageSize))}
+	if len(ns) > pageSize {
+		ns = ns[:pageSize]
+		last := ns[len(ns)-1]
+		token, err := encodePageToken(last.CreatedAt, string(last.ID), fingerprint)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to encode page token")
+			span.RecordError(err)
+			return nil, err
+		}
+		resp.NextPageToken = token
+	}
+	for _, n := range ns {
+		resp.Notifications = append(resp.Notifications, *newNotificationDTO(n))
+	}
+
+	span.SetStatus(codes.Ok, "Inbox listed")
+	span.SetAttributes(attribute.Int("query.result_count", len(resp.Notifications)))
+	return resp, nil
+}
+
+// fingerprint hashes every parameter except the paging fields so a token
+// cannot be reused with different filters.
+func (q ListNotificationsQuery) fingerprint() (string, error) {
+	payload, err := json.Marshal(q)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal inbox listing: %w", err)
+	}
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:8]), nil
+}
@@ -0,0 +1,55 @@
+package query
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+)
+
+const (
+	defaultListPageSize = 20
+	maxListPageSize     = 100
+)
+
+var (
+	ErrInvalidPageSize  = errs.NewWithReason(errs.CodeInvalidArgument, "INVALID_PAGE_SIZE", "page_size must not be negative")
+	ErrInvalidPageToken = errs.NewWithReason(errs.CodeInvalidArgument, "INVALID_PAGE_TOKEN", "page_token is invalid or does not match the request")
+)
+
+// pageToken is the decoded form of the opaque token handed to clients: the
+// keyset position of the last item of a page. The filter fingerprint makes
+// sure a token is only replayed against the same listing it was issued for.
+type pageToken struct {
+	CreatedAt time.Time `json:"v"`
+	ID        string    `json:"id"`
+	Filter    string    `json:"f"`
+}
+
+func encodePageToken(createdAt time.Time, id string, fingerprint string) (string, error) {
+	payload, err := json.Marshal(pageToken{CreatedAt: createdAt, ID: id, Filter: fingerprint})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal page token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(payload), nil
+}
+
+func decodePageToken(token string, fingerprint string) (*pageToken, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode page token: %w", err)
+	}
+	var pt pageToken
+	if err := json.Unmarshal(payload, &pt); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal page token: %w", err)
+	}
+	if pt.Filter != fingerprint {
+		return nil, fmt.Errorf("page token was issued for a different listing")
+	}
+	if pt.ID == "" {
+		return nil, fmt.Errorf("page token is missing the item ID")
+	}
+	return &pt, nil
+}
@@ -0,0 +1,42 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/notification"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/shared/ids"
+)
+
+// ErrPermanent marks a driver error that retrying cannot fix, such as an
+// unknown address. Wrap it with fmt.Errorf("...: %w", ErrPermanent).
+var ErrPermanent = errors.New("permanent delivery failure")
+
+type Message struct {
+	// NotificationID doubles as the idempotency key at providers that
+	// support one.
+	NotificationID ids.NotificationID
+	UserID         ids.UserID
+	To             string
+	Subject        string
+	Body           string
+}
+
+// Driver sends messages through one channel's provider.
+type Driver interface {
+	Channel() notification.Channel
+
+	// Send delivers a message and returns the provider's message ID.
+	Send(ctx context.Context, msg Message) (string, error)
+}
+
+// Drivers indexes the configured drivers by channel.
+type Drivers map[notification.Channel]Driver
+
+func NewDrivers(drivers ...Driver) Drivers {
+	ds := make(Drivers, len(drivers))
+	for _, d := range drivers {
+		ds[d.Channel()] = d
+	}
+	return ds
+}
@@ -0,0 +1,62 @@
+package notification
+
+import "strings"
+
+// Channel is a way of reaching a user.
+type Channel string
+
+const (
+	// ChannelInApp is the inbox in the apps; it is always on and needs no
+	// address, so storing the notification is delivering it.
+	ChannelInApp Channel = "IN_APP"
+	ChannelPush  Channel = "PUSH"
+	ChannelEmail Channel = "EMAIL"
+	ChannelSMS   Channel = "SMS"
+	ChannelLINE  Channel = "LINE"
+)
+
+// Channels lists every channel in the order notifications are created.
+var Channels = []Channel{ChannelInApp, ChannelPush, ChannelLINE, ChannelEmail, ChannelSMS}
+
+func (c Channel) IsValid() bool {
+	switch c {
+	case ChannelInApp, ChannelPush, ChannelEmail, ChannelSMS, ChannelLINE:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseChannel accepts the lower-case names the users service stores in the
+// notificationChannels preference as well as the upper-case constants.
+func ParseChannel(s string) (Channel, error) {
+	c := Channel(strings.ToUpper(strings.ReplaceAll(s, "-", "_")))
+	if !c.IsValid() {
+		return "", ErrInvalidChannel
+	}
+	return c, nil
+}
+
+// Content picks what a channel shows from a rendered template: SMS and push
+// have room for the short text only, LINE messages have no subject line.
+func (c Channel) Content(r Rendered) (subject, body string) {
+	switch c {
+	case ChannelSMS:
+		return "", r.Short
+	case ChannelPush:
+		return r.Subject, r.Short
+	case ChannelLINE:
+		return "", r.Body
+	default:
+		return r.Subject, r.Body
+	}
+}
+
+// Rendered is a template rendered for one user and locale.
+type Rendered struct {
+	Subject string
+	Body    string
+	// Short is the one-line variant for SMS and push; it defaults to the
+	// subject.
+	Short string
+}
@@ -0,0 +1,17 @@
+package notification
+
+import errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+
+var (
+	ErrNotificationNotFound = errs.NewWithReason(errs.CodeNotFound, "NOTIFICATION_NOT_FOUND", "notification not found")
+	ErrSignInRequired       = errs.NewWithReason(errs.CodeUnauthorized, "NOTIFICATION_SIGN_IN_REQUIRED", "sign in to read notifications")
+	ErrNotRecipient         = errs.NewWithReason(errs.CodeForbidden, "NOTIFICATION_NOT_RECIPIENT", "only the recipient can change a notification")
+	ErrInboxHidden          = errs.NewWithReason(errs.CodeForbidden, "NOTIFICATION_INBOX_HIDDEN", "only the owner or an admin can read an inbox")
+	ErrPublishForbidden     = errs.NewWithReason(errs.CodeForbidden, "NOTIFICATION_PUBLISH_FORBIDDEN", "events are published by services, not on behalf of other users")
+	ErrUnknownEventType     = errs.NewWithReason(errs.CodeInvalidArgument, "NOTIFICATION_UNKNOWN_EVENT_TYPE", "no notification is sent for this event type")
+	ErrInvalidChannel       = errs.NewWithReason(errs.CodeInvalidArgument, "NOTIFICATION_INVALID_CHANNEL", "invalid notification channel")
+	ErrNotInApp             = errs.NewWithReason(errs.CodeInvalidArgument, "NOTIFICATION_NOT_IN_APP", "only in-app notifications can be marked as read")
+	ErrNotPending           = errs.NewWithReason(errs.CodeFailedPrecondition, "NOTIFICATION_NOT_PENDING", "the notification is not waiting to be delivered")
+	ErrNotificationChanged  = errs.NewWithReason(errs.CodeAborted, "NOTIFICATION_CHANGED", "the notification changed while processing the request; retry it")
+	ErrPreferencesNotFound  = errs.NewWithReason(errs.CodeNotFound, "NOTIFICATION_PREFERENCES_NOT_FOUND", "notification preferences not found")
+)
@@ -0,0 +1,85 @@
+package notification
+
+import "github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/shared/ids"
+
+// Event types other services publish, named after their domain events.
+const (
+	EventUserCreated                  = "UserCreated"
+	EventIdentityVerificationApproved = "IdentityVerificationApproved"
+	EventIdentityVerificationRejected = "IdentityVerificationRejected"
+	EventBidAccepted                  = "BidAccepted"
+	EventEscrowReleased               = "EscrowReleased"
+)
+
+// templates routes each event type to the template it is rendered with.
+var templates = map[string]string{
+	EventUserCreated:                  "welcome",
+	EventIdentityVerificationApproved: "verification_approved",
+	EventIdentityVerificationRejected: "verification_rejected",
+	EventBidAccepted:                  "bid_accepted",
+	EventEscrowReleased:               "payout_released",
+}
+
+// TemplateFor returns the template key for an event type.
+func TemplateFor(eventType string) (string, error) {
+	key, ok := templates[eventType]
+	if !ok {
+		return "", ErrUnknownEventType
+	}
+	return key, nil
+}
+
+// Recipient carries the addresses the publishing service knows for the
+// user the event is about. Channels without an address are skipped.
+type Recipient struct {
+	Email       *string
+	PhoneNumber *string
+	LineUserID  *string
+	PushToken   *string
+}
+
+// AddressFor returns the address used on a channel; in-app needs none.
+func (r Recipient) AddressFor(c Channel) (string, bool) {
+	var addr *string
+	switch c {
+	case ChannelInApp:
+		return "", true
+	case ChannelPush:
+		addr = r.PushToken
+	case ChannelEmail:
+		addr = r.Email
+	case ChannelSMS:
+		addr = r.PhoneNumber
+	case ChannelLINE:
+		addr = r.LineUserID
+	}
+	if addr == nil || *addr == "" {
+		return "", false
+	}
+	return *addr, true
+}
+
+// Event is a domain event of another service that a user is told about.
+type Event struct {
+	ID        ids.EventID
+	Type      string
+	UserID    ids.UserID
+	Recipient Recipient
+	Data      map[string]any
+}
+
+// ChannelsFor picks the channels an event goes out on: the in-app inbox
+// always, push whenever the device registered a token, and the channels the
+// user opted into that the event has an address for.
+func ChannelsFor(prefs Preferences, r Recipient) []Channel {
+	chosen := make([]Channel, 0, len(Channels))
+	for _, c := range Channels {
+		if _, ok := r.AddressFor(c); !ok {
+			continue
+		}
+		if c == ChannelInApp || c == ChannelPush || prefs.Wants(c) {
+			chosen = append(chosen, c)
+		}
+	}
+	return chosen
+}
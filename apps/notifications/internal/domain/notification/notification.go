@@ -0,0 +1,227 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/shared/ids"
+)
+
+type Status string
+
+const (
+	// StatusPending waits for the dispatcher, either for the first attempt,
+	// a retry, or the user's rate limit to reset.
+	StatusPending Status = "PENDING"
+	StatusSent    Status = "SENT"
+	// StatusFailed gave up: the driver refused the message outright or every
+	// attempt failed.
+	StatusFailed Status = "FAILED"
+)
+
+// Actor is the user performing an action on a notification. The zero value
+// is an anonymous caller.
+type Actor struct {
+	UserID  ids.UserID
+	IsAdmin bool
+}
+
+// Attempt is one delivery try through a channel driver.
+type Attempt struct {
+	Number int
+	Error  *string
+	At     time.Time
+}
+
+func (a Attempt) Succeeded() bool {
+	return a.Error == nil
+}
+
+// Notification is one event told to one user on one channel. An event
+// produces at most one notification per channel, whatever number of times
+// it is published.
+type Notification struct {
+	ID        ids.NotificationID
+	UserID    ids.UserID
+	EventID   ids.EventID
+	EventType string
+	Channel   Channel
+	// Recipient is the channel address; empty for in-app.
+	Recipient     string
+	Locale        string
+	Subject       string
+	Body          string
+	Status        Status
+	Attempts      []Attempt
+	NextAttemptAt *time.Time
+	ProviderRef   *string
+	SentAt        *time.Time
+	ReadAt        *time.Time
+	Version       int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+
+	pendingAttempts []Attempt
+}
+
+// NewNotification renders an event for one channel. In-app notifications
+// are delivered by being stored; the rest wait for the dispatcher.
+func NewNotification(evt Event, channel Channel, recipient, locale string, content Rendered) *Notification {
+	now := time.Now()
+	subject, body := channel.Content(content)
+	n := &Notification{
+		ID:        ids.NewNotificationID(),
+		UserID:    evt.UserID,
+		EventID:   evt.ID,
+		EventType: evt.Type,
+		Channel:   channel,
+		Recipient: recipient,
+		Locale:    locale,
+		Subject:   subject,
+		Body:      body,
+		Status:    StatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if channel == ChannelInApp {
+		n.Status = StatusSent
+		n.SentAt = &now
+	} else {
+		n.NextAttemptAt = &now
+	}
+	return n
+}
+
+func NewNotificationFromRepository(
+	id ids.NotificationID,
+	userID ids.UserID,
+	eventID ids.EventID,
+	eventType string,
+	channel Channel,
+	recipient string,
+	locale string,
+	subject string,
+	body string,
+	status Status,
+	attempts []Attempt,
+	nextAttemptAt *time.Time,
+	providerRef *string,
+	sentAt *time.Time,
+	readAt *time.Time,
+	version int,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Notification {
+	return &Notification{
+		ID:            id,
+		UserID:        userID,
+		EventID:       eventID,
+		EventType:     eventType,
+		Channel:       channel,
+		Recipient:     recipient,
+		Locale:        locale,
+		Subject:       subject,
+		Body:          body,
+		Status:        status,
+		Attempts:      attempts,
+		NextAttemptAt: nextAttemptAt,
+		ProviderRef:   providerRef,
+		SentAt:        sentAt,
+		ReadAt:        readAt,
+		Version:       version,
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedAt,
+	}
+}
+
+func (n *Notification) CanView(a Actor) bool {
+	return a.IsAdmin || (a.UserID != "" && a.UserID == n.UserID)
+}
+
+// LastError is the error of the latest failed attempt, if the latest
+// attempt failed.
+func (n *Notification) LastError() *string {
+	if len(n.Attempts) == 0 {
+		return nil
+	}
+	return n.Attempts[len(n.Attempts)-1].Error
+}
+
+// Defer holds the notification back until the user's rate limit resets.
+// It does not count as an attempt.
+func (n *Notification) Defer(until time.Time) error {
+	if n.Status != StatusPending {
+		return ErrNotPending
+	}
+	n.NextAttemptAt = &until
+	n.UpdatedAt = time.Now()
+	return nil
+}
+
+// RecordSuccess marks the notification delivered by the channel driver.
+func (n *Notification) RecordSuccess(providerRef string) error {
+	if n.Status != StatusPending {
+		return ErrNotPending
+	}
+	now := time.Now()
+	n.addAttempt(Attempt{Number: len(n.Attempts) + 1, At: now})
+	n.Status = StatusSent
+	n.SentAt = &now
+	n.NextAttemptAt = nil
+	if providerRef != "" {
+		n.ProviderRef = &providerRef
+	}
+	n.UpdatedAt = now
+	return nil
+}
+
+// RecordFailure schedules a retry under the policy, or gives up when the
+// failure is permanent or the attempts are used up.
+func (n *Notification) RecordFailure(cause error, permanent bool, policy RetryPolicy) error {
+	if n.Status != StatusPending {
+		return ErrNotPending
+	}
+	now := time.Now()
+	msg := cause.Error()
+	n.addAttempt(Attempt{Number: len(n.Attempts) + 1, Error: &msg, At: now})
+	if permanent || len(n.Attempts) >= policy.MaxAttempts {
+		n.Status = StatusFailed
+		n.NextAttemptAt = nil
+	} else {
+		next := now.Add(policy.Backoff(len(n.Attempts)))
+		n.NextAttemptAt = &next
+	}
+	n.UpdatedAt = now
+	return nil
+}
+
+// MarkRead records that the recipient opened an in-app notification.
+// Marking it again keeps the first read time.
+func (n *Notification) MarkRead(a Actor) error {
+	if a.UserID == "" || a.UserID != n.UserID {
+		return ErrNotRecipient
+	}
+	if n.Channel != ChannelInApp {
+		return ErrNotInApp
+	}
+	if n.ReadAt != nil {
+		return nil
+	}
+	now := time.Now()
+	n.ReadAt = &now
+	n.UpdatedAt = now
+	return nil
+}
+
+func (n *Notification) addAttempt(a Attempt) {
+	n.Attempts = append(n.Attempts, a)
+	n.pendingAttempts = append(n.pendingAttempts, a)
+}
+
+// PendingAttempts are the attempts the repository has not stored yet.
+func (n *Notification) PendingAttempts() []Attempt {
+	return n.pendingAttempts
+}
+
+func (n *Notification) ClearPendingAttempts() {
+	n.pendingAttempts = nil
+}
@@ -0,0 +1,24 @@
+package notification
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/shared/ids"
+)
+
+// Preferences are the parts of a user's profile preferences, owned by the
+// users service, that decide how they are notified.
+type Preferences struct {
+	Locale   string
+	Channels []Channel
+}
+
+func (p Preferences) Wants(c Channel) bool {
+	return slices.Contains(p.Channels, c)
+}
+
+// PreferenceReader looks up a user's notification preferences.
+type PreferenceReader interface {
+	PreferencesOf(ctx context.Context, userID ids.UserID) (*Preferences, error)
+}
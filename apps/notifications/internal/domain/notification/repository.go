@@ -0,0 +1,44 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/shared/ids"
+)
+
+// ListCursor is the position after which the next inbox page starts.
+type ListCursor struct {
+	CreatedAt      time.Time
+	NotificationID ids.NotificationID
+}
+
+// InboxCriteria selects a user's in-app notifications, newest first.
+type InboxCriteria struct {
+	UserID     ids.UserID
+	UnreadOnly bool
+	After      *ListCursor
+	Limit      int
+}
+
+type NotificationRepository interface {
+	FindByID(ctx context.Context, id ids.NotificationID) (*Notification, error)
+
+	// CreateMany stores new notifications in one transaction, skipping those
+	// whose event already produced a notification on that channel. It
+	// returns the ones it stored.
+	CreateMany(ctx context.Context, ns []*Notification) ([]*Notification, error)
+
+	// ClaimDue returns up to limit pending notifications that are due and
+	// pushes their next attempt out by the lease, so concurrent dispatchers
+	// never pick the same one.
+	ClaimDue(ctx context.Context, lease time.Duration, limit int) ([]*Notification, error)
+
+	// CountSentSince counts notifications delivered to a user on a channel
+	// since the given time, for rate limiting.
+	CountSentSince(ctx context.Context, userID ids.UserID, channel Channel, since time.Time) (int, error)
+
+	ListInbox(ctx context.Context, criteria InboxCriteria) ([]*Notification, error)
+
+	Save(ctx context.Context, n *Notification) error
+}
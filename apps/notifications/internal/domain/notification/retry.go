@@ -0,0 +1,20 @@
+package notification
+
+import "time"
+
+// RetryPolicy spaces out delivery attempts exponentially.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Backoff is the wait after the given failed attempt: the base, doubled for
+// every earlier failure, capped at the maximum.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	d := p.BaseBackoff
+	for i := 1; i < attempt && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, p.MaxBackoff)
+}
@@ -0,0 +1,9 @@
+package ids
+
+import "github.com/pratchaya-maneechot/service-exchange/libs/utils"
+
+type NotificationID string
+
+func NewNotificationID() NotificationID {
+	return NotificationID(utils.UUID())
+}
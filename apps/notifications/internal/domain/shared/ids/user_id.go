@@ -0,0 +1,9 @@
+package ids
+
+// UserID identifies a user of the users service. Notifications only store the
+// reference; the user itself lives in the users service.
+type UserID string
+
+// EventID is the ID the publishing service gave a domain event. It is what
+// makes redelivered events harmless.
+type EventID string
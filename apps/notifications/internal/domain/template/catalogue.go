@@ -0,0 +1,110 @@
+package template
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/notification"
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+)
+
+var ErrTemplateNotFound = errs.NewWithReason(errs.CodeInternal, "TEMPLATE_NOT_FOUND", "notification template not found")
+
+// Block names every template file defines; "short" is optional.
+const (
+	blockSubject = "subject"
+	blockBody    = "body"
+	blockShort   = "short"
+)
+
+// Catalogue holds the notification templates per locale. Each template is a
+// text/template file at <locale>/<key>.tmpl defining the subject, body and
+// optionally short blocks.
+type Catalogue struct {
+	defaultLocale string
+	templates     map[string]map[string]*template.Template
+}
+
+// NewCatalogue parses every template in fsys. It fails when a template
+// misses a block or when a key has no variant in the default locale, so a
+// broken catalogue stops the service at start-up rather than at send time.
+func NewCatalogue(fsys fs.FS, defaultLocale string) (*Catalogue, error) {
+	files, err := fs.Glob(fsys, "*/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	c := &Catalogue{defaultLocale: defaultLocale, templates: make(map[string]map[string]*template.Template)}
+	for _, f := range files {
+		locale := path.Dir(f)
+		key := strings.TrimSuffix(path.Base(f), ".tmpl")
+		src, err := fs.ReadFile(fsys, f)
+		if err != nil {
+			return nil, err
+		}
+		t, err := template.New(key).Option("missingkey=error").Parse(string(src))
+		if err != nil {
+			return nil, fmt.Errorf("template %s: %w", f, err)
+		}
+		for _, block := range []string{blockSubject, blockBody} {
+			if t.Lookup(block) == nil {
+				return nil, fmt.Errorf("template %s: missing %q block", f, block)
+			}
+		}
+		if c.templates[locale] == nil {
+			c.templates[locale] = make(map[string]*template.Template)
+		}
+		c.templates[locale][key] = t
+	}
+	for locale, keys := range c.templates {
+		for key := range keys {
+			if _, ok := c.templates[defaultLocale][key]; !ok {
+				return nil, fmt.Errorf("template %s/%s has no %s variant", locale, key, defaultLocale)
+			}
+		}
+	}
+	return c, nil
+}
+
+// Render renders a template in the given locale, falling back to the
+// default locale when there is no variant for it. It returns the locale
+// actually used.
+func (c *Catalogue) Render(key, locale string, data map[string]any) (notification.Rendered, string, error) {
+	t, ok := c.templates[locale][key]
+	if !ok {
+		locale = c.defaultLocale
+		t, ok = c.templates[locale][key]
+	}
+	if !ok {
+		return notification.Rendered{}, "", ErrTemplateNotFound
+	}
+
+	var r notification.Rendered
+	var err error
+	if r.Subject, err = execute(t, blockSubject, data); err != nil {
+		return notification.Rendered{}, "", err
+	}
+	if r.Body, err = execute(t, blockBody, data); err != nil {
+		return notification.Rendered{}, "", err
+	}
+	r.Short = r.Subject
+	if t.Lookup(blockShort) != nil {
+		if r.Short, err = execute(t, blockShort, data); err != nil {
+			return notification.Rendered{}, "", err
+		}
+	}
+	return r, locale, nil
+}
+
+// execute renders one block. Templates are checked at start-up, so a
+// failure here means the event data lacks a key the template uses.
+func execute(t *template.Template, block string, data map[string]any) (string, error) {
+	var buf bytes.Buffer
+	if err := t.ExecuteTemplate(&buf, block, data); err != nil {
+		return "", errs.NewWithReason(errs.CodeInvalidArgument, "TEMPLATE_DATA_MISMATCH", fmt.Sprintf("the event data does not fill the %s template: %v", t.Name(), err))
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
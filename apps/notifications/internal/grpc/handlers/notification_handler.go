@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/notifications/api/proto/notification"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/notification"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/grpc/views"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type NotificationGRPCHandler struct {
+	pb.UnimplementedNotificationServiceServer
+	lg.GrpcHandlerOption
+}
+
+func RegisNotificationGRPCHandler(
+	gs *grpc.Server,
+	opt lg.GrpcHandlerOption,
+) {
+	pb.RegisterNotificationServiceServer(gs, &NotificationGRPCHandler{
+		GrpcHandlerOption: opt,
+	})
+}
+
+func (h *NotificationGRPCHandler) PublishEvent(ctx context.Context, req *pb.PublishEventRequest) (*pb.PublishEventResponse, error) {
+	cmd := command.PublishEventCommand{
+		EventID:   ids.EventID(req.GetEventId()),
+		Type:      req.GetType(),
+		UserID:    ids.UserID(req.GetUserId()),
+		Recipient: views.RecipientInput(req.GetRecipient()),
+		Data:      lg.StructToMap(req.GetData()),
+		Actor:     actorFromCtx(ctx),
+	}
+	result, err := h.Command.Dispatch(ctx, cmd)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*command.PublishEventDto)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from PublishEventCommand handler")
+	}
+	return views.PublishEventResponse(dto), nil
+}
+
+func (h *NotificationGRPCHandler) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	qry := query.ListNotificationsQuery{
+		UserID:     ids.UserID(req.GetUserId()),
+		UnreadOnly: req.GetUnreadOnly(),
+		PageSize:   int(req.GetPageSize()),
+		PageToken:  req.GetPageToken(),
+		Actor:      actorFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.ListNotificationsResultDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from ListNotificationsQuery handler")
+	}
+	return views.ListNotificationsResponse(dto), nil
+}
+
+func (h *NotificationGRPCHandler) GetNotification(ctx context.Context, req *pb.GetNotificationRequest) (*pb.Notification, error) {
+	qry := query.GetNotificationQuery{
+		NotificationID: ids.NotificationID(req.GetNotificationId()),
+		Actor:          actorFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.NotificationDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from GetNotificationQuery handler")
+	}
+	return views.Notification(dto), nil
+}
+
+func (h *NotificationGRPCHandler) MarkNotificationRead(ctx context.Context, req *pb.MarkNotificationReadRequest) (*pb.MarkNotificationReadResponse, error) {
+	cmd := command.MarkNotificationReadCommand{
+		NotificationID: ids.NotificationID(req.GetNotificationId()),
+		Actor:          actorFromCtx(ctx),
+	}
+	result, err := h.Command.Dispatch(ctx, cmd)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*command.MarkNotificationReadDto)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from MarkNotificationReadCommand handler")
+	}
+	return views.MarkNotificationReadResponse(dto), nil
+}
+
+func actorFromCtx(ctx context.Context) notification.Actor {
+	caller, ok := lg.CallerFromCtx(ctx)
+	if !ok {
+		return notification.Actor{}
+	}
+	return notification.Actor{
+		UserID:  ids.UserID(caller.UserID),
+		IsAdmin: caller.HasRole("admin"),
+	}
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/shared/ids"
+	usereventpb "github.com/pratchaya-maneechot/service-exchange/apps/users/api/proto/userevent"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// UserEventGRPCHandler serves the UserEventSink the users service delivers
+// the events of user accounts to; each is published like PublishEvent.
+type UserEventGRPCHandler struct {
+	usereventpb.UnimplementedUserEventSinkServer
+	lg.GrpcHandlerOption
+}
+
+func RegisUserEventGRPCHandler(
+	gs *grpc.Server,
+	opt lg.GrpcHandlerOption,
+) {
+	usereventpb.RegisterUserEventSinkServer(gs, &UserEventGRPCHandler{
+		GrpcHandlerOption: opt,
+	})
+}
+
+func (h *UserEventGRPCHandler) DeliverUserEvent(ctx context.Context, req *usereventpb.UserEvent) (*emptypb.Empty, error) {
+	cmd := command.PublishEventCommand{
+		EventID: ids.EventID(req.GetEventId()),
+		Type:    req.GetType(),
+		UserID:  ids.UserID(req.GetUserId()),
+		Recipient: command.RecipientInput{
+			Email:       lg.StringValueToPtr(req.GetEmail()),
+			PhoneNumber: lg.StringValueToPtr(req.GetPhoneNumber()),
+			LineUserID:  lg.StringValueToPtr(req.GetLineUserId()),
+		},
+		Data:  lg.StructToMap(req.GetData()),
+		Actor: actorFromCtx(ctx),
+	}
+	if _, err := h.Command.Dispatch(ctx, cmd); err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
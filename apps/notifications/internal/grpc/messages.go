@@ -0,0 +1,24 @@
+package grpc
+
+import lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+
+// thaiMessages are the Thai texts of the service's error reasons. English
+// needs none: it is the language of the errors themselves.
+var thaiMessages = lg.Messages{
+	"INVALID_PAGE_SIZE":  "page_size ต้องไม่ติดลบ",
+	"INVALID_PAGE_TOKEN": "page_token ไม่ถูกต้องหรือไม่ตรงกับคำขอ",
+
+	"NOTIFICATION_NOT_FOUND":             "ไม่พบการแจ้งเตือน",
+	"NOTIFICATION_SIGN_IN_REQUIRED":      "กรุณาเข้าสู่ระบบเพื่ออ่านการแจ้งเตือน",
+	"NOTIFICATION_NOT_RECIPIENT":         "เฉพาะผู้รับเท่านั้นที่เปลี่ยนแปลงการแจ้งเตือนได้",
+	"NOTIFICATION_INBOX_HIDDEN":          "เฉพาะเจ้าของหรือผู้ดูแลระบบเท่านั้นที่อ่านกล่องข้อความได้",
+	"NOTIFICATION_PUBLISH_FORBIDDEN":     "เหตุการณ์ต้องเผยแพร่โดยบริการ ไม่ใช่ในนามของผู้ใช้อื่น",
+	"NOTIFICATION_UNKNOWN_EVENT_TYPE":    "ไม่มีการแจ้งเตือนสำหรับเหตุการณ์ประเภทนี้",
+	"NOTIFICATION_INVALID_CHANNEL":       "ช่องทางการแจ้งเตือนไม่ถูกต้อง",
+	"NOTIFICATION_NOT_IN_APP":            "ทำเครื่องหมายว่าอ่านแล้วได้เฉพาะการแจ้งเตือนในแอปเท่านั้น",
+	"NOTIFICATION_NOT_PENDING":           "การแจ้งเตือนนี้ไม่ได้รอการส่ง",
+	"NOTIFICATION_CHANGED":               "การแจ้งเตือนถูกเปลี่ยนแปลงระหว่างดำเนินการ กรุณาลองใหม่อีกครั้ง",
+	"NOTIFICATION_PREFERENCES_NOT_FOUND": "ไม่พบการตั้งค่าการแจ้งเตือน",
+
+	"TEMPLATE_DATA_MISMATCH": "ข้อมูลเหตุการณ์ไม่ครบตามแม่แบบการแจ้งเตือน",
+}
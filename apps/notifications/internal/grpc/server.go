@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/grpc/handlers"
+	"github.com/pratchaya-maneechot/service-exchange/libs/bus"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	lh "github.com/pratchaya-maneechot/service-exchange/libs/health"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+func NewGRPCServer(
+	cfg *config.Config,
+	bus bus.Bus,
+	lgr *slog.Logger,
+	vd *validator.Validate,
+	mr observability.MetricsRecorder,
+	health *lh.Registry,
+) (*lg.GRPCServer, error) {
+	opts := []grpc.ServerOption{
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle:     cfg.Server.MaxConnectionIdle,
+			MaxConnectionAge:      cfg.Server.MaxConnectionAge,
+			MaxConnectionAgeGrace: cfg.Server.MaxConnectionAgeGrace,
+			Time:                  cfg.Server.KeepaliveTime,
+			Timeout:               cfg.Server.KeepaliveTimeout,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             5 * time.Second,
+			PermitWithoutStream: true,
+		}),
+		grpc.MaxRecvMsgSize(cfg.Server.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(cfg.Server.MaxSendMsgSize),
+		grpc.MaxConcurrentStreams(cfg.Server.MaxConcurrentStreams),
+	}
+
+	server, err := lg.NewServer(lg.ConfigGRPCServer{
+		Address:           cfg.Server.Address,
+		EnableHealthCheck: cfg.Server.EnableHealthCheck,
+		EnableReflection:  cfg.Server.EnableReflection,
+		ShutdownTimeout:   cfg.Server.ShutdownTimeout,
+		Options:           opts,
+		MetricsRecorder:   &mr,
+		RateLimit:         cfg.Security.RateLimit(),
+		TLS:               cfg.Security.ServerTLS(),
+		Identity:          cfg.Security.Identity(),
+		Health:            health,
+		Messages:          lg.NewCatalogue().Register(lg.LocaleThai, thaiMessages),
+	}, lgr)
+	if err != nil {
+		return nil, err
+	}
+
+	server.RegisHandler(func(gs *grpc.Server) {
+		opt := lg.NewGrpcHandlerOption(bus.CommandBus, bus.QueryBus, lgr, vd)
+		handlers.RegisNotificationGRPCHandler(gs, opt)
+		handlers.RegisUserEventGRPCHandler(gs, opt)
+	})
+
+	return server, nil
+}
@@ -0,0 +1,120 @@
+package views
+
+import (
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/notifications/api/proto/notification"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/notification"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func domainChannelToProto(channel notification.Channel) pb.Channel {
+	switch channel {
+	case notification.ChannelInApp:
+		return pb.Channel_CHANNEL_IN_APP
+	case notification.ChannelPush:
+		return pb.Channel_CHANNEL_PUSH
+	case notification.ChannelEmail:
+		return pb.Channel_CHANNEL_EMAIL
+	case notification.ChannelSMS:
+		return pb.Channel_CHANNEL_SMS
+	case notification.ChannelLINE:
+		return pb.Channel_CHANNEL_LINE
+	default:
+		return pb.Channel_CHANNEL_UNSPECIFIED
+	}
+}
+
+func domainStatusToProto(status notification.Status) pb.NotificationStatus {
+	switch status {
+	case notification.StatusPending:
+		return pb.NotificationStatus_NOTIFICATION_STATUS_PENDING
+	case notification.StatusSent:
+		return pb.NotificationStatus_NOTIFICATION_STATUS_SENT
+	case notification.StatusFailed:
+		return pb.NotificationStatus_NOTIFICATION_STATUS_FAILED
+	default:
+		return pb.NotificationStatus_NOTIFICATION_STATUS_UNSPECIFIED
+	}
+}
+
+func RecipientInput(in *pb.Recipient) command.RecipientInput {
+	return command.RecipientInput{
+		Email:       lg.StringValueToPtr(in.GetEmail()),
+		PhoneNumber: lg.StringValueToPtr(in.GetPhoneNumber()),
+		LineUserID:  lg.StringValueToPtr(in.GetLineUserId()),
+		PushToken:   lg.StringValueToPtr(in.GetPushToken()),
+	}
+}
+
+func PublishEventResponse(payload *command.PublishEventDto) *pb.PublishEventResponse {
+	if payload == nil {
+		return nil
+	}
+	return &pb.PublishEventResponse{
+		NotificationIds: payload.NotificationIDs,
+		Duplicate:       payload.Duplicate,
+	}
+}
+
+func MarkNotificationReadResponse(payload *command.MarkNotificationReadDto) *pb.MarkNotificationReadResponse {
+	if payload == nil {
+		return nil
+	}
+	return &pb.MarkNotificationReadResponse{
+		NotificationId: payload.NotificationID,
+		ReadAt:         timestamppb.New(payload.ReadAt),
+	}
+}
+
+func Notification(payload *query.NotificationDTO) *pb.Notification {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.Notification{
+		Id:        payload.ID,
+		UserId:    payload.UserID,
+		EventId:   payload.EventID,
+		EventType: payload.EventType,
+		Channel:   domainChannelToProto(payload.Channel),
+		Locale:    payload.Locale,
+		Subject:   payload.Subject,
+		Body:      payload.Body,
+		Status:    domainStatusToProto(payload.Status),
+		Attempts:  make([]*pb.DeliveryAttempt, 0, len(payload.Attempts)),
+		CreatedAt: timestamppb.New(payload.CreatedAt),
+	}
+	if payload.NextAttemptAt != nil {
+		resp.NextAttemptAt = timestamppb.New(*payload.NextAttemptAt)
+	}
+	if payload.SentAt != nil {
+		resp.SentAt = timestamppb.New(*payload.SentAt)
+	}
+	if payload.ReadAt != nil {
+		resp.ReadAt = timestamppb.New(*payload.ReadAt)
+	}
+	for _, a := range payload.Attempts {
+		resp.Attempts = append(resp.Attempts, &pb.DeliveryAttempt{
+			Number:    int32(a.Number),
+			Succeeded: a.Succeeded,
+			Error:     lg.PtrToStringValue(a.Error),
+			At:        timestamppb.New(a.At),
+		})
+	}
+	return resp
+}
+
+func ListNotificationsResponse(payload *query.ListNotificationsResultDTO) *pb.ListNotificationsResponse {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.ListNotificationsResponse{
+		Notifications: make([]*pb.Notification, 0, len(payload.Notifications)),
+		NextPageToken: payload.NextPageToken,
+	}
+	for i := range payload.Notifications {
+		resp.Notifications = append(resp.Notifications, Notification(&payload.Notifications[i]))
+	}
+	return resp
+}
@@ -0,0 +1,73 @@
+package channels
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/delivery"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/notification"
+)
+
+// Addresses containing these markers make the fake driver fail, for
+// exercising the retry and give-up paths locally.
+const (
+	FakeTransientFailureMarker = "fake-unavailable"
+	FakePermanentFailureMarker = "fake-invalid"
+)
+
+var errFakeUnavailable = errors.New("fake driver: provider unavailable")
+
+// FakeDriver logs messages instead of sending them. Message IDs are derived
+// from the notification ID, so a retried send yields the same ID.
+type FakeDriver struct {
+	channel notification.Channel
+	logger  *slog.Logger
+}
+
+func NewFakeDriver(channel notification.Channel, logger *slog.Logger) *FakeDriver {
+	return &FakeDriver{
+		channel: channel,
+		logger:  logger.With(slog.String("component", "FakeDriver"), slog.String("channel", string(channel))),
+	}
+}
+
+// NewFakeDrivers returns a fake driver for every channel that is delivered
+// through a provider.
+func NewFakeDrivers(logger *slog.Logger) delivery.Drivers {
+	drivers := make([]delivery.Driver, 0, len(notification.Channels))
+	for _, c := range notification.Channels {
+		if c != notification.ChannelInApp {
+			drivers = append(drivers, NewFakeDriver(c, logger))
+		}
+	}
+	return delivery.NewDrivers(drivers...)
+}
+
+func (d *FakeDriver) Channel() notification.Channel {
+	return d.channel
+}
+
+func (d *FakeDriver) Send(ctx context.Context, msg delivery.Message) (string, error) {
+	switch {
+	case strings.Contains(msg.To, FakePermanentFailureMarker):
+		return "", fmt.Errorf("fake driver: address %q rejected: %w", msg.To, delivery.ErrPermanent)
+	case strings.Contains(msg.To, FakeTransientFailureMarker):
+		return "", errFakeUnavailable
+	}
+
+	sum := sha256.Sum256([]byte(msg.NotificationID))
+	id := fmt.Sprintf("fake_%s_%s", strings.ToLower(string(d.channel)), hex.EncodeToString(sum[:12]))
+	d.logger.Info("Sent fake notification.",
+		"message_id", id,
+		"user_id", string(msg.UserID),
+		"to", msg.To,
+		"subject", msg.Subject,
+		"body", msg.Body,
+	)
+	return id, nil
+}
@@ -0,0 +1,142 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/google/wire"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/delivery"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/notification"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/infra/channels"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/infra/persistence/postgres"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/infra/persistence/repositories"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/infra/preferences"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/infra/templates"
+	usersclient "github.com/pratchaya-maneechot/service-exchange/apps/users/api/client"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	lh "github.com/pratchaya-maneechot/service-exchange/libs/health"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+)
+
+type Infra struct {
+	dbPool *lp.DBPool
+	users  *usersclient.Client
+	logger *slog.Logger
+	tracer *sdktrace.TracerProvider
+}
+
+func NewInfra(
+	dbPool *lp.DBPool,
+	users *usersclient.Client,
+	logger *slog.Logger,
+	tracer *sdktrace.TracerProvider,
+) *Infra {
+	return &Infra{
+		dbPool,
+		users,
+		logger,
+		tracer,
+	}
+}
+
+func (i *Infra) Close(ctx context.Context) error {
+	var errs []error
+	if i.tracer != nil {
+		if err := i.tracer.Shutdown(ctx); err != nil {
+			i.logger.Error("Failed to shutdown OpenTelemetry TracerProvider", "error", err)
+			errs = append(errs, fmt.Errorf("tracer shutdown failed: %w", err))
+		}
+	}
+	if i.users != nil {
+		if err := i.users.Close(); err != nil {
+			i.logger.Error("Failed to close users client", "error", err)
+			errs = append(errs, fmt.Errorf("users client close failed: %w", err))
+		}
+	}
+	if i.dbPool != nil {
+		i.dbPool.Close()
+		i.logger.Info("PostgreSQL database pool closed.")
+	}
+	i.logger.Info("Infrastructure components closed.")
+
+	if len(errs) > 0 {
+		return fmt.Errorf("errors during infra close: %v", errs)
+	}
+	return nil
+}
+
+func ProvideMetricServer(cfg *config.Config, health *lh.Registry) *observability.MetricServer {
+	return observability.NewMetricServer(observability.MetricConfig{
+		Path:    cfg.Metrics.Path,
+		Addr:    cfg.Metrics.Address,
+		Enabled: cfg.Metrics.Enabled,
+		Health:  health,
+	})
+}
+
+func ProvideLogger(cfg *config.Config) *slog.Logger {
+	return observability.NewLogger(observability.LoggerConfig{
+		Level:     cfg.Logging.Level,
+		Format:    cfg.Logging.Format,
+		AddSource: !cfg.IsDevelopment(),
+	})
+}
+
+func ProvideTracer(ctx context.Context, cfg *config.Config) (*sdktrace.TracerProvider, error) {
+	return observability.NewTracer(ctx, observability.TracerConfig{
+		Name:        cfg.Name,
+		Version:     cfg.Version,
+		Environment: cfg.Environment,
+	})
+}
+
+func ProvideMetricRecorder() observability.MetricsRecorder {
+	return observability.NewPrometheusMetricsRecorder()
+}
+
+func ProvideClientFactory(cfg *config.Config, logger *slog.Logger, recorder observability.MetricsRecorder) *lg.ClientFactory {
+	return lg.NewClientFactory(logger, recorder, cfg.Security.Identity())
+}
+
+func ProvideUsersClient(ctx context.Context, cfg *config.Config, factory *lg.ClientFactory) (*usersclient.Client, error) {
+	return usersclient.New(ctx, factory, cfg.Clients.Users.Client(cfg.Security))
+}
+
+func ProvideDrivers(cfg *config.Config, logger *slog.Logger) (delivery.Drivers, error) {
+	switch cfg.Notifications.Driver {
+	case "fake":
+		return channels.NewFakeDrivers(logger), nil
+	default:
+		return nil, fmt.Errorf("unknown notification driver %q", cfg.Notifications.Driver)
+	}
+}
+
+func ProvideRetryPolicy(cfg *config.Config) notification.RetryPolicy {
+	return notification.RetryPolicy{
+		MaxAttempts: cfg.Notifications.Dispatch.MaxAttempts,
+		BaseBackoff: cfg.Notifications.Dispatch.BaseBackoff,
+		MaxBackoff:  cfg.Notifications.Dispatch.MaxBackoff,
+	}
+}
+
+var InfraModuleSet = wire.NewSet(
+	postgres.NewDBConn,
+	repositories.NewPostgresNotificationRepository,
+	preferences.NewUsersPreferenceReader,
+	templates.ProvideCatalogue,
+	ProvideClientFactory,
+	ProvideUsersClient,
+	ProvideDrivers,
+	ProvideRetryPolicy,
+	ProvideMetricServer,
+	ProvideMetricRecorder,
+	ProvideHealthRegistry,
+	ProvideLogger,
+	ProvideTracer,
+	NewInfra,
+)
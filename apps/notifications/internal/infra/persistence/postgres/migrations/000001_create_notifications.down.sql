@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS delivery_attempts;
+DROP TABLE IF EXISTS notifications;
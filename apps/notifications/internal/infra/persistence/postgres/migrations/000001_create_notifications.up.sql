@@ -0,0 +1,33 @@
+CREATE TABLE notifications (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL, -- User in the users service
+    event_id VARCHAR(255) NOT NULL, -- ID the publishing service gave the event
+    event_type VARCHAR(100) NOT NULL,
+    channel VARCHAR(10) NOT NULL CHECK (channel IN ('IN_APP', 'PUSH', 'EMAIL', 'SMS', 'LINE')),
+    recipient VARCHAR(512) NOT NULL DEFAULT '', -- Channel address; empty for in-app
+    locale VARCHAR(10) NOT NULL,
+    subject TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'SENT', 'FAILED')),
+    next_attempt_at TIMESTAMP WITH TIME ZONE, -- Set while PENDING
+    provider_ref VARCHAR(255), -- Message ID at the channel provider
+    sent_at TIMESTAMP WITH TIME ZONE,
+    read_at TIMESTAMP WITH TIME ZONE, -- In-app only
+    version INTEGER NOT NULL DEFAULT 1, -- Bumped on every write, for optimistic locking
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- One notification per event and channel, however often the event is published
+CREATE UNIQUE INDEX uq_notifications_event_channel ON notifications (event_id, user_id, channel);
+CREATE INDEX idx_notifications_due ON notifications (next_attempt_at) WHERE status = 'PENDING';
+CREATE INDEX idx_notifications_inbox ON notifications (user_id, created_at DESC, id DESC) WHERE channel = 'IN_APP';
+CREATE INDEX idx_notifications_sent ON notifications (user_id, channel, sent_at) WHERE status = 'SENT';
+
+CREATE TABLE delivery_attempts (
+    notification_id UUID NOT NULL REFERENCES notifications(id) ON DELETE CASCADE,
+    number INTEGER NOT NULL CHECK (number > 0),
+    error TEXT, -- NULL when the attempt succeeded
+    attempted_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (notification_id, number)
+);
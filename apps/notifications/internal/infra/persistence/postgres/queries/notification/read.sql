@@ -0,0 +1,33 @@
+-- name: FindNotificationByID :one
+SELECT
+    id, user_id, event_id, event_type, channel, recipient, locale, subject, body, status,
+    next_attempt_at, provider_ref, sent_at, read_at, version, created_at, updated_at
+FROM notifications
+WHERE id = $1;
+
+-- name: FindDeliveryAttemptsByNotificationIDs :many
+SELECT notification_id, number, error, attempted_at
+FROM delivery_attempts
+WHERE notification_id = ANY(@notification_ids::uuid[])
+ORDER BY notification_id, number;
+
+-- name: CountSentNotificationsSince :one
+SELECT COUNT(*)
+FROM notifications
+WHERE user_id = $1 AND channel = $2 AND status = 'SENT' AND sent_at >= $3;
+
+-- name: ListInboxNotifications :many
+-- Keyset pagination over (created_at, id), newest first.
+SELECT
+    id, user_id, event_id, event_type, channel, recipient, locale, subject, body, status,
+    next_attempt_at, provider_ref, sent_at, read_at, version, created_at, updated_at
+FROM notifications
+WHERE user_id = @user_id
+  AND channel = 'IN_APP'
+  AND (NOT @unread_only::boolean OR read_at IS NULL)
+  AND (
+      sqlc.narg('after_created_at')::timestamptz IS NULL
+      OR (created_at, id) < (sqlc.narg('after_created_at')::timestamptz, sqlc.narg('after_id')::uuid)
+  )
+ORDER BY created_at DESC, id DESC
+LIMIT @page_limit;
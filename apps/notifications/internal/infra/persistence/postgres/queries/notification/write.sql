@@ -0,0 +1,45 @@
+-- name: InsertNotification :execrows
+-- Zero affected rows means the event already produced a notification on
+-- this channel.
+INSERT INTO notifications (
+    id, user_id, event_id, event_type, channel, recipient, locale, subject, body, status,
+    next_attempt_at, provider_ref, sent_at, read_at, version, created_at, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
+)
+ON CONFLICT (event_id, user_id, channel) DO NOTHING;
+
+-- name: UpdateNotification :execrows
+-- Applies only when the stored version is the one the notification was
+-- loaded with; zero affected rows means somebody else changed it first.
+UPDATE notifications
+SET
+    status = @status,
+    next_attempt_at = @next_attempt_at,
+    provider_ref = @provider_ref,
+    sent_at = @sent_at,
+    read_at = @read_at,
+    version = @version,
+    updated_at = @updated_at
+WHERE id = @id AND version = @version - 1;
+
+-- name: ClaimDueNotifications :many
+-- Leases due notifications by moving their next attempt past the lease;
+-- SKIP LOCKED keeps concurrent dispatchers off each other's rows.
+UPDATE notifications
+SET next_attempt_at = @lease_until
+WHERE id IN (
+    SELECT n.id
+    FROM notifications n
+    WHERE n.status = 'PENDING' AND n.next_attempt_at <= NOW()
+    ORDER BY n.next_attempt_at
+    LIMIT @batch_limit
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING
+    id, user_id, event_id, event_type, channel, recipient, locale, subject, body, status,
+    next_attempt_at, provider_ref, sent_at, read_at, version, created_at, updated_at;
+
+-- name: InsertDeliveryAttempt :exec
+INSERT INTO delivery_attempts (notification_id, number, error, attempted_at)
+VALUES ($1, $2, $3, $4);
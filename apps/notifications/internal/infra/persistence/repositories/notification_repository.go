@@ -0,0 +1,410 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/notification"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/shared/ids"
+	db "github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/infra/persistence/postgres/generated"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+	"github.com/pratchaya-maneechot/service-exchange/libs/utils"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type notificationRepository struct {
+	db     *db.Queries
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+	config *config.Config
+	tracer trace.Tracer
+}
+
+func NewPostgresNotificationRepository(cfg *config.Config, dbPool *lp.DBPool, logger *slog.Logger) notification.NotificationRepository {
+	repoLogger := logger.With(slog.String("component", "notificationRepository"))
+	return &notificationRepository{
+		db:     db.New(dbPool.Pool),
+		pool:   dbPool.Pool,
+		logger: repoLogger,
+		config: cfg,
+		tracer: otel.Tracer(fmt.Sprintf("%s.repository", cfg.Name)),
+	}
+}
+
+func (r *notificationRepository) FindByID(ctx context.Context, id ids.NotificationID) (*notification.Notification, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("notification_id", string(id)))
+
+	ctx, span := r.tracer.Start(ctx, "NotificationRepository.FindByID", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "read_by_id"),
+		attribute.String("db.notification_id", string(id)),
+	)
+
+	row, err := r.db.FindNotificationByID(ctx, lp.ToUUID(string(id)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.SetStatus(codes.Ok, "Notification not found in DB")
+			span.SetAttributes(attribute.Bool("notification.found", false))
+			logger.Debug("Notification not found in DB.")
+			return nil, notification.ErrNotificationNotFound
+		}
+		span.SetStatus(codes.Error, "Failed to query notification from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query notification from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query notification: %w", lp.TranslateError(err))
+	}
+
+	ns, err := r.withAttempts(ctx, []db.Notification{row})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query delivery attempts from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query delivery attempts from DB", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Notification found")
+	span.SetAttributes(attribute.Bool("notification.found", true))
+	return ns[0], nil
+}
+
+func (r *notificationRepository) CreateMany(ctx context.Context, ns []*notification.Notification) ([]*notification.Notification, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "NotificationRepository.CreateMany", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "insert_many"),
+		attribute.Int("db.notification_count", len(ns)),
+	)
+
+	created := make([]*notification.Notification, 0, len(ns))
+	err := r.inTx(ctx, span, logger, func(qtx *db.Queries) error {
+		for _, n := range ns {
+			affected, err := qtx.InsertNotification(ctx, db.InsertNotificationParams(toRow(n, n.Version+1)))
+			if err != nil {
+				span.SetStatus(codes.Error, "Failed to insert notification in DB")
+				span.RecordError(err)
+				span.SetAttributes(attribute.String("error.type", "db_write_error"))
+				logger.Error("Failed to insert notification in DB", slog.Any("error", err), "channel", string(n.Channel))
+				return fmt.Errorf("failed to insert notification: %w", lp.TranslateError(err))
+			}
+			if affected == 0 {
+				logger.Debug("Event already notified on channel; skipped.", "event_id", string(n.EventID), "channel", string(n.Channel))
+				continue
+			}
+			created = append(created, n)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range created {
+		n.Version++
+	}
+
+	span.SetStatus(codes.Ok, "Notifications created")
+	span.SetAttributes(attribute.Int("db.created_count", len(created)))
+	return created, nil
+}
+
+func (r *notificationRepository) ClaimDue(ctx context.Context, lease time.Duration, limit int) ([]*notification.Notification, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "NotificationRepository.ClaimDue", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "claim_due"),
+		attribute.Int("claim.limit", limit),
+	)
+
+	leaseUntil := time.Now().Add(lease)
+	rows, err := r.db.ClaimDueNotifications(ctx, db.ClaimDueNotificationsParams{
+		LeaseUntil: lp.ToTimestamp(&leaseUntil),
+		BatchLimit: int32(limit),
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to claim due notifications in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to claim due notifications in DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to claim due notifications: %w", lp.TranslateError(err))
+	}
+
+	ns, err := r.withAttempts(ctx, rows)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query delivery attempts from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query delivery attempts of claimed notifications from DB", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Due notifications claimed")
+	span.SetAttributes(attribute.Int("claim.result_count", len(ns)))
+	return ns, nil
+}
+
+func (r *notificationRepository) CountSentSince(ctx context.Context, userID ids.UserID, channel notification.Channel, since time.Time) (int, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(userID)))
+
+	ctx, span := r.tracer.Start(ctx, "NotificationRepository.CountSentSince", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "count_sent"),
+		attribute.String("db.channel", string(channel)),
+	)
+
+	count, err := r.db.CountSentNotificationsSince(ctx, db.CountSentNotificationsSinceParams{
+		UserID:  lp.ToUUID(string(userID)),
+		Channel: string(channel),
+		SentAt:  lp.ToTimestamp(&since),
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to count sent notifications in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to count sent notifications in DB", slog.Any("error", err))
+		return 0, fmt.Errorf("failed to count sent notifications: %w", lp.TranslateError(err))
+	}
+
+	span.SetStatus(codes.Ok, "Sent notifications counted")
+	return int(count), nil
+}
+
+func (r *notificationRepository) ListInbox(ctx context.Context, criteria notification.InboxCriteria) ([]*notification.Notification, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(criteria.UserID)))
+
+	ctx, span := r.tracer.Start(ctx, "NotificationRepository.ListInbox", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "list_inbox"),
+		attribute.Int("list.limit", criteria.Limit),
+		attribute.Bool("list.unread_only", criteria.UnreadOnly),
+	)
+
+	params := db.ListInboxNotificationsParams{
+		UserID:     lp.ToUUID(string(criteria.UserID)),
+		UnreadOnly: criteria.UnreadOnly,
+		PageLimit:  int32(criteria.Limit),
+	}
+	if criteria.After != nil {
+		params.AfterCreatedAt = lp.ToTimestamp(&criteria.After.CreatedAt)
+		params.AfterID = lp.ToUUID(string(criteria.After.NotificationID))
+	}
+
+	rows, err := r.db.ListInboxNotifications(ctx, params)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list inbox from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to list inbox from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to list inbox: %w", lp.TranslateError(err))
+	}
+
+	// In-app notifications are delivered by being stored; they have no
+	// delivery attempts to load.
+	ns := utils.ArrayMap(rows, func(row db.Notification) *notification.Notification { return toNotification(row, nil) })
+
+	span.SetStatus(codes.Ok, "Inbox listed")
+	span.SetAttributes(attribute.Int("list.result_count", len(ns)))
+	return ns, nil
+}
+
+func (r *notificationRepository) Save(ctx context.Context, n *notification.Notification) error {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("notification_id", string(n.ID)))
+
+	ctx, span := r.tracer.Start(ctx, "NotificationRepository.Save", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.notification_id", string(n.ID)),
+		attribute.String("db.notification_status", string(n.Status)),
+		attribute.Int("db.delivery_attempts", len(n.PendingAttempts())),
+	)
+
+	err := r.inTx(ctx, span, logger, func(qtx *db.Queries) error {
+		row := toRow(n, n.Version+1)
+		affected, err := qtx.UpdateNotification(ctx, db.UpdateNotificationParams{
+			Status:        row.Status,
+			NextAttemptAt: row.NextAttemptAt,
+			ProviderRef:   row.ProviderRef,
+			SentAt:        row.SentAt,
+			ReadAt:        row.ReadAt,
+			Version:       row.Version,
+			UpdatedAt:     row.UpdatedAt,
+			ID:            row.ID,
+		})
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to update notification in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to update notification in DB", slog.Any("error", err))
+			return fmt.Errorf("failed to update notification: %w", lp.TranslateError(err))
+		}
+		if affected == 0 {
+			span.SetStatus(codes.Error, "Stale notification version")
+			span.SetAttributes(attribute.String("error.type", "db_version_conflict"))
+			logger.Warn("Notification was changed concurrently.", "version", n.Version)
+			return notification.ErrNotificationChanged
+		}
+
+		for _, a := range n.PendingAttempts() {
+			err := qtx.InsertDeliveryAttempt(ctx, db.InsertDeliveryAttemptParams{
+				NotificationID: row.ID,
+				Number:         int32(a.Number),
+				Error:          a.Error,
+				AttemptedAt:    lp.ToTimestamp(&a.At),
+			})
+			if err != nil {
+				span.SetStatus(codes.Error, "Failed to insert delivery attempt in DB")
+				span.RecordError(err)
+				span.SetAttributes(attribute.String("error.type", "db_write_error"))
+				logger.Error("Failed to insert delivery attempt in DB", slog.Any("error", err), "attempt", a.Number)
+				return fmt.Errorf("failed to insert delivery attempt: %w", lp.TranslateError(err))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	n.Version++
+	n.ClearPendingAttempts()
+	span.SetStatus(codes.Ok, "Notification saved to DB")
+	logger.Info("Notification saved successfully to DB.", "status", n.Status)
+	return nil
+}
+
+// withAttempts loads the delivery attempts of the given rows in one query.
+func (r *notificationRepository) withAttempts(ctx context.Context, rows []db.Notification) ([]*notification.Notification, error) {
+	if len(rows) == 0 {
+		return []*notification.Notification{}, nil
+	}
+	attemptRows, err := r.db.FindDeliveryAttemptsByNotificationIDs(ctx, utils.ArrayMap(rows, func(row db.Notification) pgtype.UUID { return row.ID }))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query delivery attempts: %w", lp.TranslateError(err))
+	}
+	attempts := make(map[string][]notification.Attempt, len(rows))
+	for _, a := range attemptRows {
+		id := lp.FromUUID(a.NotificationID)
+		attempts[id] = append(attempts[id], notification.Attempt{
+			Number: int(a.Number),
+			Error:  a.Error,
+			At:     *lp.ToTime(a.AttemptedAt),
+		})
+	}
+	return utils.ArrayMap(rows, func(row db.Notification) *notification.Notification {
+		return toNotification(row, attempts[lp.FromUUID(row.ID)])
+	}), nil
+}
+
+func (r *notificationRepository) inTx(ctx context.Context, span trace.Span, logger *slog.Logger, fn func(qtx *db.Queries) error) (err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to begin transaction")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+		logger.Error("Failed to begin DB transaction", slog.Any("error", err))
+		return fmt.Errorf("failed to begin transaction: %w", lp.TranslateError(err))
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		} else if err != nil {
+			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+				logger.Error("Failed to rollback DB transaction", slog.Any("error", rollbackErr))
+			}
+		} else {
+			if commitErr := tx.Commit(ctx); commitErr != nil {
+				span.SetStatus(codes.Error, "Failed to commit transaction")
+				span.RecordError(commitErr)
+				span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+				logger.Error("Failed to commit DB transaction", slog.Any("error", commitErr))
+				err = fmt.Errorf("failed to commit transaction: %w", lp.TranslateError(commitErr))
+			}
+		}
+	}()
+
+	// Statements inside may wrap their errors; translating once more here
+	// makes sure serialization failures, deadlocks and constraint violations
+	// leave the transaction as the typed errors callers retry or report.
+	return lp.TranslateError(fn(r.db.WithTx(tx)))
+}
+
+func toRow(n *notification.Notification, version int) db.Notification {
+	return db.Notification{
+		ID:            lp.ToUUID(string(n.ID)),
+		UserID:        lp.ToUUID(string(n.UserID)),
+		EventID:       string(n.EventID),
+		EventType:     n.EventType,
+		Channel:       string(n.Channel),
+		Recipient:     n.Recipient,
+		Locale:        n.Locale,
+		Subject:       n.Subject,
+		Body:          n.Body,
+		Status:        string(n.Status),
+		NextAttemptAt: lp.ToTimestamp(n.NextAttemptAt),
+		ProviderRef:   n.ProviderRef,
+		SentAt:        lp.ToTimestamp(n.SentAt),
+		ReadAt:        lp.ToTimestamp(n.ReadAt),
+		Version:       int32(version),
+		CreatedAt:     lp.ToTimestamp(&n.CreatedAt),
+		UpdatedAt:     lp.ToTimestamp(&n.UpdatedAt),
+	}
+}
+
+func toNotification(row db.Notification, attempts []notification.Attempt) *notification.Notification {
+	if attempts == nil {
+		attempts = []notification.Attempt{}
+	}
+	return notification.NewNotificationFromRepository(
+		ids.NotificationID(lp.FromUUID(row.ID)),
+		ids.UserID(lp.FromUUID(row.UserID)),
+		ids.EventID(row.EventID),
+		row.EventType,
+		notification.Channel(row.Channel),
+		row.Recipient,
+		row.Locale,
+		row.Subject,
+		row.Body,
+		notification.Status(row.Status),
+		attempts,
+		lp.ToTime(row.NextAttemptAt),
+		row.ProviderRef,
+		lp.ToTime(row.SentAt),
+		lp.ToTime(row.ReadAt),
+		int(row.Version),
+		*lp.ToTime(row.CreatedAt),
+		*lp.ToTime(row.UpdatedAt),
+	)
+}
@@ -0,0 +1,63 @@
+package preferences
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/notification"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/shared/ids"
+	usersclient "github.com/pratchaya-maneechot/service-exchange/apps/users/api/client"
+	userpb "github.com/pratchaya-maneechot/service-exchange/apps/users/api/proto/user"
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+)
+
+// Keys of the profile preferences, owned by the users service, that decide
+// how users are notified.
+const (
+	preferenceLanguage             = "language"
+	preferenceNotificationChannels = "notificationChannels"
+)
+
+// usersPreferenceReader reads preferences from the users service on behalf
+// of the user, who may read their own full profile. Profiles carry the
+// effective preferences, with the users service's defaults filled in.
+type usersPreferenceReader struct {
+	client *usersclient.Client
+	locale string
+}
+
+func NewUsersPreferenceReader(c *usersclient.Client, cfg *config.Config) notification.PreferenceReader {
+	return usersPreferenceReader{client: c, locale: cfg.Notifications.DefaultLocale}
+}
+
+func (r usersPreferenceReader) PreferencesOf(ctx context.Context, userID ids.UserID) (*notification.Preferences, error) {
+	ctx = lg.ContextWithCaller(ctx, lg.Caller{UserID: string(userID)})
+	profile, err := r.client.GetUserProfile(ctx, &userpb.GetUserProfileRequest{UserId: string(userID)})
+	if err != nil {
+		err = lg.ErrorFromGRPC(err)
+		if errs.HasReason(err, usersclient.ReasonUserNotFound) {
+			return nil, notification.ErrPreferencesNotFound
+		}
+		return nil, fmt.Errorf("failed to get user profile: %w", err)
+	}
+
+	prefs := lg.StructToMap(profile.GetPreferences())
+	p := &notification.Preferences{Locale: r.locale}
+	if lang, ok := prefs[preferenceLanguage].(string); ok && lang != "" {
+		p.Locale = lang
+	}
+	names, _ := prefs[preferenceNotificationChannels].([]any)
+	for _, name := range names {
+		s, ok := name.(string)
+		if !ok {
+			continue
+		}
+		// Channels this service cannot send on are skipped, not failed on.
+		if c, err := notification.ParseChannel(s); err == nil {
+			p.Channels = append(p.Channels, c)
+		}
+	}
+	return p, nil
+}
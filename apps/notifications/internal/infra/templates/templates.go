@@ -0,0 +1,18 @@
+package templates
+
+import (
+	"embed"
+	"io/fs"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/domain/template"
+)
+
+// files holds the notification templates, one directory per locale.
+//
+//go:embed th/*.tmpl en/*.tmpl
+var files embed.FS
+
+func ProvideCatalogue(cfg *config.Config) (*template.Catalogue, error) {
+	return template.NewCatalogue(fs.FS(files), cfg.Notifications.DefaultLocale)
+}
@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/notifications/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/libs/bus"
+)
+
+// Dispatcher sends a DispatchNotificationsCommand on every tick, which
+// delivers due notifications and retries failed ones. Several replicas can
+// run it at once; the repository leases each notification to one of them.
+type Dispatcher struct {
+	bus      bus.Bus
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+func NewDispatcher(cfg *config.Config, b bus.Bus, logger *slog.Logger) *Dispatcher {
+	return &Dispatcher{
+		bus:      b,
+		interval: cfg.Notifications.Dispatch.Interval,
+		logger:   logger.With(slog.String("component", "Dispatcher")),
+	}
+}
+
+// Run blocks until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	d.logger.Info("Notification dispatcher started.", "interval", d.interval)
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("Notification dispatcher stopped.")
+			return
+		case <-ticker.C:
+			if _, err := d.bus.CommandBus.Dispatch(ctx, command.DispatchNotificationsCommand{}); err != nil {
+				d.logger.Error("Failed to dispatch notifications", "error", err)
+			}
+		}
+	}
+}
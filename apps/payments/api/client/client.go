@@ -0,0 +1,47 @@
+// Package client is how other services call PaymentService: the client
+// generated from payment.proto, over a connection made by a
+// grpc.ClientFactory, so calls get a default deadline, the caller's
+// identity, retries of the methods payment.proto marks idempotent, and a
+// circuit breaker.
+//
+// Calls fail with gRPC statuses. lg.ErrorFromGRPC turns them back into
+// application errors, which errs.HasReason tells apart by the reasons
+// below.
+package client
+
+import (
+	"context"
+
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/payments/api/proto/payment"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/escrow"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/grpc"
+)
+
+// Reasons of the errors callers usually handle.
+var (
+	ReasonEscrowNotFound = escrow.ErrEscrowNotFound.Reason
+	ReasonEscrowNotHeld  = escrow.ErrEscrowNotHeld.Reason
+	// ReasonEscrowChanged is ABORTED: the same call can be made again.
+	ReasonEscrowChanged = escrow.ErrEscrowChanged.Reason
+)
+
+type Client struct {
+	pb.PaymentServiceClient
+	conn *grpc.ClientConn
+}
+
+// New returns a client of the PaymentService at cfg.Target, usually
+// lg.DefaultConfigClient of it.
+func New(ctx context.Context, factory *lg.ClientFactory, cfg lg.ConfigClient) (*Client, error) {
+	conn, err := factory.NewClient(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{PaymentServiceClient: pb.NewPaymentServiceClient(conn), conn: conn}, nil
+}
+
+// Close closes the connection; calls in flight fail.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"github.com/google/wire"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/app/query"
+)
+
+type App struct {
+	GetEscrowQueryHandler       *query.GetEscrowQueryHandler
+	GetBalanceQueryHandler      *query.GetBalanceQueryHandler
+	HoldEscrowCommandHandler    *command.HoldEscrowCommandHandler
+	ReleaseEscrowCommandHandler *command.ReleaseEscrowCommandHandler
+	RefundEscrowCommandHandler  *command.RefundEscrowCommandHandler
+	SettleDisputeCommandHandler *command.SettleDisputeCommandHandler
+}
+
+var AppModuleSet = wire.NewSet(
+	query.NewGetEscrowQueryHandler,
+	query.NewGetBalanceQueryHandler,
+	command.NewHoldEscrowCommandHandler,
+	command.NewReleaseEscrowCommandHandler,
+	command.NewRefundEscrowCommandHandler,
+	command.NewSettleDisputeCommandHandler,
+	wire.Struct(new(App), "*"),
+)
@@ -0,0 +1,253 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/escrow"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/fee"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/idempotency"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/provider"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/money"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type MoneyInput struct {
+	// Amount is in minor currency units, e.g. satang for THB.
+	Amount   int64  `json:"amount" validate:"gt=0"`
+	Currency string `json:"currency" validate:"required,len=3"`
+}
+
+// HoldEscrowCommand charges the poster for a task and holds the funds in
+// escrow. It is sent when the poster accepts a bid; the caller is the payer.
+// The task, bid, payee and amount are checked against the tasks service
+// before anything is charged.
+type HoldEscrowCommand struct {
+	TaskID         ids.TaskID   `json:"taskId" validate:"required,uuid"`
+	BidID          *ids.BidID   `json:"bidId,omitempty" validate:"omitempty,uuid"`
+	PayeeID        ids.UserID   `json:"payeeId" validate:"required,uuid"`
+	Amount         MoneyInput   `json:"amount"`
+	PaymentMethod  string       `json:"paymentMethod" validate:"required,max=255"`
+	IdempotencyKey string       `json:"-" validate:"required,max=255"`
+	Actor          escrow.Actor `json:"-"`
+}
+
+// EscrowDto is what every money-moving command returns; it is also what is
+// replayed for a repeated idempotency key.
+type EscrowDto struct {
+	EscrowID       string        `json:"escrowId"`
+	TaskID         string        `json:"taskId"`
+	Status         escrow.Status `json:"status"`
+	Currency       string        `json:"currency"`
+	Amount         int64         `json:"amount"`
+	RefundedAmount int64         `json:"refundedAmount"`
+	FeeAmount      int64         `json:"feeAmount"`
+}
+
+func newEscrowDto(e *escrow.Escrow) *EscrowDto {
+	return &EscrowDto{
+		EscrowID:       string(e.ID),
+		TaskID:         string(e.TaskID),
+		Status:         e.Status,
+		Currency:       e.Amount.Currency,
+		Amount:         e.Amount.Amount,
+		RefundedAmount: e.RefundedAmount,
+		FeeAmount:      e.FeeAmount,
+	}
+}
+
+type HoldEscrowCommandHandler struct {
+	escrowRepo      escrow.EscrowRepository
+	idempotencyRepo idempotency.IdempotencyRepository
+	provider        provider.PaymentProvider
+	awards          escrow.AwardReader
+	fees            *fee.Policy
+	logger          *slog.Logger
+	config          *config.Config
+	tracer          trace.Tracer
+}
+
+func NewHoldEscrowCommandHandler(
+	escrowRepo escrow.EscrowRepository,
+	idempotencyRepo idempotency.IdempotencyRepository,
+	paymentProvider provider.PaymentProvider,
+	awards escrow.AwardReader,
+	fees *fee.Policy,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *HoldEscrowCommandHandler {
+	return &HoldEscrowCommandHandler{
+		escrowRepo:      escrowRepo,
+		idempotencyRepo: idempotencyRepo,
+		provider:        paymentProvider,
+		awards:          awards,
+		fees:            fees,
+		logger:          logger.With(slog.String("component", "HoldEscrowCommandHandler")),
+		config:          cfg,
+		tracer:          otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *HoldEscrowCommandHandler) Handle(ctx context.Context, cmd HoldEscrowCommand) (*EscrowDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(cmd.TaskID)))
+
+	ctx, span := h.tracer.Start(ctx, "HoldEscrowCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", string(cmd.TaskID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	if cmd.Actor.UserID == "" {
+		span.SetStatus(codes.Error, "Anonymous escrow hold")
+		span.SetAttributes(attribute.String("error.type", string(escrow.ErrSignInRequired.Code)))
+		logger.Warn("Rejected escrow hold without a caller")
+		return nil, escrow.ErrSignInRequired
+	}
+
+	record, err := idempotency.NewRecord(cmd.IdempotencyKey, "HoldEscrow", cmd.Actor.UserID, cmd)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to fingerprint request")
+		span.RecordError(err)
+		return nil, err
+	}
+	if prior, err := replay(ctx, h.idempotencyRepo, record); err != nil || prior != nil {
+		span.SetAttributes(attribute.Bool("idempotency.replayed", prior != nil))
+		if err != nil {
+			span.SetStatus(codes.Error, "Idempotency check failed")
+			logger.Warn("Idempotency check failed", slog.Any("error", err))
+		}
+		return prior, err
+	}
+
+	amount, err := money.New(cmd.Amount.Amount, cmd.Amount.Currency)
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid amount")
+		span.SetAttributes(attribute.String("error.type", "invalid_amount"))
+		return nil, err
+	}
+	if !h.fees.Supports(amount.Currency) {
+		span.SetStatus(codes.Error, "Unsupported currency")
+		span.SetAttributes(attribute.String("error.type", "unsupported_currency"))
+		logger.Warn("Rejected escrow in an unsupported currency", "currency", amount.Currency)
+		return nil, fee.ErrUnsupportedCurrency
+	}
+	if cmd.PayeeID == cmd.Actor.UserID {
+		span.SetStatus(codes.Error, "Payee is payer")
+		span.SetAttributes(attribute.String("error.type", "invalid_payee"))
+		return nil, escrow.ErrPayeeIsPayer
+	}
+
+	// Only the poster of an assigned task pays, and only its tasker the price
+	// they agreed on; otherwise anyone could take the task's escrow first.
+	award, err := h.awards.AwardOf(ctx, cmd.TaskID, cmd.BidID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to check task award")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "task_award_error"))
+		logger.Warn("Failed to check the task award with the tasks service", slog.Any("error", err))
+		return nil, err
+	}
+	if err := award.Check(cmd.Actor.UserID, cmd.PayeeID, amount); err != nil {
+		span.SetStatus(codes.Error, "Escrow does not match task award")
+		span.SetAttributes(attribute.String("error.type", "task_award_mismatch"))
+		logger.Warn("Rejected escrow that does not match the task award",
+			"payee_id", string(cmd.PayeeID), "amount", amount.Amount, "currency", amount.Currency, slog.Any("error", err))
+		return nil, err
+	}
+
+	// The escrow is reserved before the payer is charged, so of two racing
+	// holds the unique task index turns one away before it charges anybody.
+	// A reservation left PENDING by an interrupted hold is finished by the
+	// next hold of the same payment.
+	e, err := h.escrowRepo.FindByTaskID(ctx, cmd.TaskID)
+	switch {
+	case err == nil:
+		if !e.Resumes(cmd.Actor.UserID, cmd.PayeeID, amount) {
+			span.SetStatus(codes.Error, "Task already has an escrow")
+			span.SetAttributes(attribute.String("error.type", string(escrow.ErrEscrowAlreadyExists.Code)))
+			logger.Warn("Rejected second escrow for task")
+			return nil, escrow.ErrEscrowAlreadyExists
+		}
+		span.SetAttributes(attribute.Bool("escrow.resumed", true))
+		logger.Info("Resuming pending escrow.", "escrow_id", string(e.ID))
+	case errors.Is(err, escrow.ErrEscrowNotFound):
+		e, err = escrow.ReserveEscrow(cmd.TaskID, award.BidID, cmd.Actor.UserID, cmd.PayeeID, amount)
+		if err != nil {
+			span.SetStatus(codes.Error, "Invalid escrow")
+			span.SetAttributes(attribute.String("error.type", "invalid_escrow"))
+			return nil, err
+		}
+		if err := h.escrowRepo.Save(ctx, e, nil); err != nil {
+			span.SetStatus(codes.Error, "Failed to reserve escrow")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+			logger.Warn("Failed to reserve escrow", slog.Any("error", err))
+			return nil, err
+		}
+	default:
+		span.SetStatus(codes.Error, "Failed to check existing escrow")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("escrow.id", string(e.ID)))
+
+	chargeID, err := h.provider.Charge(ctx, provider.ChargeRequest{
+		IdempotencyKey: e.ChargeKey(),
+		PayerID:        cmd.Actor.UserID,
+		Amount:         amount,
+		PaymentMethod:  cmd.PaymentMethod,
+		Description:    fmt.Sprintf("escrow for task %s", cmd.TaskID),
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Charge failed")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "provider_charge_error"))
+		logger.Warn("Payment provider charge failed", slog.Any("error", err), "escrow_id", string(e.ID))
+		// A declined charge moved no money, so the task is free to be paid
+		// for again. Any other failure may have charged the payer; the
+		// reservation stays PENDING until a retry learns the outcome under
+		// the same charge key.
+		if errors.Is(err, provider.ErrPaymentDeclined) {
+			if discardErr := h.escrowRepo.Discard(ctx, e); discardErr != nil {
+				logger.Error("Failed to discard escrow after a declined charge", slog.Any("error", discardErr), "escrow_id", string(e.ID))
+			}
+		}
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("provider.charge_id", chargeID))
+
+	if err := e.Hold(chargeID); err != nil {
+		span.SetStatus(codes.Error, "Invalid escrow")
+		span.SetAttributes(attribute.String("error.type", "invalid_escrow"))
+		logger.Error("Charged but could not hold escrow", slog.Any("error", err), "charge_id", chargeID)
+		return nil, err
+	}
+
+	dto, err := saveWithRecord(ctx, h.escrowRepo, h.idempotencyRepo, e, record, newEscrowDto(e))
+	if err != nil {
+		// The escrow stays PENDING with the charge at the provider; the
+		// next hold of this payment reuses the charge instead of charging
+		// again.
+		span.SetStatus(codes.Error, "Failed to save escrow")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Error("Failed to save escrow after charging", slog.Any("error", err), "charge_id", chargeID)
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Escrow held")
+	logger.Info("Escrow held.", "escrow_id", dto.EscrowID, "amount", amount.Amount, "currency", amount.Currency)
+	return dto, nil
+}
@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/escrow"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/idempotency"
+)
+
+// replay returns the outcome stored under the record's key, or nil when the
+// key has not been used yet. A key used for a different request is an error.
+func replay(ctx context.Context, repo idempotency.IdempotencyRepository, record *idempotency.Record) (*EscrowDto, error) {
+	stored, err := repo.Find(ctx, record.Key)
+	if errors.Is(err, idempotency.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !stored.Matches(record) {
+		return nil, idempotency.ErrKeyReused
+	}
+	var dto EscrowDto
+	if err := json.Unmarshal(stored.Response, &dto); err != nil {
+		return nil, fmt.Errorf("failed to decode stored response of idempotency key: %w", err)
+	}
+	return &dto, nil
+}
+
+// saveWithRecord stores the escrow together with the outcome of the command.
+// When the save loses a race against a concurrent request with the same key,
+// that request's outcome is returned instead.
+func saveWithRecord(
+	ctx context.Context,
+	escrowRepo escrow.EscrowRepository,
+	idempotencyRepo idempotency.IdempotencyRepository,
+	e *escrow.Escrow,
+	record *idempotency.Record,
+	dto *EscrowDto,
+) (*EscrowDto, error) {
+	response, err := json.Marshal(dto)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode response for idempotency key: %w", err)
+	}
+	record.Response = response
+
+	saveErr := escrowRepo.Save(ctx, e, record)
+	if saveErr == nil {
+		return dto, nil
+	}
+	if prior, err := replay(ctx, idempotencyRepo, record); err == nil && prior != nil {
+		return prior, nil
+	}
+	return nil, saveErr
+}
@@ -0,0 +1,149 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/escrow"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/idempotency"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/provider"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/money"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RefundEscrowCommand returns escrowed money to the payer. Without an
+// amount the whole remaining balance is refunded.
+type RefundEscrowCommand struct {
+	TaskID         ids.TaskID   `json:"taskId" validate:"required,uuid"`
+	Amount         *int64       `json:"amount,omitempty" validate:"omitempty,gt=0"`
+	Reason         *string      `json:"reason,omitempty" validate:"omitempty,max=500"`
+	IdempotencyKey string       `json:"-" validate:"required,max=255"`
+	Actor          escrow.Actor `json:"-"`
+}
+
+type RefundEscrowCommandHandler struct {
+	escrowRepo      escrow.EscrowRepository
+	idempotencyRepo idempotency.IdempotencyRepository
+	provider        provider.PaymentProvider
+	logger          *slog.Logger
+	config          *config.Config
+	tracer          trace.Tracer
+}
+
+func NewRefundEscrowCommandHandler(
+	escrowRepo escrow.EscrowRepository,
+	idempotencyRepo idempotency.IdempotencyRepository,
+	paymentProvider provider.PaymentProvider,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *RefundEscrowCommandHandler {
+	return &RefundEscrowCommandHandler{
+		escrowRepo:      escrowRepo,
+		idempotencyRepo: idempotencyRepo,
+		provider:        paymentProvider,
+		logger:          logger.With(slog.String("component", "RefundEscrowCommandHandler")),
+		config:          cfg,
+		tracer:          otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *RefundEscrowCommandHandler) Handle(ctx context.Context, cmd RefundEscrowCommand) (*EscrowDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(cmd.TaskID)))
+
+	ctx, span := h.tracer.Start(ctx, "RefundEscrowCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", string(cmd.TaskID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	if cmd.Actor.UserID == "" {
+		span.SetStatus(codes.Error, "Anonymous escrow refund")
+		span.SetAttributes(attribute.String("error.type", string(escrow.ErrSignInRequired.Code)))
+		return nil, escrow.ErrSignInRequired
+	}
+
+	record, err := idempotency.NewRecord(cmd.IdempotencyKey, "RefundEscrow", cmd.Actor.UserID, cmd)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to fingerprint request")
+		span.RecordError(err)
+		return nil, err
+	}
+	if prior, err := replay(ctx, h.idempotencyRepo, record); err != nil || prior != nil {
+		span.SetAttributes(attribute.Bool("idempotency.replayed", prior != nil))
+		if err != nil {
+			span.SetStatus(codes.Error, "Idempotency check failed")
+			logger.Warn("Idempotency check failed", slog.Any("error", err))
+		}
+		return prior, err
+	}
+
+	e, err := h.escrowRepo.FindByTaskID(ctx, cmd.TaskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Escrow lookup failed")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Escrow lookup failed", slog.Any("error", err))
+		return nil, err
+	}
+	if !e.CanView(cmd.Actor) {
+		span.SetStatus(codes.Error, "Escrow not visible")
+		return nil, escrow.ErrEscrowNotFound
+	}
+
+	amount := e.Balance()
+	if cmd.Amount != nil {
+		amount = money.Money{Amount: *cmd.Amount, Currency: amount.Currency}
+	}
+	if err := e.CheckRefund(cmd.Actor, amount); err != nil {
+		span.SetStatus(codes.Error, "Refund not allowed")
+		span.SetAttributes(attribute.String("error.type", "refund_not_allowed"))
+		logger.Warn("Rejected escrow refund", slog.Any("error", err))
+		return nil, err
+	}
+
+	refundID, err := h.provider.Refund(ctx, provider.RefundRequest{
+		IdempotencyKey: cmd.IdempotencyKey,
+		ChargeID:       e.ProviderChargeID,
+		Amount:         amount,
+		Reason:         cmd.Reason,
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Provider refund failed")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "provider_refund_error"))
+		logger.Warn("Payment provider refund failed", slog.Any("error", err))
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("provider.refund_id", refundID))
+
+	if err := e.Refund(cmd.Actor, amount, refundID, cmd.Reason); err != nil {
+		span.SetStatus(codes.Error, "Refund failed")
+		span.RecordError(err)
+		logger.Error("Refunded at provider but could not record it", slog.Any("error", err), "refund_id", refundID)
+		return nil, err
+	}
+
+	dto, err := saveWithRecord(ctx, h.escrowRepo, h.idempotencyRepo, e, record, newEscrowDto(e))
+	if err != nil {
+		// Retrying with the same key reuses the provider refund.
+		span.SetStatus(codes.Error, "Failed to save escrow")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Error("Failed to save refunded escrow", slog.Any("error", err), "refund_id", refundID)
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Escrow refunded")
+	logger.Info("Escrow refunded.", "escrow_id", dto.EscrowID, "amount", amount.Amount, "currency", amount.Currency)
+	return dto, nil
+}
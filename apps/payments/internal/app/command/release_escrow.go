@@ -0,0 +1,154 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/escrow"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/fee"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/idempotency"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ReleaseEscrowCommand pays the escrowed balance out to the tasker, less the
+// platform fee. It is sent when the task is completed, and refused before.
+type ReleaseEscrowCommand struct {
+	TaskID         ids.TaskID   `json:"taskId" validate:"required,uuid"`
+	IdempotencyKey string       `json:"-" validate:"required,max=255"`
+	Actor          escrow.Actor `json:"-"`
+}
+
+type ReleaseEscrowCommandHandler struct {
+	escrowRepo      escrow.EscrowRepository
+	idempotencyRepo idempotency.IdempotencyRepository
+	awards          escrow.AwardReader
+	fees            *fee.Policy
+	logger          *slog.Logger
+	config          *config.Config
+	tracer          trace.Tracer
+}
+
+func NewReleaseEscrowCommandHandler(
+	escrowRepo escrow.EscrowRepository,
+	idempotencyRepo idempotency.IdempotencyRepository,
+	awards escrow.AwardReader,
+	fees *fee.Policy,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ReleaseEscrowCommandHandler {
+	return &ReleaseEscrowCommandHandler{
+		escrowRepo:      escrowRepo,
+		idempotencyRepo: idempotencyRepo,
+		awards:          awards,
+		fees:            fees,
+		logger:          logger.With(slog.String("component", "ReleaseEscrowCommandHandler")),
+		config:          cfg,
+		tracer:          otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *ReleaseEscrowCommandHandler) Handle(ctx context.Context, cmd ReleaseEscrowCommand) (*EscrowDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(cmd.TaskID)))
+
+	ctx, span := h.tracer.Start(ctx, "ReleaseEscrowCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", string(cmd.TaskID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	if cmd.Actor.UserID == "" {
+		span.SetStatus(codes.Error, "Anonymous escrow release")
+		span.SetAttributes(attribute.String("error.type", string(escrow.ErrSignInRequired.Code)))
+		return nil, escrow.ErrSignInRequired
+	}
+
+	record, err := idempotency.NewRecord(cmd.IdempotencyKey, "ReleaseEscrow", cmd.Actor.UserID, cmd)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to fingerprint request")
+		span.RecordError(err)
+		return nil, err
+	}
+	if prior, err := replay(ctx, h.idempotencyRepo, record); err != nil || prior != nil {
+		span.SetAttributes(attribute.Bool("idempotency.replayed", prior != nil))
+		if err != nil {
+			span.SetStatus(codes.Error, "Idempotency check failed")
+			logger.Warn("Idempotency check failed", slog.Any("error", err))
+		}
+		return prior, err
+	}
+
+	e, err := h.escrowRepo.FindByTaskID(ctx, cmd.TaskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Escrow lookup failed")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Escrow lookup failed", slog.Any("error", err))
+		return nil, err
+	}
+	if !e.CanView(cmd.Actor) {
+		span.SetStatus(codes.Error, "Escrow not visible")
+		return nil, escrow.ErrEscrowNotFound
+	}
+	if err := e.CheckRelease(cmd.Actor); err != nil {
+		span.SetStatus(codes.Error, "Release not allowed")
+		span.SetAttributes(attribute.String("error.type", "release_not_allowed"))
+		logger.Warn("Rejected escrow release", slog.Any("error", err))
+		return nil, err
+	}
+
+	// The tasks service owns task status; the payer pays out for work that
+	// is done, not for work that was merely assigned.
+	award, err := h.awards.AwardOf(ctx, cmd.TaskID, e.BidID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to check task award")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "task_award_error"))
+		logger.Warn("Failed to check the task award with the tasks service", slog.Any("error", err))
+		return nil, err
+	}
+	if err := award.CheckRelease(); err != nil {
+		span.SetStatus(codes.Error, "Task not completed")
+		span.SetAttributes(attribute.String("error.type", string(escrow.ErrTaskNotCompleted.Code)))
+		logger.Warn("Rejected escrow release before the task was completed", slog.Any("error", err))
+		return nil, err
+	}
+
+	platformFee, err := h.fees.FeeFor(e.Balance())
+	if err != nil {
+		span.SetStatus(codes.Error, "Fee calculation failed")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "fee_error"))
+		logger.Error("Failed to calculate platform fee", slog.Any("error", err))
+		return nil, err
+	}
+	if err := e.Release(cmd.Actor, platformFee); err != nil {
+		span.SetStatus(codes.Error, "Release failed")
+		span.RecordError(err)
+		logger.Error("Failed to release escrow", slog.Any("error", err))
+		return nil, err
+	}
+
+	dto, err := saveWithRecord(ctx, h.escrowRepo, h.idempotencyRepo, e, record, newEscrowDto(e))
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to save escrow")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Error("Failed to save released escrow", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Escrow released")
+	span.SetAttributes(attribute.Int64("escrow.fee", dto.FeeAmount))
+	logger.Info("Escrow released.", "escrow_id", dto.EscrowID, "fee", dto.FeeAmount, "currency", dto.Currency)
+	return dto, nil
+}
@@ -0,0 +1,181 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/escrow"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/fee"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/idempotency"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/provider"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/money"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SettleDisputeCommand carries out an admin's decision on a dispute about
+// the task: it refunds the payer, releases to the payee, or both. It is sent
+// when the tasks service resolves the dispute.
+type SettleDisputeCommand struct {
+	TaskID    ids.TaskID     `json:"taskId" validate:"required,uuid"`
+	DisputeID string         `json:"disputeId" validate:"required,uuid"`
+	Outcome   escrow.Outcome `json:"outcome" validate:"required"`
+	// RefundAmount is only given for partial refunds.
+	RefundAmount   *int64       `json:"refundAmount,omitempty" validate:"omitempty,gt=0"`
+	IdempotencyKey string       `json:"-" validate:"required,max=255"`
+	Actor          escrow.Actor `json:"-"`
+}
+
+type SettleDisputeCommandHandler struct {
+	escrowRepo      escrow.EscrowRepository
+	idempotencyRepo idempotency.IdempotencyRepository
+	provider        provider.PaymentProvider
+	fees            *fee.Policy
+	logger          *slog.Logger
+	config          *config.Config
+	tracer          trace.Tracer
+}
+
+func NewSettleDisputeCommandHandler(
+	escrowRepo escrow.EscrowRepository,
+	idempotencyRepo idempotency.IdempotencyRepository,
+	paymentProvider provider.PaymentProvider,
+	fees *fee.Policy,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *SettleDisputeCommandHandler {
+	return &SettleDisputeCommandHandler{
+		escrowRepo:      escrowRepo,
+		idempotencyRepo: idempotencyRepo,
+		provider:        paymentProvider,
+		fees:            fees,
+		logger:          logger.With(slog.String("component", "SettleDisputeCommandHandler")),
+		config:          cfg,
+		tracer:          otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *SettleDisputeCommandHandler) Handle(ctx context.Context, cmd SettleDisputeCommand) (*EscrowDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(
+		slog.String("task_id", string(cmd.TaskID)),
+		slog.String("dispute_id", cmd.DisputeID),
+	)
+
+	ctx, span := h.tracer.Start(ctx, "SettleDisputeCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", string(cmd.TaskID)),
+		attribute.String("dispute.id", cmd.DisputeID),
+		attribute.String("dispute.outcome", string(cmd.Outcome)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	if cmd.Actor.UserID == "" {
+		span.SetStatus(codes.Error, "Anonymous dispute settlement")
+		span.SetAttributes(attribute.String("error.type", string(escrow.ErrSignInRequired.Code)))
+		return nil, escrow.ErrSignInRequired
+	}
+
+	record, err := idempotency.NewRecord(cmd.IdempotencyKey, "SettleDispute", cmd.Actor.UserID, cmd)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to fingerprint request")
+		span.RecordError(err)
+		return nil, err
+	}
+	if prior, err := replay(ctx, h.idempotencyRepo, record); err != nil || prior != nil {
+		span.SetAttributes(attribute.Bool("idempotency.replayed", prior != nil))
+		if err != nil {
+			span.SetStatus(codes.Error, "Idempotency check failed")
+			logger.Warn("Idempotency check failed", slog.Any("error", err))
+		}
+		return prior, err
+	}
+
+	e, err := h.escrowRepo.FindByTaskID(ctx, cmd.TaskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Escrow lookup failed")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Escrow lookup failed", slog.Any("error", err))
+		return nil, err
+	}
+	if err := e.CheckSettle(cmd.Actor, cmd.Outcome, cmd.RefundAmount); err != nil {
+		span.SetStatus(codes.Error, "Settlement not allowed")
+		span.SetAttributes(attribute.String("error.type", "settlement_not_allowed"))
+		logger.Warn("Rejected dispute settlement", slog.Any("error", err))
+		return nil, err
+	}
+
+	var refund money.Money
+	switch cmd.Outcome {
+	case escrow.OutcomeRefund:
+		refund = e.Balance()
+	case escrow.OutcomePartialRefund:
+		refund = money.Money{Amount: *cmd.RefundAmount, Currency: e.Amount.Currency}
+	}
+	if refund.Amount > 0 {
+		reason := "dispute " + cmd.DisputeID
+		refundID, err := h.provider.Refund(ctx, provider.RefundRequest{
+			// The release below does not reach the provider, so the refund
+			// is the only call a retry has to reuse.
+			IdempotencyKey: cmd.IdempotencyKey + ":refund",
+			ChargeID:       e.ProviderChargeID,
+			Amount:         refund,
+			Reason:         &reason,
+		})
+		if err != nil {
+			span.SetStatus(codes.Error, "Provider refund failed")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "provider_refund_error"))
+			logger.Warn("Payment provider refund failed", slog.Any("error", err))
+			return nil, err
+		}
+		span.SetAttributes(attribute.String("provider.refund_id", refundID))
+
+		if err := e.Refund(cmd.Actor, refund, refundID, &reason); err != nil {
+			span.SetStatus(codes.Error, "Refund failed")
+			span.RecordError(err)
+			logger.Error("Refunded at provider but could not record it", slog.Any("error", err), "refund_id", refundID)
+			return nil, err
+		}
+	}
+
+	if cmd.Outcome != escrow.OutcomeRefund {
+		platformFee, err := h.fees.FeeFor(e.Balance())
+		if err != nil {
+			span.SetStatus(codes.Error, "Fee calculation failed")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "fee_error"))
+			logger.Error("Failed to calculate platform fee", slog.Any("error", err))
+			return nil, err
+		}
+		if err := e.Release(cmd.Actor, platformFee); err != nil {
+			span.SetStatus(codes.Error, "Release failed")
+			span.RecordError(err)
+			logger.Error("Failed to release escrow", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	dto, err := saveWithRecord(ctx, h.escrowRepo, h.idempotencyRepo, e, record, newEscrowDto(e))
+	if err != nil {
+		// Retrying with the same key reuses the provider refund.
+		span.SetStatus(codes.Error, "Failed to save escrow")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Error("Failed to save settled escrow", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Dispute settled")
+	logger.Info("Dispute settled.", "escrow_id", dto.EscrowID, "outcome", cmd.Outcome, "refunded", dto.RefundedAmount, "fee", dto.FeeAmount, "currency", dto.Currency)
+	return dto, nil
+}
@@ -0,0 +1,84 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/escrow"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/ledger"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetBalanceQuery reads what the platform owes a tasker in one currency:
+// released escrows less fees, not yet paid out.
+type GetBalanceQuery struct {
+	UserID   ids.UserID   `json:"userId" validate:"required,uuid"`
+	Currency string       `json:"currency" validate:"required,len=3"`
+	Actor    escrow.Actor `json:"-"`
+}
+
+type BalanceDTO struct {
+	UserID   string `json:"userId"`
+	Currency string `json:"currency"`
+	Amount   int64  `json:"amount"`
+}
+
+type GetBalanceQueryHandler struct {
+	ledgerRepo ledger.LedgerRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewGetBalanceQueryHandler(
+	ledgerRepo ledger.LedgerRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *GetBalanceQueryHandler {
+	return &GetBalanceQueryHandler{
+		ledgerRepo: ledgerRepo,
+		logger:     logger.With(slog.String("component", "GetBalanceQueryHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *GetBalanceQueryHandler) Handle(ctx context.Context, qry GetBalanceQuery) (*BalanceDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(qry.UserID)))
+
+	ctx, span := h.tracer.Start(ctx, "GetBalanceQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	currency := strings.ToUpper(qry.Currency)
+	span.SetAttributes(
+		attribute.String("user.id", string(qry.UserID)),
+		attribute.String("currency", currency),
+	)
+
+	if !qry.Actor.IsAdmin && (qry.Actor.UserID == "" || qry.Actor.UserID != qry.UserID) {
+		span.SetStatus(codes.Error, "Balance not visible to caller")
+		span.SetAttributes(attribute.String("error.type", string(ledger.ErrBalanceHidden.Code)))
+		logger.Warn("Rejected balance lookup", "actor_id", string(qry.Actor.UserID))
+		return nil, ledger.ErrBalanceHidden
+	}
+
+	amount, err := h.ledgerRepo.Balance(ctx, ledger.TaskerPayable(qry.UserID, currency))
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to read balance")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to read balance", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Balance retrieved")
+	return &BalanceDTO{UserID: string(qry.UserID), Currency: currency, Amount: amount}, nil
+}
@@ -0,0 +1,164 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/escrow"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/ledger"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GetEscrowQuery struct {
+	TaskID ids.TaskID   `json:"taskId" validate:"required,uuid"`
+	Actor  escrow.Actor `json:"-"`
+}
+
+type PostingDTO struct {
+	AccountCode string           `json:"accountCode"`
+	Direction   ledger.Direction `json:"direction"`
+	Amount      int64            `json:"amount"`
+}
+
+type JournalEntryDTO struct {
+	ID          string           `json:"id"`
+	Kind        ledger.EntryKind `json:"kind"`
+	Description string           `json:"description"`
+	ExternalRef *string          `json:"externalRef,omitempty"`
+	Postings    []PostingDTO     `json:"postings"`
+	CreatedAt   time.Time        `json:"createdAt"`
+}
+
+// EscrowDTO is an escrow with its journal entries, oldest first. Amounts are
+// in minor currency units.
+type EscrowDTO struct {
+	ID             string            `json:"id"`
+	TaskID         string            `json:"taskId"`
+	BidID          *string           `json:"bidId,omitempty"`
+	PayerID        string            `json:"payerId"`
+	PayeeID        string            `json:"payeeId"`
+	Currency       string            `json:"currency"`
+	Amount         int64             `json:"amount"`
+	RefundedAmount int64             `json:"refundedAmount"`
+	FeeAmount      int64             `json:"feeAmount"`
+	Balance        int64             `json:"balance"`
+	Status         escrow.Status     `json:"status"`
+	Entries        []JournalEntryDTO `json:"entries"`
+	ReleasedAt     *time.Time        `json:"releasedAt,omitempty"`
+	RefundedAt     *time.Time        `json:"refundedAt,omitempty"`
+	CreatedAt      time.Time         `json:"createdAt"`
+	UpdatedAt      time.Time         `json:"updatedAt"`
+}
+
+type GetEscrowQueryHandler struct {
+	escrowRepo escrow.EscrowRepository
+	ledgerRepo ledger.LedgerRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewGetEscrowQueryHandler(
+	escrowRepo escrow.EscrowRepository,
+	ledgerRepo ledger.LedgerRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *GetEscrowQueryHandler {
+	return &GetEscrowQueryHandler{
+		escrowRepo: escrowRepo,
+		ledgerRepo: ledgerRepo,
+		logger:     logger.With(slog.String("component", "GetEscrowQueryHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *GetEscrowQueryHandler) Handle(ctx context.Context, qry GetEscrowQuery) (*EscrowDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(qry.TaskID)))
+
+	ctx, span := h.tracer.Start(ctx, "GetEscrowQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("task.id", string(qry.TaskID)))
+
+	e, err := h.escrowRepo.FindByTaskID(ctx, qry.TaskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve escrow")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve escrow", slog.Any("error", err))
+		return nil, err
+	}
+
+	// Payments are private to payer and payee; escrows of others are
+	// reported as missing.
+	if !e.CanView(qry.Actor) {
+		span.SetStatus(codes.Error, "Escrow not visible to caller")
+		span.SetAttributes(attribute.String("error.type", string(escrow.ErrEscrowNotFound.Code)))
+		logger.Warn("Hid escrow from caller", "actor_id", string(qry.Actor.UserID))
+		return nil, escrow.ErrEscrowNotFound
+	}
+
+	entries, err := h.ledgerRepo.ListByEscrow(ctx, e.ID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve journal entries")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to retrieve journal entries", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Escrow retrieved")
+	return newEscrowDTO(e, entries), nil
+}
+
+func newEscrowDTO(e *escrow.Escrow, entries []*ledger.JournalEntry) *EscrowDTO {
+	dto := &EscrowDTO{
+		ID:             string(e.ID),
+		TaskID:         string(e.TaskID),
+		PayerID:        string(e.PayerID),
+		PayeeID:        string(e.PayeeID),
+		Currency:       e.Amount.Currency,
+		Amount:         e.Amount.Amount,
+		RefundedAmount: e.RefundedAmount,
+		FeeAmount:      e.FeeAmount,
+		Balance:        e.Balance().Amount,
+		Status:         e.Status,
+		Entries:        make([]JournalEntryDTO, 0, len(entries)),
+		ReleasedAt:     e.ReleasedAt,
+		RefundedAt:     e.RefundedAt,
+		CreatedAt:      e.CreatedAt,
+		UpdatedAt:      e.UpdatedAt,
+	}
+	if e.BidID != nil {
+		bidID := string(*e.BidID)
+		dto.BidID = &bidID
+	}
+	for _, entry := range entries {
+		entryDTO := JournalEntryDTO{
+			ID:          string(entry.ID),
+			Kind:        entry.Kind,
+			Description: entry.Description,
+			ExternalRef: entry.ExternalRef,
+			Postings:    make([]PostingDTO, 0, len(entry.Postings)),
+			CreatedAt:   entry.CreatedAt,
+		}
+		for _, p := range entry.Postings {
+			entryDTO.Postings = append(entryDTO.Postings, PostingDTO{
+				AccountCode: p.Account.Code,
+				Direction:   p.Direction,
+				Amount:      p.Amount,
+			})
+		}
+		dto.Entries = append(dto.Entries, entryDTO)
+	}
+	return dto
+}
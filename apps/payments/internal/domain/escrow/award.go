@@ -0,0 +1,56 @@
+package escrow
+
+import (
+	"context"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/money"
+)
+
+// Award is a task as the tasks service has it assigned: its poster pays the
+// assigned tasker the price they agreed on, which is the accepted offer of
+// the bid the task went through, or the task's budget when it was assigned
+// directly.
+type Award struct {
+	TaskID   ids.TaskID
+	BidID    *ids.BidID
+	PosterID ids.UserID
+	TaskerID ids.UserID
+	Price    money.Money
+	// Completed is set once the tasker finished the task.
+	Completed bool
+}
+
+// Check reports whether an escrow of amount paid by payerID to payeeID is
+// the one the award calls for. Tasks are paid for before they are done, so
+// a completed task takes no new escrow.
+func (a *Award) Check(payerID, payeeID ids.UserID, amount money.Money) error {
+	if a.Completed {
+		return ErrTaskNotAwarded
+	}
+	if payerID != a.PosterID {
+		return ErrNotTaskPoster
+	}
+	if payeeID != a.TaskerID || amount != a.Price {
+		return ErrAwardMismatch
+	}
+	return nil
+}
+
+// CheckRelease reports whether the escrow of the task may be released: the
+// payer pays out for work that is done.
+func (a *Award) CheckRelease() error {
+	if !a.Completed {
+		return ErrTaskNotCompleted
+	}
+	return nil
+}
+
+// AwardReader looks tasks up in the tasks service, which owns them.
+type AwardReader interface {
+	// AwardOf returns the award of a task, made through bidID when it is
+	// set. It fails with ErrTaskNotFound when the caller cannot see the
+	// task, and with ErrTaskNotAwarded when the task is not assigned, in
+	// progress or completed, or bidID is not its accepted bid.
+	AwardOf(ctx context.Context, taskID ids.TaskID, bidID *ids.BidID) (*Award, error)
+}
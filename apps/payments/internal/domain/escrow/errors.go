@@ -0,0 +1,23 @@
+package escrow
+
+import errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+
+var (
+	ErrEscrowNotFound       = errs.NewWithReason(errs.CodeNotFound, "ESCROW_NOT_FOUND", "escrow not found")
+	ErrEscrowAlreadyExists  = errs.NewWithReason(errs.CodeAlreadyExists, "ESCROW_EXISTS", "the task already has an escrow")
+	ErrSignInRequired       = errs.NewWithReason(errs.CodeUnauthorized, "ESCROW_SIGN_IN_REQUIRED", "sign in to manage payments")
+	ErrNotPayer             = errs.NewWithReason(errs.CodeForbidden, "ESCROW_NOT_PAYER", "only the payer or an admin can release an escrow")
+	ErrNotPayeeOrAdmin      = errs.NewWithReason(errs.CodeForbidden, "ESCROW_NOT_PAYEE_OR_ADMIN", "only the payee or an admin can refund an escrow")
+	ErrPayeeIsPayer         = errs.NewWithReason(errs.CodeInvalidArgument, "ESCROW_PAYEE_IS_PAYER", "the payer cannot pay themselves")
+	ErrEscrowNotHeld        = errs.NewWithReason(errs.CodeFailedPrecondition, "ESCROW_NOT_HELD", "the escrow has already been released or refunded")
+	ErrEscrowNotPending     = errs.NewWithReason(errs.CodeFailedPrecondition, "ESCROW_NOT_PENDING", "the escrow has already been charged")
+	ErrRefundExceedsBalance = errs.NewWithReason(errs.CodeInvalidArgument, "ESCROW_REFUND_EXCEEDS_BALANCE", "the refund is larger than the escrowed balance")
+	ErrAdminOnly            = errs.NewWithReason(errs.CodeForbidden, "ESCROW_ADMIN_ONLY", "only admins can settle disputes")
+	ErrInvalidSettlement    = errs.NewWithReason(errs.CodeInvalidArgument, "ESCROW_INVALID_SETTLEMENT", "outcome must be REFUND, PARTIAL_REFUND with an amount below the balance, or RELEASE")
+	ErrEscrowChanged        = errs.NewWithReason(errs.CodeAborted, "ESCROW_CHANGED", "the escrow changed while processing the request; retry it")
+	ErrTaskNotFound         = errs.NewWithReason(errs.CodeNotFound, "ESCROW_TASK_NOT_FOUND", "task not found")
+	ErrTaskNotAwarded       = errs.NewWithReason(errs.CodeFailedPrecondition, "ESCROW_TASK_NOT_AWARDED", "the task is not assigned through this bid; accept a bid before paying for it")
+	ErrNotTaskPoster        = errs.NewWithReason(errs.CodeForbidden, "ESCROW_NOT_TASK_POSTER", "only the task's poster can pay for it")
+	ErrAwardMismatch        = errs.NewWithReason(errs.CodeInvalidArgument, "ESCROW_AWARD_MISMATCH", "the payee and amount must be the tasker and the price the task was awarded for")
+	ErrTaskNotCompleted     = errs.NewWithReason(errs.CodeFailedPrecondition, "ESCROW_TASK_NOT_COMPLETED", "the escrow is released once the task is completed")
+)
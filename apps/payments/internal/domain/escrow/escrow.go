@@ -0,0 +1,274 @@
+package escrow
+
+import (
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/ledger"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/money"
+)
+
+// Status is where the escrowed funds are:
+//
+//	PENDING → HELD → RELEASED | REFUNDED
+//
+// PENDING reserves the task's escrow while the payer is charged, so two
+// holds cannot both charge. Partial refunds keep the escrow HELD; refunding
+// the rest makes it REFUNDED, releasing the rest makes it RELEASED.
+type Status string
+
+const (
+	StatusPending  Status = "PENDING"
+	StatusHeld     Status = "HELD"
+	StatusReleased Status = "RELEASED"
+	StatusRefunded Status = "REFUNDED"
+)
+
+// Actor is the user performing an action on an escrow. The zero value is an
+// anonymous caller.
+type Actor struct {
+	UserID  ids.UserID
+	IsAdmin bool
+}
+
+// Escrow holds what a poster pays for a task from the moment a bid is
+// accepted until the work is completed (release to the tasker) or called
+// off (refund to the poster). Every change records a balanced journal entry
+// that the repository writes in the same transaction.
+type Escrow struct {
+	ID               ids.EscrowID
+	TaskID           ids.TaskID
+	BidID            *ids.BidID
+	PayerID          ids.UserID
+	PayeeID          ids.UserID
+	Amount           money.Money
+	RefundedAmount   int64
+	FeeAmount        int64
+	Status           Status
+	ProviderChargeID string
+	ReleasedAt       *time.Time
+	RefundedAt       *time.Time
+	Version          int32
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+
+	entries []*ledger.JournalEntry
+}
+
+// ReserveEscrow claims the task's escrow for a payer before anything is
+// charged. The escrow holds nothing until Hold records the charge.
+func ReserveEscrow(
+	taskID ids.TaskID,
+	bidID *ids.BidID,
+	payerID ids.UserID,
+	payeeID ids.UserID,
+	amount money.Money,
+) (*Escrow, error) {
+	if payerID == payeeID {
+		return nil, ErrPayeeIsPayer
+	}
+	now := time.Now()
+	return &Escrow{
+		ID:        ids.NewEscrowID(),
+		TaskID:    taskID,
+		BidID:     bidID,
+		PayerID:   payerID,
+		PayeeID:   payeeID,
+		Amount:    amount,
+		Status:    StatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// ChargeKey is the idempotency key the payer is charged under. It belongs
+// to the reservation rather than the request, so finishing a reservation
+// from any request reuses the one charge.
+func (e *Escrow) ChargeKey() string {
+	return "escrow-hold:" + string(e.ID)
+}
+
+// Resumes reports whether a hold of amount from payerID to payeeID may
+// finish this reservation instead of being refused as a second escrow.
+func (e *Escrow) Resumes(payerID, payeeID ids.UserID, amount money.Money) bool {
+	return e.Status == StatusPending && e.PayerID == payerID && e.PayeeID == payeeID && e.Amount == amount
+}
+
+// Hold records funds the provider charged to the payer for a reservation.
+func (e *Escrow) Hold(providerChargeID string) error {
+	if e.Status != StatusPending {
+		return ErrEscrowNotPending
+	}
+	now := time.Now()
+	err := e.record(ledger.EntryEscrowHold, "escrow hold", &providerChargeID, now, []ledger.Posting{
+		{Account: ledger.ProviderClearing(e.Amount.Currency), Direction: ledger.Debit, Amount: e.Amount.Amount},
+		{Account: ledger.EscrowAccount(e.ID, e.Amount.Currency), Direction: ledger.Credit, Amount: e.Amount.Amount},
+	})
+	if err != nil {
+		return err
+	}
+	e.Status = StatusHeld
+	e.ProviderChargeID = providerChargeID
+	e.UpdatedAt = now
+	return nil
+}
+
+func NewEscrowFromRepository(
+	id string,
+	taskID string,
+	bidID *ids.BidID,
+	payerID string,
+	payeeID string,
+	amount money.Money,
+	refundedAmount int64,
+	feeAmount int64,
+	status Status,
+	providerChargeID string,
+	releasedAt *time.Time,
+	refundedAt *time.Time,
+	version int32,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Escrow {
+	return &Escrow{
+		ID:               ids.EscrowID(id),
+		TaskID:           ids.TaskID(taskID),
+		BidID:            bidID,
+		PayerID:          ids.UserID(payerID),
+		PayeeID:          ids.UserID(payeeID),
+		Amount:           amount,
+		RefundedAmount:   refundedAmount,
+		FeeAmount:        feeAmount,
+		Status:           status,
+		ProviderChargeID: providerChargeID,
+		ReleasedAt:       releasedAt,
+		RefundedAt:       refundedAt,
+		Version:          version,
+		CreatedAt:        createdAt,
+		UpdatedAt:        updatedAt,
+	}
+}
+
+// Balance is what is still held: the amount less refunds, or nothing
+// before the charge or once released.
+func (e *Escrow) Balance() money.Money {
+	if e.Status == StatusPending || e.Status == StatusReleased {
+		return money.Zero(e.Amount.Currency)
+	}
+	return money.Money{Amount: e.Amount.Amount - e.RefundedAmount, Currency: e.Amount.Currency}
+}
+
+// CanView reports whether the actor may see the escrow and its ledger.
+func (e *Escrow) CanView(a Actor) bool {
+	return a.IsAdmin || (a.UserID != "" && (a.UserID == e.PayerID || a.UserID == e.PayeeID))
+}
+
+// CheckRelease reports whether the actor may release the escrow now. The
+// payer releases by confirming the work is done; admins release when
+// settling disputes.
+func (e *Escrow) CheckRelease(a Actor) error {
+	if !a.IsAdmin && (a.UserID == "" || a.UserID != e.PayerID) {
+		return ErrNotPayer
+	}
+	if e.Status != StatusHeld {
+		return ErrEscrowNotHeld
+	}
+	return nil
+}
+
+// Release pays the balance out to the payee, less the platform fee.
+func (e *Escrow) Release(a Actor, fee money.Money) error {
+	if err := e.CheckRelease(a); err != nil {
+		return err
+	}
+	balance := e.Balance()
+	if fee.Currency != balance.Currency {
+		return money.ErrCurrencyMismatch
+	}
+	now := time.Now()
+	postings := []ledger.Posting{
+		{Account: ledger.EscrowAccount(e.ID, balance.Currency), Direction: ledger.Debit, Amount: balance.Amount},
+	}
+	if payout := balance.Amount - fee.Amount; payout > 0 {
+		postings = append(postings, ledger.Posting{Account: ledger.TaskerPayable(e.PayeeID, balance.Currency), Direction: ledger.Credit, Amount: payout})
+	}
+	if fee.Amount > 0 {
+		postings = append(postings, ledger.Posting{Account: ledger.PlatformFees(balance.Currency), Direction: ledger.Credit, Amount: fee.Amount})
+	}
+	if err := e.record(ledger.EntryEscrowRelease, "escrow release", nil, now, postings); err != nil {
+		return err
+	}
+	e.FeeAmount = fee.Amount
+	e.Status = StatusReleased
+	e.ReleasedAt = &now
+	e.UpdatedAt = now
+	return nil
+}
+
+// CheckRefund reports whether the actor may refund amount now. The payee
+// can hand money back; admins refund when settling disputes or when a task
+// is called off.
+func (e *Escrow) CheckRefund(a Actor, amount money.Money) error {
+	if !a.IsAdmin && (a.UserID == "" || a.UserID != e.PayeeID) {
+		return ErrNotPayeeOrAdmin
+	}
+	if e.Status != StatusHeld {
+		return ErrEscrowNotHeld
+	}
+	if amount.Currency != e.Amount.Currency {
+		return money.ErrCurrencyMismatch
+	}
+	if amount.Amount > e.Balance().Amount {
+		return ErrRefundExceedsBalance
+	}
+	return nil
+}
+
+// Refund returns amount to the payer; the provider already refunded it under
+// providerRefundID.
+func (e *Escrow) Refund(a Actor, amount money.Money, providerRefundID string, reason *string) error {
+	if err := e.CheckRefund(a, amount); err != nil {
+		return err
+	}
+	description := "escrow refund"
+	if reason != nil {
+		description = "escrow refund: " + *reason
+	}
+	now := time.Now()
+	err := e.record(ledger.EntryEscrowRefund, description, &providerRefundID, now, []ledger.Posting{
+		{Account: ledger.EscrowAccount(e.ID, amount.Currency), Direction: ledger.Debit, Amount: amount.Amount},
+		{Account: ledger.ProviderClearing(amount.Currency), Direction: ledger.Credit, Amount: amount.Amount},
+	})
+	if err != nil {
+		return err
+	}
+	e.RefundedAmount += amount.Amount
+	if e.Balance().Amount == 0 {
+		e.Status = StatusRefunded
+		e.RefundedAt = &now
+	}
+	e.UpdatedAt = now
+	return nil
+}
+
+// PendingEntries are the journal entries recorded since the escrow was
+// loaded; the repository writes them with the escrow.
+func (e *Escrow) PendingEntries() []*ledger.JournalEntry {
+	return e.entries
+}
+
+// ClearPendingEntries is called by the repository once the entries are
+// written.
+func (e *Escrow) ClearPendingEntries() {
+	e.entries = nil
+}
+
+func (e *Escrow) record(kind ledger.EntryKind, description string, externalRef *string, now time.Time, postings []ledger.Posting) error {
+	id := e.ID
+	entry, err := ledger.NewJournalEntry(kind, &id, e.Amount.Currency, description, externalRef, postings, now)
+	if err != nil {
+		return err
+	}
+	e.entries = append(e.entries, entry)
+	return nil
+}
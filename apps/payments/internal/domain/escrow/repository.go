@@ -0,0 +1,27 @@
+package escrow
+
+import (
+	"context"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/idempotency"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+)
+
+// EscrowRepository provides access to Escrow aggregates.
+type EscrowRepository interface {
+	// FindByTaskID retrieves the escrow of a task.
+	FindByTaskID(ctx context.Context, taskID ids.TaskID) (*Escrow, error)
+
+	// Save persists an Escrow aggregate (either creating or updating), its
+	// pending journal entries and the idempotency record of the command
+	// that changed it, all in one transaction. It fails with
+	// ErrEscrowChanged when the stored escrow moved past e.Version, and
+	// with idempotency.ErrKeyInFlight when another request stored the key
+	// first.
+	Save(ctx context.Context, e *Escrow, record *idempotency.Record) error
+
+	// Discard deletes a PENDING escrow whose charge failed, so the task can
+	// be paid for again. It fails with ErrEscrowChanged when the stored
+	// escrow moved past e.Version.
+	Discard(ctx context.Context, e *Escrow) error
+}
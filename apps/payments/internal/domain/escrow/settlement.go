@@ -0,0 +1,42 @@
+package escrow
+
+// Outcome is how a dispute about the task was decided, and so what happens
+// to the escrow:
+//
+//	REFUND          the payer gets back the whole balance
+//	PARTIAL_REFUND  the payer gets back part of it; the rest is released
+//	RELEASE         the payee is paid the whole balance, less the fee
+type Outcome string
+
+const (
+	OutcomeRefund        Outcome = "REFUND"
+	OutcomePartialRefund Outcome = "PARTIAL_REFUND"
+	OutcomeRelease       Outcome = "RELEASE"
+)
+
+func (o Outcome) IsValid() bool {
+	switch o {
+	case OutcomeRefund, OutcomePartialRefund, OutcomeRelease:
+		return true
+	}
+	return false
+}
+
+// CheckSettle reports whether the actor may settle a dispute about the
+// escrow with outcome. refundAmount is only given for partial refunds and
+// must leave something to release.
+func (e *Escrow) CheckSettle(a Actor, outcome Outcome, refundAmount *int64) error {
+	if !a.IsAdmin {
+		return ErrAdminOnly
+	}
+	if e.Status != StatusHeld {
+		return ErrEscrowNotHeld
+	}
+	if !outcome.IsValid() || (outcome == OutcomePartialRefund) != (refundAmount != nil) {
+		return ErrInvalidSettlement
+	}
+	if refundAmount != nil && (*refundAmount <= 0 || *refundAmount >= e.Balance().Amount) {
+		return ErrInvalidSettlement
+	}
+	return nil
+}
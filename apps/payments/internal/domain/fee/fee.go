@@ -0,0 +1,76 @@
+package fee
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/money"
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+)
+
+var ErrUnsupportedCurrency = errs.NewWithReason(errs.CodeInvalidArgument, "FEE_UNSUPPORTED_CURRENCY", "payments in this currency are not supported")
+
+// basisPoints is 100%.
+const basisPoints = 10_000
+
+// Rule is how the platform fee is calculated for one currency:
+//
+//	fee = Fixed + Amount × RateBps / 10000, rounded half up,
+//
+// then raised to Min and lowered to Max (when Max is not zero). The fee is
+// never more than the amount it is taken from.
+type Rule struct {
+	Currency string
+	RateBps  int64
+	Fixed    int64
+	Min      int64
+	Max      int64
+}
+
+// Policy holds one Rule per currency; currencies without a rule cannot be
+// paid in.
+type Policy struct {
+	rules map[string]Rule
+}
+
+func NewPolicy(rules []Rule) (*Policy, error) {
+	p := &Policy{rules: make(map[string]Rule, len(rules))}
+	for _, r := range rules {
+		r.Currency = strings.ToUpper(strings.TrimSpace(r.Currency))
+		if len(r.Currency) != 3 || r.RateBps < 0 || r.RateBps > basisPoints || r.Fixed < 0 || r.Min < 0 || r.Max < 0 || (r.Max > 0 && r.Max < r.Min) {
+			return nil, fmt.Errorf("invalid fee rule for currency %q", r.Currency)
+		}
+		if _, dup := p.rules[r.Currency]; dup {
+			return nil, fmt.Errorf("duplicate fee rule for currency %q", r.Currency)
+		}
+		p.rules[r.Currency] = r
+	}
+	return p, nil
+}
+
+// Supports reports whether there is a rule for the currency.
+func (p *Policy) Supports(currency string) bool {
+	_, ok := p.rules[currency]
+	return ok
+}
+
+// FeeFor calculates the platform fee taken from amount.
+func (p *Policy) FeeFor(amount money.Money) (money.Money, error) {
+	r, ok := p.rules[amount.Currency]
+	if !ok {
+		return money.Money{}, ErrUnsupportedCurrency
+	}
+	// Split the amount so amount × rate cannot overflow int64.
+	whole, rest := amount.Amount/basisPoints, amount.Amount%basisPoints
+	fee := r.Fixed + whole*r.RateBps + (rest*r.RateBps+basisPoints/2)/basisPoints
+	if fee < r.Min {
+		fee = r.Min
+	}
+	if r.Max > 0 && fee > r.Max {
+		fee = r.Max
+	}
+	if fee > amount.Amount {
+		fee = amount.Amount
+	}
+	return money.Money{Amount: fee, Currency: amount.Currency}, nil
+}
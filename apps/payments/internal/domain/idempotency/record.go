@@ -0,0 +1,60 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+)
+
+var (
+	ErrRecordNotFound = errs.NewWithReason(errs.CodeNotFound, "IDEMPOTENCY_RECORD_NOT_FOUND", "idempotency key not found")
+	ErrKeyReused      = errs.NewWithReason(errs.CodeInvalidArgument, "IDEMPOTENCY_KEY_REUSED", "the idempotency key was already used for a different request")
+	ErrKeyInFlight    = errs.NewWithReason(errs.CodeAborted, "IDEMPOTENCY_KEY_IN_FLIGHT", "a request with this idempotency key is already being processed; retry shortly")
+)
+
+// Record remembers the outcome of a money-moving command under the
+// caller's idempotency key, so a retried command returns the first outcome
+// instead of moving money again.
+type Record struct {
+	Key         string
+	Operation   string
+	ActorID     ids.UserID
+	RequestHash string
+	Response    []byte
+	CreatedAt   time.Time
+}
+
+// NewRecord fingerprints a request. The operation and the actor are part of
+// the fingerprint, so a key reused for another command or by another user
+// is rejected rather than replayed.
+func NewRecord(key string, operation string, actorID ids.UserID, request any) (*Record, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fingerprint request: %w", err)
+	}
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s\x00%s\x00%s", operation, actorID, body))
+	return &Record{
+		Key:         key,
+		Operation:   operation,
+		ActorID:     actorID,
+		RequestHash: hex.EncodeToString(sum[:]),
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+// Matches reports whether a stored record was made for the same request.
+func (r *Record) Matches(other *Record) bool {
+	return r.RequestHash == other.RequestHash
+}
+
+// IdempotencyRepository reads stored outcomes. Records are written in the
+// same transaction as the change they describe, see escrow.EscrowRepository.
+type IdempotencyRepository interface {
+	Find(ctx context.Context, key string) (*Record, error)
+}
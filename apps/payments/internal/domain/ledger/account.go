@@ -0,0 +1,58 @@
+package ledger
+
+import (
+	"fmt"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+)
+
+// AccountType decides which side of a posting increases an account.
+type AccountType string
+
+const (
+	// AccountAsset is money the platform holds, e.g. funds cleared by the
+	// payment provider. Debits increase it.
+	AccountAsset AccountType = "ASSET"
+	// AccountLiability is money the platform owes, e.g. escrowed funds or a
+	// tasker's earnings. Credits increase it.
+	AccountLiability AccountType = "LIABILITY"
+	// AccountRevenue is money the platform earned, i.e. fees. Credits
+	// increase it.
+	AccountRevenue AccountType = "REVENUE"
+)
+
+// IsDebitNormal reports whether debits increase the balance of the account.
+func (t AccountType) IsDebitNormal() bool {
+	return t == AccountAsset
+}
+
+// Account is identified by its Code, which encodes what the account is for
+// and its currency; accounts are created the first time they are posted to.
+type Account struct {
+	Code     string
+	Type     AccountType
+	Currency string
+	OwnerID  *ids.UserID
+}
+
+// ProviderClearing holds what the payment provider collected from payers
+// and not yet paid back out.
+func ProviderClearing(currency string) Account {
+	return Account{Code: fmt.Sprintf("provider_clearing:%s", currency), Type: AccountAsset, Currency: currency}
+}
+
+// EscrowAccount holds the funds of one escrow until they are released or
+// refunded.
+func EscrowAccount(escrowID ids.EscrowID, currency string) Account {
+	return Account{Code: fmt.Sprintf("escrow:%s", escrowID), Type: AccountLiability, Currency: currency}
+}
+
+// TaskerPayable is what the platform owes a tasker for completed work.
+func TaskerPayable(taskerID ids.UserID, currency string) Account {
+	return Account{Code: fmt.Sprintf("tasker_payable:%s:%s", taskerID, currency), Type: AccountLiability, Currency: currency, OwnerID: &taskerID}
+}
+
+// PlatformFees is what the platform earned in fees.
+func PlatformFees(currency string) Account {
+	return Account{Code: fmt.Sprintf("platform_fees:%s", currency), Type: AccountRevenue, Currency: currency}
+}
@@ -0,0 +1,110 @@
+package ledger
+
+import (
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+)
+
+type Direction string
+
+const (
+	Debit  Direction = "DEBIT"
+	Credit Direction = "CREDIT"
+)
+
+// EntryKind is the business event a journal entry records.
+type EntryKind string
+
+const (
+	EntryEscrowHold    EntryKind = "ESCROW_HOLD"
+	EntryEscrowRelease EntryKind = "ESCROW_RELEASE"
+	EntryEscrowRefund  EntryKind = "ESCROW_REFUND"
+)
+
+// Posting moves Amount minor units into or out of one account.
+type Posting struct {
+	Account   Account
+	Direction Direction
+	Amount    int64
+}
+
+// JournalEntry is one balanced movement of money: the debits equal the
+// credits. Entries are immutable once written; mistakes are corrected with
+// a new, reversing entry rather than by editing an old one.
+type JournalEntry struct {
+	ID          ids.EntryID
+	Kind        EntryKind
+	EscrowID    *ids.EscrowID
+	Currency    string
+	Description string
+	// ExternalRef is the payment provider's reference for the money
+	// movement, if any, e.g. a charge or refund ID.
+	ExternalRef *string
+	Postings    []Posting
+	CreatedAt   time.Time
+}
+
+// NewJournalEntry validates that the postings balance in a single currency.
+func NewJournalEntry(
+	kind EntryKind,
+	escrowID *ids.EscrowID,
+	currency string,
+	description string,
+	externalRef *string,
+	postings []Posting,
+	now time.Time,
+) (*JournalEntry, error) {
+	var debits, credits int64
+	for _, p := range postings {
+		if p.Amount <= 0 || p.Account.Currency != currency {
+			return nil, ErrInvalidPosting
+		}
+		switch p.Direction {
+		case Debit:
+			debits += p.Amount
+		case Credit:
+			credits += p.Amount
+		default:
+			return nil, ErrInvalidPosting
+		}
+	}
+	if debits == 0 || credits == 0 {
+		return nil, ErrTooFewPostings
+	}
+	if debits != credits {
+		return nil, ErrUnbalancedEntry
+	}
+	return &JournalEntry{
+		ID:          ids.NewEntryID(),
+		Kind:        kind,
+		EscrowID:    escrowID,
+		Currency:    currency,
+		Description: description,
+		ExternalRef: externalRef,
+		Postings:    postings,
+		CreatedAt:   now,
+	}, nil
+}
+
+func NewJournalEntryFromRepository(
+	id string,
+	kind EntryKind,
+	escrowID *ids.EscrowID,
+	currency string,
+	description string,
+	externalRef *string,
+	postings []Posting,
+	createdAt time.Time,
+) *JournalEntry {
+	return &JournalEntry{
+		ID:          ids.EntryID(id),
+		Kind:        kind,
+		EscrowID:    escrowID,
+		Currency:    currency,
+		Description: description,
+		ExternalRef: externalRef,
+		Postings:    postings,
+		CreatedAt:   createdAt,
+	}
+}
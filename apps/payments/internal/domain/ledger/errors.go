@@ -0,0 +1,10 @@
+package ledger
+
+import errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+
+var (
+	ErrUnbalancedEntry = errs.NewWithReason(errs.CodeInternal, "LEDGER_UNBALANCED_ENTRY", "journal entry debits and credits do not balance")
+	ErrInvalidPosting  = errs.NewWithReason(errs.CodeInternal, "LEDGER_INVALID_POSTING", "journal entry postings need a positive amount in the currency of the entry and its account")
+	ErrTooFewPostings  = errs.NewWithReason(errs.CodeInternal, "LEDGER_TOO_FEW_POSTINGS", "journal entries need at least one debit and one credit")
+	ErrBalanceHidden   = errs.NewWithReason(errs.CodeForbidden, "LEDGER_BALANCE_HIDDEN", "only the account owner or an admin can see a balance")
+)
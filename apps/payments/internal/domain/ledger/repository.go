@@ -0,0 +1,19 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+)
+
+// LedgerRepository reads the ledger. Entries are written together with the
+// aggregate whose change they record, see escrow.EscrowRepository.
+type LedgerRepository interface {
+	// ListByEscrow returns the entries of an escrow, oldest first.
+	ListByEscrow(ctx context.Context, escrowID ids.EscrowID) ([]*JournalEntry, error)
+
+	// Balance returns the balance of an account in its natural sign: debits
+	// minus credits for debit-normal accounts, credits minus debits
+	// otherwise. Accounts nobody posted to yet have a zero balance.
+	Balance(ctx context.Context, account Account) (int64, error)
+}
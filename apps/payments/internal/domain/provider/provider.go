@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/money"
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+)
+
+var (
+	ErrPaymentDeclined     = errs.NewWithReason(errs.CodeInvalidArgument, "PROVIDER_PAYMENT_DECLINED", "the payment was declined")
+	ErrChargeNotFound      = errs.NewWithReason(errs.CodeNotFound, "PROVIDER_CHARGE_NOT_FOUND", "charge not found at the payment provider")
+	ErrRefundExceedsCharge = errs.NewWithReason(errs.CodeInvalidArgument, "PROVIDER_REFUND_EXCEEDS_CHARGE", "refunds cannot exceed the charged amount")
+)
+
+type ChargeRequest struct {
+	// IdempotencyKey is passed through so the provider charges at most once
+	// per key, even when the service crashes between charging and recording.
+	IdempotencyKey string
+	PayerID        ids.UserID
+	Amount         money.Money
+	// PaymentMethod is the provider token of the payer's card or wallet.
+	PaymentMethod string
+	Description   string
+}
+
+type RefundRequest struct {
+	IdempotencyKey string
+	ChargeID       string
+	Amount         money.Money
+	Reason         *string
+}
+
+// PaymentProvider moves real money in and out of the platform.
+type PaymentProvider interface {
+	// Charge collects money from a payer and returns the charge ID.
+	Charge(ctx context.Context, req ChargeRequest) (string, error)
+
+	// Refund returns part or all of a charge and returns the refund ID.
+	Refund(ctx context.Context, req RefundRequest) (string, error)
+}
@@ -0,0 +1,9 @@
+package ids
+
+import "github.com/pratchaya-maneechot/service-exchange/libs/utils"
+
+type EntryID string
+
+func NewEntryID() EntryID {
+	return EntryID(utils.UUID())
+}
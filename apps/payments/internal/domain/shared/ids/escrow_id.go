@@ -0,0 +1,9 @@
+package ids
+
+import "github.com/pratchaya-maneechot/service-exchange/libs/utils"
+
+type EscrowID string
+
+func NewEscrowID() EscrowID {
+	return EscrowID(utils.UUID())
+}
@@ -0,0 +1,12 @@
+package ids
+
+// UserID identifies a user of the users service. Payments only store the
+// reference; the user itself lives in the users service.
+type UserID string
+
+// TaskID and BidID identify the task and accepted bid an escrow pays for.
+// Both live in the tasks service.
+type (
+	TaskID string
+	BidID  string
+)
@@ -0,0 +1,54 @@
+package money
+
+import (
+	"regexp"
+	"strings"
+
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+)
+
+var (
+	ErrInvalidMoney     = errs.NewWithReason(errs.CodeInvalidArgument, "MONEY_INVALID", "amounts must be positive minor units in a three-letter currency")
+	ErrCurrencyMismatch = errs.NewWithReason(errs.CodeInvalidArgument, "MONEY_CURRENCY_MISMATCH", "amounts in different currencies cannot be combined")
+)
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Money is an amount in integer minor units of its currency (satang for
+// THB). Amounts are never floating point.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// New returns a positive amount, normalising the currency code to upper case.
+func New(amount int64, currency string) (Money, error) {
+	m := Money{Amount: amount, Currency: strings.ToUpper(strings.TrimSpace(currency))}
+	if amount <= 0 || !currencyPattern.MatchString(m.Currency) {
+		return Money{}, ErrInvalidMoney
+	}
+	return m, nil
+}
+
+// Zero is nothing in the given currency.
+func Zero(currency string) Money {
+	return Money{Currency: currency}
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) Add(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	return Money{Amount: m.Amount + o.Amount, Currency: m.Currency}, nil
+}
+
+func (m Money) Sub(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	return Money{Amount: m.Amount - o.Amount, Currency: m.Currency}, nil
+}
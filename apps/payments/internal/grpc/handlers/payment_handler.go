@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/payments/api/proto/payment"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/escrow"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/grpc/views"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PaymentGRPCHandler struct {
+	pb.UnimplementedPaymentServiceServer
+	lg.GrpcHandlerOption
+}
+
+func RegisPaymentGRPCHandler(
+	gs *grpc.Server,
+	opt lg.GrpcHandlerOption,
+) {
+	pb.RegisterPaymentServiceServer(gs, &PaymentGRPCHandler{
+		GrpcHandlerOption: opt,
+	})
+}
+
+func (h *PaymentGRPCHandler) HoldEscrow(ctx context.Context, req *pb.HoldEscrowRequest) (*pb.EscrowResponse, error) {
+	cmd := command.HoldEscrowCommand{
+		TaskID:         ids.TaskID(req.GetTaskId()),
+		PayeeID:        ids.UserID(req.GetPayeeId()),
+		Amount:         views.MoneyInput(req.GetAmount()),
+		PaymentMethod:  req.GetPaymentMethod(),
+		IdempotencyKey: req.GetIdempotencyKey(),
+		Actor:          actorFromCtx(ctx),
+	}
+	if bidID := lg.StringValueToPtr(req.GetBidId()); bidID != nil {
+		id := ids.BidID(*bidID)
+		cmd.BidID = &id
+	}
+	return h.dispatchEscrowCommand(ctx, cmd, "HoldEscrowCommand")
+}
+
+func (h *PaymentGRPCHandler) ReleaseEscrow(ctx context.Context, req *pb.ReleaseEscrowRequest) (*pb.EscrowResponse, error) {
+	cmd := command.ReleaseEscrowCommand{
+		TaskID:         ids.TaskID(req.GetTaskId()),
+		IdempotencyKey: req.GetIdempotencyKey(),
+		Actor:          actorFromCtx(ctx),
+	}
+	return h.dispatchEscrowCommand(ctx, cmd, "ReleaseEscrowCommand")
+}
+
+func (h *PaymentGRPCHandler) RefundEscrow(ctx context.Context, req *pb.RefundEscrowRequest) (*pb.EscrowResponse, error) {
+	cmd := command.RefundEscrowCommand{
+		TaskID:         ids.TaskID(req.GetTaskId()),
+		Reason:         lg.StringValueToPtr(req.GetReason()),
+		IdempotencyKey: req.GetIdempotencyKey(),
+		Actor:          actorFromCtx(ctx),
+	}
+	if req.GetAmount() != nil {
+		amount := req.GetAmount().GetValue()
+		cmd.Amount = &amount
+	}
+	return h.dispatchEscrowCommand(ctx, cmd, "RefundEscrowCommand")
+}
+
+func (h *PaymentGRPCHandler) SettleDispute(ctx context.Context, req *pb.SettleDisputeRequest) (*pb.EscrowResponse, error) {
+	cmd := command.SettleDisputeCommand{
+		TaskID:         ids.TaskID(req.GetTaskId()),
+		DisputeID:      req.GetDisputeId(),
+		Outcome:        views.ProtoDisputeOutcomeToDomain(req.GetOutcome()),
+		IdempotencyKey: req.GetIdempotencyKey(),
+		Actor:          actorFromCtx(ctx),
+	}
+	if req.GetRefundAmount() != nil {
+		amount := req.GetRefundAmount().GetValue()
+		cmd.RefundAmount = &amount
+	}
+	return h.dispatchEscrowCommand(ctx, cmd, "SettleDisputeCommand")
+}
+
+func (h *PaymentGRPCHandler) GetEscrow(ctx context.Context, req *pb.GetEscrowRequest) (*pb.Escrow, error) {
+	qry := query.GetEscrowQuery{
+		TaskID: ids.TaskID(req.GetTaskId()),
+		Actor:  actorFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.EscrowDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from GetEscrowQuery handler")
+	}
+	return views.Escrow(dto), nil
+}
+
+func (h *PaymentGRPCHandler) GetBalance(ctx context.Context, req *pb.GetBalanceRequest) (*pb.Balance, error) {
+	qry := query.GetBalanceQuery{
+		UserID:   ids.UserID(req.GetUserId()),
+		Currency: req.GetCurrency(),
+		Actor:    actorFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.BalanceDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from GetBalanceQuery handler")
+	}
+	return views.Balance(dto), nil
+}
+
+// dispatchEscrowCommand runs a command whose handler reports the resulting
+// escrow, which is what every money-moving RPC returns.
+func (h *PaymentGRPCHandler) dispatchEscrowCommand(ctx context.Context, cmd any, name string) (*pb.EscrowResponse, error) {
+	result, err := h.Command.Dispatch(ctx, cmd)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*command.EscrowDto)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from %s handler", name)
+	}
+	return views.EscrowResponse(dto), nil
+}
+
+func actorFromCtx(ctx context.Context) escrow.Actor {
+	caller, ok := lg.CallerFromCtx(ctx)
+	if !ok {
+		return escrow.Actor{}
+	}
+	return escrow.Actor{
+		UserID:  ids.UserID(caller.UserID),
+		IsAdmin: caller.HasRole("admin"),
+	}
+}
@@ -0,0 +1,40 @@
+package grpc
+
+import lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+
+// thaiMessages are the Thai texts of the service's error reasons. English
+// needs none: it is the language of the errors themselves.
+var thaiMessages = lg.Messages{
+	"MONEY_INVALID":           "จำนวนเงินต้องเป็นหน่วยย่อยที่เป็นบวกพร้อมรหัสสกุลเงินสามตัวอักษร",
+	"MONEY_CURRENCY_MISMATCH": "ไม่สามารถรวมจำนวนเงินต่างสกุลเงินกันได้",
+
+	"PROVIDER_PAYMENT_DECLINED":      "การชำระเงินถูกปฏิเสธ",
+	"PROVIDER_CHARGE_NOT_FOUND":      "ไม่พบรายการเรียกเก็บเงินที่ผู้ให้บริการชำระเงิน",
+	"PROVIDER_REFUND_EXCEEDS_CHARGE": "ยอดคืนเงินต้องไม่เกินยอดที่เรียกเก็บ",
+
+	"FEE_UNSUPPORTED_CURRENCY": "ไม่รองรับการชำระเงินในสกุลเงินนี้",
+
+	"LEDGER_BALANCE_HIDDEN": "เฉพาะเจ้าของบัญชีหรือผู้ดูแลระบบเท่านั้นที่ดูยอดคงเหลือได้",
+
+	"ESCROW_NOT_FOUND":              "ไม่พบเงินที่พักไว้",
+	"ESCROW_EXISTS":                 "งานนี้มีการพักเงินไว้แล้ว",
+	"ESCROW_SIGN_IN_REQUIRED":       "กรุณาเข้าสู่ระบบเพื่อจัดการการชำระเงิน",
+	"ESCROW_NOT_PAYER":              "เฉพาะผู้จ่ายเงินหรือผู้ดูแลระบบเท่านั้นที่ปล่อยเงินที่พักไว้ได้",
+	"ESCROW_NOT_PAYEE_OR_ADMIN":     "เฉพาะผู้รับเงินหรือผู้ดูแลระบบเท่านั้นที่คืนเงินที่พักไว้ได้",
+	"ESCROW_PAYEE_IS_PAYER":         "ผู้จ่ายเงินไม่สามารถจ่ายเงินให้ตนเองได้",
+	"ESCROW_NOT_HELD":               "เงินที่พักไว้ถูกปล่อยหรือคืนไปแล้ว",
+	"ESCROW_NOT_PENDING":            "เงินที่พักไว้ถูกเรียกเก็บไปแล้ว",
+	"ESCROW_REFUND_EXCEEDS_BALANCE": "ยอดคืนเงินมากกว่ายอดเงินที่พักไว้",
+	"ESCROW_ADMIN_ONLY":             "เฉพาะผู้ดูแลระบบเท่านั้นที่ชำระข้อพิพาทได้",
+	"ESCROW_INVALID_SETTLEMENT":     "ผลการตัดสินต้องเป็น REFUND, PARTIAL_REFUND ที่มียอดน้อยกว่ายอดคงเหลือ หรือ RELEASE",
+	"ESCROW_CHANGED":                "เงินที่พักไว้ถูกเปลี่ยนแปลงระหว่างดำเนินการ กรุณาลองใหม่อีกครั้ง",
+	"ESCROW_TASK_NOT_FOUND":         "ไม่พบงาน",
+	"ESCROW_TASK_NOT_AWARDED":       "งานนี้ยังไม่ได้มอบหมายผ่านข้อเสนอนี้ กรุณายอมรับข้อเสนอก่อนชำระเงิน",
+	"ESCROW_TASK_NOT_COMPLETED":     "ปล่อยเงินที่พักไว้ได้เมื่องานเสร็จสมบูรณ์แล้วเท่านั้น",
+	"ESCROW_NOT_TASK_POSTER":        "เฉพาะผู้โพสต์งานเท่านั้นที่ชำระเงินสำหรับงานนี้ได้",
+	"ESCROW_AWARD_MISMATCH":         "ผู้รับเงินและจำนวนเงินต้องตรงกับผู้รับงานและราคาที่ตกลงกันไว้",
+
+	"IDEMPOTENCY_RECORD_NOT_FOUND": "ไม่พบคีย์ idempotency",
+	"IDEMPOTENCY_KEY_REUSED":       "คีย์ idempotency นี้ถูกใช้กับคำขออื่นแล้ว",
+	"IDEMPOTENCY_KEY_IN_FLIGHT":    "คำขอที่ใช้คีย์ idempotency นี้กำลังดำเนินการอยู่ กรุณาลองใหม่ในอีกสักครู่",
+}
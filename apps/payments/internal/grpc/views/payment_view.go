@@ -0,0 +1,129 @@
+package views
+
+import (
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/payments/api/proto/payment"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/escrow"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/ledger"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func domainEscrowStatusToProto(status escrow.Status) pb.EscrowStatus {
+	switch status {
+	case escrow.StatusPending:
+		return pb.EscrowStatus_ESCROW_STATUS_PENDING
+	case escrow.StatusHeld:
+		return pb.EscrowStatus_ESCROW_STATUS_HELD
+	case escrow.StatusReleased:
+		return pb.EscrowStatus_ESCROW_STATUS_RELEASED
+	case escrow.StatusRefunded:
+		return pb.EscrowStatus_ESCROW_STATUS_REFUNDED
+	default:
+		return pb.EscrowStatus_ESCROW_STATUS_UNSPECIFIED
+	}
+}
+
+func domainDirectionToProto(direction ledger.Direction) pb.Direction {
+	switch direction {
+	case ledger.Debit:
+		return pb.Direction_DIRECTION_DEBIT
+	case ledger.Credit:
+		return pb.Direction_DIRECTION_CREDIT
+	default:
+		return pb.Direction_DIRECTION_UNSPECIFIED
+	}
+}
+
+// ProtoDisputeOutcomeToDomain maps a decision; UNSPECIFIED maps to "" and
+// fails validation.
+func ProtoDisputeOutcomeToDomain(outcome pb.DisputeOutcome) escrow.Outcome {
+	switch outcome {
+	case pb.DisputeOutcome_DISPUTE_OUTCOME_REFUND:
+		return escrow.OutcomeRefund
+	case pb.DisputeOutcome_DISPUTE_OUTCOME_PARTIAL_REFUND:
+		return escrow.OutcomePartialRefund
+	case pb.DisputeOutcome_DISPUTE_OUTCOME_RELEASE:
+		return escrow.OutcomeRelease
+	default:
+		return ""
+	}
+}
+
+func MoneyInput(in *pb.Money) command.MoneyInput {
+	return command.MoneyInput{
+		Amount:   in.GetAmount(),
+		Currency: in.GetCurrency(),
+	}
+}
+
+func EscrowResponse(payload *command.EscrowDto) *pb.EscrowResponse {
+	if payload == nil {
+		return nil
+	}
+	return &pb.EscrowResponse{
+		EscrowId:       payload.EscrowID,
+		TaskId:         payload.TaskID,
+		Status:         domainEscrowStatusToProto(payload.Status),
+		Amount:         &pb.Money{Amount: payload.Amount, Currency: payload.Currency},
+		RefundedAmount: payload.RefundedAmount,
+		FeeAmount:      payload.FeeAmount,
+	}
+}
+
+func Escrow(payload *query.EscrowDTO) *pb.Escrow {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.Escrow{
+		Id:             payload.ID,
+		TaskId:         payload.TaskID,
+		BidId:          lg.PtrToStringValue(payload.BidID),
+		PayerId:        payload.PayerID,
+		PayeeId:        payload.PayeeID,
+		Amount:         &pb.Money{Amount: payload.Amount, Currency: payload.Currency},
+		RefundedAmount: payload.RefundedAmount,
+		FeeAmount:      payload.FeeAmount,
+		Balance:        payload.Balance,
+		Status:         domainEscrowStatusToProto(payload.Status),
+		Entries:        make([]*pb.JournalEntry, 0, len(payload.Entries)),
+		CreatedAt:      timestamppb.New(payload.CreatedAt),
+		UpdatedAt:      timestamppb.New(payload.UpdatedAt),
+	}
+	if payload.ReleasedAt != nil {
+		resp.ReleasedAt = timestamppb.New(*payload.ReleasedAt)
+	}
+	if payload.RefundedAt != nil {
+		resp.RefundedAt = timestamppb.New(*payload.RefundedAt)
+	}
+	for _, e := range payload.Entries {
+		entry := &pb.JournalEntry{
+			Id:          e.ID,
+			Kind:        string(e.Kind),
+			Description: e.Description,
+			ExternalRef: lg.PtrToStringValue(e.ExternalRef),
+			Postings:    make([]*pb.Posting, 0, len(e.Postings)),
+			CreatedAt:   timestamppb.New(e.CreatedAt),
+		}
+		for _, p := range e.Postings {
+			entry.Postings = append(entry.Postings, &pb.Posting{
+				AccountCode: p.AccountCode,
+				Direction:   domainDirectionToProto(p.Direction),
+				Amount:      p.Amount,
+			})
+		}
+		resp.Entries = append(resp.Entries, entry)
+	}
+	return resp
+}
+
+func Balance(payload *query.BalanceDTO) *pb.Balance {
+	if payload == nil {
+		return nil
+	}
+	return &pb.Balance{
+		UserId: payload.UserID,
+		Amount: &pb.Money{Amount: payload.Amount, Currency: payload.Currency},
+	}
+}
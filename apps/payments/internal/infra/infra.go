@@ -0,0 +1,150 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/google/wire"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/fee"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/provider"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/infra/payment"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/infra/persistence/postgres"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/infra/persistence/repositories"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/infra/tasks"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	lh "github.com/pratchaya-maneechot/service-exchange/libs/health"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+	"google.golang.org/grpc"
+)
+
+type Infra struct {
+	dbPool *lp.DBPool
+	tasks  *grpc.ClientConn
+	logger *slog.Logger
+	tracer *sdktrace.TracerProvider
+}
+
+func NewInfra(
+	dbPool *lp.DBPool,
+	tasks *grpc.ClientConn,
+	logger *slog.Logger,
+	tracer *sdktrace.TracerProvider,
+) *Infra {
+	return &Infra{
+		dbPool,
+		tasks,
+		logger,
+		tracer,
+	}
+}
+
+func (i *Infra) Close(ctx context.Context) error {
+	var errs []error
+	if i.tracer != nil {
+		if err := i.tracer.Shutdown(ctx); err != nil {
+			i.logger.Error("Failed to shutdown OpenTelemetry TracerProvider", "error", err)
+			errs = append(errs, fmt.Errorf("tracer shutdown failed: %w", err))
+		}
+	}
+	if i.tasks != nil {
+		if err := i.tasks.Close(); err != nil {
+			i.logger.Error("Failed to close tasks service connection", "error", err)
+			errs = append(errs, fmt.Errorf("tasks connection close failed: %w", err))
+		}
+	}
+	if i.dbPool != nil {
+		i.dbPool.Close()
+		i.logger.Info("PostgreSQL database pool closed.")
+	}
+	i.logger.Info("Infrastructure components closed.")
+
+	if len(errs) > 0 {
+		return fmt.Errorf("errors during infra close: %v", errs)
+	}
+	return nil
+}
+
+func ProvideMetricServer(cfg *config.Config, health *lh.Registry) *observability.MetricServer {
+	return observability.NewMetricServer(observability.MetricConfig{
+		Path:    cfg.Metrics.Path,
+		Addr:    cfg.Metrics.Address,
+		Enabled: cfg.Metrics.Enabled,
+		Health:  health,
+	})
+}
+
+func ProvideLogger(cfg *config.Config) *slog.Logger {
+	return observability.NewLogger(observability.LoggerConfig{
+		Level:     cfg.Logging.Level,
+		Format:    cfg.Logging.Format,
+		AddSource: !cfg.IsDevelopment(),
+	})
+}
+
+func ProvideTracer(ctx context.Context, cfg *config.Config) (*sdktrace.TracerProvider, error) {
+	return observability.NewTracer(ctx, observability.TracerConfig{
+		Name:        cfg.Name,
+		Version:     cfg.Version,
+		Environment: cfg.Environment,
+	})
+}
+
+func ProvideMetricRecorder() observability.MetricsRecorder {
+	return observability.NewPrometheusMetricsRecorder()
+}
+
+func ProvidePaymentProvider(cfg *config.Config, logger *slog.Logger) (provider.PaymentProvider, error) {
+	switch cfg.Payments.Provider {
+	case "fake":
+		return payment.NewFakeProvider(logger), nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider %q", cfg.Payments.Provider)
+	}
+}
+
+func ProvideClientFactory(cfg *config.Config, logger *slog.Logger, recorder observability.MetricsRecorder) *lg.ClientFactory {
+	return lg.NewClientFactory(logger, recorder, cfg.Security.Identity())
+}
+
+// ProvideTasksConn connects to the tasks service, which serves the
+// TaskAwardService of award.proto.
+func ProvideTasksConn(ctx context.Context, cfg *config.Config, factory *lg.ClientFactory) (*grpc.ClientConn, error) {
+	return factory.NewClient(ctx, cfg.Clients.Tasks.Client(cfg.Security))
+}
+
+func ProvideFeePolicy(cfg *config.Config) (*fee.Policy, error) {
+	rules := make([]fee.Rule, 0, len(cfg.Payments.Fees))
+	for _, r := range cfg.Payments.Fees {
+		rules = append(rules, fee.Rule{
+			Currency: r.Currency,
+			RateBps:  r.RateBps,
+			Fixed:    r.Fixed,
+			Min:      r.Min,
+			Max:      r.Max,
+		})
+	}
+	return fee.NewPolicy(rules)
+}
+
+var InfraModuleSet = wire.NewSet(
+	postgres.NewDBConn,
+	repositories.NewPostgresEscrowRepository,
+	repositories.NewPostgresLedgerRepository,
+	repositories.NewPostgresIdempotencyRepository,
+	ProvidePaymentProvider,
+	ProvideFeePolicy,
+	ProvideClientFactory,
+	ProvideTasksConn,
+	tasks.NewAwardReader,
+	ProvideMetricServer,
+	ProvideMetricRecorder,
+	ProvideHealthRegistry,
+	ProvideLogger,
+	ProvideTracer,
+	NewInfra,
+)
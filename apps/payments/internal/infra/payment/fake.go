@@ -0,0 +1,88 @@
+package payment
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/provider"
+)
+
+// FakeDeclinedPaymentMethod makes the fake provider decline a charge, for
+// exercising the failure path locally.
+const FakeDeclinedPaymentMethod = "tok_fake_declined"
+
+type fakeCharge struct {
+	request  provider.ChargeRequest
+	refunded int64
+}
+
+// FakeProvider is a deterministic, in-memory PaymentProvider for local
+// development and tests. IDs are derived from the idempotency key, so the
+// same key always yields the same charge or refund, and replaying a
+// request does not move money twice.
+type FakeProvider struct {
+	mu      sync.Mutex
+	charges map[string]*fakeCharge
+	refunds map[string]provider.RefundRequest
+	logger  *slog.Logger
+}
+
+func NewFakeProvider(logger *slog.Logger) *FakeProvider {
+	return &FakeProvider{
+		charges: make(map[string]*fakeCharge),
+		refunds: make(map[string]provider.RefundRequest),
+		logger:  logger.With(slog.String("component", "FakeProvider")),
+	}
+}
+
+func (p *FakeProvider) Charge(ctx context.Context, req provider.ChargeRequest) (string, error) {
+	if req.PaymentMethod == FakeDeclinedPaymentMethod {
+		p.logger.Info("Declined fake charge.", "payer_id", string(req.PayerID))
+		return "", provider.ErrPaymentDeclined
+	}
+
+	id := fakeID("ch", req.IdempotencyKey)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if existing, ok := p.charges[id]; ok {
+		if existing.request != req {
+			return "", fmt.Errorf("fake provider: idempotency key %q reused for a different charge", req.IdempotencyKey)
+		}
+		return id, nil
+	}
+	p.charges[id] = &fakeCharge{request: req}
+	p.logger.Info("Created fake charge.", "charge_id", id, "amount", req.Amount.Amount, "currency", req.Amount.Currency)
+	return id, nil
+}
+
+func (p *FakeProvider) Refund(ctx context.Context, req provider.RefundRequest) (string, error) {
+	id := fakeID("re", req.IdempotencyKey)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.refunds[id]; ok {
+		return id, nil
+	}
+	charge, ok := p.charges[req.ChargeID]
+	if !ok {
+		// Charges do not survive a restart of the fake; accept refunds of
+		// unknown charges so local data stays usable.
+		p.logger.Warn("Refunding a charge the fake provider does not know.", "charge_id", req.ChargeID)
+	} else {
+		if req.Amount.Currency != charge.request.Amount.Currency || charge.refunded+req.Amount.Amount > charge.request.Amount.Amount {
+			return "", provider.ErrRefundExceedsCharge
+		}
+		charge.refunded += req.Amount.Amount
+	}
+	p.refunds[id] = req
+	p.logger.Info("Created fake refund.", "refund_id", id, "charge_id", req.ChargeID, "amount", req.Amount.Amount)
+	return id, nil
+}
+
+func fakeID(prefix string, key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return fmt.Sprintf("fake_%s_%s", prefix, hex.EncodeToString(sum[:12]))
+}
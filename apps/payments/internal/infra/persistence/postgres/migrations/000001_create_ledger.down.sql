@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS idempotency_keys;
+DROP TABLE IF EXISTS postings;
+DROP TABLE IF EXISTS journal_entries;
+DROP TABLE IF EXISTS escrows;
+DROP TABLE IF EXISTS accounts;
+DROP FUNCTION IF EXISTS check_entry_balanced();
+DROP FUNCTION IF EXISTS reject_ledger_change();
@@ -0,0 +1,94 @@
+CREATE TABLE accounts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(150) NOT NULL UNIQUE, -- e.g. "escrow:<id>" or "platform_fees:THB"
+    type VARCHAR(20) NOT NULL CHECK (type IN ('ASSET', 'LIABILITY', 'REVENUE')),
+    currency CHAR(3) NOT NULL,
+    owner_id UUID, -- User the account belongs to, if any
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE escrows (
+    id UUID PRIMARY KEY,
+    task_id UUID NOT NULL UNIQUE, -- Task in the tasks service; one escrow per task
+    bid_id UUID, -- Accepted bid in the tasks service, if the escrow came from one
+    payer_id UUID NOT NULL,
+    payee_id UUID NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0), -- Minor currency units
+    currency CHAR(3) NOT NULL,
+    refunded_amount BIGINT NOT NULL DEFAULT 0 CHECK (refunded_amount >= 0 AND refunded_amount <= amount),
+    fee_amount BIGINT NOT NULL DEFAULT 0 CHECK (fee_amount >= 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'HELD', 'RELEASED', 'REFUNDED')),
+    provider_charge_id VARCHAR(255), -- Set once the payer is charged; PENDING escrows have none
+    released_at TIMESTAMP WITH TIME ZONE,
+    refunded_at TIMESTAMP WITH TIME ZONE,
+    version INTEGER NOT NULL DEFAULT 1, -- Bumped on every write, for optimistic locking
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK (payer_id <> payee_id),
+    CHECK (status = 'PENDING' OR provider_charge_id IS NOT NULL)
+);
+
+CREATE TABLE journal_entries (
+    id UUID PRIMARY KEY,
+    kind VARCHAR(30) NOT NULL CHECK (kind IN ('ESCROW_HOLD', 'ESCROW_RELEASE', 'ESCROW_REFUND')),
+    escrow_id UUID REFERENCES escrows(id),
+    currency CHAR(3) NOT NULL,
+    description VARCHAR(600) NOT NULL,
+    external_ref VARCHAR(255), -- Payment provider reference, e.g. a charge or refund ID
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE postings (
+    entry_id UUID NOT NULL REFERENCES journal_entries(id),
+    position INTEGER NOT NULL,
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    direction VARCHAR(6) NOT NULL CHECK (direction IN ('DEBIT', 'CREDIT')),
+    amount BIGINT NOT NULL CHECK (amount > 0), -- Minor currency units
+    PRIMARY KEY (entry_id, position)
+);
+
+CREATE TABLE idempotency_keys (
+    key VARCHAR(255) PRIMARY KEY,
+    operation VARCHAR(50) NOT NULL,
+    actor_id UUID,
+    request_hash CHAR(64) NOT NULL, -- SHA-256 of operation, actor and request
+    response JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_journal_entries_escrow_id ON journal_entries (escrow_id, created_at);
+CREATE INDEX idx_postings_account_id ON postings (account_id);
+CREATE INDEX idx_escrows_payer_id ON escrows (payer_id);
+CREATE INDEX idx_escrows_payee_id ON escrows (payee_id);
+
+-- The ledger is append-only: corrections are new, reversing entries.
+CREATE FUNCTION reject_ledger_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'ledger rows are immutable: % on % is not allowed', TG_OP, TG_TABLE_NAME;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER journal_entries_immutable BEFORE UPDATE OR DELETE ON journal_entries
+    FOR EACH ROW EXECUTE FUNCTION reject_ledger_change();
+CREATE TRIGGER postings_immutable BEFORE UPDATE OR DELETE ON postings
+    FOR EACH ROW EXECUTE FUNCTION reject_ledger_change();
+
+-- Every entry must balance once its transaction commits, whatever wrote it.
+CREATE FUNCTION check_entry_balanced() RETURNS trigger AS $$
+DECLARE
+    imbalance BIGINT;
+BEGIN
+    SELECT COALESCE(SUM(CASE WHEN direction = 'DEBIT' THEN amount ELSE -amount END), 0)
+    INTO imbalance
+    FROM postings
+    WHERE entry_id = NEW.entry_id;
+    IF imbalance <> 0 THEN
+        RAISE EXCEPTION 'journal entry % does not balance (off by %)', NEW.entry_id, imbalance;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER postings_balanced AFTER INSERT ON postings
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION check_entry_balanced();
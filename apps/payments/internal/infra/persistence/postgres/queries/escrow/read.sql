@@ -0,0 +1,6 @@
+-- name: FindEscrowByTaskID :one
+SELECT
+    id, task_id, bid_id, payer_id, payee_id, amount, currency, refunded_amount, fee_amount, status,
+    provider_charge_id, released_at, refunded_at, version, created_at, updated_at
+FROM escrows
+WHERE task_id = $1;
@@ -0,0 +1,26 @@
+-- name: UpsertEscrow :execrows
+-- Updates only apply when the stored version is the one the escrow was
+-- loaded with; zero affected rows means somebody else changed it first.
+INSERT INTO escrows (
+    id, task_id, bid_id, payer_id, payee_id, amount, currency, refunded_amount, fee_amount, status,
+    provider_charge_id, released_at, refunded_at, version, created_at, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+)
+ON CONFLICT (id)
+DO UPDATE SET
+    refunded_amount = EXCLUDED.refunded_amount,
+    fee_amount = EXCLUDED.fee_amount,
+    status = EXCLUDED.status,
+    provider_charge_id = EXCLUDED.provider_charge_id,
+    released_at = EXCLUDED.released_at,
+    refunded_at = EXCLUDED.refunded_at,
+    version = EXCLUDED.version,
+    updated_at = EXCLUDED.updated_at
+WHERE escrows.version = EXCLUDED.version - 1;
+
+-- name: DeletePendingEscrow :execrows
+-- Only reservations that were never charged are deleted; nothing in the
+-- ledger refers to them.
+DELETE FROM escrows
+WHERE id = $1 AND status = 'PENDING' AND version = $2;
@@ -0,0 +1,4 @@
+-- name: FindIdempotencyKey :one
+SELECT key, operation, actor_id, request_hash, response, created_at
+FROM idempotency_keys
+WHERE key = $1;
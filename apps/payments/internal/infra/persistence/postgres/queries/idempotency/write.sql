@@ -0,0 +1,6 @@
+-- name: InsertIdempotencyKey :exec
+INSERT INTO idempotency_keys (
+    key, operation, actor_id, request_hash, response, created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
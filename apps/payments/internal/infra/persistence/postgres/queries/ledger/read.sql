@@ -0,0 +1,19 @@
+-- name: ListJournalEntriesByEscrow :many
+SELECT id, kind, escrow_id, currency, description, external_ref, created_at
+FROM journal_entries
+WHERE escrow_id = $1
+ORDER BY created_at, id;
+
+-- name: ListPostingsByEntryIDs :many
+SELECT p.entry_id, p.position, p.direction, p.amount, a.code, a.type, a.currency, a.owner_id
+FROM postings p
+JOIN accounts a ON a.id = p.account_id
+WHERE p.entry_id = ANY(sqlc.arg('entry_ids')::uuid[])
+ORDER BY p.entry_id, p.position;
+
+-- name: GetAccountBalance :one
+-- Debits minus credits; callers flip the sign for credit-normal accounts.
+SELECT COALESCE(SUM(CASE WHEN p.direction = 'DEBIT' THEN p.amount ELSE -p.amount END), 0)::BIGINT AS balance
+FROM postings p
+JOIN accounts a ON a.id = p.account_id
+WHERE a.code = $1;
@@ -0,0 +1,19 @@
+-- name: EnsureAccount :one
+INSERT INTO accounts (code, type, currency, owner_id)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (code) DO UPDATE SET code = EXCLUDED.code
+RETURNING id;
+
+-- name: InsertJournalEntry :exec
+INSERT INTO journal_entries (
+    id, kind, escrow_id, currency, description, external_ref, created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: InsertPosting :exec
+INSERT INTO postings (
+    entry_id, position, account_id, direction, amount
+) VALUES (
+    $1, $2, $3, $4, $5
+);
@@ -0,0 +1,270 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/escrow"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/idempotency"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/money"
+	db "github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/infra/persistence/postgres/generated"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type escrowRepository struct {
+	db     *db.Queries
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+	config *config.Config
+	tracer trace.Tracer
+}
+
+func NewPostgresEscrowRepository(cfg *config.Config, dbPool *lp.DBPool, logger *slog.Logger) escrow.EscrowRepository {
+	repoLogger := logger.With(slog.String("component", "escrowRepository"))
+	return &escrowRepository{
+		db:     db.New(dbPool.Pool),
+		pool:   dbPool.Pool,
+		logger: repoLogger,
+		config: cfg,
+		tracer: otel.Tracer(fmt.Sprintf("%s.repository", cfg.Name)),
+	}
+}
+
+func (r *escrowRepository) FindByTaskID(ctx context.Context, taskID ids.TaskID) (*escrow.Escrow, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(taskID)))
+
+	ctx, span := r.tracer.Start(ctx, "EscrowRepository.FindByTaskID", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "read_by_task_id"),
+		attribute.String("db.task_id", string(taskID)),
+	)
+
+	raw, err := r.db.FindEscrowByTaskID(ctx, lp.ToUUID(string(taskID)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.SetStatus(codes.Ok, "Escrow not found in DB")
+			span.SetAttributes(attribute.Bool("escrow.found", false))
+			logger.Debug("Escrow not found in DB.")
+			return nil, escrow.ErrEscrowNotFound
+		}
+		span.SetStatus(codes.Error, "Failed to query escrow from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query escrow from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query escrow: %w", lp.TranslateError(err))
+	}
+
+	span.SetStatus(codes.Ok, "Escrow found")
+	span.SetAttributes(attribute.Bool("escrow.found", true))
+	return toEscrow(raw), nil
+}
+
+func (r *escrowRepository) Save(ctx context.Context, e *escrow.Escrow, record *idempotency.Record) (err error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("escrow_id", string(e.ID)))
+
+	ctx, span := r.tracer.Start(ctx, "EscrowRepository.Save", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.escrow_id", string(e.ID)),
+		attribute.String("db.escrow_status", string(e.Status)),
+		attribute.Int("db.journal_entries", len(e.PendingEntries())),
+	)
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to begin transaction")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+		logger.Error("Failed to begin DB transaction", slog.Any("error", err))
+		return fmt.Errorf("failed to begin transaction: %w", lp.TranslateError(err))
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		} else if err != nil {
+			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+				logger.Error("Failed to rollback DB transaction", slog.Any("error", rollbackErr))
+			}
+		} else {
+			if commitErr := tx.Commit(ctx); commitErr != nil {
+				span.SetStatus(codes.Error, "Failed to commit transaction")
+				span.RecordError(commitErr)
+				span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+				logger.Error("Failed to commit DB transaction", slog.Any("error", commitErr))
+				err = fmt.Errorf("failed to commit transaction: %w", lp.TranslateError(commitErr))
+				return
+			}
+			e.Version++
+			e.ClearPendingEntries()
+		}
+	}()
+
+	qtx := r.db.WithTx(tx)
+	escrowID := lp.ToUUID(string(e.ID))
+
+	params := db.UpsertEscrowParams{
+		ID:             escrowID,
+		TaskID:         lp.ToUUID(string(e.TaskID)),
+		PayerID:        lp.ToUUID(string(e.PayerID)),
+		PayeeID:        lp.ToUUID(string(e.PayeeID)),
+		Amount:         e.Amount.Amount,
+		Currency:       e.Amount.Currency,
+		RefundedAmount: e.RefundedAmount,
+		FeeAmount:      e.FeeAmount,
+		Status:         string(e.Status),
+		ReleasedAt:     lp.ToTimestamp(e.ReleasedAt),
+		RefundedAt:     lp.ToTimestamp(e.RefundedAt),
+		Version:        e.Version + 1,
+		CreatedAt:      lp.ToTimestamp(&e.CreatedAt),
+		UpdatedAt:      lp.ToTimestamp(&e.UpdatedAt),
+	}
+	if e.BidID != nil {
+		params.BidID = lp.ToUUID(string(*e.BidID))
+	}
+	if e.ProviderChargeID != "" {
+		params.ProviderChargeID = &e.ProviderChargeID
+	}
+	affected, err := qtx.UpsertEscrow(ctx, params)
+	if err != nil {
+		err = lp.TranslateError(err)
+		if errors.Is(err, lp.ErrUniqueViolation) {
+			span.SetStatus(codes.Error, "Duplicate escrow for task in DB")
+			span.SetAttributes(attribute.String("error.type", "db_unique_violation"))
+			logger.Warn("Task already has an escrow in DB.", "task_id", string(e.TaskID))
+			return escrow.ErrEscrowAlreadyExists
+		}
+		span.SetStatus(codes.Error, "Failed to upsert escrow in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to upsert escrow in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to upsert escrow: %w", err)
+	}
+	if affected == 0 {
+		span.SetStatus(codes.Error, "Stale escrow version")
+		span.SetAttributes(attribute.String("error.type", "db_version_conflict"))
+		logger.Warn("Escrow was changed concurrently.", "version", e.Version)
+		return escrow.ErrEscrowChanged
+	}
+
+	for _, entry := range e.PendingEntries() {
+		if err = insertJournalEntry(ctx, qtx, entry); err != nil {
+			span.SetStatus(codes.Error, "Failed to write journal entry in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to write journal entry in DB", slog.Any("error", err), "kind", string(entry.Kind))
+			return err
+		}
+	}
+
+	if record != nil {
+		err = qtx.InsertIdempotencyKey(ctx, db.InsertIdempotencyKeyParams{
+			Key:         record.Key,
+			Operation:   record.Operation,
+			ActorID:     lp.ToUUID(string(record.ActorID)),
+			RequestHash: record.RequestHash,
+			Response:    record.Response,
+			CreatedAt:   lp.ToTimestamp(&record.CreatedAt),
+		})
+		if err != nil {
+			err = lp.TranslateError(err)
+			if errors.Is(err, lp.ErrUniqueViolation) {
+				span.SetStatus(codes.Error, "Idempotency key stored concurrently")
+				span.SetAttributes(attribute.String("error.type", "db_unique_violation"))
+				logger.Warn("Idempotency key was stored by a concurrent request.")
+				return idempotency.ErrKeyInFlight
+			}
+			span.SetStatus(codes.Error, "Failed to store idempotency key in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to store idempotency key in DB", slog.Any("error", err))
+			return fmt.Errorf("failed to store idempotency key: %w", err)
+		}
+	}
+
+	span.SetStatus(codes.Ok, "Escrow saved to DB")
+	logger.Info("Escrow saved successfully to DB.", "status", e.Status)
+	return nil
+}
+
+func (r *escrowRepository) Discard(ctx context.Context, e *escrow.Escrow) error {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("escrow_id", string(e.ID)))
+
+	ctx, span := r.tracer.Start(ctx, "EscrowRepository.Discard", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "delete_pending"),
+		attribute.String("db.escrow_id", string(e.ID)),
+	)
+
+	affected, err := r.db.DeletePendingEscrow(ctx, db.DeletePendingEscrowParams{
+		ID:      lp.ToUUID(string(e.ID)),
+		Version: e.Version,
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to delete pending escrow from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to delete pending escrow from DB", slog.Any("error", err))
+		return fmt.Errorf("failed to delete pending escrow: %w", lp.TranslateError(err))
+	}
+	if affected == 0 {
+		span.SetStatus(codes.Error, "Stale escrow version")
+		span.SetAttributes(attribute.String("error.type", "db_version_conflict"))
+		logger.Warn("Pending escrow was changed concurrently.", "version", e.Version)
+		return escrow.ErrEscrowChanged
+	}
+
+	span.SetStatus(codes.Ok, "Pending escrow deleted from DB")
+	logger.Info("Pending escrow discarded from DB.")
+	return nil
+}
+
+func toEscrow(row db.Escrow) *escrow.Escrow {
+	var bidID *ids.BidID
+	if row.BidID.Valid {
+		id := ids.BidID(lp.FromUUID(row.BidID))
+		bidID = &id
+	}
+	var providerChargeID string
+	if row.ProviderChargeID != nil {
+		providerChargeID = *row.ProviderChargeID
+	}
+	return escrow.NewEscrowFromRepository(
+		lp.FromUUID(row.ID),
+		lp.FromUUID(row.TaskID),
+		bidID,
+		lp.FromUUID(row.PayerID),
+		lp.FromUUID(row.PayeeID),
+		money.Money{Amount: row.Amount, Currency: row.Currency},
+		row.RefundedAmount,
+		row.FeeAmount,
+		escrow.Status(row.Status),
+		providerChargeID,
+		lp.ToTime(row.ReleasedAt),
+		lp.ToTime(row.RefundedAt),
+		row.Version,
+		*lp.ToTime(row.CreatedAt),
+		*lp.ToTime(row.UpdatedAt),
+	)
+}
@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/idempotency"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+	db "github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/infra/persistence/postgres/generated"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type idempotencyRepository struct {
+	db     *db.Queries
+	logger *slog.Logger
+	config *config.Config
+	tracer trace.Tracer
+}
+
+func NewPostgresIdempotencyRepository(cfg *config.Config, dbPool *lp.DBPool, logger *slog.Logger) idempotency.IdempotencyRepository {
+	repoLogger := logger.With(slog.String("component", "idempotencyRepository"))
+	return &idempotencyRepository{
+		db:     db.New(dbPool.Pool),
+		logger: repoLogger,
+		config: cfg,
+		tracer: otel.Tracer(fmt.Sprintf("%s.repository", cfg.Name)),
+	}
+}
+
+func (r *idempotencyRepository) Find(ctx context.Context, key string) (*idempotency.Record, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "IdempotencyRepository.Find", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "read_by_key"),
+	)
+
+	row, err := r.db.FindIdempotencyKey(ctx, key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.SetStatus(codes.Ok, "Idempotency key not found in DB")
+			span.SetAttributes(attribute.Bool("idempotency.found", false))
+			return nil, idempotency.ErrRecordNotFound
+		}
+		span.SetStatus(codes.Error, "Failed to query idempotency key from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query idempotency key from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query idempotency key: %w", lp.TranslateError(err))
+	}
+
+	span.SetStatus(codes.Ok, "Idempotency key found")
+	span.SetAttributes(attribute.Bool("idempotency.found", true), attribute.String("idempotency.operation", row.Operation))
+	return &idempotency.Record{
+		Key:         row.Key,
+		Operation:   row.Operation,
+		ActorID:     ids.UserID(lp.FromUUID(row.ActorID)),
+		RequestHash: row.RequestHash,
+		Response:    row.Response,
+		CreatedAt:   *lp.ToTime(row.CreatedAt),
+	}, nil
+}
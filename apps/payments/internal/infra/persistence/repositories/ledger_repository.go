@@ -0,0 +1,193 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/ledger"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+	db "github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/infra/persistence/postgres/generated"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+	"github.com/pratchaya-maneechot/service-exchange/libs/utils"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ledgerRepository struct {
+	db     *db.Queries
+	logger *slog.Logger
+	config *config.Config
+	tracer trace.Tracer
+}
+
+func NewPostgresLedgerRepository(cfg *config.Config, dbPool *lp.DBPool, logger *slog.Logger) ledger.LedgerRepository {
+	repoLogger := logger.With(slog.String("component", "ledgerRepository"))
+	return &ledgerRepository{
+		db:     db.New(dbPool.Pool),
+		logger: repoLogger,
+		config: cfg,
+		tracer: otel.Tracer(fmt.Sprintf("%s.repository", cfg.Name)),
+	}
+}
+
+func (r *ledgerRepository) ListByEscrow(ctx context.Context, escrowID ids.EscrowID) ([]*ledger.JournalEntry, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("escrow_id", string(escrowID)))
+
+	ctx, span := r.tracer.Start(ctx, "LedgerRepository.ListByEscrow", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "list_by_escrow"),
+		attribute.String("db.escrow_id", string(escrowID)),
+	)
+
+	rows, err := r.db.ListJournalEntriesByEscrow(ctx, lp.ToUUID(string(escrowID)))
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list journal entries from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to list journal entries from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to list journal entries: %w", lp.TranslateError(err))
+	}
+
+	entries := make([]*ledger.JournalEntry, 0, len(rows))
+	if len(rows) == 0 {
+		span.SetStatus(codes.Ok, "No journal entries listed")
+		return entries, nil
+	}
+
+	postingRows, err := r.db.ListPostingsByEntryIDs(ctx, utils.ArrayMap(rows, func(row db.JournalEntry) pgtype.UUID { return row.ID }))
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query postings from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query postings of journal entries from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query postings: %w", lp.TranslateError(err))
+	}
+	postings := make(map[string][]ledger.Posting, len(rows))
+	for _, row := range postingRows {
+		id := lp.FromUUID(row.EntryID)
+		postings[id] = append(postings[id], toPosting(row))
+	}
+
+	for _, row := range rows {
+		var escrowRef *ids.EscrowID
+		if row.EscrowID.Valid {
+			id := ids.EscrowID(lp.FromUUID(row.EscrowID))
+			escrowRef = &id
+		}
+		entries = append(entries, ledger.NewJournalEntryFromRepository(
+			lp.FromUUID(row.ID),
+			ledger.EntryKind(row.Kind),
+			escrowRef,
+			row.Currency,
+			row.Description,
+			row.ExternalRef,
+			postings[lp.FromUUID(row.ID)],
+			*lp.ToTime(row.CreatedAt),
+		))
+	}
+
+	span.SetStatus(codes.Ok, "Journal entries listed")
+	span.SetAttributes(attribute.Int("list.result_count", len(entries)))
+	return entries, nil
+}
+
+func (r *ledgerRepository) Balance(ctx context.Context, account ledger.Account) (int64, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("account", account.Code))
+
+	ctx, span := r.tracer.Start(ctx, "LedgerRepository.Balance", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "account_balance"),
+		attribute.String("db.account_code", account.Code),
+	)
+
+	balance, err := r.db.GetAccountBalance(ctx, account.Code)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query account balance from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query account balance from DB", slog.Any("error", err))
+		return 0, fmt.Errorf("failed to query account balance: %w", lp.TranslateError(err))
+	}
+	if !account.Type.IsDebitNormal() {
+		balance = -balance
+	}
+
+	span.SetStatus(codes.Ok, "Account balance retrieved")
+	return balance, nil
+}
+
+// insertJournalEntry writes an entry and its postings, creating the accounts
+// it posts to on first use. The database re-checks the balance at commit.
+func insertJournalEntry(ctx context.Context, qtx *db.Queries, entry *ledger.JournalEntry) error {
+	params := db.InsertJournalEntryParams{
+		ID:          lp.ToUUID(string(entry.ID)),
+		Kind:        string(entry.Kind),
+		Currency:    entry.Currency,
+		Description: entry.Description,
+		ExternalRef: entry.ExternalRef,
+		CreatedAt:   lp.ToTimestamp(&entry.CreatedAt),
+	}
+	if entry.EscrowID != nil {
+		params.EscrowID = lp.ToUUID(string(*entry.EscrowID))
+	}
+	if err := qtx.InsertJournalEntry(ctx, params); err != nil {
+		return fmt.Errorf("failed to insert journal entry: %w", lp.TranslateError(err))
+	}
+
+	for i, p := range entry.Postings {
+		accountParams := db.EnsureAccountParams{
+			Code:     p.Account.Code,
+			Type:     string(p.Account.Type),
+			Currency: p.Account.Currency,
+		}
+		if p.Account.OwnerID != nil {
+			accountParams.OwnerID = lp.ToUUID(string(*p.Account.OwnerID))
+		}
+		accountID, err := qtx.EnsureAccount(ctx, accountParams)
+		if err != nil {
+			return fmt.Errorf("failed to ensure account %s: %w", p.Account.Code, lp.TranslateError(err))
+		}
+		err = qtx.InsertPosting(ctx, db.InsertPostingParams{
+			EntryID:   params.ID,
+			Position:  int32(i),
+			AccountID: accountID,
+			Direction: string(p.Direction),
+			Amount:    p.Amount,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to insert posting to %s: %w", p.Account.Code, lp.TranslateError(err))
+		}
+	}
+	return nil
+}
+
+func toPosting(row db.ListPostingsByEntryIDsRow) ledger.Posting {
+	account := ledger.Account{
+		Code:     row.Code,
+		Type:     ledger.AccountType(row.Type),
+		Currency: row.Currency,
+	}
+	if row.OwnerID.Valid {
+		owner := ids.UserID(lp.FromUUID(row.OwnerID))
+		account.OwnerID = &owner
+	}
+	return ledger.Posting{
+		Account:   account,
+		Direction: ledger.Direction(row.Direction),
+		Amount:    row.Amount,
+	}
+}
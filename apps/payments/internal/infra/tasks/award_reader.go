@@ -0,0 +1,66 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	awardpb "github.com/pratchaya-maneechot/service-exchange/apps/payments/api/proto/award"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/escrow"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/domain/shared/money"
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Reasons TaskAwardService fails with, as award.proto documents them.
+const (
+	reasonTaskNotFound   = "TASK_NOT_FOUND"
+	reasonTaskNotAwarded = "TASK_NOT_AWARDED"
+)
+
+// awardReader reads awards from the tasks service on behalf of the caller,
+// so only tasks the caller can see are found.
+type awardReader struct {
+	client awardpb.TaskAwardServiceClient
+}
+
+// NewAwardReader reads awards over conn, a connection to the tasks service
+// made by a grpc.ClientFactory.
+func NewAwardReader(conn *grpc.ClientConn) escrow.AwardReader {
+	return awardReader{client: awardpb.NewTaskAwardServiceClient(conn)}
+}
+
+func (r awardReader) AwardOf(ctx context.Context, taskID ids.TaskID, bidID *ids.BidID) (*escrow.Award, error) {
+	req := &awardpb.GetTaskAwardRequest{TaskId: string(taskID)}
+	if bidID != nil {
+		req.BidId = wrapperspb.String(string(*bidID))
+	}
+	a, err := r.client.GetTaskAward(ctx, req)
+	if err != nil {
+		err = lg.ErrorFromGRPC(err)
+		switch {
+		case errs.HasReason(err, reasonTaskNotFound):
+			return nil, escrow.ErrTaskNotFound
+		case errs.HasReason(err, reasonTaskNotAwarded):
+			return nil, escrow.ErrTaskNotAwarded
+		}
+		return nil, fmt.Errorf("failed to get task award: %w", err)
+	}
+
+	award := &escrow.Award{
+		TaskID:    taskID,
+		PosterID:  ids.UserID(a.GetPosterId()),
+		TaskerID:  ids.UserID(a.GetTaskerId()),
+		Completed: a.GetCompleted(),
+	}
+	if id := lg.StringValueToPtr(a.GetBidId()); id != nil {
+		acceptedID := ids.BidID(*id)
+		award.BidID = &acceptedID
+	}
+	if award.Price, err = money.New(a.GetAmount(), a.GetCurrency()); err != nil {
+		return nil, fmt.Errorf("task %s has an invalid award: %w", taskID, err)
+	}
+	return award, nil
+}
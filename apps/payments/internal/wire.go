@@ -0,0 +1,131 @@
+//go:build wireinject
+// +build wireinject
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/wire"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/app"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/grpc"
+	"github.com/pratchaya-maneechot/service-exchange/apps/payments/internal/infra"
+	"github.com/pratchaya-maneechot/service-exchange/libs/bus"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	lh "github.com/pratchaya-maneechot/service-exchange/libs/health"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+)
+
+func ProvideConfig() (*config.Config, error) {
+	return config.Load()
+}
+
+type Internal struct {
+	Config       *config.Config
+	Server       *lg.GRPCServer
+	Logger       *slog.Logger
+	App          *app.App
+	Bus          *bus.Bus
+	MetricServer *observability.MetricServer
+	// Health runs the dependency checks behind /readyz and the gRPC health
+	// service.
+	Health  *lh.Registry
+	Cleanup func()
+}
+
+func NewInternal(
+	cfg *config.Config,
+	gs *lg.GRPCServer,
+	appModule *app.App,
+	bBus *bus.Bus,
+	vd *validator.Validate,
+	logger *slog.Logger,
+	metricServer *observability.MetricServer,
+	health *lh.Registry,
+	cleanup func(),
+) *Internal {
+
+	bBus.CommandBus.Use(lg.ValidateCommands(vd))
+	bBus.QueryBus.Use(lg.ValidateQueries(vd))
+
+	bBus.CommandBus.RegisterHandler(command.HoldEscrowCommand{}, appModule.HoldEscrowCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.ReleaseEscrowCommand{}, appModule.ReleaseEscrowCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.RefundEscrowCommand{}, appModule.RefundEscrowCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.SettleDisputeCommand{}, appModule.SettleDisputeCommandHandler)
+	bBus.QueryBus.RegisterHandler(query.GetEscrowQuery{}, appModule.GetEscrowQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.GetBalanceQuery{}, appModule.GetBalanceQueryHandler)
+
+	return &Internal{
+		Config:       cfg,
+		Server:       gs,
+		App:          appModule,
+		Bus:          bBus,
+		Logger:       logger,
+		MetricServer: metricServer,
+		Health:       health,
+		Cleanup:      cleanup,
+	}
+}
+
+func InitializeApp(parentCtx context.Context) (*Internal, error) {
+	wire.Build(
+		app.AppModuleSet,
+		bus.BusModuleSet,
+		infra.InfraModuleSet,
+		grpc.NewGRPCServer,
+		NewInternal,
+		lg.ProvideValidator,
+		ProvideAppCleanup,
+		ProvideConfig,
+	)
+	return &Internal{}, nil
+}
+
+func ProvideAppCleanup(
+	infraModule *infra.Infra,
+	server *lg.GRPCServer,
+	metricServer *observability.MetricServer,
+	logger *slog.Logger,
+) func() {
+	return func() {
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		var shutdownErrors []error
+
+		if metricServer != nil {
+			if err := metricServer.Stop(cleanupCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				shutdownErrors = append(shutdownErrors, fmt.Errorf("failed to stop metrics server: %w", err))
+				logger.Error("Failed to stop metrics server", "error", err)
+			} else if err == nil {
+				logger.Info("Metrics server stopped.")
+			}
+		}
+
+		// gRPC server is typically stopped by context cancellation in its Start() method,
+		// so an explicit server.Stop() might not be needed here if Start() is blocking.
+		// If you had a separate server.Stop() method, you'd call it here.
+
+		if err := infraModule.Close(cleanupCtx); err != nil {
+			shutdownErrors = append(shutdownErrors, fmt.Errorf("failed to stop infra: %w", err))
+			logger.Error("Failed to stop infrastructure", "error", err)
+		} else {
+			logger.Info("Infrastructure components closed.")
+		}
+
+		if len(shutdownErrors) > 0 {
+			logger.Error("Errors encountered during application shutdown", "errors", shutdownErrors)
+		} else {
+			logger.Info("Application shutdown completed successfully.")
+		}
+	}
+}
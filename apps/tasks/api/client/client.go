@@ -0,0 +1,60 @@
+// Package client is how other services call the tasks service: the clients
+// generated from task.proto, bid.proto, review.proto and support.proto, over
+// one connection made by a grpc.ClientFactory, so calls get a default
+// deadline, the caller's identity, retries of the methods the protos mark
+// idempotent, and a circuit breaker.
+//
+// Calls fail with gRPC statuses. lg.ErrorFromGRPC turns them back into
+// application errors, which errs.HasReason tells apart by the reasons
+// below.
+package client
+
+import (
+	"context"
+
+	bidpb "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/proto/bid"
+	reviewpb "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/proto/review"
+	supportpb "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/proto/support"
+	taskpb "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/proto/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/bid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/review"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/grpc"
+)
+
+// Reasons of the errors callers usually handle.
+var (
+	ReasonTaskNotFound       = task.ErrTaskNotFound.Reason
+	ReasonBidNotFound        = bid.ErrBidNotFound.Reason
+	ReasonReputationNotFound = review.ErrReputationNotFound.Reason
+)
+
+type Client struct {
+	Tasks   taskpb.TaskServiceClient
+	Bids    bidpb.BidServiceClient
+	Reviews reviewpb.ReviewServiceClient
+	Support supportpb.SupportServiceClient
+	conn    *grpc.ClientConn
+}
+
+// New returns a client of the tasks service at cfg.Target, usually
+// lg.DefaultConfigClient of it.
+func New(ctx context.Context, factory *lg.ClientFactory, cfg lg.ConfigClient) (*Client, error) {
+	conn, err := factory.NewClient(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		Tasks:   taskpb.NewTaskServiceClient(conn),
+		Bids:    bidpb.NewBidServiceClient(conn),
+		Reviews: reviewpb.NewReviewServiceClient(conn),
+		Support: supportpb.NewSupportServiceClient(conn),
+		conn:    conn,
+	}, nil
+}
+
+// Close closes the connection; calls in flight fail.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,100 @@
+package app
+
+import (
+	"github.com/google/wire"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/query"
+)
+
+type App struct {
+	GetTaskQueryHandler                   *query.GetTaskQueryHandler
+	ListTasksQueryHandler                 *query.ListTasksQueryHandler
+	GetTaskAwardQueryHandler              *query.GetTaskAwardQueryHandler
+	CreateTaskCommandHandler              *command.CreateTaskCommandHandler
+	UpdateTaskCommandHandler              *command.UpdateTaskCommandHandler
+	PublishTaskCommandHandler             *command.PublishTaskCommandHandler
+	AssignTaskCommandHandler              *command.AssignTaskCommandHandler
+	StartTaskCommandHandler               *command.StartTaskCommandHandler
+	CompleteTaskCommandHandler            *command.CompleteTaskCommandHandler
+	CancelTaskCommandHandler              *command.CancelTaskCommandHandler
+	GetBidQueryHandler                    *query.GetBidQueryHandler
+	ListTaskBidsQueryHandler              *query.ListTaskBidsQueryHandler
+	ListBookingsQueryHandler              *query.ListBookingsQueryHandler
+	PlaceBidCommandHandler                *command.PlaceBidCommandHandler
+	CounterBidCommandHandler              *command.CounterBidCommandHandler
+	AcceptBidCommandHandler               *command.AcceptBidCommandHandler
+	RejectBidCommandHandler               *command.RejectBidCommandHandler
+	WithdrawBidCommandHandler             *command.WithdrawBidCommandHandler
+	GetReviewQueryHandler                 *query.GetReviewQueryHandler
+	ListTaskReviewsQueryHandler           *query.ListTaskReviewsQueryHandler
+	ListUserReviewsQueryHandler           *query.ListUserReviewsQueryHandler
+	GetReputationQueryHandler             *query.GetReputationQueryHandler
+	SubmitReviewCommandHandler            *command.SubmitReviewCommandHandler
+	EditReviewCommandHandler              *command.EditReviewCommandHandler
+	ReplyToReviewCommandHandler           *command.ReplyToReviewCommandHandler
+	FlagReviewCommandHandler              *command.FlagReviewCommandHandler
+	ModerateReviewCommandHandler          *command.ModerateReviewCommandHandler
+	GetTicketQueryHandler                 *query.GetTicketQueryHandler
+	ListTicketsQueryHandler               *query.ListTicketsQueryHandler
+	OpenTicketCommandHandler              *command.OpenTicketCommandHandler
+	ReplyToTicketCommandHandler           *command.ReplyToTicketCommandHandler
+	AssignTicketCommandHandler            *command.AssignTicketCommandHandler
+	UpdateTicketCommandHandler            *command.UpdateTicketCommandHandler
+	DetectSLABreachesCommandHandler       *command.DetectSLABreachesCommandHandler
+	RetryDisputeSettlementsCommandHandler *command.RetryDisputeSettlementsCommandHandler
+	GetDisputeQueryHandler                *query.GetDisputeQueryHandler
+	ListDisputesQueryHandler              *query.ListDisputesQueryHandler
+	OpenDisputeCommandHandler             *command.OpenDisputeCommandHandler
+	SubmitDisputeEvidenceCommandHandler   *command.SubmitDisputeEvidenceCommandHandler
+	StartDisputeReviewCommandHandler      *command.StartDisputeReviewCommandHandler
+	ResolveDisputeCommandHandler          *command.ResolveDisputeCommandHandler
+	WithdrawDisputeCommandHandler         *command.WithdrawDisputeCommandHandler
+	SettleResolvedDisputeHandler          *command.SettleResolvedDisputeHandler
+}
+
+var AppModuleSet = wire.NewSet(
+	query.NewGetTaskQueryHandler,
+	query.NewListTasksQueryHandler,
+	query.NewGetTaskAwardQueryHandler,
+	command.NewCreateTaskCommandHandler,
+	command.NewUpdateTaskCommandHandler,
+	command.NewPublishTaskCommandHandler,
+	command.NewAssignTaskCommandHandler,
+	command.NewStartTaskCommandHandler,
+	command.NewCompleteTaskCommandHandler,
+	command.NewCancelTaskCommandHandler,
+	query.NewGetBidQueryHandler,
+	query.NewListTaskBidsQueryHandler,
+	query.NewListBookingsQueryHandler,
+	command.NewPlaceBidCommandHandler,
+	command.NewCounterBidCommandHandler,
+	command.NewAcceptBidCommandHandler,
+	command.NewRejectBidCommandHandler,
+	command.NewWithdrawBidCommandHandler,
+	query.NewGetReviewQueryHandler,
+	query.NewListTaskReviewsQueryHandler,
+	query.NewListUserReviewsQueryHandler,
+	query.NewGetReputationQueryHandler,
+	command.NewSubmitReviewCommandHandler,
+	command.NewEditReviewCommandHandler,
+	command.NewReplyToReviewCommandHandler,
+	command.NewFlagReviewCommandHandler,
+	command.NewModerateReviewCommandHandler,
+	query.NewGetTicketQueryHandler,
+	query.NewListTicketsQueryHandler,
+	command.NewOpenTicketCommandHandler,
+	command.NewReplyToTicketCommandHandler,
+	command.NewAssignTicketCommandHandler,
+	command.NewUpdateTicketCommandHandler,
+	command.NewDetectSLABreachesCommandHandler,
+	command.NewRetryDisputeSettlementsCommandHandler,
+	query.NewGetDisputeQueryHandler,
+	query.NewListDisputesQueryHandler,
+	command.NewOpenDisputeCommandHandler,
+	command.NewSubmitDisputeEvidenceCommandHandler,
+	command.NewStartDisputeReviewCommandHandler,
+	command.NewResolveDisputeCommandHandler,
+	command.NewWithdrawDisputeCommandHandler,
+	command.NewSettleResolvedDisputeHandler,
+	wire.Struct(new(App), "*"),
+)
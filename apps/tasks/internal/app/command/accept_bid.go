@@ -0,0 +1,131 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/bid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/bus/event"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AcceptBidCommand agrees to the latest offer on a bid. The task is assigned
+// to the tasker and every other open bid on it rejected in the same
+// transaction, and BidAccepted is published once that is committed.
+type AcceptBidCommand struct {
+	BidID ids.BidID  `json:"bidId" validate:"required,uuid"`
+	Actor task.Actor `json:"-"`
+}
+
+type AcceptBidCommandHandler struct {
+	taskRepo task.TaskRepository
+	bidRepo  bid.BidRepository
+	eventBus event.EventBus
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewAcceptBidCommandHandler(
+	taskRepo task.TaskRepository,
+	bidRepo bid.BidRepository,
+	eventBus event.EventBus,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *AcceptBidCommandHandler {
+	return &AcceptBidCommandHandler{
+		taskRepo: taskRepo,
+		bidRepo:  bidRepo,
+		eventBus: eventBus,
+		logger:   logger.With(slog.String("component", "AcceptBidCommandHandler")),
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *AcceptBidCommandHandler) Handle(ctx context.Context, cmd AcceptBidCommand) (*BidDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("bid_id", string(cmd.BidID)))
+
+	ctx, span := h.tracer.Start(ctx, "AcceptBidCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("bid.id", string(cmd.BidID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	b, err := h.bidRepo.FindByID(ctx, cmd.BidID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve bid")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve bid", slog.Any("error", err))
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("task.id", string(b.TaskID)))
+
+	// The task is checked up front so a bid on a task that was cancelled or
+	// assigned in the meantime is turned down before anything is written;
+	// the repository checks again when it assigns the task.
+	t, err := h.taskRepo.FindByID(ctx, b.TaskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve task")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve task of bid", slog.Any("error", err))
+		return nil, err
+	}
+	if t.Status != task.StatusOpen {
+		span.SetStatus(codes.Error, "Task not open")
+		span.SetAttributes(attribute.String("error.type", "task_not_open"))
+		logger.Warn("Rejected accepting a bid on a task that is not open", "status", string(t.Status))
+		return nil, bid.ErrTaskNotOpen
+	}
+
+	if err := b.Accept(cmd.Actor.UserID); err != nil {
+		span.SetStatus(codes.Error, "Bid acceptance rejected")
+		span.SetAttributes(attribute.String("error.type", "invalid_bid_response"))
+		logger.Warn("Rejected bid acceptance", "status", string(b.Status), slog.Any("error", err))
+		return nil, err
+	}
+
+	// Accepting a bid is the poster's decision, even when the tasker accepted
+	// the poster's counter-offer, so the task is assigned on their behalf.
+	if err := t.Assign(task.Actor{UserID: b.PosterID}, b.TaskerID); err != nil {
+		span.SetStatus(codes.Error, "Task assignment rejected")
+		span.SetAttributes(attribute.String("error.type", "invalid_task_transition"))
+		logger.Warn("Rejected assigning the task to the bid's tasker", "status", string(t.Status), slog.Any("error", err))
+		return nil, err
+	}
+
+	rejected, err := h.bidRepo.SaveAccepted(ctx, b)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to save accepted bid")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Warn("Failed to save accepted bid to repository", slog.Any("error", err))
+		return nil, err
+	}
+	logger.Info("Bid accepted and task assigned.", "task_id", string(b.TaskID), "rejected_bids", len(rejected))
+
+	// The acceptance and the assignment are committed at this point; a
+	// subscriber failing does not undo them, so it is reported rather than
+	// returned.
+	if err := h.eventBus.Publish(ctx, bid.NewBidAccepted(b, rejected)); err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "event_publish_error"))
+		logger.Error("Failed to handle BidAccepted", slog.Any("error", err))
+	}
+
+	span.SetStatus(codes.Ok, "Bid accepted")
+	return &BidDto{BidID: string(b.ID), Status: b.Status}, nil
+}
@@ -0,0 +1,67 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AssignTaskCommand hands an open task to a tasker, usually the author of the
+// accepted bid.
+type AssignTaskCommand struct {
+	TaskID   ids.TaskID `json:"taskId" validate:"required,uuid"`
+	TaskerID ids.UserID `json:"taskerId" validate:"required,uuid"`
+	Actor    task.Actor `json:"-"`
+}
+
+type AssignTaskCommandHandler struct {
+	taskRepo task.TaskRepository
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewAssignTaskCommandHandler(
+	taskRepo task.TaskRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *AssignTaskCommandHandler {
+	return &AssignTaskCommandHandler{
+		taskRepo: taskRepo,
+		logger:   logger.With(slog.String("component", "AssignTaskCommandHandler")),
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *AssignTaskCommandHandler) Handle(ctx context.Context, cmd AssignTaskCommand) (*TaskDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(cmd.TaskID)))
+
+	ctx, span := h.tracer.Start(ctx, "AssignTaskCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", string(cmd.TaskID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	t, err := transitionTask(ctx, h.taskRepo, span, logger, cmd.TaskID, func(t *task.Task) error {
+		return t.Assign(cmd.Actor, cmd.TaskerID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Task assigned")
+	return &TaskDto{TaskID: string(t.ID), Status: t.Status}, nil
+}
@@ -0,0 +1,73 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AssignTicketCommand hands a ticket to a staff member; without an assignee
+// the acting staff member takes it.
+type AssignTicketCommand struct {
+	TicketID   ids.TicketID `json:"ticketId" validate:"required,uuid"`
+	AssigneeID *ids.UserID  `json:"assigneeId,omitempty" validate:"omitempty,uuid"`
+	Actor      task.Actor   `json:"-"`
+}
+
+type AssignTicketCommandHandler struct {
+	ticketRepo ticket.TicketRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewAssignTicketCommandHandler(
+	ticketRepo ticket.TicketRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *AssignTicketCommandHandler {
+	return &AssignTicketCommandHandler{
+		ticketRepo: ticketRepo,
+		logger:     logger.With(slog.String("component", "AssignTicketCommandHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *AssignTicketCommandHandler) Handle(ctx context.Context, cmd AssignTicketCommand) (*TicketDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("ticket_id", string(cmd.TicketID)))
+
+	ctx, span := h.tracer.Start(ctx, "AssignTicketCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	assigneeID := cmd.Actor.UserID
+	if cmd.AssigneeID != nil {
+		assigneeID = *cmd.AssigneeID
+	}
+	span.SetAttributes(
+		attribute.String("ticket.id", string(cmd.TicketID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+		attribute.String("ticket.assignee_id", string(assigneeID)),
+	)
+
+	t, err := changeTicket(ctx, h.ticketRepo, span, logger, cmd.TicketID, cmd.Actor, func(t *ticket.Ticket) error {
+		return t.Assign(cmd.Actor, assigneeID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Ticket assigned")
+	return newTicketDto(t), nil
+}
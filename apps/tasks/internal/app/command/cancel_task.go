@@ -0,0 +1,66 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CancelTaskCommand withdraws a task that is not yet completed.
+type CancelTaskCommand struct {
+	TaskID ids.TaskID `json:"taskId" validate:"required,uuid"`
+	Reason *string    `json:"reason,omitempty"`
+	Actor  task.Actor `json:"-"`
+}
+
+type CancelTaskCommandHandler struct {
+	taskRepo task.TaskRepository
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewCancelTaskCommandHandler(
+	taskRepo task.TaskRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *CancelTaskCommandHandler {
+	return &CancelTaskCommandHandler{
+		taskRepo: taskRepo,
+		logger:   logger.With(slog.String("component", "CancelTaskCommandHandler")),
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *CancelTaskCommandHandler) Handle(ctx context.Context, cmd CancelTaskCommand) (*TaskDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(cmd.TaskID)))
+
+	ctx, span := h.tracer.Start(ctx, "CancelTaskCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", string(cmd.TaskID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	t, err := transitionTask(ctx, h.taskRepo, span, logger, cmd.TaskID, func(t *task.Task) error {
+		return t.Cancel(cmd.Actor, cmd.Reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Task cancelled")
+	return &TaskDto{TaskID: string(t.ID), Status: t.Status}, nil
+}
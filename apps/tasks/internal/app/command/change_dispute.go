@@ -0,0 +1,76 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/dispute"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// changeDispute loads a dispute and its ticket, applies one change to them
+// and saves both in one transaction. apply is where the Dispute methods
+// enforce who may do what; it keeps the ticket in step. Disputes the actor
+// cannot see are reported as missing.
+func changeDispute(
+	ctx context.Context,
+	disputeRepo dispute.DisputeRepository,
+	ticketRepo ticket.TicketRepository,
+	span trace.Span,
+	logger *slog.Logger,
+	disputeID ids.DisputeID,
+	actor task.Actor,
+	apply func(d *dispute.Dispute, t *ticket.Ticket) error,
+) (*dispute.Dispute, error) {
+	d, err := disputeRepo.FindByID(ctx, disputeID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve dispute")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve dispute", slog.Any("error", err))
+		return nil, err
+	}
+	if !d.CanView(actor) {
+		span.SetStatus(codes.Error, "Dispute not visible")
+		span.SetAttributes(attribute.String("error.type", string(dispute.ErrDisputeNotFound.Code)))
+		return nil, dispute.ErrDisputeNotFound
+	}
+	t, err := ticketRepo.FindByID(ctx, d.TicketID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve dispute ticket")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to retrieve ticket of dispute", slog.Any("error", err))
+		return nil, err
+	}
+	from := d.Status
+	span.SetAttributes(
+		attribute.String("task.id", string(d.TaskID)),
+		attribute.String("dispute.status_from", string(from)),
+	)
+
+	if err := apply(d, t); err != nil {
+		span.SetStatus(codes.Error, "Dispute change rejected")
+		span.SetAttributes(attribute.String("error.type", "invalid_dispute_change"))
+		logger.Warn("Rejected dispute change", "status", string(from), slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := disputeRepo.Save(ctx, d, t); err != nil {
+		span.SetStatus(codes.Error, "Failed to save dispute")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Warn("Failed to save dispute to repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.String("dispute.status_to", string(d.Status)))
+	logger.Info("Dispute changed.", "from", string(from), "to", string(d.Status))
+	return d, nil
+}
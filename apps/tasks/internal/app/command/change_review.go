@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/review"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// changeReview loads a review, applies one change to it and saves it. apply
+// is a Review method call, which is where authorship is enforced. Reviews
+// the actor cannot see are reported as missing.
+func changeReview(
+	ctx context.Context,
+	reviewRepo review.ReviewRepository,
+	span trace.Span,
+	logger *slog.Logger,
+	reviewID ids.ReviewID,
+	actor task.Actor,
+	apply func(r *review.Review) error,
+) (*review.Review, error) {
+	r, err := reviewRepo.FindByID(ctx, reviewID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve review")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve review", slog.Any("error", err))
+		return nil, err
+	}
+	if !r.CanView(actor.UserID, actor.IsAdmin) {
+		span.SetStatus(codes.Error, "Review not visible")
+		span.SetAttributes(attribute.String("error.type", string(review.ErrReviewNotFound.Code)))
+		return nil, review.ErrReviewNotFound
+	}
+	from := r.Status
+	span.SetAttributes(
+		attribute.String("task.id", string(r.TaskID)),
+		attribute.String("review.status_from", string(from)),
+	)
+
+	if err := apply(r); err != nil {
+		span.SetStatus(codes.Error, "Review change rejected")
+		span.SetAttributes(attribute.String("error.type", "invalid_review_change"))
+		logger.Warn("Rejected review change", "status", string(from), slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := reviewRepo.Save(ctx, r); err != nil {
+		span.SetStatus(codes.Error, "Failed to save review")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Warn("Failed to save review to repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.String("review.status_to", string(r.Status)))
+	logger.Info("Review changed.", "from", string(from), "to", string(r.Status))
+	return r, nil
+}
@@ -0,0 +1,62 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// changeTicket loads a ticket, applies one change to it and saves it. apply
+// is a Ticket method call, which is where the reporter and staff rules are
+// enforced. Tickets the actor cannot see are reported as missing.
+func changeTicket(
+	ctx context.Context,
+	ticketRepo ticket.TicketRepository,
+	span trace.Span,
+	logger *slog.Logger,
+	ticketID ids.TicketID,
+	actor task.Actor,
+	apply func(t *ticket.Ticket) error,
+) (*ticket.Ticket, error) {
+	t, err := ticketRepo.FindByID(ctx, ticketID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve ticket")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve ticket", slog.Any("error", err))
+		return nil, err
+	}
+	if !t.CanView(actor) {
+		span.SetStatus(codes.Error, "Ticket not visible")
+		span.SetAttributes(attribute.String("error.type", string(ticket.ErrTicketNotFound.Code)))
+		return nil, ticket.ErrTicketNotFound
+	}
+	from := t.Status
+	span.SetAttributes(attribute.String("ticket.status_from", string(from)))
+
+	if err := apply(t); err != nil {
+		span.SetStatus(codes.Error, "Ticket change rejected")
+		span.SetAttributes(attribute.String("error.type", "invalid_ticket_change"))
+		logger.Warn("Rejected ticket change", "status", string(from), slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := ticketRepo.Save(ctx, t); err != nil {
+		span.SetStatus(codes.Error, "Failed to save ticket")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Warn("Failed to save ticket to repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.String("ticket.status_to", string(t.Status)))
+	logger.Info("Ticket changed.", "from", string(from), "to", string(t.Status))
+	return t, nil
+}
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CompleteTaskCommand is the poster confirming the work is done.
+type CompleteTaskCommand struct {
+	TaskID ids.TaskID `json:"taskId" validate:"required,uuid"`
+	Actor  task.Actor `json:"-"`
+}
+
+type CompleteTaskCommandHandler struct {
+	taskRepo task.TaskRepository
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewCompleteTaskCommandHandler(
+	taskRepo task.TaskRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *CompleteTaskCommandHandler {
+	return &CompleteTaskCommandHandler{
+		taskRepo: taskRepo,
+		logger:   logger.With(slog.String("component", "CompleteTaskCommandHandler")),
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *CompleteTaskCommandHandler) Handle(ctx context.Context, cmd CompleteTaskCommand) (*TaskDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(cmd.TaskID)))
+
+	ctx, span := h.tracer.Start(ctx, "CompleteTaskCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", string(cmd.TaskID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	t, err := transitionTask(ctx, h.taskRepo, span, logger, cmd.TaskID, func(t *task.Task) error {
+		return t.Complete(cmd.Actor)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Task completed")
+	return &TaskDto{TaskID: string(t.ID), Status: t.Status}, nil
+}
@@ -0,0 +1,68 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/bid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CounterBidCommand answers the latest offer on a bid with new terms. Whoever
+// the bid is waiting on, the poster or the tasker, can counter.
+type CounterBidCommand struct {
+	BidID ids.BidID     `json:"bidId" validate:"required,uuid"`
+	Terms BidTermsInput `json:"terms"`
+	Actor task.Actor    `json:"-"`
+}
+
+type CounterBidCommandHandler struct {
+	bidRepo bid.BidRepository
+	logger  *slog.Logger
+	config  *config.Config
+	tracer  trace.Tracer
+}
+
+func NewCounterBidCommandHandler(
+	bidRepo bid.BidRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *CounterBidCommandHandler {
+	return &CounterBidCommandHandler{
+		bidRepo: bidRepo,
+		logger:  logger.With(slog.String("component", "CounterBidCommandHandler")),
+		config:  cfg,
+		tracer:  otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *CounterBidCommandHandler) Handle(ctx context.Context, cmd CounterBidCommand) (*BidDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("bid_id", string(cmd.BidID)))
+
+	ctx, span := h.tracer.Start(ctx, "CounterBidCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("bid.id", string(cmd.BidID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	b, err := respondToBid(ctx, h.bidRepo, span, logger, cmd.BidID, func(b *bid.Bid) error {
+		return b.Counter(cmd.Actor.UserID, cmd.Terms.toTerms())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Counter-offer made")
+	return &BidDto{BidID: string(b.ID), Status: b.Status}, nil
+}
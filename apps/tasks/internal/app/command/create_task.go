@@ -0,0 +1,156 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type BudgetInput struct {
+	Amount int64 `json:"amount" validate:"gt=0"`
+	// Currency defaults to THB when empty.
+	Currency string `json:"currency,omitempty" validate:"omitempty,len=3"`
+}
+
+type LocationInput struct {
+	Province   string   `json:"province" validate:"required"`
+	District   *string  `json:"district,omitempty"`
+	PostalCode *string  `json:"postalCode,omitempty"`
+	Latitude   *float64 `json:"latitude,omitempty" validate:"required_with=Longitude"`
+	Longitude  *float64 `json:"longitude,omitempty" validate:"required_with=Latitude"`
+}
+
+type AttachmentInput struct {
+	// ID is empty for new attachments and kept for existing ones.
+	ID          string  `json:"id,omitempty" validate:"omitempty,uuid"`
+	URL         string  `json:"url" validate:"required,url"`
+	FileName    string  `json:"fileName" validate:"required"`
+	ContentType *string `json:"contentType,omitempty"`
+	SizeBytes   int64   `json:"sizeBytes" validate:"gte=0"`
+}
+
+// TaskInput is the full editable state of a task. A nil Location means the
+// task can be done remotely.
+type TaskInput struct {
+	Title        string            `json:"title" validate:"required"`
+	Description  string            `json:"description" validate:"required"`
+	CategoryCode string            `json:"categoryCode" validate:"required"`
+	Budget       BudgetInput       `json:"budget"`
+	Location     *LocationInput    `json:"location,omitempty"`
+	DueAt        *time.Time        `json:"dueAt,omitempty"`
+	Attachments  []AttachmentInput `json:"attachments,omitempty" validate:"dive"`
+}
+
+func (in TaskInput) toDetails() task.TaskDetails {
+	d := task.TaskDetails{
+		Title:        in.Title,
+		Description:  in.Description,
+		CategoryCode: in.CategoryCode,
+		Budget:       task.Budget{Amount: in.Budget.Amount, Currency: in.Budget.Currency},
+		DueAt:        in.DueAt,
+		Attachments:  make([]task.Attachment, 0, len(in.Attachments)),
+	}
+	if in.Location != nil {
+		d.Location = &task.Location{
+			Province:   in.Location.Province,
+			District:   in.Location.District,
+			PostalCode: in.Location.PostalCode,
+			Latitude:   in.Location.Latitude,
+			Longitude:  in.Location.Longitude,
+		}
+	}
+	for _, a := range in.Attachments {
+		id, _ := uuid.Parse(a.ID)
+		d.Attachments = append(d.Attachments, task.Attachment{
+			ID:          id,
+			URL:         a.URL,
+			FileName:    a.FileName,
+			ContentType: a.ContentType,
+			SizeBytes:   a.SizeBytes,
+		})
+	}
+	return d
+}
+
+// CreateTaskCommand creates a DRAFT task posted by the actor.
+type CreateTaskCommand struct {
+	Task  TaskInput  `json:"task"`
+	Actor task.Actor `json:"-"`
+}
+
+type TaskDto struct {
+	TaskID string      `json:"taskId"`
+	Status task.Status `json:"status"`
+}
+
+type CreateTaskCommandHandler struct {
+	taskRepo task.TaskRepository
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewCreateTaskCommandHandler(
+	taskRepo task.TaskRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *CreateTaskCommandHandler {
+	return &CreateTaskCommandHandler{
+		taskRepo: taskRepo,
+		logger:   logger.With(slog.String("component", "CreateTaskCommandHandler")),
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *CreateTaskCommandHandler) Handle(ctx context.Context, cmd CreateTaskCommand) (*TaskDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("poster_id", string(cmd.Actor.UserID)))
+
+	ctx, span := h.tracer.Start(ctx, "CreateTaskCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.poster_id", string(cmd.Actor.UserID)),
+		attribute.String("task.category_code", cmd.Task.CategoryCode),
+	)
+
+	if cmd.Actor.UserID == "" {
+		span.SetStatus(codes.Error, "Anonymous task creation")
+		span.SetAttributes(attribute.String("error.type", string(task.ErrSignInRequired.Code)))
+		logger.Warn("Rejected task creation without a caller")
+		return nil, task.ErrSignInRequired
+	}
+
+	t, err := task.NewTask(cmd.Actor.UserID, cmd.Task.toDetails())
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid task")
+		span.SetAttributes(attribute.String("error.type", "invalid_task"))
+		logger.Warn("Rejected invalid task", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := h.taskRepo.Save(ctx, t); err != nil {
+		span.SetStatus(codes.Error, "Failed to save task")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Error("Failed to save task to repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Task created")
+	span.SetAttributes(attribute.String("task.id", string(t.ID)))
+	logger.Info("Task created successfully.", "task_id", string(t.ID))
+
+	return &TaskDto{TaskID: string(t.ID), Status: t.Status}, nil
+}
@@ -0,0 +1,93 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DetectSLABreachesCommand marks one batch of tickets that support did not
+// answer within their SLA as breached. The SLA monitor sends it on every
+// tick.
+type DetectSLABreachesCommand struct{}
+
+type DetectSLABreachesDto struct {
+	Checked  int `json:"checked"`
+	Breached int `json:"breached"`
+}
+
+type DetectSLABreachesCommandHandler struct {
+	ticketRepo ticket.TicketRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewDetectSLABreachesCommandHandler(
+	ticketRepo ticket.TicketRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *DetectSLABreachesCommandHandler {
+	return &DetectSLABreachesCommandHandler{
+		ticketRepo: ticketRepo,
+		logger:     logger.With(slog.String("component", "DetectSLABreachesCommandHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *DetectSLABreachesCommandHandler) Handle(ctx context.Context, cmd DetectSLABreachesCommand) (*DetectSLABreachesDto, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := h.tracer.Start(ctx, "DetectSLABreachesCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	now := time.Now()
+	overdue, err := h.ticketRepo.ListOverdue(ctx, now, h.config.Support.BreachCheck.BatchSize)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list overdue tickets")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to list overdue tickets", slog.Any("error", err))
+		return nil, err
+	}
+
+	dto := &DetectSLABreachesDto{Checked: len(overdue)}
+	for _, t := range overdue {
+		if !t.MarkBreached(now) {
+			continue
+		}
+		if err := h.ticketRepo.Save(ctx, t); err != nil {
+			// A ticket changed under us is looked at again on the next tick.
+			if !errors.Is(err, ticket.ErrTicketChanged) {
+				span.RecordError(err)
+				logger.Error("Failed to mark ticket as breached", slog.String("ticket_id", string(t.ID)), slog.Any("error", err))
+			}
+			continue
+		}
+		dto.Breached++
+		logger.Warn("Ticket breached its SLA.",
+			slog.String("ticket_id", string(t.ID)),
+			slog.String("priority", string(t.Priority)),
+			slog.Time("sla_due_at", t.SLADueAt),
+		)
+	}
+
+	span.SetStatus(codes.Ok, "SLA breaches detected")
+	span.SetAttributes(
+		attribute.Int("tickets.checked", dto.Checked),
+		attribute.Int("tickets.breached", dto.Breached),
+	)
+	return dto, nil
+}
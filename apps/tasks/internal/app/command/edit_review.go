@@ -0,0 +1,72 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/review"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// EditReviewCommand is the author changing their review within the edit
+// window.
+type EditReviewCommand struct {
+	ReviewID ids.ReviewID       `json:"reviewId" validate:"required,uuid"`
+	Content  ReviewContentInput `json:"content"`
+	Actor    task.Actor         `json:"-"`
+}
+
+type EditReviewCommandHandler struct {
+	reviewRepo review.ReviewRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewEditReviewCommandHandler(
+	reviewRepo review.ReviewRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *EditReviewCommandHandler {
+	return &EditReviewCommandHandler{
+		reviewRepo: reviewRepo,
+		logger:     logger.With(slog.String("component", "EditReviewCommandHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *EditReviewCommandHandler) Handle(ctx context.Context, cmd EditReviewCommand) (*ReviewDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("review_id", string(cmd.ReviewID)))
+
+	ctx, span := h.tracer.Start(ctx, "EditReviewCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("review.id", string(cmd.ReviewID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	r, err := changeReview(ctx, h.reviewRepo, span, logger, cmd.ReviewID, cmd.Actor, func(r *review.Review) error {
+		content, err := cmd.Content.toContent()
+		if err != nil {
+			return err
+		}
+		return r.Edit(cmd.Actor.UserID, content)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Review edited")
+	return &ReviewDto{ReviewID: string(r.ID), Status: r.Status}, nil
+}
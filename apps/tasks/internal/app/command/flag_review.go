@@ -0,0 +1,68 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/review"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// FlagReviewCommand is the reviewed user asking a moderator to look at a
+// review.
+type FlagReviewCommand struct {
+	ReviewID ids.ReviewID `json:"reviewId" validate:"required,uuid"`
+	Reason   string       `json:"reason" validate:"required,max=500"`
+	Actor    task.Actor   `json:"-"`
+}
+
+type FlagReviewCommandHandler struct {
+	reviewRepo review.ReviewRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewFlagReviewCommandHandler(
+	reviewRepo review.ReviewRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *FlagReviewCommandHandler {
+	return &FlagReviewCommandHandler{
+		reviewRepo: reviewRepo,
+		logger:     logger.With(slog.String("component", "FlagReviewCommandHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *FlagReviewCommandHandler) Handle(ctx context.Context, cmd FlagReviewCommand) (*ReviewDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("review_id", string(cmd.ReviewID)))
+
+	ctx, span := h.tracer.Start(ctx, "FlagReviewCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("review.id", string(cmd.ReviewID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	r, err := changeReview(ctx, h.reviewRepo, span, logger, cmd.ReviewID, cmd.Actor, func(r *review.Review) error {
+		return r.Flag(cmd.Actor.UserID, cmd.Reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Review flagged")
+	return &ReviewDto{ReviewID: string(r.ID), Status: r.Status}, nil
+}
@@ -0,0 +1,76 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/review"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ModerateReviewCommand is an admin publishing a flagged review again or
+// removing a review.
+type ModerateReviewCommand struct {
+	ReviewID ids.ReviewID  `json:"reviewId" validate:"required,uuid"`
+	Decision review.Status `json:"decision" validate:"required,oneof=PUBLISHED REMOVED"`
+	Note     *string       `json:"note,omitempty" validate:"omitempty,max=500"`
+	Actor    task.Actor    `json:"-"`
+}
+
+type ModerateReviewCommandHandler struct {
+	reviewRepo review.ReviewRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewModerateReviewCommandHandler(
+	reviewRepo review.ReviewRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ModerateReviewCommandHandler {
+	return &ModerateReviewCommandHandler{
+		reviewRepo: reviewRepo,
+		logger:     logger.With(slog.String("component", "ModerateReviewCommandHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *ModerateReviewCommandHandler) Handle(ctx context.Context, cmd ModerateReviewCommand) (*ReviewDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("review_id", string(cmd.ReviewID)))
+
+	ctx, span := h.tracer.Start(ctx, "ModerateReviewCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("review.id", string(cmd.ReviewID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	if !cmd.Actor.IsAdmin {
+		span.SetStatus(codes.Error, "Moderation denied")
+		span.SetAttributes(attribute.String("error.type", string(review.ErrModeratorOnly.Code)))
+		logger.Warn("Rejected moderation by non-admin", "actor_id", string(cmd.Actor.UserID))
+		return nil, review.ErrModeratorOnly
+	}
+
+	r, err := changeReview(ctx, h.reviewRepo, span, logger, cmd.ReviewID, cmd.Actor, func(r *review.Review) error {
+		return r.Moderate(cmd.Decision, cmd.Note)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Review moderated")
+	return &ReviewDto{ReviewID: string(r.ID), Status: r.Status}, nil
+}
@@ -0,0 +1,137 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/dispute"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// disputeSubjectTitleLength keeps the subject of a dispute ticket within
+// the ticket subject limit.
+const disputeSubjectTitleLength = 150
+
+// OpenDisputeCommand is one party of a task disputing the work or the
+// payment held for it. A DISPUTE ticket is opened with it so support can
+// track it.
+type OpenDisputeCommand struct {
+	TaskID ids.TaskID `json:"taskId" validate:"required,uuid"`
+	Reason string     `json:"reason" validate:"required"`
+	Actor  task.Actor `json:"-"`
+}
+
+type DisputeDto struct {
+	DisputeID string         `json:"disputeId"`
+	TicketID  string         `json:"ticketId"`
+	Status    dispute.Status `json:"status"`
+}
+
+func newDisputeDto(d *dispute.Dispute) *DisputeDto {
+	return &DisputeDto{DisputeID: string(d.ID), TicketID: string(d.TicketID), Status: d.Status}
+}
+
+type OpenDisputeCommandHandler struct {
+	taskRepo    task.TaskRepository
+	disputeRepo dispute.DisputeRepository
+	slaPolicy   ticket.SLAPolicy
+	logger      *slog.Logger
+	config      *config.Config
+	tracer      trace.Tracer
+}
+
+func NewOpenDisputeCommandHandler(
+	taskRepo task.TaskRepository,
+	disputeRepo dispute.DisputeRepository,
+	slaPolicy ticket.SLAPolicy,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *OpenDisputeCommandHandler {
+	return &OpenDisputeCommandHandler{
+		taskRepo:    taskRepo,
+		disputeRepo: disputeRepo,
+		slaPolicy:   slaPolicy,
+		logger:      logger.With(slog.String("component", "OpenDisputeCommandHandler")),
+		config:      cfg,
+		tracer:      otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *OpenDisputeCommandHandler) Handle(ctx context.Context, cmd OpenDisputeCommand) (*DisputeDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(
+		slog.String("task_id", string(cmd.TaskID)),
+		slog.String("actor_id", string(cmd.Actor.UserID)),
+	)
+
+	ctx, span := h.tracer.Start(ctx, "OpenDisputeCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", string(cmd.TaskID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	if cmd.Actor.UserID == "" {
+		span.SetStatus(codes.Error, "Anonymous dispute")
+		span.SetAttributes(attribute.String("error.type", string(dispute.ErrSignInRequired.Code)))
+		logger.Warn("Rejected dispute without a caller")
+		return nil, dispute.ErrSignInRequired
+	}
+
+	t, err := h.taskRepo.FindByID(ctx, cmd.TaskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve task")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve task to dispute", slog.Any("error", err))
+		return nil, err
+	}
+	if !t.CanView(cmd.Actor) {
+		span.SetStatus(codes.Error, "Task not visible")
+		span.SetAttributes(attribute.String("error.type", string(task.ErrTaskNotFound.Code)))
+		return nil, task.ErrTaskNotFound
+	}
+
+	title := []rune(t.Title)
+	if len(title) > disputeSubjectTitleLength {
+		title = append(title[:disputeSubjectTitleLength-1], '…')
+	}
+	tk, err := ticket.NewDisputeTicket(cmd.Actor, t.ID, "Dispute: "+string(title), cmd.Reason, h.slaPolicy)
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid dispute")
+		span.SetAttributes(attribute.String("error.type", "invalid_dispute"))
+		logger.Warn("Rejected invalid dispute ticket", slog.Any("error", err))
+		return nil, err
+	}
+	d, err := dispute.NewDispute(t, cmd.Actor, cmd.Reason, tk.ID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid dispute")
+		span.SetAttributes(attribute.String("error.type", "invalid_dispute"))
+		logger.Warn("Rejected invalid dispute", "status", string(t.Status), slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := h.disputeRepo.Save(ctx, d, tk); err != nil {
+		span.SetStatus(codes.Error, "Failed to save dispute")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Warn("Failed to save dispute to repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Dispute opened")
+	span.SetAttributes(attribute.String("dispute.id", string(d.ID)), attribute.String("ticket.id", string(tk.ID)))
+	logger.Info("Dispute opened successfully.", "dispute_id", string(d.ID), "ticket_id", string(tk.ID))
+
+	return newDisputeDto(d), nil
+}
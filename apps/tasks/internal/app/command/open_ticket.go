@@ -0,0 +1,153 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// FileInput is a file attached to a ticket message or to dispute evidence.
+// The client uploads it to object storage first.
+type FileInput struct {
+	URL         string  `json:"url" validate:"required,url"`
+	FileName    string  `json:"fileName" validate:"required"`
+	ContentType *string `json:"contentType,omitempty"`
+	SizeBytes   int64   `json:"sizeBytes" validate:"gte=0"`
+}
+
+func toTicketAttachments(in []FileInput) []ticket.Attachment {
+	atts := make([]ticket.Attachment, 0, len(in))
+	for _, f := range in {
+		atts = append(atts, ticket.Attachment{
+			URL:         f.URL,
+			FileName:    f.FileName,
+			ContentType: f.ContentType,
+			SizeBytes:   f.SizeBytes,
+		})
+	}
+	return atts
+}
+
+// OpenTicketCommand asks support for help. The priority, and with it the SLA
+// due time, follows from the category. TaskID optionally points at the task
+// the request is about.
+type OpenTicketCommand struct {
+	Category    ticket.Category `json:"category" validate:"required"`
+	Subject     string          `json:"subject" validate:"required"`
+	Body        string          `json:"body" validate:"required"`
+	Attachments []FileInput     `json:"attachments,omitempty" validate:"dive"`
+	TaskID      *ids.TaskID     `json:"taskId,omitempty" validate:"omitempty,uuid"`
+	Actor       task.Actor      `json:"-"`
+}
+
+type TicketDto struct {
+	TicketID string          `json:"ticketId"`
+	Status   ticket.Status   `json:"status"`
+	Priority ticket.Priority `json:"priority"`
+	SLADueAt time.Time       `json:"slaDueAt"`
+}
+
+func newTicketDto(t *ticket.Ticket) *TicketDto {
+	return &TicketDto{
+		TicketID: string(t.ID),
+		Status:   t.Status,
+		Priority: t.Priority,
+		SLADueAt: t.SLADueAt,
+	}
+}
+
+type OpenTicketCommandHandler struct {
+	taskRepo   task.TaskRepository
+	ticketRepo ticket.TicketRepository
+	slaPolicy  ticket.SLAPolicy
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewOpenTicketCommandHandler(
+	taskRepo task.TaskRepository,
+	ticketRepo ticket.TicketRepository,
+	slaPolicy ticket.SLAPolicy,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *OpenTicketCommandHandler {
+	return &OpenTicketCommandHandler{
+		taskRepo:   taskRepo,
+		ticketRepo: ticketRepo,
+		slaPolicy:  slaPolicy,
+		logger:     logger.With(slog.String("component", "OpenTicketCommandHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *OpenTicketCommandHandler) Handle(ctx context.Context, cmd OpenTicketCommand) (*TicketDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("reporter_id", string(cmd.Actor.UserID)))
+
+	ctx, span := h.tracer.Start(ctx, "OpenTicketCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+		attribute.String("ticket.category", string(cmd.Category)),
+	)
+
+	if cmd.Actor.UserID == "" {
+		span.SetStatus(codes.Error, "Anonymous ticket")
+		span.SetAttributes(attribute.String("error.type", string(ticket.ErrSignInRequired.Code)))
+		logger.Warn("Rejected ticket without a caller")
+		return nil, ticket.ErrSignInRequired
+	}
+
+	if cmd.TaskID != nil {
+		t, err := h.taskRepo.FindByID(ctx, *cmd.TaskID)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to retrieve task")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+			logger.Warn("Failed to retrieve task of ticket", slog.Any("error", err))
+			return nil, err
+		}
+		if !t.CanView(cmd.Actor) {
+			span.SetStatus(codes.Error, "Task not visible")
+			span.SetAttributes(attribute.String("error.type", string(task.ErrTaskNotFound.Code)))
+			return nil, task.ErrTaskNotFound
+		}
+		span.SetAttributes(attribute.String("task.id", string(t.ID)))
+	}
+
+	t, err := ticket.NewTicket(cmd.Actor, cmd.Category, cmd.Subject, cmd.Body, toTicketAttachments(cmd.Attachments), cmd.TaskID, h.slaPolicy)
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid ticket")
+		span.SetAttributes(attribute.String("error.type", "invalid_ticket"))
+		logger.Warn("Rejected invalid ticket", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := h.ticketRepo.Save(ctx, t); err != nil {
+		span.SetStatus(codes.Error, "Failed to save ticket")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Warn("Failed to save ticket to repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Ticket opened")
+	span.SetAttributes(attribute.String("ticket.id", string(t.ID)), attribute.String("ticket.priority", string(t.Priority)))
+	logger.Info("Ticket opened successfully.", "ticket_id", string(t.ID), "priority", string(t.Priority))
+
+	return newTicketDto(t), nil
+}
@@ -0,0 +1,147 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/bid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ScheduleInput struct {
+	StartAt time.Time `json:"startAt" validate:"required"`
+	EndAt   time.Time `json:"endAt" validate:"required,gtfield=StartAt"`
+}
+
+// BidTermsInput is one offer of a negotiation.
+type BidTermsInput struct {
+	Amount int64 `json:"amount" validate:"gt=0"`
+	// Currency defaults to the currency of the task budget when empty.
+	Currency string         `json:"currency,omitempty" validate:"omitempty,len=3"`
+	Message  *string        `json:"message,omitempty"`
+	Schedule *ScheduleInput `json:"schedule,omitempty"`
+	// ExpiresAt defaults to three days from now.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+func (in BidTermsInput) toTerms() bid.Terms {
+	terms := bid.Terms{
+		Amount:    in.Amount,
+		Currency:  in.Currency,
+		Message:   in.Message,
+		ExpiresAt: in.ExpiresAt,
+	}
+	if in.Schedule != nil {
+		terms.Schedule = &bid.Schedule{StartAt: in.Schedule.StartAt, EndAt: in.Schedule.EndAt}
+	}
+	return terms
+}
+
+// PlaceBidCommand is a tasker offering to do an open task.
+type PlaceBidCommand struct {
+	TaskID ids.TaskID    `json:"taskId" validate:"required,uuid"`
+	Terms  BidTermsInput `json:"terms"`
+	Actor  task.Actor    `json:"-"`
+}
+
+type BidDto struct {
+	BidID  string     `json:"bidId"`
+	Status bid.Status `json:"status"`
+}
+
+type PlaceBidCommandHandler struct {
+	taskRepo task.TaskRepository
+	bidRepo  bid.BidRepository
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewPlaceBidCommandHandler(
+	taskRepo task.TaskRepository,
+	bidRepo bid.BidRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *PlaceBidCommandHandler {
+	return &PlaceBidCommandHandler{
+		taskRepo: taskRepo,
+		bidRepo:  bidRepo,
+		logger:   logger.With(slog.String("component", "PlaceBidCommandHandler")),
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *PlaceBidCommandHandler) Handle(ctx context.Context, cmd PlaceBidCommand) (*BidDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(
+		slog.String("task_id", string(cmd.TaskID)),
+		slog.String("tasker_id", string(cmd.Actor.UserID)),
+	)
+
+	ctx, span := h.tracer.Start(ctx, "PlaceBidCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", string(cmd.TaskID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	if cmd.Actor.UserID == "" {
+		span.SetStatus(codes.Error, "Anonymous bid")
+		span.SetAttributes(attribute.String("error.type", string(bid.ErrSignInRequired.Code)))
+		logger.Warn("Rejected bid without a caller")
+		return nil, bid.ErrSignInRequired
+	}
+
+	t, err := h.taskRepo.FindByID(ctx, cmd.TaskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve task")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve task to bid on", slog.Any("error", err))
+		return nil, err
+	}
+	if !t.CanView(cmd.Actor) {
+		span.SetStatus(codes.Error, "Task not visible")
+		span.SetAttributes(attribute.String("error.type", string(task.ErrTaskNotFound.Code)))
+		return nil, task.ErrTaskNotFound
+	}
+	if t.Status != task.StatusOpen {
+		span.SetStatus(codes.Error, "Task not open")
+		span.SetAttributes(attribute.String("error.type", "task_not_open"))
+		logger.Warn("Rejected bid on a task that is not open", "status", string(t.Status))
+		return nil, bid.ErrTaskNotOpen
+	}
+
+	b, err := bid.NewBid(t.ID, t.PosterID, cmd.Actor.UserID, t.Budget.Currency, cmd.Terms.toTerms())
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid bid")
+		span.SetAttributes(attribute.String("error.type", "invalid_bid"))
+		logger.Warn("Rejected invalid bid", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := h.bidRepo.Save(ctx, b); err != nil {
+		span.SetStatus(codes.Error, "Failed to save bid")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Warn("Failed to save bid to repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Bid placed")
+	span.SetAttributes(attribute.String("bid.id", string(b.ID)))
+	logger.Info("Bid placed successfully.", "bid_id", string(b.ID))
+
+	return &BidDto{BidID: string(b.ID), Status: b.Status}, nil
+}
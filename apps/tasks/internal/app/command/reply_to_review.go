@@ -0,0 +1,67 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/review"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ReplyToReviewCommand is the reviewed user answering a review in public.
+type ReplyToReviewCommand struct {
+	ReviewID ids.ReviewID `json:"reviewId" validate:"required,uuid"`
+	Body     string       `json:"body" validate:"required,max=1000"`
+	Actor    task.Actor   `json:"-"`
+}
+
+type ReplyToReviewCommandHandler struct {
+	reviewRepo review.ReviewRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewReplyToReviewCommandHandler(
+	reviewRepo review.ReviewRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ReplyToReviewCommandHandler {
+	return &ReplyToReviewCommandHandler{
+		reviewRepo: reviewRepo,
+		logger:     logger.With(slog.String("component", "ReplyToReviewCommandHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *ReplyToReviewCommandHandler) Handle(ctx context.Context, cmd ReplyToReviewCommand) (*ReviewDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("review_id", string(cmd.ReviewID)))
+
+	ctx, span := h.tracer.Start(ctx, "ReplyToReviewCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("review.id", string(cmd.ReviewID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	r, err := changeReview(ctx, h.reviewRepo, span, logger, cmd.ReviewID, cmd.Actor, func(r *review.Review) error {
+		return r.Respond(cmd.Actor.UserID, cmd.Body)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Review replied to")
+	return &ReviewDto{ReviewID: string(r.ID), Status: r.Status}, nil
+}
@@ -0,0 +1,71 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ReplyToTicketCommand adds a message to the thread of a ticket. Internal
+// messages are staff notes the reporter does not see.
+type ReplyToTicketCommand struct {
+	TicketID    ids.TicketID `json:"ticketId" validate:"required,uuid"`
+	Body        string       `json:"body" validate:"required"`
+	Internal    bool         `json:"internal"`
+	Attachments []FileInput  `json:"attachments,omitempty" validate:"dive"`
+	Actor       task.Actor   `json:"-"`
+}
+
+type ReplyToTicketCommandHandler struct {
+	ticketRepo ticket.TicketRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewReplyToTicketCommandHandler(
+	ticketRepo ticket.TicketRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ReplyToTicketCommandHandler {
+	return &ReplyToTicketCommandHandler{
+		ticketRepo: ticketRepo,
+		logger:     logger.With(slog.String("component", "ReplyToTicketCommandHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *ReplyToTicketCommandHandler) Handle(ctx context.Context, cmd ReplyToTicketCommand) (*TicketDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("ticket_id", string(cmd.TicketID)))
+
+	ctx, span := h.tracer.Start(ctx, "ReplyToTicketCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("ticket.id", string(cmd.TicketID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+		attribute.Bool("message.internal", cmd.Internal),
+	)
+
+	t, err := changeTicket(ctx, h.ticketRepo, span, logger, cmd.TicketID, cmd.Actor, func(t *ticket.Ticket) error {
+		return t.Reply(cmd.Actor, cmd.Body, cmd.Internal, toTicketAttachments(cmd.Attachments))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Ticket replied to")
+	return newTicketDto(t), nil
+}
@@ -0,0 +1,111 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/dispute"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+	"github.com/pratchaya-maneechot/service-exchange/libs/bus/event"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ResolveDisputeCommand is an admin's decision on a dispute under review.
+// The decision is posted to the dispute ticket, which is resolved with it,
+// and DisputeResolved is published so the escrow gets settled.
+type ResolveDisputeCommand struct {
+	DisputeID    ids.DisputeID   `json:"disputeId" validate:"required,uuid"`
+	Outcome      dispute.Outcome `json:"outcome" validate:"required"`
+	RefundAmount *int64          `json:"refundAmount,omitempty" validate:"omitempty,gt=0"`
+	Note         string          `json:"note" validate:"required"`
+	Actor        task.Actor      `json:"-"`
+}
+
+type ResolveDisputeCommandHandler struct {
+	disputeRepo dispute.DisputeRepository
+	ticketRepo  ticket.TicketRepository
+	eventBus    event.EventBus
+	logger      *slog.Logger
+	config      *config.Config
+	tracer      trace.Tracer
+}
+
+func NewResolveDisputeCommandHandler(
+	disputeRepo dispute.DisputeRepository,
+	ticketRepo ticket.TicketRepository,
+	eventBus event.EventBus,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ResolveDisputeCommandHandler {
+	return &ResolveDisputeCommandHandler{
+		disputeRepo: disputeRepo,
+		ticketRepo:  ticketRepo,
+		eventBus:    eventBus,
+		logger:      logger.With(slog.String("component", "ResolveDisputeCommandHandler")),
+		config:      cfg,
+		tracer:      otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *ResolveDisputeCommandHandler) Handle(ctx context.Context, cmd ResolveDisputeCommand) (*DisputeDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("dispute_id", string(cmd.DisputeID)))
+
+	ctx, span := h.tracer.Start(ctx, "ResolveDisputeCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("dispute.id", string(cmd.DisputeID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+		attribute.String("dispute.outcome", string(cmd.Outcome)),
+	)
+
+	var resolved dispute.DisputeResolved
+	d, err := changeDispute(ctx, h.disputeRepo, h.ticketRepo, span, logger, cmd.DisputeID, cmd.Actor, func(d *dispute.Dispute, t *ticket.Ticket) error {
+		var err error
+		if resolved, err = d.Decide(cmd.Actor, cmd.Outcome, cmd.RefundAmount, cmd.Note); err != nil {
+			return err
+		}
+		if err := t.Reply(cmd.Actor, decisionMessage(d), false, nil); err != nil {
+			return err
+		}
+		t.Resolve()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The decision is committed at this point; a subscriber failing does not
+	// undo it, so it is reported rather than returned.
+	if err := h.eventBus.Publish(ctx, resolved); err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "event_publish_error"))
+		logger.Error("Failed to handle DisputeResolved", slog.Any("error", err))
+	}
+
+	span.SetStatus(codes.Ok, "Dispute resolved")
+	return newDisputeDto(d), nil
+}
+
+// decisionMessage is how the decision reads in the dispute ticket's thread.
+func decisionMessage(d *dispute.Dispute) string {
+	decision := d.Decision
+	switch decision.Outcome {
+	case dispute.OutcomeRefund:
+		return fmt.Sprintf("Decision: the payment is refunded to the poster.\n\n%s", decision.Note)
+	case dispute.OutcomePartialRefund:
+		return fmt.Sprintf("Decision: %d %s (minor units) is refunded to the poster and the rest is paid to the tasker.\n\n%s",
+			*decision.RefundAmount, d.Currency, decision.Note)
+	default:
+		return fmt.Sprintf("Decision: the payment is released to the tasker.\n\n%s", decision.Note)
+	}
+}
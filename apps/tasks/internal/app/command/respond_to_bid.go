@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/bid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// respondToBid loads a bid, applies one negotiation step to it and saves
+// it. apply is a Bid method call, which is where the parties and the turn
+// order are enforced.
+func respondToBid(
+	ctx context.Context,
+	bidRepo bid.BidRepository,
+	span trace.Span,
+	logger *slog.Logger,
+	bidID ids.BidID,
+	apply func(b *bid.Bid) error,
+) (*bid.Bid, error) {
+	b, err := bidRepo.FindByID(ctx, bidID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve bid")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve bid", slog.Any("error", err))
+		return nil, err
+	}
+	from := b.Status
+	span.SetAttributes(
+		attribute.String("task.id", string(b.TaskID)),
+		attribute.String("bid.status_from", string(from)),
+	)
+
+	if err := apply(b); err != nil {
+		span.SetStatus(codes.Error, "Bid response rejected")
+		span.SetAttributes(attribute.String("error.type", "invalid_bid_response"))
+		logger.Warn("Rejected bid response", "status", string(from), slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := bidRepo.Save(ctx, b); err != nil {
+		span.SetStatus(codes.Error, "Failed to save bid")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Warn("Failed to save bid to repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.String("bid.status_to", string(b.Status)))
+	logger.Info("Bid status changed.", "from", string(from), "to", string(b.Status))
+	return b, nil
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/dispute"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// settlementRetryGrace is how long a resolved dispute is left to the
+// DisputeResolved subscriber before it is retried here.
+const settlementRetryGrace = time.Minute
+
+// RetryDisputeSettlementsCommand settles one batch of resolved disputes
+// whose escrow was not settled when they were decided, such as while the
+// payments service was down or the process stopped. The settlement retry
+// sends it on every tick.
+type RetryDisputeSettlementsCommand struct{}
+
+type RetryDisputeSettlementsDto struct {
+	Checked int `json:"checked"`
+	Settled int `json:"settled"`
+}
+
+type RetryDisputeSettlementsCommandHandler struct {
+	disputeRepo dispute.DisputeRepository
+	settler     dispute.Settler
+	logger      *slog.Logger
+	config      *config.Config
+	tracer      trace.Tracer
+}
+
+func NewRetryDisputeSettlementsCommandHandler(
+	disputeRepo dispute.DisputeRepository,
+	settler dispute.Settler,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *RetryDisputeSettlementsCommandHandler {
+	return &RetryDisputeSettlementsCommandHandler{
+		disputeRepo: disputeRepo,
+		settler:     settler,
+		logger:      logger.With(slog.String("component", "RetryDisputeSettlementsCommandHandler")),
+		config:      cfg,
+		tracer:      otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *RetryDisputeSettlementsCommandHandler) Handle(ctx context.Context, cmd RetryDisputeSettlementsCommand) (*RetryDisputeSettlementsDto, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := h.tracer.Start(ctx, "RetryDisputeSettlementsCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	unsettled, err := h.disputeRepo.ListUnsettled(ctx, time.Now().Add(-settlementRetryGrace), h.config.Support.SettlementRetry.BatchSize)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list unsettled disputes")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to list unsettled disputes", slog.Any("error", err))
+		return nil, err
+	}
+
+	dto := &RetryDisputeSettlementsDto{Checked: len(unsettled)}
+	for _, d := range unsettled {
+		if d.Decision == nil {
+			continue
+		}
+		if err := h.settler.Settle(ctx, dispute.NewDisputeResolved(d)); err != nil {
+			// Tried again on the next tick.
+			span.RecordError(err)
+			logger.Error("Failed to settle escrow of resolved dispute", slog.String("dispute_id", string(d.ID)), slog.Any("error", err))
+			continue
+		}
+		if err := h.disputeRepo.MarkSettled(ctx, d.ID, time.Now()); err != nil {
+			span.RecordError(err)
+			logger.Error("Failed to record dispute settlement", slog.String("dispute_id", string(d.ID)), slog.Any("error", err))
+			continue
+		}
+		dto.Settled++
+		logger.Info("Settled escrow of resolved dispute.",
+			slog.String("dispute_id", string(d.ID)),
+			slog.String("task_id", string(d.TaskID)),
+			slog.Time("decided_at", d.Decision.DecidedAt),
+		)
+	}
+
+	span.SetStatus(codes.Ok, "Dispute settlements retried")
+	span.SetAttributes(
+		attribute.Int("disputes.checked", dto.Checked),
+		attribute.Int("disputes.settled", dto.Settled),
+	)
+	return dto, nil
+}
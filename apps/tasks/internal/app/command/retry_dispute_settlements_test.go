@@ -0,0 +1,119 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/dispute"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+)
+
+// unsettledDisputes keeps resolved disputes unsettled until MarkSettled is
+// called, like the disputes table does.
+type unsettledDisputes struct {
+	dispute.DisputeRepository
+	disputes []*dispute.Dispute
+	settled  map[ids.DisputeID]time.Time
+}
+
+func (r *unsettledDisputes) ListUnsettled(ctx context.Context, decidedBefore time.Time, limit int) ([]*dispute.Dispute, error) {
+	var unsettled []*dispute.Dispute
+	for _, d := range r.disputes {
+		if _, ok := r.settled[d.ID]; !ok && d.Decision.DecidedAt.Before(decidedBefore) && len(unsettled) < limit {
+			unsettled = append(unsettled, d)
+		}
+	}
+	return unsettled, nil
+}
+
+func (r *unsettledDisputes) MarkSettled(ctx context.Context, id ids.DisputeID, at time.Time) error {
+	r.settled[id] = at
+	return nil
+}
+
+// flakySettler fails the first failures calls, as when the payments
+// service is down.
+type flakySettler struct {
+	failures int
+	settled  []ids.DisputeID
+}
+
+func (s *flakySettler) Settle(ctx context.Context, resolved dispute.DisputeResolved) error {
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("payments service unavailable")
+	}
+	s.settled = append(s.settled, resolved.DisputeID)
+	return nil
+}
+
+func TestFailedSettlementIsRetried(t *testing.T) {
+	ctx := context.Background()
+	resolved := &dispute.Dispute{
+		ID:       "dispute-1",
+		TaskID:   "task-1",
+		Status:   dispute.StatusResolved,
+		Currency: "THB",
+		Decision: &dispute.Decision{
+			Outcome:   dispute.OutcomeRefund,
+			DecidedBy: "admin-1",
+			DecidedAt: time.Now().Add(-time.Hour),
+		},
+	}
+	repo := &unsettledDisputes{disputes: []*dispute.Dispute{resolved}, settled: map[ids.DisputeID]time.Time{}}
+	settler := &flakySettler{failures: 2}
+	cfg := &config.Config{Name: "tasks"}
+	cfg.Support.SettlementRetry.BatchSize = 10
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	// The DisputeResolved subscriber fails and leaves the dispute unsettled.
+	subscriber := NewSettleResolvedDisputeHandler(repo, settler, logger, cfg)
+	if err := subscriber.Handle(ctx, dispute.NewDisputeResolved(resolved)); err == nil {
+		t.Fatal("Handle() = nil, want the settlement error")
+	}
+	if _, ok := repo.settled[resolved.ID]; ok {
+		t.Fatal("dispute marked settled after its settlement failed")
+	}
+
+	retry := NewRetryDisputeSettlementsCommandHandler(repo, settler, logger, cfg)
+
+	// The first retry fails too; the dispute is kept for the next one.
+	dto, err := retry.Handle(ctx, RetryDisputeSettlementsCommand{})
+	if err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if dto.Checked != 1 || dto.Settled != 0 {
+		t.Fatalf("first retry = %+v, want 1 checked and none settled", dto)
+	}
+	if _, ok := repo.settled[resolved.ID]; ok {
+		t.Fatal("dispute marked settled after its retry failed")
+	}
+
+	dto, err = retry.Handle(ctx, RetryDisputeSettlementsCommand{})
+	if err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if dto.Checked != 1 || dto.Settled != 1 {
+		t.Fatalf("second retry = %+v, want 1 checked and 1 settled", dto)
+	}
+	if len(settler.settled) != 1 || settler.settled[0] != resolved.ID {
+		t.Fatalf("settled = %v, want [%s]", settler.settled, resolved.ID)
+	}
+	if _, ok := repo.settled[resolved.ID]; !ok {
+		t.Fatal("dispute not marked settled after its retry succeeded")
+	}
+
+	// Once settled, it is not settled again.
+	dto, err = retry.Handle(ctx, RetryDisputeSettlementsCommand{})
+	if err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if dto.Checked != 0 || len(settler.settled) != 1 {
+		t.Fatalf("third retry = %+v with %d settlements, want nothing left to settle", dto, len(settler.settled))
+	}
+}
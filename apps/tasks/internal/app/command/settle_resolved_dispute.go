@@ -0,0 +1,76 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/dispute"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SettleResolvedDisputeHandler asks the payments side to settle the escrow
+// of a task the way its dispute was decided, and records that it did. It
+// subscribes to dispute.DisputeResolved; disputes it fails to settle are
+// left to RetryDisputeSettlementsCommand.
+type SettleResolvedDisputeHandler struct {
+	disputeRepo dispute.DisputeRepository
+	settler     dispute.Settler
+	logger      *slog.Logger
+	config      *config.Config
+	tracer      trace.Tracer
+}
+
+func NewSettleResolvedDisputeHandler(
+	disputeRepo dispute.DisputeRepository,
+	settler dispute.Settler,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *SettleResolvedDisputeHandler {
+	return &SettleResolvedDisputeHandler{
+		disputeRepo: disputeRepo,
+		settler:     settler,
+		logger:      logger.With(slog.String("component", "SettleResolvedDisputeHandler")),
+		config:      cfg,
+		tracer:      otel.Tracer(fmt.Sprintf("%s.event-handler", cfg.Name)),
+	}
+}
+
+func (h *SettleResolvedDisputeHandler) Handle(ctx context.Context, evt dispute.DisputeResolved) error {
+	logger := observability.LoggerFromCtx(ctx).With(
+		slog.String("task_id", string(evt.TaskID)),
+		slog.String("dispute_id", string(evt.DisputeID)),
+	)
+
+	ctx, span := h.tracer.Start(ctx, "SettleResolvedDisputeHandler.Handle", trace.WithSpanKind(trace.SpanKindConsumer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", string(evt.TaskID)),
+		attribute.String("dispute.id", string(evt.DisputeID)),
+		attribute.String("dispute.outcome", string(evt.Outcome)),
+	)
+
+	if err := h.settler.Settle(ctx, evt); err != nil {
+		span.SetStatus(codes.Error, "Failed to settle dispute")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "settlement_error"))
+		logger.Error("Failed to settle escrow of resolved dispute; it is retried later", slog.Any("error", err))
+		return err
+	}
+	if err := h.disputeRepo.MarkSettled(ctx, evt.DisputeID, time.Now()); err != nil {
+		// Settling again is harmless: payments settle each dispute once.
+		span.RecordError(err)
+		logger.Warn("Settled dispute but failed to record it; it is settled again later", slog.Any("error", err))
+	}
+
+	span.SetStatus(codes.Ok, "Dispute settlement requested")
+	return nil
+}
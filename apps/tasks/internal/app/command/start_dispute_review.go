@@ -0,0 +1,74 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/dispute"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// StartDisputeReviewCommand puts a dispute in front of the acting admin,
+// who also takes its ticket.
+type StartDisputeReviewCommand struct {
+	DisputeID ids.DisputeID `json:"disputeId" validate:"required,uuid"`
+	Actor     task.Actor    `json:"-"`
+}
+
+type StartDisputeReviewCommandHandler struct {
+	disputeRepo dispute.DisputeRepository
+	ticketRepo  ticket.TicketRepository
+	logger      *slog.Logger
+	config      *config.Config
+	tracer      trace.Tracer
+}
+
+func NewStartDisputeReviewCommandHandler(
+	disputeRepo dispute.DisputeRepository,
+	ticketRepo ticket.TicketRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *StartDisputeReviewCommandHandler {
+	return &StartDisputeReviewCommandHandler{
+		disputeRepo: disputeRepo,
+		ticketRepo:  ticketRepo,
+		logger:      logger.With(slog.String("component", "StartDisputeReviewCommandHandler")),
+		config:      cfg,
+		tracer:      otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *StartDisputeReviewCommandHandler) Handle(ctx context.Context, cmd StartDisputeReviewCommand) (*DisputeDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("dispute_id", string(cmd.DisputeID)))
+
+	ctx, span := h.tracer.Start(ctx, "StartDisputeReviewCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("dispute.id", string(cmd.DisputeID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	d, err := changeDispute(ctx, h.disputeRepo, h.ticketRepo, span, logger, cmd.DisputeID, cmd.Actor, func(d *dispute.Dispute, t *ticket.Ticket) error {
+		if err := d.StartReview(cmd.Actor); err != nil {
+			return err
+		}
+		return t.Assign(cmd.Actor, cmd.Actor.UserID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Dispute review started")
+	return newDisputeDto(d), nil
+}
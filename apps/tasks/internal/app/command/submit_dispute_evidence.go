@@ -0,0 +1,74 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/dispute"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SubmitDisputeEvidenceCommand is one party backing their side of a
+// dispute with a statement and supporting files.
+type SubmitDisputeEvidenceCommand struct {
+	DisputeID   ids.DisputeID `json:"disputeId" validate:"required,uuid"`
+	Statement   string        `json:"statement" validate:"required"`
+	Attachments []FileInput   `json:"attachments,omitempty" validate:"dive"`
+	Actor       task.Actor    `json:"-"`
+}
+
+type SubmitDisputeEvidenceCommandHandler struct {
+	disputeRepo dispute.DisputeRepository
+	ticketRepo  ticket.TicketRepository
+	logger      *slog.Logger
+	config      *config.Config
+	tracer      trace.Tracer
+}
+
+func NewSubmitDisputeEvidenceCommandHandler(
+	disputeRepo dispute.DisputeRepository,
+	ticketRepo ticket.TicketRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *SubmitDisputeEvidenceCommandHandler {
+	return &SubmitDisputeEvidenceCommandHandler{
+		disputeRepo: disputeRepo,
+		ticketRepo:  ticketRepo,
+		logger:      logger.With(slog.String("component", "SubmitDisputeEvidenceCommandHandler")),
+		config:      cfg,
+		tracer:      otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *SubmitDisputeEvidenceCommandHandler) Handle(ctx context.Context, cmd SubmitDisputeEvidenceCommand) (*DisputeDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("dispute_id", string(cmd.DisputeID)))
+
+	ctx, span := h.tracer.Start(ctx, "SubmitDisputeEvidenceCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("dispute.id", string(cmd.DisputeID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	d, err := changeDispute(ctx, h.disputeRepo, h.ticketRepo, span, logger, cmd.DisputeID, cmd.Actor, func(d *dispute.Dispute, t *ticket.Ticket) error {
+		_, err := d.SubmitEvidence(cmd.Actor, cmd.Statement, toTicketAttachments(cmd.Attachments))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Dispute evidence submitted")
+	return newDisputeDto(d), nil
+}
@@ -0,0 +1,152 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/review"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ScoreInput struct {
+	Category string `json:"category" validate:"required"`
+	Score    int    `json:"score" validate:"min=1,max=5"`
+}
+
+// ReviewContentInput is the star rating, optional category sub-scores and
+// comment of a review.
+type ReviewContentInput struct {
+	Rating  int          `json:"rating" validate:"min=1,max=5"`
+	Scores  []ScoreInput `json:"scores,omitempty" validate:"dive"`
+	Comment *string      `json:"comment,omitempty"`
+}
+
+func (in ReviewContentInput) toContent() (review.Content, error) {
+	content := review.Content{
+		Rating:  in.Rating,
+		Scores:  make(map[review.Category]int, len(in.Scores)),
+		Comment: in.Comment,
+	}
+	for _, s := range in.Scores {
+		category := review.Category(s.Category)
+		if _, dup := content.Scores[category]; dup {
+			return review.Content{}, review.ErrDuplicateCategory
+		}
+		content.Scores[category] = s.Score
+	}
+	return content, nil
+}
+
+// SubmitReviewCommand is one party of a completed task reviewing the other.
+type SubmitReviewCommand struct {
+	TaskID  ids.TaskID         `json:"taskId" validate:"required,uuid"`
+	Content ReviewContentInput `json:"content"`
+	Actor   task.Actor         `json:"-"`
+}
+
+type ReviewDto struct {
+	ReviewID string        `json:"reviewId"`
+	Status   review.Status `json:"status"`
+}
+
+type SubmitReviewCommandHandler struct {
+	taskRepo   task.TaskRepository
+	reviewRepo review.ReviewRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewSubmitReviewCommandHandler(
+	taskRepo task.TaskRepository,
+	reviewRepo review.ReviewRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *SubmitReviewCommandHandler {
+	return &SubmitReviewCommandHandler{
+		taskRepo:   taskRepo,
+		reviewRepo: reviewRepo,
+		logger:     logger.With(slog.String("component", "SubmitReviewCommandHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *SubmitReviewCommandHandler) Handle(ctx context.Context, cmd SubmitReviewCommand) (*ReviewDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(
+		slog.String("task_id", string(cmd.TaskID)),
+		slog.String("reviewer_id", string(cmd.Actor.UserID)),
+	)
+
+	ctx, span := h.tracer.Start(ctx, "SubmitReviewCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", string(cmd.TaskID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	if cmd.Actor.UserID == "" {
+		span.SetStatus(codes.Error, "Anonymous review")
+		span.SetAttributes(attribute.String("error.type", string(review.ErrSignInRequired.Code)))
+		logger.Warn("Rejected review without a caller")
+		return nil, review.ErrSignInRequired
+	}
+
+	t, err := h.taskRepo.FindByID(ctx, cmd.TaskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve task")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve task to review", slog.Any("error", err))
+		return nil, err
+	}
+	if !t.CanView(cmd.Actor) {
+		span.SetStatus(codes.Error, "Task not visible")
+		span.SetAttributes(attribute.String("error.type", string(task.ErrTaskNotFound.Code)))
+		return nil, task.ErrTaskNotFound
+	}
+	if t.Status != task.StatusCompleted || t.AssigneeID == nil {
+		span.SetStatus(codes.Error, "Task not completed")
+		span.SetAttributes(attribute.String("error.type", "task_not_completed"))
+		logger.Warn("Rejected review of a task that is not completed", "status", string(t.Status))
+		return nil, review.ErrTaskNotCompleted
+	}
+
+	content, err := cmd.Content.toContent()
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid review")
+		span.SetAttributes(attribute.String("error.type", "invalid_review"))
+		return nil, err
+	}
+	r, err := review.NewReview(t.ID, t.PosterID, *t.AssigneeID, cmd.Actor.UserID, content)
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid review")
+		span.SetAttributes(attribute.String("error.type", "invalid_review"))
+		logger.Warn("Rejected invalid review", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := h.reviewRepo.Save(ctx, r); err != nil {
+		span.SetStatus(codes.Error, "Failed to save review")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Warn("Failed to save review to repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Review submitted")
+	span.SetAttributes(attribute.String("review.id", string(r.ID)))
+	logger.Info("Review submitted successfully.", "review_id", string(r.ID), "reviewee_role", string(r.RevieweeRole))
+
+	return &ReviewDto{ReviewID: string(r.ID), Status: r.Status}, nil
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// transitionTask loads a task, applies one lifecycle change to it and saves
+// it. apply is a Task method call, which is where ownership and the state
+// machine are enforced.
+func transitionTask(
+	ctx context.Context,
+	taskRepo task.TaskRepository,
+	span trace.Span,
+	logger *slog.Logger,
+	taskID ids.TaskID,
+	apply func(t *task.Task) error,
+) (*task.Task, error) {
+	t, err := taskRepo.FindByID(ctx, taskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve task")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve task for transition", slog.Any("error", err))
+		return nil, err
+	}
+	from := t.Status
+	span.SetAttributes(attribute.String("task.status_from", string(from)))
+
+	if err := apply(t); err != nil {
+		span.SetStatus(codes.Error, "Task transition rejected")
+		span.SetAttributes(attribute.String("error.type", "invalid_task_transition"))
+		logger.Warn("Rejected task transition", "status", string(from), slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := taskRepo.Save(ctx, t); err != nil {
+		span.SetStatus(codes.Error, "Failed to save task")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Warn("Failed to save task to repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.String("task.status_to", string(t.Status)))
+	logger.Info("Task status changed.", "from", string(from), "to", string(t.Status))
+	return t, nil
+}
@@ -0,0 +1,85 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// UpdateTaskCommand replaces the editable state of a draft or open task.
+type UpdateTaskCommand struct {
+	TaskID ids.TaskID `json:"taskId" validate:"required,uuid"`
+	Task   TaskInput  `json:"task"`
+	Actor  task.Actor `json:"-"`
+}
+
+type UpdateTaskCommandHandler struct {
+	taskRepo task.TaskRepository
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewUpdateTaskCommandHandler(
+	taskRepo task.TaskRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *UpdateTaskCommandHandler {
+	return &UpdateTaskCommandHandler{
+		taskRepo: taskRepo,
+		logger:   logger.With(slog.String("component", "UpdateTaskCommandHandler")),
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *UpdateTaskCommandHandler) Handle(ctx context.Context, cmd UpdateTaskCommand) (*TaskDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(cmd.TaskID)))
+
+	ctx, span := h.tracer.Start(ctx, "UpdateTaskCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("task.id", string(cmd.TaskID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	t, err := h.taskRepo.FindByID(ctx, cmd.TaskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve task")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve task for update", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := t.Update(cmd.Actor, cmd.Task.toDetails()); err != nil {
+		span.SetStatus(codes.Error, "Task update rejected")
+		span.SetAttributes(attribute.String("error.type", "invalid_task"))
+		logger.Warn("Rejected task update", "status", string(t.Status), slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := h.taskRepo.Save(ctx, t); err != nil {
+		span.SetStatus(codes.Error, "Failed to save task")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Warn("Failed to save task to repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Task updated")
+	logger.Info("Task updated successfully.")
+
+	return &TaskDto{TaskID: string(t.ID), Status: t.Status}, nil
+}
@@ -0,0 +1,87 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// UpdateTicketCommand changes the priority and/or the status of a ticket.
+// Staff do both; the reporter can only close the ticket or reopen it once
+// resolved.
+type UpdateTicketCommand struct {
+	TicketID ids.TicketID     `json:"ticketId" validate:"required,uuid"`
+	Priority *ticket.Priority `json:"priority,omitempty"`
+	Status   *ticket.Status   `json:"status,omitempty"`
+	Actor    task.Actor       `json:"-"`
+}
+
+type UpdateTicketCommandHandler struct {
+	ticketRepo ticket.TicketRepository
+	slaPolicy  ticket.SLAPolicy
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewUpdateTicketCommandHandler(
+	ticketRepo ticket.TicketRepository,
+	slaPolicy ticket.SLAPolicy,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *UpdateTicketCommandHandler {
+	return &UpdateTicketCommandHandler{
+		ticketRepo: ticketRepo,
+		slaPolicy:  slaPolicy,
+		logger:     logger.With(slog.String("component", "UpdateTicketCommandHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *UpdateTicketCommandHandler) Handle(ctx context.Context, cmd UpdateTicketCommand) (*TicketDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("ticket_id", string(cmd.TicketID)))
+
+	ctx, span := h.tracer.Start(ctx, "UpdateTicketCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("ticket.id", string(cmd.TicketID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	if cmd.Priority == nil && cmd.Status == nil {
+		span.SetStatus(codes.Error, "Empty ticket update")
+		span.SetAttributes(attribute.String("error.type", string(ticket.ErrNothingToUpdate.Code)))
+		return nil, ticket.ErrNothingToUpdate
+	}
+
+	t, err := changeTicket(ctx, h.ticketRepo, span, logger, cmd.TicketID, cmd.Actor, func(t *ticket.Ticket) error {
+		if cmd.Priority != nil {
+			if err := t.Reprioritize(cmd.Actor, *cmd.Priority, h.slaPolicy); err != nil {
+				return err
+			}
+		}
+		if cmd.Status != nil {
+			return t.ChangeStatus(cmd.Actor, *cmd.Status)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Ticket updated")
+	return newTicketDto(t), nil
+}
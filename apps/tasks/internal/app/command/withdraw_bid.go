@@ -0,0 +1,66 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/bid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// WithdrawBidCommand is the tasker taking their bid back.
+type WithdrawBidCommand struct {
+	BidID ids.BidID  `json:"bidId" validate:"required,uuid"`
+	Actor task.Actor `json:"-"`
+}
+
+type WithdrawBidCommandHandler struct {
+	bidRepo bid.BidRepository
+	logger  *slog.Logger
+	config  *config.Config
+	tracer  trace.Tracer
+}
+
+func NewWithdrawBidCommandHandler(
+	bidRepo bid.BidRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *WithdrawBidCommandHandler {
+	return &WithdrawBidCommandHandler{
+		bidRepo: bidRepo,
+		logger:  logger.With(slog.String("component", "WithdrawBidCommandHandler")),
+		config:  cfg,
+		tracer:  otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *WithdrawBidCommandHandler) Handle(ctx context.Context, cmd WithdrawBidCommand) (*BidDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("bid_id", string(cmd.BidID)))
+
+	ctx, span := h.tracer.Start(ctx, "WithdrawBidCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("bid.id", string(cmd.BidID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	b, err := respondToBid(ctx, h.bidRepo, span, logger, cmd.BidID, func(b *bid.Bid) error {
+		return b.Withdraw(cmd.Actor.UserID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Bid withdrawn")
+	return &BidDto{BidID: string(b.ID), Status: b.Status}, nil
+}
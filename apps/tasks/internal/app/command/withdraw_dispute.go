@@ -0,0 +1,75 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/dispute"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// WithdrawDisputeCommand drops a dispute before it is decided and closes
+// its ticket. Only whoever opened the dispute can withdraw it.
+type WithdrawDisputeCommand struct {
+	DisputeID ids.DisputeID `json:"disputeId" validate:"required,uuid"`
+	Actor     task.Actor    `json:"-"`
+}
+
+type WithdrawDisputeCommandHandler struct {
+	disputeRepo dispute.DisputeRepository
+	ticketRepo  ticket.TicketRepository
+	logger      *slog.Logger
+	config      *config.Config
+	tracer      trace.Tracer
+}
+
+func NewWithdrawDisputeCommandHandler(
+	disputeRepo dispute.DisputeRepository,
+	ticketRepo ticket.TicketRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *WithdrawDisputeCommandHandler {
+	return &WithdrawDisputeCommandHandler{
+		disputeRepo: disputeRepo,
+		ticketRepo:  ticketRepo,
+		logger:      logger.With(slog.String("component", "WithdrawDisputeCommandHandler")),
+		config:      cfg,
+		tracer:      otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *WithdrawDisputeCommandHandler) Handle(ctx context.Context, cmd WithdrawDisputeCommand) (*DisputeDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("dispute_id", string(cmd.DisputeID)))
+
+	ctx, span := h.tracer.Start(ctx, "WithdrawDisputeCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("dispute.id", string(cmd.DisputeID)),
+		attribute.String("actor.id", string(cmd.Actor.UserID)),
+	)
+
+	d, err := changeDispute(ctx, h.disputeRepo, h.ticketRepo, span, logger, cmd.DisputeID, cmd.Actor, func(d *dispute.Dispute, t *ticket.Ticket) error {
+		if err := d.Withdraw(cmd.Actor); err != nil {
+			return err
+		}
+		t.Close()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Dispute withdrawn")
+	return newDisputeDto(d), nil
+}
@@ -0,0 +1,140 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/bid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GetBidQuery struct {
+	BidID ids.BidID  `json:"bidId" validate:"required,uuid"`
+	Actor task.Actor `json:"-"`
+}
+
+type ScheduleDTO struct {
+	StartAt time.Time `json:"startAt"`
+	EndAt   time.Time `json:"endAt"`
+}
+
+type OfferDTO struct {
+	ID        string       `json:"id"`
+	Party     bid.Party    `json:"party"`
+	Amount    int64        `json:"amount"`
+	Currency  string       `json:"currency"`
+	Message   *string      `json:"message,omitempty"`
+	Schedule  *ScheduleDTO `json:"schedule,omitempty"`
+	ExpiresAt time.Time    `json:"expiresAt"`
+	CreatedAt time.Time    `json:"createdAt"`
+}
+
+// BidDTO reports the effective status of a bid, so an open bid past its
+// expiry reads as EXPIRED.
+type BidDTO struct {
+	ID            string     `json:"id"`
+	TaskID        string     `json:"taskId"`
+	PosterID      string     `json:"posterId"`
+	TaskerID      string     `json:"taskerId"`
+	Status        bid.Status `json:"status"`
+	AwaitingParty *bid.Party `json:"awaitingParty,omitempty"`
+	Offers        []OfferDTO `json:"offers"`
+	ExpiresAt     time.Time  `json:"expiresAt"`
+	ClosedAt      *time.Time `json:"closedAt,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+}
+
+type GetBidQueryHandler struct {
+	bidRepo bid.BidRepository
+	logger  *slog.Logger
+	config  *config.Config
+	tracer  trace.Tracer
+}
+
+func NewGetBidQueryHandler(
+	bidRepo bid.BidRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *GetBidQueryHandler {
+	return &GetBidQueryHandler{
+		bidRepo: bidRepo,
+		logger:  logger.With(slog.String("component", "GetBidQueryHandler")),
+		config:  cfg,
+		tracer:  otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *GetBidQueryHandler) Handle(ctx context.Context, qry GetBidQuery) (*BidDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("bid_id", string(qry.BidID)))
+
+	ctx, span := h.tracer.Start(ctx, "GetBidQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("bid.id", string(qry.BidID)))
+
+	b, err := h.bidRepo.FindByID(ctx, qry.BidID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve bid")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve bid", slog.Any("error", err))
+		return nil, err
+	}
+
+	// Negotiations are private to their two parties; like drafts, bids of
+	// others are reported as missing.
+	if b.PartyOf(qry.Actor.UserID) == "" && !qry.Actor.IsAdmin {
+		span.SetStatus(codes.Error, "Bid not visible to caller")
+		span.SetAttributes(attribute.String("error.type", string(bid.ErrBidNotFound.Code)))
+		logger.Warn("Hid bid from caller", "actor_id", string(qry.Actor.UserID))
+		return nil, bid.ErrBidNotFound
+	}
+
+	span.SetStatus(codes.Ok, "Bid retrieved")
+	return newBidDTO(b, time.Now()), nil
+}
+
+func newBidDTO(b *bid.Bid, now time.Time) *BidDTO {
+	dto := &BidDTO{
+		ID:        string(b.ID),
+		TaskID:    string(b.TaskID),
+		PosterID:  string(b.PosterID),
+		TaskerID:  string(b.TaskerID),
+		Status:    b.EffectiveStatus(now),
+		Offers:    make([]OfferDTO, 0, len(b.Offers)),
+		ExpiresAt: b.ExpiresAt,
+		ClosedAt:  b.ClosedAt,
+		CreatedAt: b.CreatedAt,
+		UpdatedAt: b.UpdatedAt,
+	}
+	if dto.Status.IsOpen() {
+		party := b.AwaitingParty()
+		dto.AwaitingParty = &party
+	}
+	for _, o := range b.Offers {
+		offer := OfferDTO{
+			ID:        o.ID.String(),
+			Party:     o.Party,
+			Amount:    o.Amount,
+			Currency:  o.Currency,
+			Message:   o.Message,
+			ExpiresAt: o.ExpiresAt,
+			CreatedAt: o.CreatedAt,
+		}
+		if o.Schedule != nil {
+			offer.Schedule = &ScheduleDTO{StartAt: o.Schedule.StartAt, EndAt: o.Schedule.EndAt}
+		}
+		dto.Offers = append(dto.Offers, offer)
+	}
+	return dto
+}
@@ -0,0 +1,147 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/dispute"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GetDisputeQuery struct {
+	DisputeID ids.DisputeID `json:"disputeId" validate:"required,uuid"`
+	Actor     task.Actor    `json:"-"`
+}
+
+type EvidenceDTO struct {
+	ID          string    `json:"id"`
+	SubmittedBy string    `json:"submittedBy"`
+	Statement   string    `json:"statement"`
+	Attachments []FileDTO `json:"attachments"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type DecisionDTO struct {
+	Outcome      dispute.Outcome `json:"outcome"`
+	RefundAmount *int64          `json:"refundAmount,omitempty"`
+	Note         string          `json:"note"`
+	DecidedBy    string          `json:"decidedBy"`
+	DecidedAt    time.Time       `json:"decidedAt"`
+}
+
+// DisputeDTO carries the evidence of both parties; listings leave it empty.
+type DisputeDTO struct {
+	ID         string         `json:"id"`
+	TaskID     string         `json:"taskId"`
+	TicketID   string         `json:"ticketId"`
+	PosterID   string         `json:"posterId"`
+	TaskerID   string         `json:"taskerId"`
+	OpenedBy   string         `json:"openedBy"`
+	Reason     string         `json:"reason"`
+	Currency   string         `json:"currency"`
+	Status     dispute.Status `json:"status"`
+	ReviewerID *string        `json:"reviewerId,omitempty"`
+	Evidence   []EvidenceDTO  `json:"evidence"`
+	Decision   *DecisionDTO   `json:"decision,omitempty"`
+	ClosedAt   *time.Time     `json:"closedAt,omitempty"`
+	CreatedAt  time.Time      `json:"createdAt"`
+	UpdatedAt  time.Time      `json:"updatedAt"`
+}
+
+type GetDisputeQueryHandler struct {
+	disputeRepo dispute.DisputeRepository
+	logger      *slog.Logger
+	config      *config.Config
+	tracer      trace.Tracer
+}
+
+func NewGetDisputeQueryHandler(
+	disputeRepo dispute.DisputeRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *GetDisputeQueryHandler {
+	return &GetDisputeQueryHandler{
+		disputeRepo: disputeRepo,
+		logger:      logger.With(slog.String("component", "GetDisputeQueryHandler")),
+		config:      cfg,
+		tracer:      otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *GetDisputeQueryHandler) Handle(ctx context.Context, qry GetDisputeQuery) (*DisputeDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("dispute_id", string(qry.DisputeID)))
+
+	ctx, span := h.tracer.Start(ctx, "GetDisputeQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("dispute.id", string(qry.DisputeID)))
+
+	d, err := h.disputeRepo.FindByID(ctx, qry.DisputeID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve dispute")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve dispute", slog.Any("error", err))
+		return nil, err
+	}
+
+	if !d.CanView(qry.Actor) {
+		span.SetStatus(codes.Error, "Dispute not visible to caller")
+		span.SetAttributes(attribute.String("error.type", string(dispute.ErrDisputeNotFound.Code)))
+		logger.Warn("Hid dispute from caller", "actor_id", string(qry.Actor.UserID))
+		return nil, dispute.ErrDisputeNotFound
+	}
+
+	span.SetStatus(codes.Ok, "Dispute retrieved")
+	return newDisputeDTO(d), nil
+}
+
+func newDisputeDTO(d *dispute.Dispute) *DisputeDTO {
+	dto := &DisputeDTO{
+		ID:        string(d.ID),
+		TaskID:    string(d.TaskID),
+		TicketID:  string(d.TicketID),
+		PosterID:  string(d.PosterID),
+		TaskerID:  string(d.TaskerID),
+		OpenedBy:  string(d.OpenedBy),
+		Reason:    d.Reason,
+		Currency:  d.Currency,
+		Status:    d.Status,
+		Evidence:  make([]EvidenceDTO, 0, len(d.Evidence)),
+		ClosedAt:  d.ClosedAt,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+	if d.ReviewerID != nil {
+		id := string(*d.ReviewerID)
+		dto.ReviewerID = &id
+	}
+	for _, e := range d.Evidence {
+		dto.Evidence = append(dto.Evidence, EvidenceDTO{
+			ID:          string(e.ID),
+			SubmittedBy: string(e.SubmittedBy),
+			Statement:   e.Statement,
+			Attachments: newFileDTOs(e.Attachments),
+			CreatedAt:   e.CreatedAt,
+		})
+	}
+	if d.Decision != nil {
+		dto.Decision = &DecisionDTO{
+			Outcome:      d.Decision.Outcome,
+			RefundAmount: d.Decision.RefundAmount,
+			Note:         d.Decision.Note,
+			DecidedBy:    string(d.Decision.DecidedBy),
+			DecidedAt:    d.Decision.DecidedAt,
+		}
+	}
+	return dto
+}
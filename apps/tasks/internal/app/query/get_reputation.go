@@ -0,0 +1,106 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/review"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetReputationQuery reads the public reputation of a user. Users who were
+// never reviewed have a reputation with no reviews.
+type GetReputationQuery struct {
+	UserID ids.UserID `json:"userId" validate:"required,uuid"`
+}
+
+type CategoryAverageDTO struct {
+	Category review.Category `json:"category"`
+	Average  float64         `json:"average"`
+}
+
+type ReputationDTO struct {
+	UserID           string               `json:"userId"`
+	ReviewCount      int                  `json:"reviewCount"`
+	AverageRating    float64              `json:"averageRating"`
+	BayesianAverage  float64              `json:"bayesianAverage"`
+	RecentAverage    float64              `json:"recentAverage"`
+	Trend            review.Trend         `json:"trend"`
+	CategoryAverages []CategoryAverageDTO `json:"categoryAverages"`
+	UpdatedAt        time.Time            `json:"updatedAt"`
+}
+
+type GetReputationQueryHandler struct {
+	reputationRepo review.ReputationRepository
+	logger         *slog.Logger
+	config         *config.Config
+	tracer         trace.Tracer
+}
+
+func NewGetReputationQueryHandler(
+	reputationRepo review.ReputationRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *GetReputationQueryHandler {
+	return &GetReputationQueryHandler{
+		reputationRepo: reputationRepo,
+		logger:         logger.With(slog.String("component", "GetReputationQueryHandler")),
+		config:         cfg,
+		tracer:         otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *GetReputationQueryHandler) Handle(ctx context.Context, qry GetReputationQuery) (*ReputationDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(qry.UserID)))
+
+	ctx, span := h.tracer.Start(ctx, "GetReputationQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", string(qry.UserID)))
+
+	rep, err := h.reputationRepo.FindByUserID(ctx, qry.UserID)
+	if errors.Is(err, review.ErrReputationNotFound) {
+		rep, err = review.NewReputation(qry.UserID, review.Stats{}, time.Now()), nil
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve reputation")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to retrieve reputation", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Reputation retrieved")
+	span.SetAttributes(attribute.Int("reputation.review_count", rep.ReviewCount))
+	return newReputationDTO(rep), nil
+}
+
+func newReputationDTO(rep *review.Reputation) *ReputationDTO {
+	dto := &ReputationDTO{
+		UserID:           string(rep.UserID),
+		ReviewCount:      rep.ReviewCount,
+		AverageRating:    rep.AverageRating,
+		BayesianAverage:  rep.BayesianAverage,
+		RecentAverage:    rep.RecentAverage,
+		Trend:            rep.Trend,
+		CategoryAverages: make([]CategoryAverageDTO, 0, len(rep.CategoryAverages)),
+		UpdatedAt:        rep.UpdatedAt,
+	}
+	for category, average := range rep.CategoryAverages {
+		dto.CategoryAverages = append(dto.CategoryAverages, CategoryAverageDTO{Category: category, Average: average})
+	}
+	sort.Slice(dto.CategoryAverages, func(i, j int) bool {
+		return dto.CategoryAverages[i].Category < dto.CategoryAverages[j].Category
+	})
+	return dto
+}
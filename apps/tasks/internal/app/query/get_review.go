@@ -0,0 +1,132 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/review"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GetReviewQuery struct {
+	ReviewID ids.ReviewID `json:"reviewId" validate:"required,uuid"`
+	Actor    task.Actor   `json:"-"`
+}
+
+type ScoreDTO struct {
+	Category review.Category `json:"category"`
+	Score    int             `json:"score"`
+}
+
+type ReplyDTO struct {
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ReviewDTO only carries the flag reason and moderation note for the people
+// moderation concerns: the reviewed user, the author and admins.
+type ReviewDTO struct {
+	ID             string        `json:"id"`
+	TaskID         string        `json:"taskId"`
+	ReviewerID     string        `json:"reviewerId"`
+	RevieweeID     string        `json:"revieweeId"`
+	RevieweeRole   review.Role   `json:"revieweeRole"`
+	Rating         int           `json:"rating"`
+	Scores         []ScoreDTO    `json:"scores"`
+	Comment        *string       `json:"comment,omitempty"`
+	Reply          *ReplyDTO     `json:"reply,omitempty"`
+	Status         review.Status `json:"status"`
+	FlagReason     *string       `json:"flagReason,omitempty"`
+	ModerationNote *string       `json:"moderationNote,omitempty"`
+	EditableUntil  time.Time     `json:"editableUntil"`
+	CreatedAt      time.Time     `json:"createdAt"`
+	UpdatedAt      time.Time     `json:"updatedAt"`
+}
+
+type GetReviewQueryHandler struct {
+	reviewRepo review.ReviewRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewGetReviewQueryHandler(
+	reviewRepo review.ReviewRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *GetReviewQueryHandler {
+	return &GetReviewQueryHandler{
+		reviewRepo: reviewRepo,
+		logger:     logger.With(slog.String("component", "GetReviewQueryHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *GetReviewQueryHandler) Handle(ctx context.Context, qry GetReviewQuery) (*ReviewDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("review_id", string(qry.ReviewID)))
+
+	ctx, span := h.tracer.Start(ctx, "GetReviewQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("review.id", string(qry.ReviewID)))
+
+	r, err := h.reviewRepo.FindByID(ctx, qry.ReviewID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve review")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve review", slog.Any("error", err))
+		return nil, err
+	}
+
+	if !r.CanView(qry.Actor.UserID, qry.Actor.IsAdmin) {
+		span.SetStatus(codes.Error, "Review not visible to caller")
+		span.SetAttributes(attribute.String("error.type", string(review.ErrReviewNotFound.Code)))
+		logger.Warn("Hid removed review from caller", "actor_id", string(qry.Actor.UserID))
+		return nil, review.ErrReviewNotFound
+	}
+
+	span.SetStatus(codes.Ok, "Review retrieved")
+	return newReviewDTO(r, qry.Actor), nil
+}
+
+func newReviewDTO(r *review.Review, actor task.Actor) *ReviewDTO {
+	dto := &ReviewDTO{
+		ID:            string(r.ID),
+		TaskID:        string(r.TaskID),
+		ReviewerID:    string(r.ReviewerID),
+		RevieweeID:    string(r.RevieweeID),
+		RevieweeRole:  r.RevieweeRole,
+		Rating:        r.Rating,
+		Scores:        make([]ScoreDTO, 0, len(r.Scores)),
+		Comment:       r.Comment,
+		Status:        r.Status,
+		EditableUntil: r.EditableUntil(),
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+	for category, score := range r.Scores {
+		dto.Scores = append(dto.Scores, ScoreDTO{Category: category, Score: score})
+	}
+	sort.Slice(dto.Scores, func(i, j int) bool { return dto.Scores[i].Category < dto.Scores[j].Category })
+	if r.Reply != nil {
+		dto.Reply = &ReplyDTO{Body: r.Reply.Body, CreatedAt: r.Reply.CreatedAt, UpdatedAt: r.Reply.UpdatedAt}
+	}
+	if actor.IsAdmin || (actor.UserID != "" && (actor.UserID == r.ReviewerID || actor.UserID == r.RevieweeID)) {
+		dto.FlagReason = r.FlagReason
+		dto.ModerationNote = r.ModerationNote
+	}
+	return dto
+}
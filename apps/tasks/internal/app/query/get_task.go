@@ -0,0 +1,160 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GetTaskQuery struct {
+	TaskID ids.TaskID `json:"taskId" validate:"required,uuid"`
+	Actor  task.Actor `json:"-"`
+}
+
+type BudgetDTO struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+type LocationDTO struct {
+	Province   string   `json:"province"`
+	District   *string  `json:"district,omitempty"`
+	PostalCode *string  `json:"postalCode,omitempty"`
+	Latitude   *float64 `json:"latitude,omitempty"`
+	Longitude  *float64 `json:"longitude,omitempty"`
+}
+
+type AttachmentDTO struct {
+	ID          string  `json:"id"`
+	URL         string  `json:"url"`
+	FileName    string  `json:"fileName"`
+	ContentType *string `json:"contentType,omitempty"`
+	SizeBytes   int64   `json:"sizeBytes"`
+}
+
+type TaskDTO struct {
+	ID                 string          `json:"id"`
+	PosterID           string          `json:"posterId"`
+	Title              string          `json:"title"`
+	Description        string          `json:"description"`
+	CategoryCode       string          `json:"categoryCode"`
+	Budget             BudgetDTO       `json:"budget"`
+	Location           *LocationDTO    `json:"location,omitempty"`
+	DueAt              *time.Time      `json:"dueAt,omitempty"`
+	Attachments        []AttachmentDTO `json:"attachments"`
+	Status             task.Status     `json:"status"`
+	AssigneeID         *string         `json:"assigneeId,omitempty"`
+	CancellationReason *string         `json:"cancellationReason,omitempty"`
+	PublishedAt        *time.Time      `json:"publishedAt,omitempty"`
+	AssignedAt         *time.Time      `json:"assignedAt,omitempty"`
+	StartedAt          *time.Time      `json:"startedAt,omitempty"`
+	CompletedAt        *time.Time      `json:"completedAt,omitempty"`
+	CancelledAt        *time.Time      `json:"cancelledAt,omitempty"`
+	CreatedAt          time.Time       `json:"createdAt"`
+	UpdatedAt          time.Time       `json:"updatedAt"`
+}
+
+type GetTaskQueryHandler struct {
+	taskRepo task.TaskRepository
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewGetTaskQueryHandler(
+	taskRepo task.TaskRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *GetTaskQueryHandler {
+	return &GetTaskQueryHandler{
+		taskRepo: taskRepo,
+		logger:   logger.With(slog.String("component", "GetTaskQueryHandler")),
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *GetTaskQueryHandler) Handle(ctx context.Context, qry GetTaskQuery) (*TaskDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(qry.TaskID)))
+
+	ctx, span := h.tracer.Start(ctx, "GetTaskQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("task.id", string(qry.TaskID)))
+
+	t, err := h.taskRepo.FindByID(ctx, qry.TaskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve task")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve task", slog.Any("error", err))
+		return nil, err
+	}
+
+	// Drafts are reported as missing rather than forbidden so their IDs do
+	// not leak to other users.
+	if !t.CanView(qry.Actor) {
+		span.SetStatus(codes.Error, "Task not visible to caller")
+		span.SetAttributes(attribute.String("error.type", string(task.ErrTaskNotFound.Code)))
+		logger.Warn("Hid task from caller", "actor_id", string(qry.Actor.UserID), "status", string(t.Status))
+		return nil, task.ErrTaskNotFound
+	}
+
+	span.SetStatus(codes.Ok, "Task retrieved")
+	return newTaskDTO(t), nil
+}
+
+func newTaskDTO(t *task.Task) *TaskDTO {
+	dto := &TaskDTO{
+		ID:                 string(t.ID),
+		PosterID:           string(t.PosterID),
+		Title:              t.Title,
+		Description:        t.Description,
+		CategoryCode:       t.CategoryCode,
+		Budget:             BudgetDTO{Amount: t.Budget.Amount, Currency: t.Budget.Currency},
+		DueAt:              t.DueAt,
+		Attachments:        make([]AttachmentDTO, 0, len(t.Attachments)),
+		Status:             t.Status,
+		CancellationReason: t.CancellationReason,
+		PublishedAt:        t.PublishedAt,
+		AssignedAt:         t.AssignedAt,
+		StartedAt:          t.StartedAt,
+		CompletedAt:        t.CompletedAt,
+		CancelledAt:        t.CancelledAt,
+		CreatedAt:          t.CreatedAt,
+		UpdatedAt:          t.UpdatedAt,
+	}
+	if t.Location != nil {
+		dto.Location = &LocationDTO{
+			Province:   t.Location.Province,
+			District:   t.Location.District,
+			PostalCode: t.Location.PostalCode,
+			Latitude:   t.Location.Latitude,
+			Longitude:  t.Location.Longitude,
+		}
+	}
+	if t.AssigneeID != nil {
+		id := string(*t.AssigneeID)
+		dto.AssigneeID = &id
+	}
+	for _, a := range t.Attachments {
+		dto.Attachments = append(dto.Attachments, AttachmentDTO{
+			ID:          a.ID.String(),
+			URL:         a.URL,
+			FileName:    a.FileName,
+			ContentType: a.ContentType,
+			SizeBytes:   a.SizeBytes,
+		})
+	}
+	return dto
+}
@@ -0,0 +1,131 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/bid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetTaskAwardQuery asks who pays whom how much for an assigned task, which
+// the payments service checks escrows against. BidID, when set, must be the
+// task's accepted bid.
+type GetTaskAwardQuery struct {
+	TaskID ids.TaskID `json:"taskId" validate:"required,uuid"`
+	BidID  *ids.BidID `json:"bidId,omitempty" validate:"omitempty,uuid"`
+	Actor  task.Actor `json:"-"`
+}
+
+type TaskAwardDTO struct {
+	TaskID   string    `json:"taskId"`
+	BidID    *string   `json:"bidId,omitempty"`
+	PosterID string    `json:"posterId"`
+	TaskerID string    `json:"taskerId"`
+	Price    BudgetDTO `json:"price"`
+	// Completed is set once the task is done, so its escrow can be released.
+	Completed bool `json:"completed"`
+}
+
+type GetTaskAwardQueryHandler struct {
+	taskRepo task.TaskRepository
+	bidRepo  bid.BidRepository
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewGetTaskAwardQueryHandler(
+	taskRepo task.TaskRepository,
+	bidRepo bid.BidRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *GetTaskAwardQueryHandler {
+	return &GetTaskAwardQueryHandler{
+		taskRepo: taskRepo,
+		bidRepo:  bidRepo,
+		logger:   logger.With(slog.String("component", "GetTaskAwardQueryHandler")),
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *GetTaskAwardQueryHandler) Handle(ctx context.Context, qry GetTaskAwardQuery) (*TaskAwardDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(qry.TaskID)))
+
+	ctx, span := h.tracer.Start(ctx, "GetTaskAwardQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("task.id", string(qry.TaskID)))
+
+	t, err := h.taskRepo.FindByID(ctx, qry.TaskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve task")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve task", slog.Any("error", err))
+		return nil, err
+	}
+	if !t.CanView(qry.Actor) {
+		span.SetStatus(codes.Error, "Task not visible to caller")
+		span.SetAttributes(attribute.String("error.type", string(task.ErrTaskNotFound.Code)))
+		logger.Warn("Hid task from caller", "actor_id", string(qry.Actor.UserID), "status", string(t.Status))
+		return nil, task.ErrTaskNotFound
+	}
+	if (t.Status != task.StatusAssigned && t.Status != task.StatusInProgress && t.Status != task.StatusCompleted) || t.AssigneeID == nil {
+		span.SetStatus(codes.Error, "Task not awarded")
+		span.SetAttributes(attribute.String("error.type", string(task.ErrTaskNotAwarded.Code)))
+		logger.Debug("Task is not awarded.", "status", string(t.Status))
+		return nil, task.ErrTaskNotAwarded
+	}
+
+	bids, err := h.bidRepo.ListByTask(ctx, qry.TaskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list bids")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to list bids from repository", slog.Any("error", err))
+		return nil, err
+	}
+	var accepted *bid.Bid
+	for _, b := range bids {
+		if b.Status == bid.StatusAccepted {
+			accepted = b
+			break
+		}
+	}
+
+	dto := &TaskAwardDTO{
+		TaskID:    string(t.ID),
+		PosterID:  string(t.PosterID),
+		TaskerID:  string(*t.AssigneeID),
+		Completed: t.Status == task.StatusCompleted,
+	}
+	// A task assigned through a bid is paid at the accepted offer, whether
+	// the caller names the bid or not; one assigned directly at its budget.
+	switch {
+	case accepted == nil && qry.BidID == nil:
+		dto.Price = BudgetDTO{Amount: t.Budget.Amount, Currency: t.Budget.Currency}
+	case accepted != nil && (qry.BidID == nil || *qry.BidID == accepted.ID) && accepted.TaskerID == *t.AssigneeID:
+		offer := accepted.CurrentOffer()
+		bidID := string(accepted.ID)
+		dto.BidID = &bidID
+		dto.Price = BudgetDTO{Amount: offer.Amount, Currency: offer.Currency}
+	default:
+		span.SetStatus(codes.Error, "Bid not accepted for task")
+		span.SetAttributes(attribute.String("error.type", string(task.ErrTaskNotAwarded.Code)))
+		logger.Debug("Bid is not the accepted bid of the task.")
+		return nil, task.ErrTaskNotAwarded
+	}
+
+	span.SetStatus(codes.Ok, "Task award retrieved")
+	return dto, nil
+}
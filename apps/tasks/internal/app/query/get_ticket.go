@@ -0,0 +1,154 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GetTicketQuery struct {
+	TicketID ids.TicketID `json:"ticketId" validate:"required,uuid"`
+	Actor    task.Actor   `json:"-"`
+}
+
+type FileDTO struct {
+	URL         string  `json:"url"`
+	FileName    string  `json:"fileName"`
+	ContentType *string `json:"contentType,omitempty"`
+	SizeBytes   int64   `json:"sizeBytes"`
+}
+
+func newFileDTOs(atts []ticket.Attachment) []FileDTO {
+	files := make([]FileDTO, 0, len(atts))
+	for _, a := range atts {
+		files = append(files, FileDTO(a))
+	}
+	return files
+}
+
+type TicketMessageDTO struct {
+	ID          string    `json:"id"`
+	AuthorID    string    `json:"authorId"`
+	FromStaff   bool      `json:"fromStaff"`
+	Internal    bool      `json:"internal"`
+	Body        string    `json:"body"`
+	Attachments []FileDTO `json:"attachments"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// TicketDTO carries the thread as the caller may see it; listings leave it
+// empty.
+type TicketDTO struct {
+	ID            string             `json:"id"`
+	ReporterID    string             `json:"reporterId"`
+	Category      ticket.Category    `json:"category"`
+	Priority      ticket.Priority    `json:"priority"`
+	Subject       string             `json:"subject"`
+	Status        ticket.Status      `json:"status"`
+	AssigneeID    *string            `json:"assigneeId,omitempty"`
+	TaskID        *string            `json:"taskId,omitempty"`
+	Messages      []TicketMessageDTO `json:"messages"`
+	SLADueAt      time.Time          `json:"slaDueAt"`
+	SLABreachedAt *time.Time         `json:"slaBreachedAt,omitempty"`
+	ResolvedAt    *time.Time         `json:"resolvedAt,omitempty"`
+	ClosedAt      *time.Time         `json:"closedAt,omitempty"`
+	CreatedAt     time.Time          `json:"createdAt"`
+	UpdatedAt     time.Time          `json:"updatedAt"`
+}
+
+type GetTicketQueryHandler struct {
+	ticketRepo ticket.TicketRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewGetTicketQueryHandler(
+	ticketRepo ticket.TicketRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *GetTicketQueryHandler {
+	return &GetTicketQueryHandler{
+		ticketRepo: ticketRepo,
+		logger:     logger.With(slog.String("component", "GetTicketQueryHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *GetTicketQueryHandler) Handle(ctx context.Context, qry GetTicketQuery) (*TicketDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("ticket_id", string(qry.TicketID)))
+
+	ctx, span := h.tracer.Start(ctx, "GetTicketQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("ticket.id", string(qry.TicketID)))
+
+	t, err := h.ticketRepo.FindByID(ctx, qry.TicketID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve ticket")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve ticket", slog.Any("error", err))
+		return nil, err
+	}
+
+	if !t.CanView(qry.Actor) {
+		span.SetStatus(codes.Error, "Ticket not visible to caller")
+		span.SetAttributes(attribute.String("error.type", string(ticket.ErrTicketNotFound.Code)))
+		logger.Warn("Hid ticket from caller", "actor_id", string(qry.Actor.UserID))
+		return nil, ticket.ErrTicketNotFound
+	}
+
+	span.SetStatus(codes.Ok, "Ticket retrieved")
+	return newTicketDTO(t, qry.Actor), nil
+}
+
+func newTicketDTO(t *ticket.Ticket, actor task.Actor) *TicketDTO {
+	dto := &TicketDTO{
+		ID:            string(t.ID),
+		ReporterID:    string(t.ReporterID),
+		Category:      t.Category,
+		Priority:      t.Priority,
+		Subject:       t.Subject,
+		Status:        t.Status,
+		Messages:      make([]TicketMessageDTO, 0, len(t.Messages)),
+		SLADueAt:      t.SLADueAt,
+		SLABreachedAt: t.SLABreachedAt,
+		ResolvedAt:    t.ResolvedAt,
+		ClosedAt:      t.ClosedAt,
+		CreatedAt:     t.CreatedAt,
+		UpdatedAt:     t.UpdatedAt,
+	}
+	if t.AssigneeID != nil {
+		id := string(*t.AssigneeID)
+		dto.AssigneeID = &id
+	}
+	if t.TaskID != nil {
+		id := string(*t.TaskID)
+		dto.TaskID = &id
+	}
+	for _, m := range t.VisibleMessages(actor) {
+		dto.Messages = append(dto.Messages, TicketMessageDTO{
+			ID:          string(m.ID),
+			AuthorID:    string(m.AuthorID),
+			FromStaff:   m.FromStaff,
+			Internal:    m.Internal,
+			Body:        m.Body,
+			Attachments: newFileDTOs(m.Attachments),
+			CreatedAt:   m.CreatedAt,
+		})
+	}
+	return dto
+}
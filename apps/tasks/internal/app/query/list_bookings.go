@@ -0,0 +1,87 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/bid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListBookingsQuery lists when taskers are booked during a window, so their
+// availability can leave that time out. Anyone may ask: bookings only tell
+// when a tasker is busy, never for which task.
+type ListBookingsQuery struct {
+	TaskerIDs   []ids.UserID `json:"taskerIds" validate:"required,max=100,dive,uuid"`
+	WindowStart time.Time    `json:"windowStart" validate:"required"`
+	WindowEnd   time.Time    `json:"windowEnd" validate:"required,gtfield=WindowStart"`
+}
+
+type BookingDTO struct {
+	TaskerID string    `json:"taskerId"`
+	StartAt  time.Time `json:"startAt"`
+	EndAt    time.Time `json:"endAt"`
+}
+
+type ListBookingsQueryHandler struct {
+	bidRepo bid.BidRepository
+	logger  *slog.Logger
+	config  *config.Config
+	tracer  trace.Tracer
+}
+
+func NewListBookingsQueryHandler(
+	bidRepo bid.BidRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ListBookingsQueryHandler {
+	return &ListBookingsQueryHandler{
+		bidRepo: bidRepo,
+		logger:  logger.With(slog.String("component", "ListBookingsQueryHandler")),
+		config:  cfg,
+		tracer:  otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *ListBookingsQueryHandler) Handle(ctx context.Context, qry ListBookingsQuery) ([]BookingDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.Int("tasker_count", len(qry.TaskerIDs)))
+
+	ctx, span := h.tracer.Start(ctx, "ListBookingsQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("query.tasker_count", len(qry.TaskerIDs)),
+		attribute.String("query.window_start", qry.WindowStart.Format(time.RFC3339)),
+		attribute.String("query.window_end", qry.WindowEnd.Format(time.RFC3339)),
+	)
+
+	bookings, err := h.bidRepo.ListBookings(ctx, qry.TaskerIDs, qry.WindowStart, qry.WindowEnd)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list bookings")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to list bookings from repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	result := make([]BookingDTO, 0, len(bookings))
+	for _, b := range bookings {
+		result = append(result, BookingDTO{
+			TaskerID: string(b.TaskerID),
+			StartAt:  b.Schedule.StartAt,
+			EndAt:    b.Schedule.EndAt,
+		})
+	}
+
+	span.SetStatus(codes.Ok, "Bookings listed")
+	span.SetAttributes(attribute.Int("list.result_count", len(result)))
+	return result, nil
+}
@@ -0,0 +1,163 @@
+package query
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/dispute"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListDisputesQuery pages through disputes, newest first. Users list the
+// disputes they are a party to; admins can list every dispute, for example
+// the ones waiting for review.
+type ListDisputesQuery struct {
+	// PartyID defaults to the caller for everyone but admins.
+	PartyID   *ids.UserID     `json:"partyId,omitempty" validate:"omitempty,uuid"`
+	TaskID    *ids.TaskID     `json:"taskId,omitempty" validate:"omitempty,uuid"`
+	Status    *dispute.Status `json:"status,omitempty"`
+	PageSize  int             `json:"-"`
+	PageToken string          `json:"-"`
+	Actor     task.Actor      `json:"-"`
+}
+
+type ListDisputesResultDTO struct {
+	Disputes      []DisputeDTO `json:"disputes"`
+	NextPageToken string       `json:"nextPageToken"`
+}
+
+type ListDisputesQueryHandler struct {
+	disputeRepo dispute.DisputeRepository
+	logger      *slog.Logger
+	config      *config.Config
+	tracer      trace.Tracer
+}
+
+func NewListDisputesQueryHandler(
+	disputeRepo dispute.DisputeRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ListDisputesQueryHandler {
+	return &ListDisputesQueryHandler{
+		disputeRepo: disputeRepo,
+		logger:      logger.With(slog.String("component", "ListDisputesQueryHandler")),
+		config:      cfg,
+		tracer:      otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *ListDisputesQueryHandler) Handle(ctx context.Context, qry ListDisputesQuery) (*ListDisputesResultDTO, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := h.tracer.Start(ctx, "ListDisputesQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	if qry.Actor.UserID == "" {
+		span.SetStatus(codes.Error, "Anonymous dispute listing")
+		span.SetAttributes(attribute.String("error.type", string(dispute.ErrSignInRequired.Code)))
+		return nil, dispute.ErrSignInRequired
+	}
+	if !qry.Actor.IsAdmin {
+		if qry.PartyID != nil && *qry.PartyID != qry.Actor.UserID {
+			span.SetStatus(codes.Error, "Dispute listing denied")
+			span.SetAttributes(attribute.String("error.type", string(dispute.ErrDisputeListForbidden.Code)))
+			return nil, dispute.ErrDisputeListForbidden
+		}
+		qry.PartyID = &qry.Actor.UserID
+	}
+	if qry.Status != nil && !qry.Status.IsValid() {
+		span.SetStatus(codes.Error, "Invalid status")
+		return nil, dispute.ErrInvalidStatus
+	}
+
+	if qry.PageSize < 0 {
+		span.SetStatus(codes.Error, "Invalid page size")
+		return nil, ErrInvalidPageSize
+	}
+	pageSize := qry.PageSize
+	if pageSize == 0 {
+		pageSize = defaultListPageSize
+	}
+	pageSize = min(pageSize, maxListPageSize)
+
+	fingerprint, err := qry.fingerprint()
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to fingerprint listing")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	criteria := dispute.ListCriteria{
+		PartyID: qry.PartyID,
+		TaskID:  qry.TaskID,
+		Status:  qry.Status,
+		// One extra row tells us whether another page exists.
+		Limit: pageSize + 1,
+	}
+	if qry.PageToken != "" {
+		pt, err := decodePageToken(qry.PageToken, fingerprint)
+		if err != nil {
+			span.SetStatus(codes.Error, "Invalid page token")
+			logger.Warn("Rejected dispute listing page token", slog.Any("error", err))
+			return nil, ErrInvalidPageToken
+		}
+		criteria.After = &dispute.ListCursor{CreatedAt: pt.CreatedAt, DisputeID: ids.DisputeID(pt.ID)}
+	}
+
+	span.SetAttributes(
+		attribute.String("actor.id", string(qry.Actor.UserID)),
+		attribute.Int("query.page_size", pageSize),
+		attribute.Bool("query.has_page_token", qry.PageToken != ""),
+	)
+
+	disputes, err := h.disputeRepo.List(ctx, criteria)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list disputes")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to list disputes in repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	resp := &ListDisputesResultDTO{Disputes: make([]DisputeDTO, 0, min(len(disputes), pageSize))}
+	if len(disputes) > pageSize {
+		disputes = disputes[:pageSize]
+		last := disputes[len(disputes)-1]
+		token, err := encodePageToken(last.CreatedAt, string(last.ID), fingerprint)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to encode page token")
+			span.RecordError(err)
+			return nil, err
+		}
+		resp.NextPageToken = token
+	}
+	for _, d := range disputes {
+		resp.Disputes = append(resp.Disputes, *newDisputeDTO(d))
+	}
+
+	span.SetStatus(codes.Ok, "Disputes listed")
+	span.SetAttributes(attribute.Int("query.result_count", len(resp.Disputes)))
+	return resp, nil
+}
+
+// fingerprint hashes every filter, after the party was defaulted to the
+// caller, so a token cannot be reused with different filters.
+func (q ListDisputesQuery) fingerprint() (string, error) {
+	payload, err := json.Marshal(q)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal dispute listing: %w", err)
+	}
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:8]), nil
+}
@@ -0,0 +1,94 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/bid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListTaskBidsQuery lists the bids on a task, oldest first. The poster and
+// admins see every bid; a tasker only sees their own.
+type ListTaskBidsQuery struct {
+	TaskID ids.TaskID `json:"taskId" validate:"required,uuid"`
+	Actor  task.Actor `json:"-"`
+}
+
+type ListTaskBidsQueryHandler struct {
+	taskRepo task.TaskRepository
+	bidRepo  bid.BidRepository
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewListTaskBidsQueryHandler(
+	taskRepo task.TaskRepository,
+	bidRepo bid.BidRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ListTaskBidsQueryHandler {
+	return &ListTaskBidsQueryHandler{
+		taskRepo: taskRepo,
+		bidRepo:  bidRepo,
+		logger:   logger.With(slog.String("component", "ListTaskBidsQueryHandler")),
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *ListTaskBidsQueryHandler) Handle(ctx context.Context, qry ListTaskBidsQuery) ([]*BidDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(qry.TaskID)))
+
+	ctx, span := h.tracer.Start(ctx, "ListTaskBidsQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("task.id", string(qry.TaskID)))
+
+	t, err := h.taskRepo.FindByID(ctx, qry.TaskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve task")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve task", slog.Any("error", err))
+		return nil, err
+	}
+	if !t.CanView(qry.Actor) {
+		span.SetStatus(codes.Error, "Task not visible to caller")
+		span.SetAttributes(attribute.String("error.type", string(task.ErrTaskNotFound.Code)))
+		logger.Warn("Hid task from caller", "actor_id", string(qry.Actor.UserID), "status", string(t.Status))
+		return nil, task.ErrTaskNotFound
+	}
+
+	bids, err := h.bidRepo.ListByTask(ctx, qry.TaskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list bids")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to list bids from repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	seeAll := t.IsPoster(qry.Actor) || qry.Actor.IsAdmin
+	now := time.Now()
+	result := make([]*BidDTO, 0, len(bids))
+	for _, b := range bids {
+		if seeAll || (qry.Actor.UserID != "" && b.TaskerID == qry.Actor.UserID) {
+			result = append(result, newBidDTO(b, now))
+		}
+	}
+
+	span.SetStatus(codes.Ok, "Bids listed")
+	span.SetAttributes(attribute.Int("list.result_count", len(result)))
+	return result, nil
+}
@@ -0,0 +1,73 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/review"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListTaskReviewsQuery returns the (at most two) reviews written about a
+// task, oldest first.
+type ListTaskReviewsQuery struct {
+	TaskID ids.TaskID `json:"taskId" validate:"required,uuid"`
+	Actor  task.Actor `json:"-"`
+}
+
+type ListTaskReviewsQueryHandler struct {
+	reviewRepo review.ReviewRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewListTaskReviewsQueryHandler(
+	reviewRepo review.ReviewRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ListTaskReviewsQueryHandler {
+	return &ListTaskReviewsQueryHandler{
+		reviewRepo: reviewRepo,
+		logger:     logger.With(slog.String("component", "ListTaskReviewsQueryHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *ListTaskReviewsQueryHandler) Handle(ctx context.Context, qry ListTaskReviewsQuery) ([]*ReviewDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(qry.TaskID)))
+
+	ctx, span := h.tracer.Start(ctx, "ListTaskReviewsQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("task.id", string(qry.TaskID)))
+
+	reviews, err := h.reviewRepo.ListByTask(ctx, qry.TaskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list reviews")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to list reviews of task", slog.Any("error", err))
+		return nil, err
+	}
+
+	dtos := make([]*ReviewDTO, 0, len(reviews))
+	for _, r := range reviews {
+		if r.CanView(qry.Actor.UserID, qry.Actor.IsAdmin) {
+			dtos = append(dtos, newReviewDTO(r, qry.Actor))
+		}
+	}
+
+	span.SetStatus(codes.Ok, "Reviews listed")
+	span.SetAttributes(attribute.Int("query.result_count", len(dtos)))
+	return dtos, nil
+}
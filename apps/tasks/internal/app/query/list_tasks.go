@@ -0,0 +1,164 @@
+package query
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListTasksQuery pages through tasks, newest first. Drafts are only included
+// when the caller lists their own tasks or is an admin.
+type ListTasksQuery struct {
+	PosterID     *ids.UserID  `json:"posterId,omitempty" validate:"omitempty,uuid"`
+	AssigneeID   *ids.UserID  `json:"assigneeId,omitempty" validate:"omitempty,uuid"`
+	Status       *task.Status `json:"status,omitempty"`
+	CategoryCode *string      `json:"categoryCode,omitempty"`
+	PageSize     int          `json:"-"`
+	PageToken    string       `json:"-"`
+	Actor        task.Actor   `json:"-"`
+}
+
+type ListTasksResultDTO struct {
+	Tasks         []TaskDTO `json:"tasks"`
+	NextPageToken string    `json:"nextPageToken"`
+}
+
+type ListTasksQueryHandler struct {
+	taskRepo task.TaskRepository
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewListTasksQueryHandler(
+	taskRepo task.TaskRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ListTasksQueryHandler {
+	return &ListTasksQueryHandler{
+		taskRepo: taskRepo,
+		logger:   logger.With(slog.String("component", "ListTasksQueryHandler")),
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *ListTasksQueryHandler) Handle(ctx context.Context, qry ListTasksQuery) (*ListTasksResultDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("query", "ListTasks"))
+
+	ctx, span := h.tracer.Start(ctx, "ListTasksQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	if qry.Status != nil && !qry.Status.IsValid() {
+		span.SetStatus(codes.Error, "Invalid task status")
+		return nil, task.ErrInvalidTaskStatus
+	}
+
+	ownList := qry.PosterID != nil && qry.Actor.UserID != "" && *qry.PosterID == qry.Actor.UserID
+	includeDrafts := ownList || qry.Actor.IsAdmin
+	if qry.Status != nil && *qry.Status == task.StatusDraft && !includeDrafts {
+		span.SetStatus(codes.Error, "Draft listing denied")
+		span.SetAttributes(attribute.String("error.type", string(task.ErrDraftListDenied.Code)))
+		logger.Warn("Rejected draft listing", "actor_id", string(qry.Actor.UserID))
+		return nil, task.ErrDraftListDenied
+	}
+
+	if qry.PageSize < 0 {
+		span.SetStatus(codes.Error, "Invalid page size")
+		return nil, ErrInvalidPageSize
+	}
+	pageSize := qry.PageSize
+	if pageSize == 0 {
+		pageSize = defaultListPageSize
+	}
+	pageSize = min(pageSize, maxListPageSize)
+
+	fingerprint, err := qry.fingerprint()
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to fingerprint listing")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	criteria := task.ListCriteria{
+		PosterID:      qry.PosterID,
+		AssigneeID:    qry.AssigneeID,
+		Status:        qry.Status,
+		CategoryCode:  qry.CategoryCode,
+		IncludeDrafts: includeDrafts,
+		// One extra row tells us whether another page exists.
+		Limit: pageSize + 1,
+	}
+	if qry.PageToken != "" {
+		pt, err := decodePageToken(qry.PageToken, fingerprint)
+		if err != nil {
+			span.SetStatus(codes.Error, "Invalid page token")
+			logger.Warn("Rejected task listing page token", slog.Any("error", err))
+			return nil, ErrInvalidPageToken
+		}
+		criteria.After = &task.ListCursor{CreatedAt: pt.CreatedAt, TaskID: ids.TaskID(pt.ID)}
+	}
+
+	span.SetAttributes(
+		attribute.Int("query.page_size", pageSize),
+		attribute.Bool("query.include_drafts", includeDrafts),
+		attribute.Bool("query.has_page_token", qry.PageToken != ""),
+	)
+
+	tasks, err := h.taskRepo.List(ctx, criteria)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list tasks")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to list tasks in repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	resp := &ListTasksResultDTO{Tasks: make([]TaskDTO, 0, min(len(tasks), pageSize))}
+	if len(tasks) > pageSize {
+		tasks = tasks[:pageSize]
+		last := tasks[len(tasks)-1]
+		token, err := encodePageToken(last.CreatedAt, string(last.ID), fingerprint)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to encode page token")
+			span.RecordError(err)
+			return nil, err
+		}
+		resp.NextPageToken = token
+	}
+	for _, t := range tasks {
+		resp.Tasks = append(resp.Tasks, *newTaskDTO(t))
+	}
+
+	span.SetStatus(codes.Ok, "Tasks listed")
+	span.SetAttributes(attribute.Int("query.result_count", len(resp.Tasks)))
+	logger.Info("Tasks listed successfully.", "count", len(resp.Tasks))
+	return resp, nil
+}
+
+// fingerprint hashes every parameter except the paging fields so a token
+// cannot be reused with different filters. The caller is part of it because
+// it decides whether drafts are included.
+func (q ListTasksQuery) fingerprint() (string, error) {
+	payload, err := json.Marshal(struct {
+		ListTasksQuery
+		ActorID ids.UserID `json:"actorId"`
+	}{q, q.Actor.UserID})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal task listing: %w", err)
+	}
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:8]), nil
+}
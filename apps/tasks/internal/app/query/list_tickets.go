@@ -0,0 +1,171 @@
+package query
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListTicketsQuery pages through tickets, newest first. Users list their own
+// tickets; staff can list anyone's and filter by assignee, for example to
+// work through the breached queue.
+type ListTicketsQuery struct {
+	// ReporterID defaults to the caller for everyone but staff.
+	ReporterID   *ids.UserID      `json:"reporterId,omitempty" validate:"omitempty,uuid"`
+	AssigneeID   *ids.UserID      `json:"assigneeId,omitempty" validate:"omitempty,uuid"`
+	Status       *ticket.Status   `json:"status,omitempty"`
+	Category     *ticket.Category `json:"category,omitempty"`
+	BreachedOnly bool             `json:"breachedOnly,omitempty"`
+	PageSize     int              `json:"-"`
+	PageToken    string           `json:"-"`
+	Actor        task.Actor       `json:"-"`
+}
+
+type ListTicketsResultDTO struct {
+	Tickets       []TicketDTO `json:"tickets"`
+	NextPageToken string      `json:"nextPageToken"`
+}
+
+type ListTicketsQueryHandler struct {
+	ticketRepo ticket.TicketRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewListTicketsQueryHandler(
+	ticketRepo ticket.TicketRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ListTicketsQueryHandler {
+	return &ListTicketsQueryHandler{
+		ticketRepo: ticketRepo,
+		logger:     logger.With(slog.String("component", "ListTicketsQueryHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *ListTicketsQueryHandler) Handle(ctx context.Context, qry ListTicketsQuery) (*ListTicketsResultDTO, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := h.tracer.Start(ctx, "ListTicketsQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	if qry.Actor.UserID == "" {
+		span.SetStatus(codes.Error, "Anonymous ticket listing")
+		span.SetAttributes(attribute.String("error.type", string(ticket.ErrSignInRequired.Code)))
+		return nil, ticket.ErrSignInRequired
+	}
+	if !qry.Actor.IsAdmin {
+		if (qry.ReporterID != nil && *qry.ReporterID != qry.Actor.UserID) || qry.AssigneeID != nil {
+			span.SetStatus(codes.Error, "Ticket listing denied")
+			span.SetAttributes(attribute.String("error.type", string(ticket.ErrTicketListForbidden.Code)))
+			return nil, ticket.ErrTicketListForbidden
+		}
+		qry.ReporterID = &qry.Actor.UserID
+	}
+	if qry.Status != nil && !qry.Status.IsValid() {
+		span.SetStatus(codes.Error, "Invalid status")
+		return nil, ticket.ErrInvalidStatus
+	}
+	if qry.Category != nil && !qry.Category.IsValid() {
+		span.SetStatus(codes.Error, "Invalid category")
+		return nil, ticket.ErrInvalidCategory
+	}
+
+	if qry.PageSize < 0 {
+		span.SetStatus(codes.Error, "Invalid page size")
+		return nil, ErrInvalidPageSize
+	}
+	pageSize := qry.PageSize
+	if pageSize == 0 {
+		pageSize = defaultListPageSize
+	}
+	pageSize = min(pageSize, maxListPageSize)
+
+	fingerprint, err := qry.fingerprint()
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to fingerprint listing")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	criteria := ticket.ListCriteria{
+		ReporterID:   qry.ReporterID,
+		AssigneeID:   qry.AssigneeID,
+		Status:       qry.Status,
+		Category:     qry.Category,
+		BreachedOnly: qry.BreachedOnly,
+		// One extra row tells us whether another page exists.
+		Limit: pageSize + 1,
+	}
+	if qry.PageToken != "" {
+		pt, err := decodePageToken(qry.PageToken, fingerprint)
+		if err != nil {
+			span.SetStatus(codes.Error, "Invalid page token")
+			logger.Warn("Rejected ticket listing page token", slog.Any("error", err))
+			return nil, ErrInvalidPageToken
+		}
+		criteria.After = &ticket.ListCursor{CreatedAt: pt.CreatedAt, TicketID: ids.TicketID(pt.ID)}
+	}
+
+	span.SetAttributes(
+		attribute.String("actor.id", string(qry.Actor.UserID)),
+		attribute.Int("query.page_size", pageSize),
+		attribute.Bool("query.has_page_token", qry.PageToken != ""),
+	)
+
+	tickets, err := h.ticketRepo.List(ctx, criteria)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list tickets")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to list tickets in repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	resp := &ListTicketsResultDTO{Tickets: make([]TicketDTO, 0, min(len(tickets), pageSize))}
+	if len(tickets) > pageSize {
+		tickets = tickets[:pageSize]
+		last := tickets[len(tickets)-1]
+		token, err := encodePageToken(last.CreatedAt, string(last.ID), fingerprint)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to encode page token")
+			span.RecordError(err)
+			return nil, err
+		}
+		resp.NextPageToken = token
+	}
+	for _, t := range tickets {
+		resp.Tickets = append(resp.Tickets, *newTicketDTO(t, qry.Actor))
+	}
+
+	span.SetStatus(codes.Ok, "Tickets listed")
+	span.SetAttributes(attribute.Int("query.result_count", len(resp.Tickets)))
+	return resp, nil
+}
+
+// fingerprint hashes every filter, after the reporter was defaulted to the
+// caller, so a token cannot be reused with different filters.
+func (q ListTicketsQuery) fingerprint() (string, error) {
+	payload, err := json.Marshal(q)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal ticket listing: %w", err)
+	}
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:8]), nil
+}
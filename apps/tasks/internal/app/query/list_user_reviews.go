@@ -0,0 +1,153 @@
+package query
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/review"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListUserReviewsQuery pages through the reviews a user received, newest
+// first. Removed reviews are only included for admins.
+type ListUserReviewsQuery struct {
+	UserID ids.UserID `json:"userId" validate:"required,uuid"`
+	// Role narrows the listing to reviews of the user as a poster or as a
+	// tasker.
+	Role      *review.Role `json:"role,omitempty"`
+	PageSize  int          `json:"-"`
+	PageToken string       `json:"-"`
+	Actor     task.Actor   `json:"-"`
+}
+
+type ListUserReviewsResultDTO struct {
+	Reviews       []ReviewDTO `json:"reviews"`
+	NextPageToken string      `json:"nextPageToken"`
+}
+
+type ListUserReviewsQueryHandler struct {
+	reviewRepo review.ReviewRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewListUserReviewsQueryHandler(
+	reviewRepo review.ReviewRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ListUserReviewsQueryHandler {
+	return &ListUserReviewsQueryHandler{
+		reviewRepo: reviewRepo,
+		logger:     logger.With(slog.String("component", "ListUserReviewsQueryHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *ListUserReviewsQueryHandler) Handle(ctx context.Context, qry ListUserReviewsQuery) (*ListUserReviewsResultDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(qry.UserID)))
+
+	ctx, span := h.tracer.Start(ctx, "ListUserReviewsQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	if qry.Role != nil && !qry.Role.IsValid() {
+		span.SetStatus(codes.Error, "Invalid role")
+		return nil, review.ErrInvalidRole
+	}
+
+	if qry.PageSize < 0 {
+		span.SetStatus(codes.Error, "Invalid page size")
+		return nil, ErrInvalidPageSize
+	}
+	pageSize := qry.PageSize
+	if pageSize == 0 {
+		pageSize = defaultListPageSize
+	}
+	pageSize = min(pageSize, maxListPageSize)
+
+	fingerprint, err := qry.fingerprint()
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to fingerprint listing")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	criteria := review.ListCriteria{
+		RevieweeID:     qry.UserID,
+		RevieweeRole:   qry.Role,
+		IncludeRemoved: qry.Actor.IsAdmin,
+		// One extra row tells us whether another page exists.
+		Limit: pageSize + 1,
+	}
+	if qry.PageToken != "" {
+		pt, err := decodePageToken(qry.PageToken, fingerprint)
+		if err != nil {
+			span.SetStatus(codes.Error, "Invalid page token")
+			logger.Warn("Rejected review listing page token", slog.Any("error", err))
+			return nil, ErrInvalidPageToken
+		}
+		criteria.After = &review.ListCursor{CreatedAt: pt.CreatedAt, ReviewID: ids.ReviewID(pt.ID)}
+	}
+
+	span.SetAttributes(
+		attribute.String("user.id", string(qry.UserID)),
+		attribute.Int("query.page_size", pageSize),
+		attribute.Bool("query.has_page_token", qry.PageToken != ""),
+	)
+
+	reviews, err := h.reviewRepo.List(ctx, criteria)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list reviews")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to list reviews in repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	resp := &ListUserReviewsResultDTO{Reviews: make([]ReviewDTO, 0, min(len(reviews), pageSize))}
+	if len(reviews) > pageSize {
+		reviews = reviews[:pageSize]
+		last := reviews[len(reviews)-1]
+		token, err := encodePageToken(last.CreatedAt, string(last.ID), fingerprint)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to encode page token")
+			span.RecordError(err)
+			return nil, err
+		}
+		resp.NextPageToken = token
+	}
+	for _, r := range reviews {
+		resp.Reviews = append(resp.Reviews, *newReviewDTO(r, qry.Actor))
+	}
+
+	span.SetStatus(codes.Ok, "Reviews listed")
+	span.SetAttributes(attribute.Int("query.result_count", len(resp.Reviews)))
+	return resp, nil
+}
+
+// fingerprint hashes every parameter except the paging fields so a token
+// cannot be reused with different filters. Whether the caller is an admin
+// is part of it because it decides whether removed reviews are included.
+func (q ListUserReviewsQuery) fingerprint() (string, error) {
+	payload, err := json.Marshal(struct {
+		ListUserReviewsQuery
+		IsAdmin bool `json:"isAdmin"`
+	}{q, q.Actor.IsAdmin})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal review listing: %w", err)
+	}
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:8]), nil
+}
@@ -0,0 +1,305 @@
+package bid
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+)
+
+const (
+	// MaxOffers caps the negotiation so a bid cannot be countered forever.
+	MaxOffers        = 20
+	DefaultOfferTTL  = 72 * time.Hour
+	minOfferTTL      = time.Hour
+	maxOfferTTL      = 30 * 24 * time.Hour
+	maxMessageLength = 1000
+)
+
+// Schedule is when the tasker proposes to do the work.
+type Schedule struct {
+	StartAt time.Time
+	EndAt   time.Time
+}
+
+// Booking is time a tasker has committed to: the schedule of a bid they won
+// on a task that is not yet done.
+type Booking struct {
+	TaskID   ids.TaskID
+	TaskerID ids.UserID
+	Schedule Schedule
+}
+
+// Terms is what one party proposes. An empty Currency means the currency of
+// the bid, and a nil ExpiresAt means DefaultOfferTTL from now.
+type Terms struct {
+	Amount    int64
+	Currency  string
+	Message   *string
+	Schedule  *Schedule
+	ExpiresAt *time.Time
+}
+
+// Offer is one round of the negotiation. The latest offer holds the terms
+// that are accepted if the other party agrees.
+type Offer struct {
+	ID        uuid.UUID
+	Party     Party
+	Amount    int64
+	Currency  string
+	Message   *string
+	Schedule  *Schedule
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// Bid is a tasker's offer to do a task, and the counter-offers the poster
+// and the tasker exchanged on it. Version guards against two responses to
+// the same offer racing each other.
+type Bid struct {
+	ID        ids.BidID
+	TaskID    ids.TaskID
+	PosterID  ids.UserID
+	TaskerID  ids.UserID
+	Currency  string
+	Status    Status
+	Offers    []Offer
+	ExpiresAt time.Time
+	ClosedAt  *time.Time
+	Version   int32
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewBid places a tasker's opening offer on a task. currency is the currency
+// of the task budget; every offer on the bid must use it.
+func NewBid(taskID ids.TaskID, posterID ids.UserID, taskerID ids.UserID, currency string, terms Terms) (*Bid, error) {
+	if taskerID == posterID {
+		return nil, ErrCannotBidOwnTask
+	}
+	now := time.Now()
+	b := &Bid{
+		ID:        ids.NewBidID(),
+		TaskID:    taskID,
+		PosterID:  posterID,
+		TaskerID:  taskerID,
+		Currency:  currency,
+		CreatedAt: now,
+	}
+	if err := b.propose(PartyTasker, terms, now); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func NewBidFromRepository(
+	id string,
+	taskID string,
+	posterID string,
+	taskerID string,
+	currency string,
+	status Status,
+	offers []Offer,
+	expiresAt time.Time,
+	closedAt *time.Time,
+	version int32,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Bid {
+	return &Bid{
+		ID:        ids.BidID(id),
+		TaskID:    ids.TaskID(taskID),
+		PosterID:  ids.UserID(posterID),
+		TaskerID:  ids.UserID(taskerID),
+		Currency:  currency,
+		Status:    status,
+		Offers:    offers,
+		ExpiresAt: expiresAt,
+		ClosedAt:  closedAt,
+		Version:   version,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+}
+
+// PartyOf returns the side of the negotiation the user is on, or "" when
+// they are not part of it.
+func (b *Bid) PartyOf(userID ids.UserID) Party {
+	switch {
+	case userID == "":
+		return ""
+	case userID == b.PosterID:
+		return PartyPoster
+	case userID == b.TaskerID:
+		return PartyTasker
+	}
+	return ""
+}
+
+// AwaitingParty is the side that has to respond next, or "" once the bid
+// is closed.
+func (b *Bid) AwaitingParty() Party {
+	switch b.Status {
+	case StatusPending:
+		return PartyPoster
+	case StatusCountered:
+		return PartyTasker
+	}
+	return ""
+}
+
+// CurrentOffer is the latest offer, whose terms apply if the bid is accepted.
+func (b *Bid) CurrentOffer() Offer {
+	return b.Offers[len(b.Offers)-1]
+}
+
+// EffectiveStatus is Status with expiry applied. Expired bids are only
+// marked EXPIRED when read, so the stored status may still say otherwise.
+func (b *Bid) EffectiveStatus(now time.Time) Status {
+	if b.Status.IsOpen() && !now.Before(b.ExpiresAt) {
+		return StatusExpired
+	}
+	return b.Status
+}
+
+// Counter answers the latest offer with new terms. Only the party the bid
+// is waiting on can counter.
+func (b *Bid) Counter(by ids.UserID, terms Terms) error {
+	party := b.PartyOf(by)
+	if party == "" {
+		return ErrNotBidParticipant
+	}
+	now := time.Now()
+	if err := b.respondable(party, now); err != nil {
+		return err
+	}
+	if len(b.Offers) >= MaxOffers {
+		return ErrTooManyCounterOffers
+	}
+	return b.propose(party, terms, now)
+}
+
+// Accept agrees to the latest offer: the poster accepts a tasker's offer,
+// the tasker accepts a poster's counter-offer.
+func (b *Bid) Accept(by ids.UserID) error {
+	party := b.PartyOf(by)
+	if party == "" {
+		return ErrNotBidParticipant
+	}
+	now := time.Now()
+	if err := b.respondable(party, now); err != nil {
+		return err
+	}
+	b.close(StatusAccepted, now)
+	return nil
+}
+
+// Reject turns the bid down. The poster can reject at any point of the
+// negotiation.
+func (b *Bid) Reject(by ids.UserID) error {
+	if b.PartyOf(by) != PartyPoster {
+		return ErrNotTaskPoster
+	}
+	now := time.Now()
+	if err := b.open(now); err != nil {
+		return err
+	}
+	b.close(StatusRejected, now)
+	return nil
+}
+
+// Withdraw takes the bid back. The tasker can withdraw at any point of the
+// negotiation.
+func (b *Bid) Withdraw(by ids.UserID) error {
+	if b.PartyOf(by) != PartyTasker {
+		return ErrNotBidder
+	}
+	now := time.Now()
+	if err := b.open(now); err != nil {
+		return err
+	}
+	b.close(StatusWithdrawn, now)
+	return nil
+}
+
+func (b *Bid) open(now time.Time) error {
+	if !b.Status.IsOpen() {
+		return ErrBidClosed
+	}
+	if !now.Before(b.ExpiresAt) {
+		return ErrBidExpired
+	}
+	return nil
+}
+
+func (b *Bid) respondable(party Party, now time.Time) error {
+	if err := b.open(now); err != nil {
+		return err
+	}
+	if b.AwaitingParty() != party {
+		return ErrNotYourTurn
+	}
+	return nil
+}
+
+func (b *Bid) close(status Status, now time.Time) {
+	b.Status = status
+	b.ClosedAt = &now
+	b.UpdatedAt = now
+}
+
+func (b *Bid) propose(party Party, terms Terms, now time.Time) error {
+	if terms.Amount <= 0 {
+		return ErrInvalidAmount
+	}
+	currency := strings.ToUpper(strings.TrimSpace(terms.Currency))
+	if currency == "" {
+		currency = b.Currency
+	}
+	if currency != b.Currency {
+		return ErrCurrencyMismatch
+	}
+
+	var message *string
+	if terms.Message != nil {
+		trimmed := strings.TrimSpace(*terms.Message)
+		if len([]rune(trimmed)) > maxMessageLength {
+			return ErrMessageTooLong
+		}
+		if trimmed != "" {
+			message = &trimmed
+		}
+	}
+
+	if s := terms.Schedule; s != nil && (!s.StartAt.After(now) || !s.EndAt.After(s.StartAt)) {
+		return ErrInvalidSchedule
+	}
+
+	expiresAt := now.Add(DefaultOfferTTL)
+	if terms.ExpiresAt != nil {
+		ttl := terms.ExpiresAt.Sub(now)
+		if ttl < minOfferTTL || ttl > maxOfferTTL {
+			return ErrInvalidExpiry
+		}
+		expiresAt = *terms.ExpiresAt
+	}
+
+	b.Offers = append(b.Offers, Offer{
+		ID:        uuid.New(),
+		Party:     party,
+		Amount:    terms.Amount,
+		Currency:  currency,
+		Message:   message,
+		Schedule:  terms.Schedule,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	})
+	b.Status = StatusPending
+	if party == PartyPoster {
+		b.Status = StatusCountered
+	}
+	b.ExpiresAt = expiresAt
+	b.UpdatedAt = now
+	return nil
+}
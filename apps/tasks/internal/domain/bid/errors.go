@@ -0,0 +1,25 @@
+package bid
+
+import errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+
+var (
+	ErrBidNotFound          = errs.NewWithReason(errs.CodeNotFound, "BID_NOT_FOUND", "bid not found")
+	ErrSignInRequired       = errs.NewWithReason(errs.CodeUnauthorized, "BID_SIGN_IN_REQUIRED", "sign in to bid on tasks")
+	ErrTaskNotOpen          = errs.NewWithReason(errs.CodeFailedPrecondition, "BID_TASK_NOT_OPEN", "only open tasks accept bids")
+	ErrCannotBidOwnTask     = errs.NewWithReason(errs.CodeInvalidArgument, "BID_CANNOT_BID_OWN_TASK", "posters cannot bid on their own tasks")
+	ErrBidAlreadyPlaced     = errs.NewWithReason(errs.CodeAlreadyExists, "BID_ALREADY_PLACED", "you already have an open bid on this task")
+	ErrTaskAlreadyAwarded   = errs.NewWithReason(errs.CodeAlreadyExists, "BID_TASK_ALREADY_AWARDED", "another bid on this task has already been accepted")
+	ErrNotBidParticipant    = errs.NewWithReason(errs.CodeForbidden, "BID_NOT_BID_PARTICIPANT", "only the poster of the task or the bidding tasker can do this")
+	ErrNotTaskPoster        = errs.NewWithReason(errs.CodeForbidden, "BID_NOT_TASK_POSTER", "only the poster of the task can do this")
+	ErrNotBidder            = errs.NewWithReason(errs.CodeForbidden, "BID_NOT_BIDDER", "only the tasker who placed the bid can do this")
+	ErrNotYourTurn          = errs.NewWithReason(errs.CodeFailedPrecondition, "BID_NOT_YOUR_TURN", "the bid is waiting for the other party to respond")
+	ErrBidClosed            = errs.NewWithReason(errs.CodeFailedPrecondition, "BID_CLOSED", "the bid has already been accepted, rejected or withdrawn")
+	ErrBidExpired           = errs.NewWithReason(errs.CodeFailedPrecondition, "BID_EXPIRED", "the bid has expired")
+	ErrBidChanged           = errs.NewWithReason(errs.CodeAborted, "BID_CHANGED", "the bid changed while you were responding; reload it and try again")
+	ErrInvalidAmount        = errs.NewWithReason(errs.CodeInvalidArgument, "BID_INVALID_AMOUNT", "amount must be positive")
+	ErrCurrencyMismatch     = errs.NewWithReason(errs.CodeInvalidArgument, "BID_CURRENCY_MISMATCH", "bids must use the currency of the task budget")
+	ErrMessageTooLong       = errs.NewWithReason(errs.CodeInvalidArgument, "BID_MESSAGE_TOO_LONG", "message must be at most 1000 characters")
+	ErrInvalidSchedule      = errs.NewWithReason(errs.CodeInvalidArgument, "BID_INVALID_SCHEDULE", "a proposed schedule must start in the future and end after it starts")
+	ErrInvalidExpiry        = errs.NewWithReason(errs.CodeInvalidArgument, "BID_INVALID_EXPIRY", "an offer must expire between one hour and 30 days from now")
+	ErrTooManyCounterOffers = errs.NewWithReason(errs.CodeInvalidArgument, "BID_TOO_MANY_COUNTER_OFFERS", "too many counter-offers on this bid")
+)
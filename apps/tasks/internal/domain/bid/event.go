@@ -0,0 +1,36 @@
+package bid
+
+import (
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+)
+
+// BidAccepted is published once a bid has been accepted, its task assigned to
+// the tasker and the other open bids on the task rejected.
+type BidAccepted struct {
+	BidID          ids.BidID
+	TaskID         ids.TaskID
+	PosterID       ids.UserID
+	TaskerID       ids.UserID
+	Amount         int64
+	Currency       string
+	Schedule       *Schedule
+	RejectedBidIDs []ids.BidID
+	AcceptedAt     time.Time
+}
+
+func NewBidAccepted(b *Bid, rejected []ids.BidID) BidAccepted {
+	offer := b.CurrentOffer()
+	return BidAccepted{
+		BidID:          b.ID,
+		TaskID:         b.TaskID,
+		PosterID:       b.PosterID,
+		TaskerID:       b.TaskerID,
+		Amount:         offer.Amount,
+		Currency:       offer.Currency,
+		Schedule:       offer.Schedule,
+		RejectedBidIDs: rejected,
+		AcceptedAt:     *b.ClosedAt,
+	}
+}
@@ -0,0 +1,33 @@
+package bid
+
+import (
+	"context"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+)
+
+// BidRepository provides access to Bid aggregates.
+type BidRepository interface {
+	// FindByID retrieves a bid with all of its offers.
+	FindByID(ctx context.Context, id ids.BidID) (*Bid, error)
+
+	// ListByTask returns every bid on a task, oldest first.
+	ListByTask(ctx context.Context, taskID ids.TaskID) ([]*Bid, error)
+
+	// ListBookings returns the bookings of the taskers that overlap the
+	// window [start, end), by tasker then start. Accepted bids without a
+	// schedule book no time.
+	ListBookings(ctx context.Context, taskerIDs []ids.UserID, start, end time.Time) ([]Booking, error)
+
+	// Save persists a Bid aggregate (either creating or updating) and any new
+	// offers. It fails with ErrBidChanged when the stored bid moved past
+	// b.Version in the meantime.
+	Save(ctx context.Context, b *Bid) error
+
+	// SaveAccepted saves an accepted bid and, in the same transaction,
+	// rejects every other open bid on its task and assigns the task to the
+	// bid's tasker. It returns the IDs of the rejected bids, or
+	// ErrTaskNotOpen, saving nothing, when the task is no longer open.
+	SaveAccepted(ctx context.Context, b *Bid) ([]ids.BidID, error)
+}
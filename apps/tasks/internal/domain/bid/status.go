@@ -0,0 +1,40 @@
+package bid
+
+// Status is where a bid is in its negotiation:
+//
+//	PENDING ⇄ COUNTERED → ACCEPTED | REJECTED | WITHDRAWN | EXPIRED
+//
+// PENDING waits for the poster to respond to the tasker's latest offer,
+// COUNTERED waits for the tasker to respond to the poster's. The other
+// statuses are terminal.
+type Status string
+
+const (
+	StatusPending   Status = "PENDING"
+	StatusCountered Status = "COUNTERED"
+	StatusAccepted  Status = "ACCEPTED"
+	StatusRejected  Status = "REJECTED"
+	StatusWithdrawn Status = "WITHDRAWN"
+	StatusExpired   Status = "EXPIRED"
+)
+
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusPending, StatusCountered, StatusAccepted, StatusRejected, StatusWithdrawn, StatusExpired:
+		return true
+	}
+	return false
+}
+
+// IsOpen reports whether the bid is still being negotiated.
+func (s Status) IsOpen() bool {
+	return s == StatusPending || s == StatusCountered
+}
+
+// Party is a side of the negotiation.
+type Party string
+
+const (
+	PartyPoster Party = "POSTER"
+	PartyTasker Party = "TASKER"
+)
@@ -0,0 +1,280 @@
+package dispute
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+)
+
+const (
+	MaxEvidencePerParty = 10
+	maxReasonLength     = 2000
+	maxStatementLength  = 5000
+	maxNoteLength       = 2000
+)
+
+// Evidence is what one party submits to back their side: a statement and
+// the files that support it.
+type Evidence struct {
+	ID          ids.EvidenceID
+	SubmittedBy ids.UserID
+	Statement   string
+	Attachments []ticket.Attachment
+	CreatedAt   time.Time
+}
+
+// Decision is an admin's ruling on a dispute. RefundAmount is only set for
+// partial refunds, in minor units of Currency.
+type Decision struct {
+	Outcome      Outcome
+	RefundAmount *int64
+	Note         string
+	DecidedBy    ids.UserID
+	DecidedAt    time.Time
+}
+
+// Dispute is a disagreement between the poster and the tasker of a task
+// about the work or the payment held for it. The payment is the task's
+// escrow in the payments service, which keeps one escrow per task. A dispute
+// is tracked by a DISPUTE ticket so support works it like any other request.
+// Evidence is append-only: the repository writes the pending pieces with
+// the dispute.
+type Dispute struct {
+	ID         ids.DisputeID
+	TaskID     ids.TaskID
+	TicketID   ids.TicketID
+	PosterID   ids.UserID
+	TaskerID   ids.UserID
+	OpenedBy   ids.UserID
+	Reason     string
+	Currency   string
+	Status     Status
+	ReviewerID *ids.UserID
+	Evidence   []Evidence
+	Decision   *Decision
+	ClosedAt   *time.Time
+	Version    int32
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+
+	pendingEvidence []Evidence
+}
+
+// NewDispute opens a dispute about t on behalf of one of its parties, tracked
+// by ticketID. Only tasks with a tasker and money at stake can be disputed.
+func NewDispute(t *task.Task, by task.Actor, reason string, ticketID ids.TicketID) (*Dispute, error) {
+	if by.UserID == "" {
+		return nil, ErrSignInRequired
+	}
+	if !t.IsPoster(by) && !t.IsAssignee(by) {
+		return nil, ErrNotTaskParticipant
+	}
+	switch t.Status {
+	case task.StatusAssigned, task.StatusInProgress, task.StatusCompleted:
+	default:
+		return nil, ErrTaskNotDisputable
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" || len([]rune(reason)) > maxReasonLength {
+		return nil, ErrInvalidReason
+	}
+
+	now := time.Now()
+	return &Dispute{
+		ID:        ids.NewDisputeID(),
+		TaskID:    t.ID,
+		TicketID:  ticketID,
+		PosterID:  t.PosterID,
+		TaskerID:  *t.AssigneeID,
+		OpenedBy:  by.UserID,
+		Reason:    reason,
+		Currency:  t.Budget.Currency,
+		Status:    StatusOpen,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func NewDisputeFromRepository(
+	id string,
+	taskID string,
+	ticketID string,
+	posterID string,
+	taskerID string,
+	openedBy string,
+	reason string,
+	currency string,
+	status Status,
+	reviewerID *ids.UserID,
+	evidence []Evidence,
+	decision *Decision,
+	closedAt *time.Time,
+	version int32,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Dispute {
+	return &Dispute{
+		ID:         ids.DisputeID(id),
+		TaskID:     ids.TaskID(taskID),
+		TicketID:   ids.TicketID(ticketID),
+		PosterID:   ids.UserID(posterID),
+		TaskerID:   ids.UserID(taskerID),
+		OpenedBy:   ids.UserID(openedBy),
+		Reason:     reason,
+		Currency:   currency,
+		Status:     status,
+		ReviewerID: reviewerID,
+		Evidence:   evidence,
+		Decision:   decision,
+		ClosedAt:   closedAt,
+		Version:    version,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
+
+// IsParty reports whether the actor is the poster or the tasker.
+func (d *Dispute) IsParty(a task.Actor) bool {
+	return a.UserID != "" && (a.UserID == d.PosterID || a.UserID == d.TaskerID)
+}
+
+// CanView reports whether the actor may see the dispute: both parties and
+// admins. Each party sees the other's evidence.
+func (d *Dispute) CanView(a task.Actor) bool {
+	return a.IsAdmin || d.IsParty(a)
+}
+
+// SubmitEvidence adds a statement from one of the parties while the dispute
+// waits for a decision.
+func (d *Dispute) SubmitEvidence(by task.Actor, statement string, attachments []ticket.Attachment) (*Evidence, error) {
+	if by.UserID == "" {
+		return nil, ErrSignInRequired
+	}
+	if !d.IsParty(by) {
+		return nil, ErrNotTaskParticipant
+	}
+	if !d.Status.IsPending() {
+		return nil, ErrDisputeClosed
+	}
+	statement = strings.TrimSpace(statement)
+	if statement == "" || len([]rune(statement)) > maxStatementLength {
+		return nil, ErrInvalidStatement
+	}
+	atts, err := ticket.NormalizeAttachments(attachments)
+	if err != nil {
+		return nil, err
+	}
+	submitted := 0
+	for _, e := range d.Evidence {
+		if e.SubmittedBy == by.UserID {
+			submitted++
+		}
+	}
+	if submitted >= MaxEvidencePerParty {
+		return nil, ErrTooMuchEvidence
+	}
+
+	now := time.Now()
+	e := Evidence{
+		ID:          ids.NewEvidenceID(),
+		SubmittedBy: by.UserID,
+		Statement:   statement,
+		Attachments: atts,
+		CreatedAt:   now,
+	}
+	d.Evidence = append(d.Evidence, e)
+	d.pendingEvidence = append(d.pendingEvidence, e)
+	d.UpdatedAt = now
+	return &e, nil
+}
+
+// StartReview puts the dispute in front of the reviewing admin. Evidence
+// can still be added until the decision.
+func (d *Dispute) StartReview(by task.Actor) error {
+	if !by.IsAdmin {
+		return ErrAdminOnly
+	}
+	switch d.Status {
+	case StatusOpen:
+	case StatusUnderReview:
+		return ErrAlreadyUnderReview
+	default:
+		return ErrDisputeClosed
+	}
+	d.Status = StatusUnderReview
+	d.ReviewerID = &by.UserID
+	d.UpdatedAt = time.Now()
+	return nil
+}
+
+// Decide records the admin's ruling and returns the event that asks the
+// payments side to settle the escrow accordingly.
+func (d *Dispute) Decide(by task.Actor, outcome Outcome, refundAmount *int64, note string) (DisputeResolved, error) {
+	if !by.IsAdmin {
+		return DisputeResolved{}, ErrAdminOnly
+	}
+	switch d.Status {
+	case StatusUnderReview:
+	case StatusOpen:
+		return DisputeResolved{}, ErrNotUnderReview
+	default:
+		return DisputeResolved{}, ErrDisputeClosed
+	}
+	if !outcome.IsValid() {
+		return DisputeResolved{}, ErrInvalidOutcome
+	}
+	if (outcome == OutcomePartialRefund) != (refundAmount != nil) || (refundAmount != nil && *refundAmount <= 0) {
+		return DisputeResolved{}, ErrInvalidRefundAmount
+	}
+	note = strings.TrimSpace(note)
+	if note == "" || len([]rune(note)) > maxNoteLength {
+		return DisputeResolved{}, ErrInvalidDecisionNote
+	}
+
+	now := time.Now()
+	d.Decision = &Decision{
+		Outcome:      outcome,
+		RefundAmount: refundAmount,
+		Note:         note,
+		DecidedBy:    by.UserID,
+		DecidedAt:    now,
+	}
+	d.Status = StatusResolved
+	d.ClosedAt = &now
+	d.UpdatedAt = now
+	return NewDisputeResolved(d), nil
+}
+
+// Withdraw drops the dispute before a decision; the escrow is left as it
+// is.
+func (d *Dispute) Withdraw(by task.Actor) error {
+	if by.UserID == "" {
+		return ErrSignInRequired
+	}
+	if by.UserID != d.OpenedBy {
+		return ErrNotOpener
+	}
+	if !d.Status.IsPending() {
+		return ErrDisputeClosed
+	}
+	now := time.Now()
+	d.Status = StatusWithdrawn
+	d.ClosedAt = &now
+	d.UpdatedAt = now
+	return nil
+}
+
+// PendingEvidence is the evidence submitted since the dispute was loaded;
+// the repository writes it with the dispute.
+func (d *Dispute) PendingEvidence() []Evidence {
+	return d.pendingEvidence
+}
+
+// ClearPendingEvidence is called by the repository once the evidence is
+// written.
+func (d *Dispute) ClearPendingEvidence() {
+	d.pendingEvidence = nil
+}
@@ -0,0 +1,25 @@
+package dispute
+
+import errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+
+var (
+	ErrDisputeNotFound      = errs.NewWithReason(errs.CodeNotFound, "DISPUTE_NOT_FOUND", "dispute not found")
+	ErrSignInRequired       = errs.NewWithReason(errs.CodeUnauthorized, "DISPUTE_SIGN_IN_REQUIRED", "sign in to manage disputes")
+	ErrNotTaskParticipant   = errs.NewWithReason(errs.CodeForbidden, "DISPUTE_NOT_TASK_PARTICIPANT", "only the poster and the assigned tasker can do this")
+	ErrAdminOnly            = errs.NewWithReason(errs.CodeForbidden, "DISPUTE_ADMIN_ONLY", "only admins can review and decide disputes")
+	ErrNotOpener            = errs.NewWithReason(errs.CodeForbidden, "DISPUTE_NOT_OPENER", "only the user who opened the dispute can withdraw it")
+	ErrTaskNotDisputable    = errs.NewWithReason(errs.CodeFailedPrecondition, "DISPUTE_TASK_NOT_DISPUTABLE", "only assigned, in-progress or completed tasks can be disputed")
+	ErrDisputeAlreadyOpen   = errs.NewWithReason(errs.CodeAlreadyExists, "DISPUTE_ALREADY_OPEN", "the task already has a dispute waiting for a decision")
+	ErrInvalidReason        = errs.NewWithReason(errs.CodeInvalidArgument, "DISPUTE_INVALID_REASON", "reason is required and must be at most 2000 characters")
+	ErrInvalidStatement     = errs.NewWithReason(errs.CodeInvalidArgument, "DISPUTE_INVALID_STATEMENT", "statement is required and must be at most 5000 characters")
+	ErrTooMuchEvidence      = errs.NewWithReason(errs.CodeInvalidArgument, "DISPUTE_TOO_MUCH_EVIDENCE", "each party can submit at most 10 pieces of evidence")
+	ErrDisputeClosed        = errs.NewWithReason(errs.CodeFailedPrecondition, "DISPUTE_CLOSED", "the dispute has already been decided or withdrawn")
+	ErrNotUnderReview       = errs.NewWithReason(errs.CodeFailedPrecondition, "DISPUTE_NOT_UNDER_REVIEW", "disputes are decided once they are under review")
+	ErrAlreadyUnderReview   = errs.NewWithReason(errs.CodeFailedPrecondition, "DISPUTE_ALREADY_UNDER_REVIEW", "the dispute is already under review")
+	ErrInvalidStatus        = errs.NewWithReason(errs.CodeInvalidArgument, "DISPUTE_INVALID_STATUS", "status must be OPEN, UNDER_REVIEW, RESOLVED or WITHDRAWN")
+	ErrInvalidOutcome       = errs.NewWithReason(errs.CodeInvalidArgument, "DISPUTE_INVALID_OUTCOME", "outcome must be REFUND, PARTIAL_REFUND or RELEASE")
+	ErrInvalidRefundAmount  = errs.NewWithReason(errs.CodeInvalidArgument, "DISPUTE_INVALID_REFUND_AMOUNT", "partial refunds need a positive amount; other outcomes take none")
+	ErrInvalidDecisionNote  = errs.NewWithReason(errs.CodeInvalidArgument, "DISPUTE_INVALID_DECISION_NOTE", "decision note is required and must be at most 2000 characters")
+	ErrDisputeChanged       = errs.NewWithReason(errs.CodeAborted, "DISPUTE_CHANGED", "the dispute changed while you were editing it; reload it and try again")
+	ErrDisputeListForbidden = errs.NewWithReason(errs.CodeForbidden, "DISPUTE_LIST_FORBIDDEN", "only admins can list disputes of other users")
+)
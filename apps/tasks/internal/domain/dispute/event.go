@@ -0,0 +1,38 @@
+package dispute
+
+import (
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+)
+
+// DisputeResolved is published once an admin decided a dispute. The payments
+// side settles the task's escrow from it: a refund, a partial refund with
+// the rest released, or a release.
+type DisputeResolved struct {
+	DisputeID    ids.DisputeID
+	TaskID       ids.TaskID
+	TicketID     ids.TicketID
+	PosterID     ids.UserID
+	TaskerID     ids.UserID
+	Outcome      Outcome
+	RefundAmount *int64
+	Currency     string
+	DecidedBy    ids.UserID
+	DecidedAt    time.Time
+}
+
+func NewDisputeResolved(d *Dispute) DisputeResolved {
+	return DisputeResolved{
+		DisputeID:    d.ID,
+		TaskID:       d.TaskID,
+		TicketID:     d.TicketID,
+		PosterID:     d.PosterID,
+		TaskerID:     d.TaskerID,
+		Outcome:      d.Decision.Outcome,
+		RefundAmount: d.Decision.RefundAmount,
+		Currency:     d.Currency,
+		DecidedBy:    d.Decision.DecidedBy,
+		DecidedAt:    d.Decision.DecidedAt,
+	}
+}
@@ -0,0 +1,51 @@
+package dispute
+
+import (
+	"context"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+)
+
+// ListCursor is the keyset position of the last dispute of a page.
+type ListCursor struct {
+	CreatedAt time.Time
+	DisputeID ids.DisputeID
+}
+
+// ListCriteria filters disputes, newest first. Every filter is optional.
+type ListCriteria struct {
+	// PartyID keeps the disputes the user is the poster or the tasker of.
+	PartyID *ids.UserID
+	TaskID  *ids.TaskID
+	Status  *Status
+	After   *ListCursor
+	Limit   int
+}
+
+// DisputeRepository provides access to Dispute aggregates.
+type DisputeRepository interface {
+	// FindByID retrieves a dispute with its evidence.
+	FindByID(ctx context.Context, id ids.DisputeID) (*Dispute, error)
+
+	// List returns up to criteria.Limit disputes matching the criteria,
+	// without their evidence.
+	List(ctx context.Context, criteria ListCriteria) ([]*Dispute, error)
+
+	// Save persists a Dispute aggregate (either creating or updating) with
+	// its pending evidence and, in the same transaction, its ticket when t
+	// is not nil. It fails with ErrDisputeAlreadyOpen when the task already
+	// has a pending dispute, with ErrDisputeChanged when the stored dispute
+	// moved past d.Version, and with ticket.ErrTicketChanged when the
+	// ticket did.
+	Save(ctx context.Context, d *Dispute, t *ticket.Ticket) error
+
+	// ListUnsettled returns up to limit resolved disputes decided before
+	// decidedBefore whose escrow has not been settled yet, oldest first.
+	ListUnsettled(ctx context.Context, decidedBefore time.Time, limit int) ([]*Dispute, error)
+
+	// MarkSettled records that the escrow of a resolved dispute has been
+	// settled, so it is not settled again.
+	MarkSettled(ctx context.Context, id ids.DisputeID, at time.Time) error
+}
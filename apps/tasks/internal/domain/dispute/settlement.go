@@ -0,0 +1,10 @@
+package dispute
+
+import "context"
+
+// Settler moves the escrowed money of a task the way a dispute was decided.
+// It is implemented by the payments service, which settles each dispute at
+// most once, so delivering the same decision twice is safe.
+type Settler interface {
+	Settle(ctx context.Context, resolved DisputeResolved) error
+}
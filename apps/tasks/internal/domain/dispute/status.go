@@ -0,0 +1,51 @@
+package dispute
+
+// Status is where a dispute is in the decision workflow:
+//
+//	OPEN → UNDER_REVIEW → RESOLVED
+//	OPEN | UNDER_REVIEW → WITHDRAWN
+//
+// Both parties add evidence until an admin decides. Whoever opened the
+// dispute can withdraw it before then.
+type Status string
+
+const (
+	StatusOpen        Status = "OPEN"
+	StatusUnderReview Status = "UNDER_REVIEW"
+	StatusResolved    Status = "RESOLVED"
+	StatusWithdrawn   Status = "WITHDRAWN"
+)
+
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusOpen, StatusUnderReview, StatusResolved, StatusWithdrawn:
+		return true
+	}
+	return false
+}
+
+// IsPending reports whether the dispute still waits for a decision.
+func (s Status) IsPending() bool {
+	return s == StatusOpen || s == StatusUnderReview
+}
+
+// Outcome is what happens to the money held in escrow for the task.
+type Outcome string
+
+const (
+	// OutcomeRefund returns everything still held to the poster.
+	OutcomeRefund Outcome = "REFUND"
+	// OutcomePartialRefund returns part to the poster and pays the rest
+	// out to the tasker.
+	OutcomePartialRefund Outcome = "PARTIAL_REFUND"
+	// OutcomeRelease pays everything still held out to the tasker.
+	OutcomeRelease Outcome = "RELEASE"
+)
+
+func (o Outcome) IsValid() bool {
+	switch o {
+	case OutcomeRefund, OutcomePartialRefund, OutcomeRelease:
+		return true
+	}
+	return false
+}
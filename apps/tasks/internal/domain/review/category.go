@@ -0,0 +1,35 @@
+package review
+
+import "slices"
+
+// Category is an aspect of the work together a review can score on its own,
+// next to the overall star rating.
+type Category string
+
+const (
+	CategoryQuality         Category = "QUALITY"
+	CategoryPunctuality     Category = "PUNCTUALITY"
+	CategoryCommunication   Category = "COMMUNICATION"
+	CategoryProfessionalism Category = "PROFESSIONALISM"
+	// CategoryClarity is how well the poster described the task.
+	CategoryClarity Category = "CLARITY"
+	// CategoryPayment is how promptly and fairly the poster paid.
+	CategoryPayment Category = "PAYMENT"
+)
+
+var categories = map[Role][]Category{
+	RoleTasker: {CategoryQuality, CategoryPunctuality, CategoryCommunication, CategoryProfessionalism},
+	RolePoster: {CategoryClarity, CategoryCommunication, CategoryPayment},
+}
+
+// CategoriesFor returns the categories a review of a user on the given side
+// of the task can score.
+func CategoriesFor(reviewee Role) []Category {
+	return slices.Clone(categories[reviewee])
+}
+
+// AppliesTo reports whether reviews of a user on the given side of the task
+// can score the category.
+func (c Category) AppliesTo(reviewee Role) bool {
+	return slices.Contains(categories[reviewee], c)
+}
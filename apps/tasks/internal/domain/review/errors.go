@@ -0,0 +1,26 @@
+package review
+
+import errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+
+var (
+	ErrReviewNotFound            = errs.NewWithReason(errs.CodeNotFound, "REVIEW_NOT_FOUND", "review not found")
+	ErrSignInRequired            = errs.NewWithReason(errs.CodeUnauthorized, "REVIEW_SIGN_IN_REQUIRED", "sign in to review tasks")
+	ErrTaskNotCompleted          = errs.NewWithReason(errs.CodeFailedPrecondition, "REVIEW_TASK_NOT_COMPLETED", "only completed tasks can be reviewed")
+	ErrNotTaskParticipant        = errs.NewWithReason(errs.CodeForbidden, "REVIEW_NOT_TASK_PARTICIPANT", "only the poster and the assigned tasker can review a task")
+	ErrAlreadyReviewed           = errs.NewWithReason(errs.CodeAlreadyExists, "REVIEW_ALREADY_REVIEWED", "you already reviewed this task")
+	ErrNotReviewer               = errs.NewWithReason(errs.CodeForbidden, "REVIEW_NOT_REVIEWER", "only the author can edit a review")
+	ErrNotReviewee               = errs.NewWithReason(errs.CodeForbidden, "REVIEW_NOT_REVIEWEE", "only the reviewed user can do this")
+	ErrModeratorOnly             = errs.NewWithReason(errs.CodeForbidden, "REVIEW_MODERATOR_ONLY", "only admins can moderate reviews")
+	ErrEditWindowClosed          = errs.NewWithReason(errs.CodeFailedPrecondition, "REVIEW_EDIT_WINDOW_CLOSED", "the edit window has closed")
+	ErrReviewRemoved             = errs.NewWithReason(errs.CodeFailedPrecondition, "REVIEW_REMOVED", "the review was removed by a moderator")
+	ErrInvalidRating             = errs.NewWithReason(errs.CodeInvalidArgument, "REVIEW_INVALID_RATING", "ratings must be between 1 and 5 stars")
+	ErrInvalidCategory           = errs.NewWithReason(errs.CodeInvalidArgument, "REVIEW_INVALID_CATEGORY", "the category does not apply to this review")
+	ErrDuplicateCategory         = errs.NewWithReason(errs.CodeInvalidArgument, "REVIEW_DUPLICATE_CATEGORY", "each category can only be scored once")
+	ErrCommentTooLong            = errs.NewWithReason(errs.CodeInvalidArgument, "REVIEW_COMMENT_TOO_LONG", "comment must be at most 2000 characters")
+	ErrInvalidReply              = errs.NewWithReason(errs.CodeInvalidArgument, "REVIEW_INVALID_REPLY", "reply must be between 1 and 1000 characters")
+	ErrInvalidReason             = errs.NewWithReason(errs.CodeInvalidArgument, "REVIEW_INVALID_REASON", "reason must be between 1 and 500 characters")
+	ErrInvalidModerationDecision = errs.NewWithReason(errs.CodeInvalidArgument, "REVIEW_INVALID_MODERATION_DECISION", "moderators can only publish or remove a review")
+	ErrReviewChanged             = errs.NewWithReason(errs.CodeAborted, "REVIEW_CHANGED", "the review changed while you were editing it; reload it and try again")
+	ErrInvalidRole               = errs.NewWithReason(errs.CodeInvalidArgument, "REVIEW_INVALID_ROLE", "role must be POSTER or TASKER")
+	ErrReputationNotFound        = errs.NewWithReason(errs.CodeNotFound, "REVIEW_REPUTATION_NOT_FOUND", "reputation not found")
+)
@@ -0,0 +1,49 @@
+package review
+
+import (
+	"context"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+)
+
+// ListCursor is the keyset position of the last review of a page.
+type ListCursor struct {
+	CreatedAt time.Time
+	ReviewID  ids.ReviewID
+}
+
+// ListCriteria filters the reviews a user received, newest first.
+type ListCriteria struct {
+	RevieweeID     ids.UserID
+	RevieweeRole   *Role
+	IncludeRemoved bool
+	After          *ListCursor
+	Limit          int
+}
+
+// ReviewRepository provides access to Review aggregates.
+type ReviewRepository interface {
+	// FindByID retrieves a review with its category scores.
+	FindByID(ctx context.Context, id ids.ReviewID) (*Review, error)
+
+	// ListByTask returns the reviews written about a task, oldest first.
+	ListByTask(ctx context.Context, taskID ids.TaskID) ([]*Review, error)
+
+	// List returns up to criteria.Limit reviews matching the criteria.
+	List(ctx context.Context, criteria ListCriteria) ([]*Review, error)
+
+	// Save persists a Review aggregate (either creating or updating) and, in
+	// the same transaction, recalculates the reputation of the reviewed
+	// user. It fails with ErrAlreadyReviewed when the author already
+	// reviewed the task, and with ErrReviewChanged when the stored review
+	// moved past r.Version in the meantime.
+	Save(ctx context.Context, r *Review) error
+}
+
+// ReputationRepository reads the reputations ReviewRepository maintains.
+type ReputationRepository interface {
+	// FindByUserID fails with ErrReputationNotFound when the user was never
+	// reviewed.
+	FindByUserID(ctx context.Context, userID ids.UserID) (*Reputation, error)
+}
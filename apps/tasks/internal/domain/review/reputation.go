@@ -0,0 +1,111 @@
+package review
+
+import (
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+)
+
+const (
+	// PriorMean and PriorWeight shape the Bayesian average: every user is
+	// treated as if they started with PriorWeight reviews of PriorMean stars,
+	// so a single five-star review does not outrank a long good record.
+	PriorMean   = 4.0
+	PriorWeight = 5
+	// RecentWindow is how many of the latest reviews the trend compares
+	// against the reviews before them.
+	RecentWindow = 10
+	// trendThreshold is how many stars the recent average has to move
+	// before the trend is reported as up or down.
+	trendThreshold = 0.25
+)
+
+// Trend is where a user's ratings are heading.
+type Trend string
+
+const (
+	TrendUp     Trend = "UP"
+	TrendDown   Trend = "DOWN"
+	TrendStable Trend = "STABLE"
+)
+
+// Stats are the sums a Reputation is derived from, over the reviews that
+// count towards it. Recent* cover the latest RecentWindow reviews.
+type Stats struct {
+	ReviewCount int
+	RatingSum   int64
+	RecentCount int
+	RecentSum   int64
+	ScoreCounts map[Category]int
+	ScoreSums   map[Category]int64
+}
+
+// Reputation summarises the reviews a user received. It is recalculated
+// whenever one of those reviews is written, edited or moderated.
+type Reputation struct {
+	UserID          ids.UserID
+	ReviewCount     int
+	AverageRating   float64
+	BayesianAverage float64
+	RecentAverage   float64
+	Trend           Trend
+	// CategoryAverages holds the average score of every category that was
+	// scored at least once.
+	CategoryAverages map[Category]float64
+	UpdatedAt        time.Time
+}
+
+func NewReputation(userID ids.UserID, s Stats, now time.Time) *Reputation {
+	r := &Reputation{
+		UserID:           userID,
+		ReviewCount:      s.ReviewCount,
+		BayesianAverage:  (PriorMean*PriorWeight + float64(s.RatingSum)) / float64(PriorWeight+s.ReviewCount),
+		Trend:            TrendStable,
+		CategoryAverages: make(map[Category]float64, len(s.ScoreCounts)),
+		UpdatedAt:        now,
+	}
+	if s.ReviewCount > 0 {
+		r.AverageRating = float64(s.RatingSum) / float64(s.ReviewCount)
+	}
+	if s.RecentCount > 0 {
+		r.RecentAverage = float64(s.RecentSum) / float64(s.RecentCount)
+	}
+	// The trend needs older reviews to compare the recent ones against.
+	if older := s.ReviewCount - s.RecentCount; older > 0 && s.RecentCount > 0 {
+		delta := r.RecentAverage - float64(s.RatingSum-s.RecentSum)/float64(older)
+		switch {
+		case delta >= trendThreshold:
+			r.Trend = TrendUp
+		case delta <= -trendThreshold:
+			r.Trend = TrendDown
+		}
+	}
+	for category, count := range s.ScoreCounts {
+		if count > 0 {
+			r.CategoryAverages[category] = float64(s.ScoreSums[category]) / float64(count)
+		}
+	}
+	return r
+}
+
+func NewReputationFromRepository(
+	userID string,
+	reviewCount int,
+	averageRating float64,
+	bayesianAverage float64,
+	recentAverage float64,
+	trend Trend,
+	categoryAverages map[Category]float64,
+	updatedAt time.Time,
+) *Reputation {
+	return &Reputation{
+		UserID:           ids.UserID(userID),
+		ReviewCount:      reviewCount,
+		AverageRating:    averageRating,
+		BayesianAverage:  bayesianAverage,
+		RecentAverage:    recentAverage,
+		Trend:            trend,
+		CategoryAverages: categoryAverages,
+		UpdatedAt:        updatedAt,
+	}
+}
@@ -0,0 +1,256 @@
+package review
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+)
+
+const (
+	MinRating = 1
+	MaxRating = 5
+	// EditWindow is how long a review, and a reply to it, can be changed
+	// after it was first written.
+	EditWindow       = 7 * 24 * time.Hour
+	maxCommentLength = 2000
+	maxReplyLength   = 1000
+	maxReasonLength  = 500
+)
+
+// Content is what the author of a review writes. Scores are optional and
+// keyed by the categories that apply to the reviewed user.
+type Content struct {
+	Rating  int
+	Scores  map[Category]int
+	Comment *string
+}
+
+// Reply is the reviewed user's public answer to a review.
+type Reply struct {
+	Body      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Review is what one party of a completed task says about the other. Each
+// party reviews a task at most once. Version guards against an edit racing
+// a moderation decision.
+type Review struct {
+	ID             ids.ReviewID
+	TaskID         ids.TaskID
+	ReviewerID     ids.UserID
+	RevieweeID     ids.UserID
+	RevieweeRole   Role
+	Rating         int
+	Scores         map[Category]int
+	Comment        *string
+	Reply          *Reply
+	Status         Status
+	FlagReason     *string
+	ModerationNote *string
+	ModeratedAt    *time.Time
+	Version        int32
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// NewReview writes reviewerID's review of the other party of a completed
+// task.
+func NewReview(taskID ids.TaskID, posterID ids.UserID, taskerID ids.UserID, reviewerID ids.UserID, content Content) (*Review, error) {
+	r := &Review{
+		ID:         ids.NewReviewID(),
+		TaskID:     taskID,
+		ReviewerID: reviewerID,
+		Status:     StatusPublished,
+	}
+	switch {
+	case reviewerID == "":
+		return nil, ErrNotTaskParticipant
+	case reviewerID == posterID:
+		r.RevieweeID, r.RevieweeRole = taskerID, RoleTasker
+	case reviewerID == taskerID:
+		r.RevieweeID, r.RevieweeRole = posterID, RolePoster
+	default:
+		return nil, ErrNotTaskParticipant
+	}
+	now := time.Now()
+	if err := r.apply(content, now); err != nil {
+		return nil, err
+	}
+	r.CreatedAt = now
+	return r, nil
+}
+
+func NewReviewFromRepository(
+	id string,
+	taskID string,
+	reviewerID string,
+	revieweeID string,
+	revieweeRole Role,
+	rating int,
+	scores map[Category]int,
+	comment *string,
+	reply *Reply,
+	status Status,
+	flagReason *string,
+	moderationNote *string,
+	moderatedAt *time.Time,
+	version int32,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Review {
+	return &Review{
+		ID:             ids.ReviewID(id),
+		TaskID:         ids.TaskID(taskID),
+		ReviewerID:     ids.UserID(reviewerID),
+		RevieweeID:     ids.UserID(revieweeID),
+		RevieweeRole:   revieweeRole,
+		Rating:         rating,
+		Scores:         scores,
+		Comment:        comment,
+		Reply:          reply,
+		Status:         status,
+		FlagReason:     flagReason,
+		ModerationNote: moderationNote,
+		ModeratedAt:    moderatedAt,
+		Version:        version,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}
+}
+
+// EditableUntil is when the author can no longer change the review.
+func (r *Review) EditableUntil() time.Time {
+	return r.CreatedAt.Add(EditWindow)
+}
+
+// CanView reports whether the user may see the review. Reviews are public
+// until a moderator removes them.
+func (r *Review) CanView(userID ids.UserID, isAdmin bool) bool {
+	return r.Status != StatusRemoved || isAdmin || (userID != "" && userID == r.ReviewerID)
+}
+
+// CountsTowardsReputation reports whether the rating is part of the
+// reviewed user's reputation.
+func (r *Review) CountsTowardsReputation() bool {
+	return r.Status != StatusRemoved
+}
+
+// Edit replaces the content of the review within EditWindow of writing it.
+func (r *Review) Edit(by ids.UserID, content Content) error {
+	if by == "" || by != r.ReviewerID {
+		return ErrNotReviewer
+	}
+	if r.Status == StatusRemoved {
+		return ErrReviewRemoved
+	}
+	now := time.Now()
+	if !now.Before(r.EditableUntil()) {
+		return ErrEditWindowClosed
+	}
+	return r.apply(content, now)
+}
+
+// Respond writes or rewrites the reviewed user's reply. A reply can be
+// rewritten within EditWindow of first writing it.
+func (r *Review) Respond(by ids.UserID, body string) error {
+	if by == "" || by != r.RevieweeID {
+		return ErrNotReviewee
+	}
+	if r.Status == StatusRemoved {
+		return ErrReviewRemoved
+	}
+	body = strings.TrimSpace(body)
+	if body == "" || len([]rune(body)) > maxReplyLength {
+		return ErrInvalidReply
+	}
+	now := time.Now()
+	if r.Reply == nil {
+		r.Reply = &Reply{Body: body, CreatedAt: now, UpdatedAt: now}
+	} else {
+		if !now.Before(r.Reply.CreatedAt.Add(EditWindow)) {
+			return ErrEditWindowClosed
+		}
+		r.Reply.Body = body
+		r.Reply.UpdatedAt = now
+	}
+	r.UpdatedAt = now
+	return nil
+}
+
+// Flag asks a moderator to look at the review. Only the reviewed user can
+// flag it; the review stays visible until a moderator decides.
+func (r *Review) Flag(by ids.UserID, reason string) error {
+	if by == "" || by != r.RevieweeID {
+		return ErrNotReviewee
+	}
+	if r.Status == StatusRemoved {
+		return ErrReviewRemoved
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" || len([]rune(reason)) > maxReasonLength {
+		return ErrInvalidReason
+	}
+	r.Status = StatusFlagged
+	r.FlagReason = &reason
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+// Moderate records a moderator's decision to publish the review (again) or
+// to remove it. The caller checks that the actor is a moderator.
+func (r *Review) Moderate(decision Status, note *string) error {
+	if decision != StatusPublished && decision != StatusRemoved {
+		return ErrInvalidModerationDecision
+	}
+	if note != nil {
+		trimmed := strings.TrimSpace(*note)
+		if len([]rune(trimmed)) > maxReasonLength {
+			return ErrInvalidReason
+		}
+		note = nil
+		if trimmed != "" {
+			note = &trimmed
+		}
+	}
+	now := time.Now()
+	r.Status = decision
+	r.ModerationNote = note
+	r.ModeratedAt = &now
+	r.UpdatedAt = now
+	return nil
+}
+
+func (r *Review) apply(c Content, now time.Time) error {
+	if c.Rating < MinRating || c.Rating > MaxRating {
+		return ErrInvalidRating
+	}
+	scores := make(map[Category]int, len(c.Scores))
+	for category, score := range c.Scores {
+		if !category.AppliesTo(r.RevieweeRole) {
+			return ErrInvalidCategory
+		}
+		if score < MinRating || score > MaxRating {
+			return ErrInvalidRating
+		}
+		scores[category] = score
+	}
+
+	var comment *string
+	if c.Comment != nil {
+		trimmed := strings.TrimSpace(*c.Comment)
+		if len([]rune(trimmed)) > maxCommentLength {
+			return ErrCommentTooLong
+		}
+		if trimmed != "" {
+			comment = &trimmed
+		}
+	}
+
+	r.Rating = c.Rating
+	r.Scores = scores
+	r.Comment = comment
+	r.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,37 @@
+package review
+
+// Status is the moderation state of a review:
+//
+//	PUBLISHED ⇄ FLAGGED, PUBLISHED | FLAGGED ⇄ REMOVED
+//
+// The reviewed user can flag a review for moderation; it stays visible until
+// a moderator publishes it again or removes it. Removed reviews are hidden
+// from everyone but their author and admins, and do not count towards the
+// reputation of the reviewed user.
+type Status string
+
+const (
+	StatusPublished Status = "PUBLISHED"
+	StatusFlagged   Status = "FLAGGED"
+	StatusRemoved   Status = "REMOVED"
+)
+
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusPublished, StatusFlagged, StatusRemoved:
+		return true
+	}
+	return false
+}
+
+// Role is the side of the task a user was on.
+type Role string
+
+const (
+	RolePoster Role = "POSTER"
+	RoleTasker Role = "TASKER"
+)
+
+func (r Role) IsValid() bool {
+	return r == RolePoster || r == RoleTasker
+}
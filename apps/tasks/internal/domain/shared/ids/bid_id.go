@@ -0,0 +1,9 @@
+package ids
+
+import "github.com/pratchaya-maneechot/service-exchange/libs/utils"
+
+type BidID string
+
+func NewBidID() BidID {
+	return BidID(utils.UUID())
+}
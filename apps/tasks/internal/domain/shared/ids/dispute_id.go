@@ -0,0 +1,15 @@
+package ids
+
+import "github.com/pratchaya-maneechot/service-exchange/libs/utils"
+
+type DisputeID string
+
+func NewDisputeID() DisputeID {
+	return DisputeID(utils.UUID())
+}
+
+type EvidenceID string
+
+func NewEvidenceID() EvidenceID {
+	return EvidenceID(utils.UUID())
+}
@@ -0,0 +1,9 @@
+package ids
+
+import "github.com/pratchaya-maneechot/service-exchange/libs/utils"
+
+type ReviewID string
+
+func NewReviewID() ReviewID {
+	return ReviewID(utils.UUID())
+}
@@ -0,0 +1,9 @@
+package ids
+
+import "github.com/pratchaya-maneechot/service-exchange/libs/utils"
+
+type TaskID string
+
+func NewTaskID() TaskID {
+	return TaskID(utils.UUID())
+}
@@ -0,0 +1,15 @@
+package ids
+
+import "github.com/pratchaya-maneechot/service-exchange/libs/utils"
+
+type TicketID string
+
+func NewTicketID() TicketID {
+	return TicketID(utils.UUID())
+}
+
+type MessageID string
+
+func NewMessageID() MessageID {
+	return MessageID(utils.UUID())
+}
@@ -0,0 +1,5 @@
+package ids
+
+// UserID identifies a user of the users service. Tasks only store the
+// reference; the user itself lives in the users service.
+type UserID string
@@ -0,0 +1,27 @@
+package task
+
+import errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+
+var (
+	ErrTaskNotFound         = errs.NewWithReason(errs.CodeNotFound, "TASK_NOT_FOUND", "task not found")
+	ErrSignInRequired       = errs.NewWithReason(errs.CodeUnauthorized, "TASK_SIGN_IN_REQUIRED", "sign in to manage tasks")
+	ErrNotTaskPoster        = errs.NewWithReason(errs.CodeForbidden, "TASK_NOT_TASK_POSTER", "only the poster of a task can do this")
+	ErrNotTaskParticipant   = errs.NewWithReason(errs.CodeForbidden, "TASK_NOT_TASK_PARTICIPANT", "only the poster or the assigned tasker can do this")
+	ErrInvalidTransition    = errs.NewWithReason(errs.CodeFailedPrecondition, "TASK_INVALID_TRANSITION", "the task cannot move to that status from its current status")
+	ErrTaskNotEditable      = errs.NewWithReason(errs.CodeFailedPrecondition, "TASK_NOT_EDITABLE", "only draft or open tasks can be edited")
+	ErrInvalidTitle         = errs.NewWithReason(errs.CodeInvalidArgument, "TASK_INVALID_TITLE", "title is required and must be at most 150 characters")
+	ErrInvalidDescription   = errs.NewWithReason(errs.CodeInvalidArgument, "TASK_INVALID_DESCRIPTION", "description is required and must be at most 5000 characters")
+	ErrInvalidCategory      = errs.NewWithReason(errs.CodeInvalidArgument, "TASK_INVALID_CATEGORY", "category must be 2-64 lower-case letters, digits or underscores, starting with a letter")
+	ErrInvalidBudget        = errs.NewWithReason(errs.CodeInvalidArgument, "TASK_INVALID_BUDGET", "budget must be a positive amount in a three-letter currency")
+	ErrInvalidLocation      = errs.NewWithReason(errs.CodeInvalidArgument, "TASK_INVALID_LOCATION", "locations need a province, and latitude and longitude must be given together and in range")
+	ErrDueDateInPast        = errs.NewWithReason(errs.CodeInvalidArgument, "TASK_DUE_DATE_IN_PAST", "due date must be in the future")
+	ErrInvalidAttachment    = errs.NewWithReason(errs.CodeInvalidArgument, "TASK_INVALID_ATTACHMENT", "attachments need a URL, a file name of at most 255 characters and a non-negative size")
+	ErrTooManyAttachments   = errs.NewWithReason(errs.CodeInvalidArgument, "TASK_TOO_MANY_ATTACHMENTS", "too many attachments")
+	ErrAssigneeRequired     = errs.NewWithReason(errs.CodeInvalidArgument, "TASK_ASSIGNEE_REQUIRED", "a tasker is required to assign a task")
+	ErrCannotAssignToPoster = errs.NewWithReason(errs.CodeInvalidArgument, "TASK_CANNOT_ASSIGN_TO_POSTER", "a task cannot be assigned to its poster")
+	ErrCancellationTooLong  = errs.NewWithReason(errs.CodeInvalidArgument, "TASK_CANCELLATION_TOO_LONG", "cancellation reason must be at most 500 characters")
+	ErrInvalidTaskStatus    = errs.NewWithReason(errs.CodeInvalidArgument, "TASK_INVALID_TASK_STATUS", "unknown task status")
+	ErrDraftListDenied      = errs.NewWithReason(errs.CodeForbidden, "TASK_DRAFT_LIST_DENIED", "drafts can only be listed by their poster or an admin")
+	ErrTaskNotAwarded       = errs.NewWithReason(errs.CodeFailedPrecondition, "TASK_NOT_AWARDED", "the task is not assigned, or not through that bid")
+	ErrTaskChanged          = errs.NewWithReason(errs.CodeAborted, "TASK_CHANGED", "the task changed while you were editing it; reload it and try again")
+)
@@ -0,0 +1,39 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+)
+
+// ListCursor is the keyset position of the last task of a page.
+type ListCursor struct {
+	CreatedAt time.Time
+	TaskID    ids.TaskID
+}
+
+// ListCriteria filters a task listing, newest first. Nil filters are ignored.
+type ListCriteria struct {
+	PosterID      *ids.UserID
+	AssigneeID    *ids.UserID
+	Status        *Status
+	CategoryCode  *string
+	IncludeDrafts bool
+	After         *ListCursor
+	Limit         int
+}
+
+// TaskRepository provides access to Task aggregates.
+type TaskRepository interface {
+	// FindByID retrieves a task with its attachments.
+	FindByID(ctx context.Context, id ids.TaskID) (*Task, error)
+
+	// Save persists a Task aggregate (either creating or updating), replacing
+	// its attachments. It fails with ErrTaskChanged when the stored task moved
+	// past t.Version in the meantime.
+	Save(ctx context.Context, t *Task) error
+
+	// List returns up to criteria.Limit tasks matching the criteria.
+	List(ctx context.Context, criteria ListCriteria) ([]*Task, error)
+}
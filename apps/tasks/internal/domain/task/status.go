@@ -0,0 +1,45 @@
+package task
+
+import "slices"
+
+// Status is where a task is in its lifecycle:
+//
+//	DRAFT → OPEN → ASSIGNED → IN_PROGRESS → COMPLETED
+//
+// Any task that is not yet completed can be CANCELLED. COMPLETED and
+// CANCELLED are terminal.
+type Status string
+
+const (
+	StatusDraft      Status = "DRAFT"
+	StatusOpen       Status = "OPEN"
+	StatusAssigned   Status = "ASSIGNED"
+	StatusInProgress Status = "IN_PROGRESS"
+	StatusCompleted  Status = "COMPLETED"
+	StatusCancelled  Status = "CANCELLED"
+)
+
+var transitions = map[Status][]Status{
+	StatusDraft:      {StatusOpen, StatusCancelled},
+	StatusOpen:       {StatusAssigned, StatusCancelled},
+	StatusAssigned:   {StatusInProgress, StatusCancelled},
+	StatusInProgress: {StatusCompleted, StatusCancelled},
+}
+
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusDraft, StatusOpen, StatusAssigned, StatusInProgress, StatusCompleted, StatusCancelled:
+		return true
+	}
+	return false
+}
+
+// IsTerminal reports whether no further transitions are possible.
+func (s Status) IsTerminal() bool {
+	return s == StatusCompleted || s == StatusCancelled
+}
+
+// CanTransitionTo reports whether the state machine allows moving from s to next.
+func (s Status) CanTransitionTo(next Status) bool {
+	return slices.Contains(transitions[s], next)
+}
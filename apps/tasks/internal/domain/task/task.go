@@ -0,0 +1,378 @@
+package task
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+)
+
+const (
+	MaxAttachments         = 10
+	maxTitleLength         = 150
+	maxDescriptionLength   = 5000
+	maxFileNameLength      = 255
+	maxCancellationLength  = 500
+	maxLocationFieldLength = 100
+	maxContentTypeLength   = 100
+	maxAttachmentURLLength = 2048
+	defaultBudgetCurrency  = "THB"
+)
+
+var (
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	// categoryPattern matches the skill codes of the users service catalogue.
+	categoryPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,63}$`)
+)
+
+// Budget is what the poster is willing to pay, in minor currency units
+// (satang for THB).
+type Budget struct {
+	Amount   int64
+	Currency string
+}
+
+func (b Budget) validate() error {
+	if b.Amount <= 0 || !currencyPattern.MatchString(b.Currency) {
+		return ErrInvalidBudget
+	}
+	return nil
+}
+
+// Location is where the work happens. Latitude and Longitude are optional
+// but must be given together. A task without a location can be done remotely.
+type Location struct {
+	Province   string
+	District   *string
+	PostalCode *string
+	Latitude   *float64
+	Longitude  *float64
+}
+
+func (l Location) validate() error {
+	if strings.TrimSpace(l.Province) == "" || len([]rune(l.Province)) > maxLocationFieldLength {
+		return ErrInvalidLocation
+	}
+	if l.District != nil && len([]rune(*l.District)) > maxLocationFieldLength {
+		return ErrInvalidLocation
+	}
+	if (l.Latitude == nil) != (l.Longitude == nil) {
+		return ErrInvalidLocation
+	}
+	if l.Latitude != nil && (*l.Latitude < -90 || *l.Latitude > 90 || *l.Longitude < -180 || *l.Longitude > 180) {
+		return ErrInvalidLocation
+	}
+	return nil
+}
+
+// Attachment is a file the poster uploaded to describe the work. The file
+// itself lives in object storage; the task only keeps its metadata.
+type Attachment struct {
+	ID          uuid.UUID
+	URL         string
+	FileName    string
+	ContentType *string
+	SizeBytes   int64
+}
+
+// Actor is the user performing an action on a task. The zero value is an
+// anonymous caller.
+type Actor struct {
+	UserID  ids.UserID
+	IsAdmin bool
+}
+
+// TaskDetails is the editable part of a task; create and update take the
+// whole set so the aggregate is always validated as a unit.
+type TaskDetails struct {
+	Title        string
+	Description  string
+	CategoryCode string
+	Budget       Budget
+	Location     *Location
+	DueAt        *time.Time
+	Attachments  []Attachment
+}
+
+// Task is a piece of work a poster wants done. It starts as a DRAFT only its
+// poster can see and moves through the Status state machine from there.
+// Version guards against a change made from a stale read undoing an
+// assignment, or any other write, that happened since.
+type Task struct {
+	ID                 ids.TaskID
+	PosterID           ids.UserID
+	Title              string
+	Description        string
+	CategoryCode       string
+	Budget             Budget
+	Location           *Location
+	DueAt              *time.Time
+	Attachments        []Attachment
+	Status             Status
+	AssigneeID         *ids.UserID
+	CancellationReason *string
+	PublishedAt        *time.Time
+	AssignedAt         *time.Time
+	StartedAt          *time.Time
+	CompletedAt        *time.Time
+	CancelledAt        *time.Time
+	Version            int32
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+func NewTask(posterID ids.UserID, details TaskDetails) (*Task, error) {
+	now := time.Now()
+	t := &Task{
+		ID:        ids.NewTaskID(),
+		PosterID:  posterID,
+		Status:    StatusDraft,
+		CreatedAt: now,
+	}
+	if err := t.apply(details, now); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func NewTaskFromRepository(
+	id string,
+	posterID string,
+	title string,
+	description string,
+	categoryCode string,
+	budget Budget,
+	location *Location,
+	dueAt *time.Time,
+	attachments []Attachment,
+	status Status,
+	assigneeID *ids.UserID,
+	cancellationReason *string,
+	publishedAt *time.Time,
+	assignedAt *time.Time,
+	startedAt *time.Time,
+	completedAt *time.Time,
+	cancelledAt *time.Time,
+	version int32,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Task {
+	return &Task{
+		ID:                 ids.TaskID(id),
+		PosterID:           ids.UserID(posterID),
+		Title:              title,
+		Description:        description,
+		CategoryCode:       categoryCode,
+		Budget:             budget,
+		Location:           location,
+		DueAt:              dueAt,
+		Attachments:        attachments,
+		Status:             status,
+		AssigneeID:         assigneeID,
+		CancellationReason: cancellationReason,
+		PublishedAt:        publishedAt,
+		AssignedAt:         assignedAt,
+		StartedAt:          startedAt,
+		CompletedAt:        completedAt,
+		CancelledAt:        cancelledAt,
+		Version:            version,
+		CreatedAt:          createdAt,
+		UpdatedAt:          updatedAt,
+	}
+}
+
+// IsPoster reports whether the actor posted the task.
+func (t *Task) IsPoster(a Actor) bool {
+	return a.UserID != "" && a.UserID == t.PosterID
+}
+
+// IsAssignee reports whether the actor is the tasker assigned to the task.
+func (t *Task) IsAssignee(a Actor) bool {
+	return a.UserID != "" && t.AssigneeID != nil && a.UserID == *t.AssigneeID
+}
+
+// CanView reports whether the actor may see the task. Drafts are private to
+// their poster and admins; everything else is public.
+func (t *Task) CanView(a Actor) bool {
+	return t.Status != StatusDraft || t.IsPoster(a) || a.IsAdmin
+}
+
+// Update replaces the editable details. Only the poster can edit, and only
+// while nobody has been assigned yet.
+func (t *Task) Update(a Actor, d TaskDetails) error {
+	if !t.IsPoster(a) {
+		return ErrNotTaskPoster
+	}
+	if t.Status != StatusDraft && t.Status != StatusOpen {
+		return ErrTaskNotEditable
+	}
+	return t.apply(d, time.Now())
+}
+
+// Publish opens a draft for taskers to see and bid on.
+func (t *Task) Publish(a Actor) error {
+	if !t.IsPoster(a) {
+		return ErrNotTaskPoster
+	}
+	now := time.Now()
+	if t.DueAt != nil && !t.DueAt.After(now) {
+		return ErrDueDateInPast
+	}
+	if err := t.transition(StatusOpen, now); err != nil {
+		return err
+	}
+	t.PublishedAt = &now
+	return nil
+}
+
+// Assign hands an open task to a tasker.
+func (t *Task) Assign(a Actor, taskerID ids.UserID) error {
+	if !t.IsPoster(a) {
+		return ErrNotTaskPoster
+	}
+	if taskerID == "" {
+		return ErrAssigneeRequired
+	}
+	if taskerID == t.PosterID {
+		return ErrCannotAssignToPoster
+	}
+	now := time.Now()
+	if err := t.transition(StatusAssigned, now); err != nil {
+		return err
+	}
+	t.AssigneeID = &taskerID
+	t.AssignedAt = &now
+	return nil
+}
+
+// Start marks an assigned task as being worked on. The assigned tasker
+// usually does this on arrival, but the poster may too.
+func (t *Task) Start(a Actor) error {
+	if !t.IsPoster(a) && !t.IsAssignee(a) {
+		return ErrNotTaskParticipant
+	}
+	now := time.Now()
+	if err := t.transition(StatusInProgress, now); err != nil {
+		return err
+	}
+	t.StartedAt = &now
+	return nil
+}
+
+// Complete is the poster confirming the work is done.
+func (t *Task) Complete(a Actor) error {
+	if !t.IsPoster(a) {
+		return ErrNotTaskPoster
+	}
+	now := time.Now()
+	if err := t.transition(StatusCompleted, now); err != nil {
+		return err
+	}
+	t.CompletedAt = &now
+	return nil
+}
+
+// Cancel withdraws a task that is not yet completed. Admins may cancel any
+// task, e.g. when moderating.
+func (t *Task) Cancel(a Actor, reason *string) error {
+	if !t.IsPoster(a) && !a.IsAdmin {
+		return ErrNotTaskPoster
+	}
+	if reason != nil {
+		trimmed := strings.TrimSpace(*reason)
+		if len([]rune(trimmed)) > maxCancellationLength {
+			return ErrCancellationTooLong
+		}
+		reason = &trimmed
+		if trimmed == "" {
+			reason = nil
+		}
+	}
+	now := time.Now()
+	if err := t.transition(StatusCancelled, now); err != nil {
+		return err
+	}
+	t.CancellationReason = reason
+	t.CancelledAt = &now
+	return nil
+}
+
+func (t *Task) transition(next Status, now time.Time) error {
+	if !t.Status.CanTransitionTo(next) {
+		return ErrInvalidTransition
+	}
+	t.Status = next
+	t.UpdatedAt = now
+	return nil
+}
+
+func (t *Task) apply(d TaskDetails, now time.Time) error {
+	title := strings.TrimSpace(d.Title)
+	if title == "" || len([]rune(title)) > maxTitleLength {
+		return ErrInvalidTitle
+	}
+	description := strings.TrimSpace(d.Description)
+	if description == "" || len([]rune(description)) > maxDescriptionLength {
+		return ErrInvalidDescription
+	}
+	category := strings.ToLower(strings.TrimSpace(d.CategoryCode))
+	if !categoryPattern.MatchString(category) {
+		return ErrInvalidCategory
+	}
+
+	budget := d.Budget
+	budget.Currency = strings.ToUpper(strings.TrimSpace(budget.Currency))
+	if budget.Currency == "" {
+		budget.Currency = defaultBudgetCurrency
+	}
+	if err := budget.validate(); err != nil {
+		return err
+	}
+
+	var location *Location
+	if d.Location != nil {
+		l := *d.Location
+		l.Province = strings.TrimSpace(l.Province)
+		if err := l.validate(); err != nil {
+			return err
+		}
+		location = &l
+	}
+
+	// A due date can stay as it is while editing an open task, but a new one
+	// must lie ahead.
+	if d.DueAt != nil && !d.DueAt.After(now) && (t.DueAt == nil || !t.DueAt.Equal(*d.DueAt)) {
+		return ErrDueDateInPast
+	}
+
+	if len(d.Attachments) > MaxAttachments {
+		return ErrTooManyAttachments
+	}
+	attachments := make([]Attachment, 0, len(d.Attachments))
+	for _, att := range d.Attachments {
+		att.URL = strings.TrimSpace(att.URL)
+		att.FileName = strings.TrimSpace(att.FileName)
+		if att.URL == "" || len(att.URL) > maxAttachmentURLLength ||
+			att.FileName == "" || len([]rune(att.FileName)) > maxFileNameLength ||
+			att.SizeBytes < 0 ||
+			(att.ContentType != nil && len(*att.ContentType) > maxContentTypeLength) {
+			return ErrInvalidAttachment
+		}
+		if att.ID == uuid.Nil {
+			att.ID = uuid.New()
+		}
+		attachments = append(attachments, att)
+	}
+
+	t.Title = title
+	t.Description = description
+	t.CategoryCode = category
+	t.Budget = budget
+	t.Location = location
+	t.DueAt = d.DueAt
+	t.Attachments = attachments
+	t.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,25 @@
+package ticket
+
+import errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+
+var (
+	ErrTicketNotFound      = errs.NewWithReason(errs.CodeNotFound, "TICKET_NOT_FOUND", "ticket not found")
+	ErrSignInRequired      = errs.NewWithReason(errs.CodeUnauthorized, "TICKET_SIGN_IN_REQUIRED", "sign in to contact support")
+	ErrStaffOnly           = errs.NewWithReason(errs.CodeForbidden, "TICKET_STAFF_ONLY", "only support staff can do this")
+	ErrNotReporter         = errs.NewWithReason(errs.CodeForbidden, "TICKET_NOT_REPORTER", "only the reporter or support staff can do this")
+	ErrInvalidCategory     = errs.NewWithReason(errs.CodeInvalidArgument, "TICKET_INVALID_CATEGORY", "unknown ticket category")
+	ErrDisputeCategory     = errs.NewWithReason(errs.CodeInvalidArgument, "TICKET_DISPUTE_CATEGORY", "dispute tickets are opened by opening a dispute")
+	ErrInvalidPriority     = errs.NewWithReason(errs.CodeInvalidArgument, "TICKET_INVALID_PRIORITY", "priority must be LOW, NORMAL, HIGH or URGENT")
+	ErrInvalidStatus       = errs.NewWithReason(errs.CodeInvalidArgument, "TICKET_INVALID_STATUS", "unknown ticket status")
+	ErrInvalidSubject      = errs.NewWithReason(errs.CodeInvalidArgument, "TICKET_INVALID_SUBJECT", "subject is required and must be at most 200 characters")
+	ErrInvalidMessage      = errs.NewWithReason(errs.CodeInvalidArgument, "TICKET_INVALID_MESSAGE", "message is required and must be at most 5000 characters")
+	ErrInvalidAttachment   = errs.NewWithReason(errs.CodeInvalidArgument, "TICKET_INVALID_ATTACHMENT", "attachments need a URL, a file name of at most 255 characters and a size of at most 10 MB")
+	ErrTooManyAttachments  = errs.NewWithReason(errs.CodeInvalidArgument, "TICKET_TOO_MANY_ATTACHMENTS", "at most 5 attachments per message")
+	ErrInvalidTransition   = errs.NewWithReason(errs.CodeFailedPrecondition, "TICKET_INVALID_TRANSITION", "the ticket cannot move to that status from its current status")
+	ErrTicketClosed        = errs.NewWithReason(errs.CodeFailedPrecondition, "TICKET_CLOSED", "the ticket is closed")
+	ErrManagedByDispute    = errs.NewWithReason(errs.CodeFailedPrecondition, "TICKET_MANAGED_BY_DISPUTE", "the status of a dispute ticket follows its dispute")
+	ErrNothingToUpdate     = errs.NewWithReason(errs.CodeInvalidArgument, "TICKET_NOTHING_TO_UPDATE", "give a priority or a status to change")
+	ErrAssigneeRequired    = errs.NewWithReason(errs.CodeInvalidArgument, "TICKET_ASSIGNEE_REQUIRED", "a staff member is required to assign a ticket")
+	ErrTicketChanged       = errs.NewWithReason(errs.CodeAborted, "TICKET_CHANGED", "the ticket changed while you were editing it; reload it and try again")
+	ErrTicketListForbidden = errs.NewWithReason(errs.CodeForbidden, "TICKET_LIST_FORBIDDEN", "only support staff can list other users' tickets")
+)
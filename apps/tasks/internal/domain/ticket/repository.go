@@ -0,0 +1,46 @@
+package ticket
+
+import (
+	"context"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+)
+
+// ListCursor is the keyset position of the last ticket of a page.
+type ListCursor struct {
+	CreatedAt time.Time
+	TicketID  ids.TicketID
+}
+
+// ListCriteria filters tickets, newest first. Every filter is optional.
+type ListCriteria struct {
+	ReporterID *ids.UserID
+	AssigneeID *ids.UserID
+	Status     *Status
+	Category   *Category
+	// BreachedOnly keeps the tickets that missed their SLA.
+	BreachedOnly bool
+	After        *ListCursor
+	Limit        int
+}
+
+// TicketRepository provides access to Ticket aggregates.
+type TicketRepository interface {
+	// FindByID retrieves a ticket with its whole thread.
+	FindByID(ctx context.Context, id ids.TicketID) (*Ticket, error)
+
+	// List returns up to criteria.Limit tickets matching the criteria,
+	// without their threads.
+	List(ctx context.Context, criteria ListCriteria) ([]*Ticket, error)
+
+	// ListOverdue returns up to limit tickets support still owes an answer
+	// past their SLA due time that are not marked as breached yet, oldest
+	// due first, without their threads.
+	ListOverdue(ctx context.Context, now time.Time, limit int) ([]*Ticket, error)
+
+	// Save persists a Ticket aggregate (either creating or updating) with
+	// its pending messages. It fails with ErrTicketChanged when the stored
+	// ticket moved past t.Version in the meantime.
+	Save(ctx context.Context, t *Ticket) error
+}
@@ -0,0 +1,28 @@
+package ticket
+
+import "time"
+
+// SLAPolicy is how long support has to resolve a ticket of each priority,
+// counted from when it was opened.
+type SLAPolicy struct {
+	Low    time.Duration
+	Normal time.Duration
+	High   time.Duration
+	Urgent time.Duration
+}
+
+// DueAt is when a ticket of priority opened at openedAt has to be resolved.
+func (p SLAPolicy) DueAt(priority Priority, openedAt time.Time) time.Time {
+	var d time.Duration
+	switch priority {
+	case PriorityLow:
+		d = p.Low
+	case PriorityHigh:
+		d = p.High
+	case PriorityUrgent:
+		d = p.Urgent
+	default:
+		d = p.Normal
+	}
+	return openedAt.Add(d)
+}
@@ -0,0 +1,84 @@
+package ticket
+
+// Status is where a ticket is in the support workflow:
+//
+//	OPEN → IN_PROGRESS ⇄ WAITING_ON_USER → RESOLVED → CLOSED
+//
+// Assigning an OPEN ticket moves it to IN_PROGRESS, and the reporter
+// answering a ticket that is WAITING_ON_USER hands it back to support. A
+// RESOLVED ticket can be reopened; a CLOSED one is final.
+type Status string
+
+const (
+	StatusOpen          Status = "OPEN"
+	StatusInProgress    Status = "IN_PROGRESS"
+	StatusWaitingOnUser Status = "WAITING_ON_USER"
+	StatusResolved      Status = "RESOLVED"
+	StatusClosed        Status = "CLOSED"
+)
+
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusOpen, StatusInProgress, StatusWaitingOnUser, StatusResolved, StatusClosed:
+		return true
+	}
+	return false
+}
+
+// IsActive reports whether support still owes the reporter an answer, which
+// is when the SLA clock runs.
+func (s Status) IsActive() bool {
+	return s == StatusOpen || s == StatusInProgress
+}
+
+// Category is what a ticket is about. DISPUTE tickets are opened together
+// with a dispute and follow its decision.
+type Category string
+
+const (
+	CategoryAccount Category = "ACCOUNT"
+	CategoryPayment Category = "PAYMENT"
+	CategoryTask    Category = "TASK"
+	CategorySafety  Category = "SAFETY"
+	CategoryDispute Category = "DISPUTE"
+	CategoryOther   Category = "OTHER"
+)
+
+func (c Category) IsValid() bool {
+	switch c {
+	case CategoryAccount, CategoryPayment, CategoryTask, CategorySafety, CategoryDispute, CategoryOther:
+		return true
+	}
+	return false
+}
+
+// DefaultPriority is the priority a ticket of the category is opened with;
+// staff can change it afterwards.
+func (c Category) DefaultPriority() Priority {
+	switch c {
+	case CategorySafety:
+		return PriorityUrgent
+	case CategoryPayment, CategoryDispute:
+		return PriorityHigh
+	}
+	return PriorityNormal
+}
+
+// Priority decides how quickly support has to resolve a ticket; see
+// SLAPolicy.
+type Priority string
+
+const (
+	PriorityLow    Priority = "LOW"
+	PriorityNormal Priority = "NORMAL"
+	PriorityHigh   Priority = "HIGH"
+	PriorityUrgent Priority = "URGENT"
+)
+
+func (p Priority) IsValid() bool {
+	switch p {
+	case PriorityLow, PriorityNormal, PriorityHigh, PriorityUrgent:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,408 @@
+package ticket
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+)
+
+const (
+	MaxAttachments         = 5
+	MaxAttachmentSize      = 10 << 20
+	maxSubjectLength       = 200
+	maxMessageLength       = 5000
+	maxFileNameLength      = 255
+	maxContentTypeLength   = 100
+	maxAttachmentURLLength = 2048
+)
+
+// Attachment is a file added to a message, such as a screenshot or a
+// receipt. The client uploads the file to object storage; the ticket only
+// keeps its metadata.
+type Attachment struct {
+	URL         string
+	FileName    string
+	ContentType *string
+	SizeBytes   int64
+}
+
+// NormalizeAttachments trims and validates the attachments of one message.
+func NormalizeAttachments(in []Attachment) ([]Attachment, error) {
+	if len(in) > MaxAttachments {
+		return nil, ErrTooManyAttachments
+	}
+	out := make([]Attachment, 0, len(in))
+	for _, att := range in {
+		att.URL = strings.TrimSpace(att.URL)
+		att.FileName = strings.TrimSpace(att.FileName)
+		if att.URL == "" || len(att.URL) > maxAttachmentURLLength ||
+			att.FileName == "" || len([]rune(att.FileName)) > maxFileNameLength ||
+			att.SizeBytes < 0 || att.SizeBytes > MaxAttachmentSize ||
+			(att.ContentType != nil && len(*att.ContentType) > maxContentTypeLength) {
+			return nil, ErrInvalidAttachment
+		}
+		out = append(out, att)
+	}
+	return out, nil
+}
+
+// Message is one entry of a ticket's thread. Internal messages are notes
+// between support staff and are hidden from the reporter.
+type Message struct {
+	ID          ids.MessageID
+	AuthorID    ids.UserID
+	FromStaff   bool
+	Internal    bool
+	Body        string
+	Attachments []Attachment
+	CreatedAt   time.Time
+}
+
+// Ticket is a request for help from a user to support staff. Its SLA due
+// time follows from its priority; a ticket still waiting for support after
+// that is marked as breached. Messages are append-only: the repository
+// writes the pending ones with the ticket. Version guards against two staff
+// members changing the ticket at once.
+type Ticket struct {
+	ID            ids.TicketID
+	ReporterID    ids.UserID
+	Category      Category
+	Priority      Priority
+	Subject       string
+	Status        Status
+	AssigneeID    *ids.UserID
+	TaskID        *ids.TaskID
+	Messages      []Message
+	SLADueAt      time.Time
+	SLABreachedAt *time.Time
+	ResolvedAt    *time.Time
+	ClosedAt      *time.Time
+	Version       int32
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+
+	pendingMessages []Message
+}
+
+// NewTicket opens a ticket on behalf of the reporter with body as its first
+// message, at the default priority of its category. Dispute tickets are
+// opened with NewDisputeTicket instead.
+func NewTicket(
+	reporter task.Actor,
+	category Category,
+	subject string,
+	body string,
+	attachments []Attachment,
+	taskID *ids.TaskID,
+	policy SLAPolicy,
+) (*Ticket, error) {
+	if category == CategoryDispute {
+		return nil, ErrDisputeCategory
+	}
+	if !category.IsValid() {
+		return nil, ErrInvalidCategory
+	}
+	return newTicket(reporter, category, category.DefaultPriority(), subject, body, attachments, taskID, policy)
+}
+
+// NewDisputeTicket opens the ticket that tracks a dispute about a task.
+func NewDisputeTicket(reporter task.Actor, taskID ids.TaskID, subject string, body string, policy SLAPolicy) (*Ticket, error) {
+	return newTicket(reporter, CategoryDispute, CategoryDispute.DefaultPriority(), subject, body, nil, &taskID, policy)
+}
+
+func newTicket(
+	reporter task.Actor,
+	category Category,
+	priority Priority,
+	subject string,
+	body string,
+	attachments []Attachment,
+	taskID *ids.TaskID,
+	policy SLAPolicy,
+) (*Ticket, error) {
+	if reporter.UserID == "" {
+		return nil, ErrSignInRequired
+	}
+	subject = strings.TrimSpace(subject)
+	if subject == "" || len([]rune(subject)) > maxSubjectLength {
+		return nil, ErrInvalidSubject
+	}
+
+	now := time.Now()
+	t := &Ticket{
+		ID:         ids.NewTicketID(),
+		ReporterID: reporter.UserID,
+		Category:   category,
+		Priority:   priority,
+		Subject:    subject,
+		Status:     StatusOpen,
+		TaskID:     taskID,
+		SLADueAt:   policy.DueAt(priority, now),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := t.appendMessage(reporter, body, false, attachments, now); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func NewTicketFromRepository(
+	id string,
+	reporterID string,
+	category Category,
+	priority Priority,
+	subject string,
+	status Status,
+	assigneeID *ids.UserID,
+	taskID *ids.TaskID,
+	messages []Message,
+	slaDueAt time.Time,
+	slaBreachedAt *time.Time,
+	resolvedAt *time.Time,
+	closedAt *time.Time,
+	version int32,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Ticket {
+	return &Ticket{
+		ID:            ids.TicketID(id),
+		ReporterID:    ids.UserID(reporterID),
+		Category:      category,
+		Priority:      priority,
+		Subject:       subject,
+		Status:        status,
+		AssigneeID:    assigneeID,
+		TaskID:        taskID,
+		Messages:      messages,
+		SLADueAt:      slaDueAt,
+		SLABreachedAt: slaBreachedAt,
+		ResolvedAt:    resolvedAt,
+		ClosedAt:      closedAt,
+		Version:       version,
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedAt,
+	}
+}
+
+// IsReporter reports whether the actor opened the ticket.
+func (t *Ticket) IsReporter(a task.Actor) bool {
+	return a.UserID != "" && a.UserID == t.ReporterID
+}
+
+// CanView reports whether the actor may see the ticket: its reporter and
+// support staff.
+func (t *Ticket) CanView(a task.Actor) bool {
+	return a.IsAdmin || t.IsReporter(a)
+}
+
+// VisibleMessages is the thread as the actor may see it; the reporter does
+// not see internal notes.
+func (t *Ticket) VisibleMessages(a task.Actor) []Message {
+	if a.IsAdmin {
+		return t.Messages
+	}
+	visible := make([]Message, 0, len(t.Messages))
+	for _, m := range t.Messages {
+		if !m.Internal {
+			visible = append(visible, m)
+		}
+	}
+	return visible
+}
+
+// IsOverdue reports whether support still owes an answer past the SLA due
+// time.
+func (t *Ticket) IsOverdue(now time.Time) bool {
+	return t.Status.IsActive() && now.After(t.SLADueAt)
+}
+
+// Reply adds a message to the thread. Only staff can write internal notes.
+// The reporter answering a ticket that waits on them hands it back to
+// support.
+func (t *Ticket) Reply(by task.Actor, body string, internal bool, attachments []Attachment) error {
+	if by.UserID == "" {
+		return ErrSignInRequired
+	}
+	if !t.CanView(by) {
+		return ErrNotReporter
+	}
+	if internal && !by.IsAdmin {
+		return ErrStaffOnly
+	}
+	if t.Status == StatusClosed {
+		return ErrTicketClosed
+	}
+	now := time.Now()
+	if err := t.appendMessage(by, body, internal, attachments, now); err != nil {
+		return err
+	}
+	if !by.IsAdmin && t.Status == StatusWaitingOnUser {
+		t.Status = t.activeStatus()
+	}
+	t.UpdatedAt = now
+	return nil
+}
+
+// Assign hands the ticket to a staff member. An OPEN ticket moves to
+// IN_PROGRESS.
+func (t *Ticket) Assign(by task.Actor, assigneeID ids.UserID) error {
+	if !by.IsAdmin {
+		return ErrStaffOnly
+	}
+	if assigneeID == "" {
+		return ErrAssigneeRequired
+	}
+	if t.Status == StatusClosed {
+		return ErrTicketClosed
+	}
+	t.AssigneeID = &assigneeID
+	if t.Status == StatusOpen {
+		t.Status = StatusInProgress
+	}
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// Reprioritize changes the priority and moves the SLA due time with it. A
+// breach already recorded stays on the ticket.
+func (t *Ticket) Reprioritize(by task.Actor, priority Priority, policy SLAPolicy) error {
+	if !by.IsAdmin {
+		return ErrStaffOnly
+	}
+	if !priority.IsValid() {
+		return ErrInvalidPriority
+	}
+	if t.Status == StatusClosed {
+		return ErrTicketClosed
+	}
+	t.Priority = priority
+	t.SLADueAt = policy.DueAt(priority, t.CreatedAt)
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// ChangeStatus moves the ticket through the workflow. Staff can move an
+// open ticket to any status; the reporter can only close it, or reopen it
+// once it is resolved. The status of dispute tickets follows the dispute.
+func (t *Ticket) ChangeStatus(by task.Actor, to Status) error {
+	if by.UserID == "" {
+		return ErrSignInRequired
+	}
+	if !t.CanView(by) {
+		return ErrNotReporter
+	}
+	if !to.IsValid() {
+		return ErrInvalidStatus
+	}
+	if t.Category == CategoryDispute {
+		return ErrManagedByDispute
+	}
+	if t.Status == StatusClosed {
+		return ErrTicketClosed
+	}
+	if to == t.Status {
+		return ErrInvalidTransition
+	}
+	if !by.IsAdmin {
+		switch {
+		case to == StatusClosed:
+		case to == StatusOpen && t.Status == StatusResolved:
+		default:
+			return ErrStaffOnly
+		}
+	}
+	t.moveTo(to, time.Now())
+	return nil
+}
+
+// Resolve resolves the ticket on behalf of the process that owns it, such
+// as the decision on its dispute.
+func (t *Ticket) Resolve() {
+	if t.Status == StatusResolved || t.Status == StatusClosed {
+		return
+	}
+	t.moveTo(StatusResolved, time.Now())
+}
+
+// Close closes the ticket on behalf of the process that owns it, such as a
+// dispute being withdrawn.
+func (t *Ticket) Close() {
+	if t.Status == StatusClosed {
+		return
+	}
+	t.moveTo(StatusClosed, time.Now())
+}
+
+// MarkBreached records that support missed the SLA. It reports whether the
+// ticket changed: a breach is recorded once and only while support still
+// owes an answer.
+func (t *Ticket) MarkBreached(now time.Time) bool {
+	if t.SLABreachedAt != nil || !t.IsOverdue(now) {
+		return false
+	}
+	t.SLABreachedAt = &now
+	t.UpdatedAt = now
+	return true
+}
+
+// PendingMessages are the messages added since the ticket was loaded; the
+// repository writes them with the ticket.
+func (t *Ticket) PendingMessages() []Message {
+	return t.pendingMessages
+}
+
+// ClearPendingMessages is called by the repository once the messages are
+// written.
+func (t *Ticket) ClearPendingMessages() {
+	t.pendingMessages = nil
+}
+
+func (t *Ticket) moveTo(to Status, now time.Time) {
+	if to == StatusOpen && t.AssigneeID != nil {
+		to = StatusInProgress
+	}
+	t.Status = to
+	switch to {
+	case StatusResolved:
+		t.ResolvedAt = &now
+	case StatusClosed:
+		t.ClosedAt = &now
+	default:
+		t.ResolvedAt = nil
+	}
+	t.UpdatedAt = now
+}
+
+// activeStatus is where a ticket goes when it is support's turn again.
+func (t *Ticket) activeStatus() Status {
+	if t.AssigneeID != nil {
+		return StatusInProgress
+	}
+	return StatusOpen
+}
+
+func (t *Ticket) appendMessage(by task.Actor, body string, internal bool, attachments []Attachment, now time.Time) error {
+	body = strings.TrimSpace(body)
+	if body == "" || len([]rune(body)) > maxMessageLength {
+		return ErrInvalidMessage
+	}
+	atts, err := NormalizeAttachments(attachments)
+	if err != nil {
+		return err
+	}
+	m := Message{
+		ID:          ids.NewMessageID(),
+		AuthorID:    by.UserID,
+		FromStaff:   by.IsAdmin && by.UserID != t.ReporterID,
+		Internal:    internal,
+		Body:        body,
+		Attachments: atts,
+		CreatedAt:   now,
+	}
+	t.Messages = append(t.Messages, m)
+	t.pendingMessages = append(t.pendingMessages, m)
+	return nil
+}
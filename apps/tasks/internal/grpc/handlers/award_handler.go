@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/payments/api/proto/award"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/grpc/views"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TaskAwardGRPCHandler serves the TaskAwardService the payments service
+// defines and checks escrows against.
+type TaskAwardGRPCHandler struct {
+	pb.UnimplementedTaskAwardServiceServer
+	lg.GrpcHandlerOption
+}
+
+func RegisTaskAwardGRPCHandler(
+	gs *grpc.Server,
+	opt lg.GrpcHandlerOption,
+) {
+	pb.RegisterTaskAwardServiceServer(gs, &TaskAwardGRPCHandler{
+		GrpcHandlerOption: opt,
+	})
+}
+
+func (h *TaskAwardGRPCHandler) GetTaskAward(ctx context.Context, req *pb.GetTaskAwardRequest) (*pb.TaskAward, error) {
+	qry := query.GetTaskAwardQuery{
+		TaskID: ids.TaskID(req.GetTaskId()),
+		Actor:  actorFromCtx(ctx),
+	}
+	if bidID := lg.StringValueToPtr(req.GetBidId()); bidID != nil {
+		id := ids.BidID(*bidID)
+		qry.BidID = &id
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.TaskAwardDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from GetTaskAwardQuery handler")
+	}
+	return views.TaskAward(dto), nil
+}
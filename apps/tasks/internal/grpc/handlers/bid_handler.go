@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/proto/bid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/grpc/views"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"github.com/pratchaya-maneechot/service-exchange/libs/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type BidGRPCHandler struct {
+	pb.UnimplementedBidServiceServer
+	lg.GrpcHandlerOption
+}
+
+func RegisBidGRPCHandler(
+	gs *grpc.Server,
+	opt lg.GrpcHandlerOption,
+) {
+	pb.RegisterBidServiceServer(gs, &BidGRPCHandler{
+		GrpcHandlerOption: opt,
+	})
+}
+
+func (h *BidGRPCHandler) PlaceBid(ctx context.Context, req *pb.PlaceBidRequest) (*pb.BidStatusResponse, error) {
+	cmd := command.PlaceBidCommand{
+		TaskID: ids.TaskID(req.GetTaskId()),
+		Terms:  views.BidTermsInput(req.GetTerms()),
+		Actor:  actorFromCtx(ctx),
+	}
+	return h.dispatchStatusCommand(ctx, cmd, "PlaceBidCommand")
+}
+
+func (h *BidGRPCHandler) CounterBid(ctx context.Context, req *pb.CounterBidRequest) (*pb.BidStatusResponse, error) {
+	cmd := command.CounterBidCommand{
+		BidID: ids.BidID(req.GetBidId()),
+		Terms: views.BidTermsInput(req.GetTerms()),
+		Actor: actorFromCtx(ctx),
+	}
+	return h.dispatchStatusCommand(ctx, cmd, "CounterBidCommand")
+}
+
+func (h *BidGRPCHandler) AcceptBid(ctx context.Context, req *pb.AcceptBidRequest) (*pb.BidStatusResponse, error) {
+	cmd := command.AcceptBidCommand{
+		BidID: ids.BidID(req.GetBidId()),
+		Actor: actorFromCtx(ctx),
+	}
+	return h.dispatchStatusCommand(ctx, cmd, "AcceptBidCommand")
+}
+
+func (h *BidGRPCHandler) RejectBid(ctx context.Context, req *pb.RejectBidRequest) (*pb.BidStatusResponse, error) {
+	cmd := command.RejectBidCommand{
+		BidID: ids.BidID(req.GetBidId()),
+		Actor: actorFromCtx(ctx),
+	}
+	return h.dispatchStatusCommand(ctx, cmd, "RejectBidCommand")
+}
+
+func (h *BidGRPCHandler) WithdrawBid(ctx context.Context, req *pb.WithdrawBidRequest) (*pb.BidStatusResponse, error) {
+	cmd := command.WithdrawBidCommand{
+		BidID: ids.BidID(req.GetBidId()),
+		Actor: actorFromCtx(ctx),
+	}
+	return h.dispatchStatusCommand(ctx, cmd, "WithdrawBidCommand")
+}
+
+func (h *BidGRPCHandler) GetBid(ctx context.Context, req *pb.GetBidRequest) (*pb.Bid, error) {
+	qry := query.GetBidQuery{
+		BidID: ids.BidID(req.GetBidId()),
+		Actor: actorFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.BidDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from GetBidQuery handler")
+	}
+	return views.Bid(dto), nil
+}
+
+func (h *BidGRPCHandler) ListTaskBids(ctx context.Context, req *pb.ListTaskBidsRequest) (*pb.ListTaskBidsResponse, error) {
+	qry := query.ListTaskBidsQuery{
+		TaskID: ids.TaskID(req.GetTaskId()),
+		Actor:  actorFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.([]*query.BidDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from ListTaskBidsQuery handler")
+	}
+	return views.ListTaskBidsResponse(dto), nil
+}
+
+func (h *BidGRPCHandler) ListBookings(ctx context.Context, req *pb.ListBookingsRequest) (*pb.ListBookingsResponse, error) {
+	qry := query.ListBookingsQuery{
+		TaskerIDs:   utils.ArrayMap(req.GetTaskerIds(), func(id string) ids.UserID { return ids.UserID(id) }),
+		WindowStart: req.GetWindowStart().AsTime(),
+		WindowEnd:   req.GetWindowEnd().AsTime(),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.([]query.BookingDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from ListBookingsQuery handler")
+	}
+	return views.ListBookingsResponse(dto), nil
+}
+
+// dispatchStatusCommand runs a command whose handler reports the resulting
+// bid status, which is what every negotiation RPC returns.
+func (h *BidGRPCHandler) dispatchStatusCommand(ctx context.Context, cmd any, name string) (*pb.BidStatusResponse, error) {
+	result, err := h.Command.Dispatch(ctx, cmd)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*command.BidDto)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from %s handler", name)
+	}
+	return views.BidStatusResponse(dto), nil
+}
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/proto/review"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/grpc/views"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ReviewGRPCHandler struct {
+	pb.UnimplementedReviewServiceServer
+	lg.GrpcHandlerOption
+}
+
+func RegisReviewGRPCHandler(
+	gs *grpc.Server,
+	opt lg.GrpcHandlerOption,
+) {
+	pb.RegisterReviewServiceServer(gs, &ReviewGRPCHandler{
+		GrpcHandlerOption: opt,
+	})
+}
+
+func (h *ReviewGRPCHandler) SubmitReview(ctx context.Context, req *pb.SubmitReviewRequest) (*pb.ReviewStatusResponse, error) {
+	cmd := command.SubmitReviewCommand{
+		TaskID:  ids.TaskID(req.GetTaskId()),
+		Content: views.ReviewContentInput(req.GetContent()),
+		Actor:   actorFromCtx(ctx),
+	}
+	return h.dispatchStatusCommand(ctx, cmd, "SubmitReviewCommand")
+}
+
+func (h *ReviewGRPCHandler) EditReview(ctx context.Context, req *pb.EditReviewRequest) (*pb.ReviewStatusResponse, error) {
+	cmd := command.EditReviewCommand{
+		ReviewID: ids.ReviewID(req.GetReviewId()),
+		Content:  views.ReviewContentInput(req.GetContent()),
+		Actor:    actorFromCtx(ctx),
+	}
+	return h.dispatchStatusCommand(ctx, cmd, "EditReviewCommand")
+}
+
+func (h *ReviewGRPCHandler) ReplyToReview(ctx context.Context, req *pb.ReplyToReviewRequest) (*pb.ReviewStatusResponse, error) {
+	cmd := command.ReplyToReviewCommand{
+		ReviewID: ids.ReviewID(req.GetReviewId()),
+		Body:     req.GetBody(),
+		Actor:    actorFromCtx(ctx),
+	}
+	return h.dispatchStatusCommand(ctx, cmd, "ReplyToReviewCommand")
+}
+
+func (h *ReviewGRPCHandler) FlagReview(ctx context.Context, req *pb.FlagReviewRequest) (*pb.ReviewStatusResponse, error) {
+	cmd := command.FlagReviewCommand{
+		ReviewID: ids.ReviewID(req.GetReviewId()),
+		Reason:   req.GetReason(),
+		Actor:    actorFromCtx(ctx),
+	}
+	return h.dispatchStatusCommand(ctx, cmd, "FlagReviewCommand")
+}
+
+func (h *ReviewGRPCHandler) ModerateReview(ctx context.Context, req *pb.ModerateReviewRequest) (*pb.ReviewStatusResponse, error) {
+	cmd := command.ModerateReviewCommand{
+		ReviewID: ids.ReviewID(req.GetReviewId()),
+		Decision: views.ProtoReviewStatusToDomain(req.GetDecision()),
+		Note:     lg.StringValueToPtr(req.GetNote()),
+		Actor:    actorFromCtx(ctx),
+	}
+	return h.dispatchStatusCommand(ctx, cmd, "ModerateReviewCommand")
+}
+
+func (h *ReviewGRPCHandler) GetReview(ctx context.Context, req *pb.GetReviewRequest) (*pb.Review, error) {
+	qry := query.GetReviewQuery{
+		ReviewID: ids.ReviewID(req.GetReviewId()),
+		Actor:    actorFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.ReviewDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from GetReviewQuery handler")
+	}
+	return views.Review(dto), nil
+}
+
+func (h *ReviewGRPCHandler) ListTaskReviews(ctx context.Context, req *pb.ListTaskReviewsRequest) (*pb.ListTaskReviewsResponse, error) {
+	qry := query.ListTaskReviewsQuery{
+		TaskID: ids.TaskID(req.GetTaskId()),
+		Actor:  actorFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.([]*query.ReviewDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from ListTaskReviewsQuery handler")
+	}
+	return views.ListTaskReviewsResponse(dto), nil
+}
+
+func (h *ReviewGRPCHandler) ListUserReviews(ctx context.Context, req *pb.ListUserReviewsRequest) (*pb.ListUserReviewsResponse, error) {
+	qry := query.ListUserReviewsQuery{
+		UserID:    ids.UserID(req.GetUserId()),
+		Role:      views.ProtoRoleToDomain(req.GetRole()),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+		Actor:     actorFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.ListUserReviewsResultDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from ListUserReviewsQuery handler")
+	}
+	return views.ListUserReviewsResponse(dto), nil
+}
+
+func (h *ReviewGRPCHandler) GetReputation(ctx context.Context, req *pb.GetReputationRequest) (*pb.Reputation, error) {
+	qry := query.GetReputationQuery{
+		UserID: ids.UserID(req.GetUserId()),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.ReputationDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from GetReputationQuery handler")
+	}
+	return views.Reputation(dto), nil
+}
+
+// dispatchStatusCommand runs a command whose handler reports the resulting
+// review status, which is what every review command RPC returns.
+func (h *ReviewGRPCHandler) dispatchStatusCommand(ctx context.Context, cmd any, name string) (*pb.ReviewStatusResponse, error) {
+	result, err := h.Command.Dispatch(ctx, cmd)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*command.ReviewDto)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from %s handler", name)
+	}
+	return views.ReviewStatusResponse(dto), nil
+}
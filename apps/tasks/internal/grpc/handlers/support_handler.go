@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/proto/support"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/grpc/views"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SupportGRPCHandler struct {
+	pb.UnimplementedSupportServiceServer
+	lg.GrpcHandlerOption
+}
+
+func RegisSupportGRPCHandler(
+	gs *grpc.Server,
+	opt lg.GrpcHandlerOption,
+) {
+	pb.RegisterSupportServiceServer(gs, &SupportGRPCHandler{
+		GrpcHandlerOption: opt,
+	})
+}
+
+func (h *SupportGRPCHandler) OpenTicket(ctx context.Context, req *pb.OpenTicketRequest) (*pb.TicketStatusResponse, error) {
+	cmd := command.OpenTicketCommand{
+		Category:    views.ProtoTicketCategoryToDomain(req.GetCategory()),
+		Subject:     req.GetSubject(),
+		Body:        req.GetBody(),
+		Attachments: views.AttachmentInputs(req.GetAttachments()),
+		TaskID:      views.TaskIDValueToPtr(req.GetTaskId()),
+		Actor:       actorFromCtx(ctx),
+	}
+	return h.dispatchTicketCommand(ctx, cmd, "OpenTicketCommand")
+}
+
+func (h *SupportGRPCHandler) GetTicket(ctx context.Context, req *pb.GetTicketRequest) (*pb.Ticket, error) {
+	qry := query.GetTicketQuery{
+		TicketID: ids.TicketID(req.GetTicketId()),
+		Actor:    actorFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.TicketDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from GetTicketQuery handler")
+	}
+	return views.Ticket(dto), nil
+}
+
+func (h *SupportGRPCHandler) ListTickets(ctx context.Context, req *pb.ListTicketsRequest) (*pb.ListTicketsResponse, error) {
+	qry := query.ListTicketsQuery{
+		ReporterID:   views.UserIDValueToPtr(req.GetReporterId()),
+		AssigneeID:   views.UserIDValueToPtr(req.GetAssigneeId()),
+		Status:       views.ProtoTicketStatusToDomain(req.GetStatus()),
+		Category:     views.ProtoTicketCategoryFilterToDomain(req.GetCategory()),
+		BreachedOnly: req.GetBreachedOnly(),
+		PageSize:     int(req.GetPageSize()),
+		PageToken:    req.GetPageToken(),
+		Actor:        actorFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.ListTicketsResultDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from ListTicketsQuery handler")
+	}
+	return views.ListTicketsResponse(dto), nil
+}
+
+func (h *SupportGRPCHandler) ReplyToTicket(ctx context.Context, req *pb.ReplyToTicketRequest) (*pb.TicketStatusResponse, error) {
+	cmd := command.ReplyToTicketCommand{
+		TicketID:    ids.TicketID(req.GetTicketId()),
+		Body:        req.GetBody(),
+		Internal:    req.GetInternal(),
+		Attachments: views.AttachmentInputs(req.GetAttachments()),
+		Actor:       actorFromCtx(ctx),
+	}
+	return h.dispatchTicketCommand(ctx, cmd, "ReplyToTicketCommand")
+}
+
+func (h *SupportGRPCHandler) AssignTicket(ctx context.Context, req *pb.AssignTicketRequest) (*pb.TicketStatusResponse, error) {
+	cmd := command.AssignTicketCommand{
+		TicketID:   ids.TicketID(req.GetTicketId()),
+		AssigneeID: views.UserIDValueToPtr(req.GetAssigneeId()),
+		Actor:      actorFromCtx(ctx),
+	}
+	return h.dispatchTicketCommand(ctx, cmd, "AssignTicketCommand")
+}
+
+func (h *SupportGRPCHandler) UpdateTicket(ctx context.Context, req *pb.UpdateTicketRequest) (*pb.TicketStatusResponse, error) {
+	cmd := command.UpdateTicketCommand{
+		TicketID: ids.TicketID(req.GetTicketId()),
+		Priority: views.ProtoTicketPriorityToDomain(req.GetPriority()),
+		Status:   views.ProtoTicketStatusToDomain(req.GetStatus()),
+		Actor:    actorFromCtx(ctx),
+	}
+	return h.dispatchTicketCommand(ctx, cmd, "UpdateTicketCommand")
+}
+
+func (h *SupportGRPCHandler) OpenDispute(ctx context.Context, req *pb.OpenDisputeRequest) (*pb.DisputeStatusResponse, error) {
+	cmd := command.OpenDisputeCommand{
+		TaskID: ids.TaskID(req.GetTaskId()),
+		Reason: req.GetReason(),
+		Actor:  actorFromCtx(ctx),
+	}
+	return h.dispatchDisputeCommand(ctx, cmd, "OpenDisputeCommand")
+}
+
+func (h *SupportGRPCHandler) GetDispute(ctx context.Context, req *pb.GetDisputeRequest) (*pb.Dispute, error) {
+	qry := query.GetDisputeQuery{
+		DisputeID: ids.DisputeID(req.GetDisputeId()),
+		Actor:     actorFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.DisputeDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from GetDisputeQuery handler")
+	}
+	return views.Dispute(dto), nil
+}
+
+func (h *SupportGRPCHandler) ListDisputes(ctx context.Context, req *pb.ListDisputesRequest) (*pb.ListDisputesResponse, error) {
+	qry := query.ListDisputesQuery{
+		PartyID:   views.UserIDValueToPtr(req.GetPartyId()),
+		TaskID:    views.TaskIDValueToPtr(req.GetTaskId()),
+		Status:    views.ProtoDisputeStatusToDomain(req.GetStatus()),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+		Actor:     actorFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.ListDisputesResultDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from ListDisputesQuery handler")
+	}
+	return views.ListDisputesResponse(dto), nil
+}
+
+func (h *SupportGRPCHandler) SubmitDisputeEvidence(ctx context.Context, req *pb.SubmitDisputeEvidenceRequest) (*pb.DisputeStatusResponse, error) {
+	cmd := command.SubmitDisputeEvidenceCommand{
+		DisputeID:   ids.DisputeID(req.GetDisputeId()),
+		Statement:   req.GetStatement(),
+		Attachments: views.AttachmentInputs(req.GetAttachments()),
+		Actor:       actorFromCtx(ctx),
+	}
+	return h.dispatchDisputeCommand(ctx, cmd, "SubmitDisputeEvidenceCommand")
+}
+
+func (h *SupportGRPCHandler) StartDisputeReview(ctx context.Context, req *pb.StartDisputeReviewRequest) (*pb.DisputeStatusResponse, error) {
+	cmd := command.StartDisputeReviewCommand{
+		DisputeID: ids.DisputeID(req.GetDisputeId()),
+		Actor:     actorFromCtx(ctx),
+	}
+	return h.dispatchDisputeCommand(ctx, cmd, "StartDisputeReviewCommand")
+}
+
+func (h *SupportGRPCHandler) ResolveDispute(ctx context.Context, req *pb.ResolveDisputeRequest) (*pb.DisputeStatusResponse, error) {
+	cmd := command.ResolveDisputeCommand{
+		DisputeID:    ids.DisputeID(req.GetDisputeId()),
+		Outcome:      views.ProtoDisputeOutcomeToDomain(req.GetOutcome()),
+		RefundAmount: views.Int64ValueToPtr(req.GetRefundAmount()),
+		Note:         req.GetNote(),
+		Actor:        actorFromCtx(ctx),
+	}
+	return h.dispatchDisputeCommand(ctx, cmd, "ResolveDisputeCommand")
+}
+
+func (h *SupportGRPCHandler) WithdrawDispute(ctx context.Context, req *pb.WithdrawDisputeRequest) (*pb.DisputeStatusResponse, error) {
+	cmd := command.WithdrawDisputeCommand{
+		DisputeID: ids.DisputeID(req.GetDisputeId()),
+		Actor:     actorFromCtx(ctx),
+	}
+	return h.dispatchDisputeCommand(ctx, cmd, "WithdrawDisputeCommand")
+}
+
+// dispatchTicketCommand runs a command whose handler reports the resulting
+// ticket state, which is what every ticket command RPC returns.
+func (h *SupportGRPCHandler) dispatchTicketCommand(ctx context.Context, cmd any, name string) (*pb.TicketStatusResponse, error) {
+	result, err := h.Command.Dispatch(ctx, cmd)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*command.TicketDto)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from %s handler", name)
+	}
+	return views.TicketStatusResponse(dto), nil
+}
+
+// dispatchDisputeCommand runs a command whose handler reports the resulting
+// dispute status, which is what every dispute command RPC returns.
+func (h *SupportGRPCHandler) dispatchDisputeCommand(ctx context.Context, cmd any, name string) (*pb.DisputeStatusResponse, error) {
+	result, err := h.Command.Dispatch(ctx, cmd)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*command.DisputeDto)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from %s handler", name)
+	}
+	return views.DisputeStatusResponse(dto), nil
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/proto/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/grpc/views"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type TaskGRPCHandler struct {
+	pb.UnimplementedTaskServiceServer
+	lg.GrpcHandlerOption
+}
+
+func RegisTaskGRPCHandler(
+	gs *grpc.Server,
+	opt lg.GrpcHandlerOption,
+) {
+	pb.RegisterTaskServiceServer(gs, &TaskGRPCHandler{
+		GrpcHandlerOption: opt,
+	})
+}
+
+func (h *TaskGRPCHandler) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.TaskStatusResponse, error) {
+	cmd := command.CreateTaskCommand{
+		Task:  views.TaskInput(req.GetTask()),
+		Actor: actorFromCtx(ctx),
+	}
+	return h.dispatchStatusCommand(ctx, cmd, "CreateTaskCommand")
+}
+
+func (h *TaskGRPCHandler) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*emptypb.Empty, error) {
+	cmd := command.UpdateTaskCommand{
+		TaskID: ids.TaskID(req.GetTaskId()),
+		Task:   views.TaskInput(req.GetTask()),
+		Actor:  actorFromCtx(ctx),
+	}
+	if _, err := h.Command.Dispatch(ctx, cmd); err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *TaskGRPCHandler) PublishTask(ctx context.Context, req *pb.PublishTaskRequest) (*pb.TaskStatusResponse, error) {
+	cmd := command.PublishTaskCommand{
+		TaskID: ids.TaskID(req.GetTaskId()),
+		Actor:  actorFromCtx(ctx),
+	}
+	return h.dispatchStatusCommand(ctx, cmd, "PublishTaskCommand")
+}
+
+func (h *TaskGRPCHandler) AssignTask(ctx context.Context, req *pb.AssignTaskRequest) (*pb.TaskStatusResponse, error) {
+	cmd := command.AssignTaskCommand{
+		TaskID:   ids.TaskID(req.GetTaskId()),
+		TaskerID: ids.UserID(req.GetTaskerId()),
+		Actor:    actorFromCtx(ctx),
+	}
+	return h.dispatchStatusCommand(ctx, cmd, "AssignTaskCommand")
+}
+
+func (h *TaskGRPCHandler) StartTask(ctx context.Context, req *pb.StartTaskRequest) (*pb.TaskStatusResponse, error) {
+	cmd := command.StartTaskCommand{
+		TaskID: ids.TaskID(req.GetTaskId()),
+		Actor:  actorFromCtx(ctx),
+	}
+	return h.dispatchStatusCommand(ctx, cmd, "StartTaskCommand")
+}
+
+func (h *TaskGRPCHandler) CompleteTask(ctx context.Context, req *pb.CompleteTaskRequest) (*pb.TaskStatusResponse, error) {
+	cmd := command.CompleteTaskCommand{
+		TaskID: ids.TaskID(req.GetTaskId()),
+		Actor:  actorFromCtx(ctx),
+	}
+	return h.dispatchStatusCommand(ctx, cmd, "CompleteTaskCommand")
+}
+
+func (h *TaskGRPCHandler) CancelTask(ctx context.Context, req *pb.CancelTaskRequest) (*pb.TaskStatusResponse, error) {
+	cmd := command.CancelTaskCommand{
+		TaskID: ids.TaskID(req.GetTaskId()),
+		Reason: lg.StringValueToPtr(req.GetReason()),
+		Actor:  actorFromCtx(ctx),
+	}
+	return h.dispatchStatusCommand(ctx, cmd, "CancelTaskCommand")
+}
+
+func (h *TaskGRPCHandler) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.Task, error) {
+	qry := query.GetTaskQuery{
+		TaskID: ids.TaskID(req.GetTaskId()),
+		Actor:  actorFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.TaskDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from GetTaskQuery handler")
+	}
+	return views.Task(dto), nil
+}
+
+func (h *TaskGRPCHandler) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
+	qry := query.ListTasksQuery{
+		Status:       views.ProtoTaskStatusToDomain(req.GetStatus()),
+		CategoryCode: lg.StringValueToPtr(req.GetCategoryCode()),
+		PageSize:     int(req.GetPageSize()),
+		PageToken:    req.GetPageToken(),
+		Actor:        actorFromCtx(ctx),
+	}
+	if posterID := lg.StringValueToPtr(req.GetPosterId()); posterID != nil {
+		id := ids.UserID(*posterID)
+		qry.PosterID = &id
+	}
+	if assigneeID := lg.StringValueToPtr(req.GetAssigneeId()); assigneeID != nil {
+		id := ids.UserID(*assigneeID)
+		qry.AssigneeID = &id
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.ListTasksResultDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from ListTasksQuery handler")
+	}
+	return views.ListTasksResponse(dto), nil
+}
+
+// dispatchStatusCommand runs a command whose handler reports the resulting
+// task status, which is what every lifecycle RPC returns.
+func (h *TaskGRPCHandler) dispatchStatusCommand(ctx context.Context, cmd any, name string) (*pb.TaskStatusResponse, error) {
+	result, err := h.Command.Dispatch(ctx, cmd)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*command.TaskDto)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from %s handler", name)
+	}
+	return views.TaskStatusResponse(dto), nil
+}
+
+// actorFromCtx turns the gateway-authenticated caller into a domain actor;
+// requests without caller metadata are anonymous.
+func actorFromCtx(ctx context.Context) task.Actor {
+	caller, ok := lg.CallerFromCtx(ctx)
+	if !ok {
+		return task.Actor{}
+	}
+	return task.Actor{
+		UserID:  ids.UserID(caller.UserID),
+		IsAdmin: caller.HasRole("admin"),
+	}
+}
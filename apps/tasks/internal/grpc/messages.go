@@ -0,0 +1,113 @@
+package grpc
+
+import lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+
+// thaiMessages are the Thai texts of the service's error reasons. English
+// needs none: it is the language of the errors themselves.
+var thaiMessages = lg.Messages{
+	"INVALID_PAGE_SIZE":  "page_size ต้องไม่ติดลบ",
+	"INVALID_PAGE_TOKEN": "page_token ไม่ถูกต้องหรือไม่ตรงกับคำขอ",
+
+	"TASK_NOT_FOUND":               "ไม่พบงาน",
+	"TASK_SIGN_IN_REQUIRED":        "กรุณาเข้าสู่ระบบเพื่อจัดการงาน",
+	"TASK_NOT_TASK_POSTER":         "เฉพาะผู้ประกาศงานเท่านั้นที่ทำรายการนี้ได้",
+	"TASK_NOT_TASK_PARTICIPANT":    "เฉพาะผู้ประกาศงานหรือผู้รับงานที่ได้รับมอบหมายเท่านั้นที่ทำรายการนี้ได้",
+	"TASK_INVALID_TRANSITION":      "ไม่สามารถเปลี่ยนงานจากสถานะปัจจุบันเป็นสถานะนี้ได้",
+	"TASK_NOT_EDITABLE":            "แก้ไขได้เฉพาะงานที่เป็นฉบับร่างหรือเปิดรับอยู่เท่านั้น",
+	"TASK_INVALID_TITLE":           "กรุณาระบุชื่องาน ยาวไม่เกิน 150 ตัวอักษร",
+	"TASK_INVALID_DESCRIPTION":     "กรุณาระบุรายละเอียดงาน ยาวไม่เกิน 5000 ตัวอักษร",
+	"TASK_INVALID_CATEGORY":        "หมวดหมู่ต้องมี 2-64 ตัว ประกอบด้วยตัวพิมพ์เล็ก ตัวเลข หรือขีดล่าง และขึ้นต้นด้วยตัวอักษร",
+	"TASK_INVALID_BUDGET":          "งบประมาณต้องเป็นจำนวนบวกพร้อมรหัสสกุลเงินสามตัวอักษร",
+	"TASK_INVALID_LOCATION":        "สถานที่ต้องระบุจังหวัด และต้องระบุละติจูดกับลองจิจูดคู่กันในช่วงที่ถูกต้อง",
+	"TASK_DUE_DATE_IN_PAST":        "วันครบกำหนดต้องเป็นวันในอนาคต",
+	"TASK_INVALID_ATTACHMENT":      "ไฟล์แนบต้องมี URL ชื่อไฟล์ยาวไม่เกิน 255 ตัวอักษร และขนาดไม่ติดลบ",
+	"TASK_TOO_MANY_ATTACHMENTS":    "มีไฟล์แนบมากเกินไป",
+	"TASK_ASSIGNEE_REQUIRED":       "กรุณาระบุผู้รับงานที่จะมอบหมาย",
+	"TASK_CANNOT_ASSIGN_TO_POSTER": "ไม่สามารถมอบหมายงานให้ผู้ประกาศงานเองได้",
+	"TASK_CANCELLATION_TOO_LONG":   "เหตุผลการยกเลิกต้องยาวไม่เกิน 500 ตัวอักษร",
+	"TASK_INVALID_TASK_STATUS":     "ไม่รู้จักสถานะงานนี้",
+	"TASK_DRAFT_LIST_DENIED":       "เฉพาะผู้ประกาศงานหรือผู้ดูแลระบบเท่านั้นที่ดูรายการฉบับร่างได้",
+	"TASK_NOT_AWARDED":             "งานนี้ยังไม่ได้มอบหมาย หรือไม่ได้มอบหมายผ่านข้อเสนอนี้",
+	"TASK_CHANGED":                 "งานถูกแก้ไขระหว่างที่คุณกำลังแก้ไข กรุณาโหลดใหม่แล้วลองอีกครั้ง",
+
+	"REVIEW_NOT_FOUND":                   "ไม่พบรีวิว",
+	"REVIEW_SIGN_IN_REQUIRED":            "กรุณาเข้าสู่ระบบเพื่อรีวิวงาน",
+	"REVIEW_TASK_NOT_COMPLETED":          "รีวิวได้เฉพาะงานที่เสร็จแล้วเท่านั้น",
+	"REVIEW_NOT_TASK_PARTICIPANT":        "เฉพาะผู้ประกาศงานและผู้รับงานที่ได้รับมอบหมายเท่านั้นที่รีวิวงานได้",
+	"REVIEW_ALREADY_REVIEWED":            "คุณรีวิวงานนี้แล้ว",
+	"REVIEW_NOT_REVIEWER":                "เฉพาะผู้เขียนรีวิวเท่านั้นที่แก้ไขได้",
+	"REVIEW_NOT_REVIEWEE":                "เฉพาะผู้ที่ถูกรีวิวเท่านั้นที่ทำรายการนี้ได้",
+	"REVIEW_MODERATOR_ONLY":              "เฉพาะผู้ดูแลระบบเท่านั้นที่ตรวจสอบรีวิวได้",
+	"REVIEW_EDIT_WINDOW_CLOSED":          "หมดเวลาแก้ไขรีวิวแล้ว",
+	"REVIEW_REMOVED":                     "รีวิวนี้ถูกผู้ดูแลระบบลบแล้ว",
+	"REVIEW_INVALID_RATING":              "คะแนนต้องอยู่ระหว่าง 1 ถึง 5 ดาว",
+	"REVIEW_INVALID_CATEGORY":            "หมวดคะแนนนี้ใช้กับรีวิวนี้ไม่ได้",
+	"REVIEW_DUPLICATE_CATEGORY":          "ให้คะแนนแต่ละหมวดได้เพียงครั้งเดียว",
+	"REVIEW_COMMENT_TOO_LONG":            "ความคิดเห็นต้องยาวไม่เกิน 2000 ตัวอักษร",
+	"REVIEW_INVALID_REPLY":               "คำตอบต้องยาว 1 ถึง 1000 ตัวอักษร",
+	"REVIEW_INVALID_REASON":              "เหตุผลต้องยาว 1 ถึง 500 ตัวอักษร",
+	"REVIEW_INVALID_MODERATION_DECISION": "ผู้ดูแลระบบทำได้เพียงเผยแพร่หรือลบรีวิว",
+	"REVIEW_CHANGED":                     "รีวิวถูกแก้ไขระหว่างที่คุณกำลังแก้ไข กรุณาโหลดใหม่แล้วลองอีกครั้ง",
+	"REVIEW_INVALID_ROLE":                "บทบาทต้องเป็น POSTER หรือ TASKER",
+	"REVIEW_REPUTATION_NOT_FOUND":        "ไม่พบคะแนนชื่อเสียง",
+
+	"DISPUTE_NOT_FOUND":             "ไม่พบข้อพิพาท",
+	"DISPUTE_SIGN_IN_REQUIRED":      "กรุณาเข้าสู่ระบบเพื่อจัดการข้อพิพาท",
+	"DISPUTE_NOT_TASK_PARTICIPANT":  "เฉพาะผู้ประกาศงานและผู้รับงานที่ได้รับมอบหมายเท่านั้นที่ทำรายการนี้ได้",
+	"DISPUTE_ADMIN_ONLY":            "เฉพาะผู้ดูแลระบบเท่านั้นที่พิจารณาและตัดสินข้อพิพาทได้",
+	"DISPUTE_NOT_OPENER":            "เฉพาะผู้ที่เปิดข้อพิพาทเท่านั้นที่ถอนข้อพิพาทได้",
+	"DISPUTE_TASK_NOT_DISPUTABLE":   "เปิดข้อพิพาทได้เฉพาะงานที่มอบหมายแล้ว กำลังดำเนินการ หรือเสร็จแล้วเท่านั้น",
+	"DISPUTE_ALREADY_OPEN":          "งานนี้มีข้อพิพาทที่รอการตัดสินอยู่แล้ว",
+	"DISPUTE_INVALID_REASON":        "กรุณาระบุเหตุผล ยาวไม่เกิน 2000 ตัวอักษร",
+	"DISPUTE_INVALID_STATEMENT":     "กรุณาระบุคำชี้แจง ยาวไม่เกิน 5000 ตัวอักษร",
+	"DISPUTE_TOO_MUCH_EVIDENCE":     "แต่ละฝ่ายส่งหลักฐานได้ไม่เกิน 10 รายการ",
+	"DISPUTE_CLOSED":                "ข้อพิพาทนี้ถูกตัดสินหรือถอนไปแล้ว",
+	"DISPUTE_NOT_UNDER_REVIEW":      "ตัดสินข้อพิพาทได้เมื่ออยู่ระหว่างการพิจารณาเท่านั้น",
+	"DISPUTE_ALREADY_UNDER_REVIEW":  "ข้อพิพาทนี้อยู่ระหว่างการพิจารณาแล้ว",
+	"DISPUTE_INVALID_STATUS":        "สถานะต้องเป็น OPEN, UNDER_REVIEW, RESOLVED หรือ WITHDRAWN",
+	"DISPUTE_INVALID_OUTCOME":       "ผลการตัดสินต้องเป็น REFUND, PARTIAL_REFUND หรือ RELEASE",
+	"DISPUTE_INVALID_REFUND_AMOUNT": "การคืนเงินบางส่วนต้องระบุจำนวนเงินที่เป็นบวก ผลการตัดสินอื่นต้องไม่ระบุจำนวนเงิน",
+	"DISPUTE_INVALID_DECISION_NOTE": "กรุณาระบุบันทึกการตัดสิน ยาวไม่เกิน 2000 ตัวอักษร",
+	"DISPUTE_CHANGED":               "ข้อพิพาทถูกแก้ไขระหว่างที่คุณกำลังแก้ไข กรุณาโหลดใหม่แล้วลองอีกครั้ง",
+	"DISPUTE_LIST_FORBIDDEN":        "เฉพาะผู้ดูแลระบบเท่านั้นที่ดูข้อพิพาทของผู้ใช้อื่นได้",
+
+	"TICKET_NOT_FOUND":            "ไม่พบคำร้อง",
+	"TICKET_SIGN_IN_REQUIRED":     "กรุณาเข้าสู่ระบบเพื่อติดต่อฝ่ายช่วยเหลือ",
+	"TICKET_STAFF_ONLY":           "เฉพาะเจ้าหน้าที่ฝ่ายช่วยเหลือเท่านั้นที่ทำรายการนี้ได้",
+	"TICKET_NOT_REPORTER":         "เฉพาะผู้แจ้งหรือเจ้าหน้าที่ฝ่ายช่วยเหลือเท่านั้นที่ทำรายการนี้ได้",
+	"TICKET_INVALID_CATEGORY":     "ไม่รู้จักหมวดหมู่คำร้องนี้",
+	"TICKET_DISPUTE_CATEGORY":     "คำร้องข้อพิพาทจะเปิดขึ้นเมื่อเปิดข้อพิพาท",
+	"TICKET_INVALID_PRIORITY":     "ความสำคัญต้องเป็น LOW, NORMAL, HIGH หรือ URGENT",
+	"TICKET_INVALID_STATUS":       "ไม่รู้จักสถานะคำร้องนี้",
+	"TICKET_INVALID_SUBJECT":      "กรุณาระบุหัวข้อ ยาวไม่เกิน 200 ตัวอักษร",
+	"TICKET_INVALID_MESSAGE":      "กรุณาระบุข้อความ ยาวไม่เกิน 5000 ตัวอักษร",
+	"TICKET_INVALID_ATTACHMENT":   "ไฟล์แนบต้องมี URL ชื่อไฟล์ยาวไม่เกิน 255 ตัวอักษร และขนาดไม่เกิน 10 MB",
+	"TICKET_TOO_MANY_ATTACHMENTS": "แนบไฟล์ได้ไม่เกิน 5 ไฟล์ต่อข้อความ",
+	"TICKET_INVALID_TRANSITION":   "ไม่สามารถเปลี่ยนคำร้องจากสถานะปัจจุบันเป็นสถานะนี้ได้",
+	"TICKET_CLOSED":               "คำร้องนี้ปิดแล้ว",
+	"TICKET_MANAGED_BY_DISPUTE":   "สถานะของคำร้องข้อพิพาทเปลี่ยนตามข้อพิพาท",
+	"TICKET_NOTHING_TO_UPDATE":    "กรุณาระบุความสำคัญหรือสถานะที่ต้องการเปลี่ยน",
+	"TICKET_ASSIGNEE_REQUIRED":    "กรุณาระบุเจ้าหน้าที่ที่จะมอบหมายคำร้อง",
+	"TICKET_CHANGED":              "คำร้องถูกแก้ไขระหว่างที่คุณกำลังแก้ไข กรุณาโหลดใหม่แล้วลองอีกครั้ง",
+	"TICKET_LIST_FORBIDDEN":       "เฉพาะเจ้าหน้าที่ฝ่ายช่วยเหลือเท่านั้นที่ดูคำร้องของผู้ใช้อื่นได้",
+
+	"BID_NOT_FOUND":               "ไม่พบการเสนอราคา",
+	"BID_SIGN_IN_REQUIRED":        "กรุณาเข้าสู่ระบบเพื่อเสนอราคางาน",
+	"BID_TASK_NOT_OPEN":           "เสนอราคาได้เฉพาะงานที่เปิดรับอยู่เท่านั้น",
+	"BID_CANNOT_BID_OWN_TASK":     "ผู้ประกาศงานไม่สามารถเสนอราคางานของตนเองได้",
+	"BID_ALREADY_PLACED":          "คุณมีการเสนอราคาที่เปิดอยู่สำหรับงานนี้แล้ว",
+	"BID_TASK_ALREADY_AWARDED":    "มีการตอบรับการเสนอราคาอื่นของงานนี้แล้ว",
+	"BID_NOT_BID_PARTICIPANT":     "เฉพาะผู้ประกาศงานหรือผู้รับงานที่เสนอราคาเท่านั้นที่ทำรายการนี้ได้",
+	"BID_NOT_TASK_POSTER":         "เฉพาะผู้ประกาศงานเท่านั้นที่ทำรายการนี้ได้",
+	"BID_NOT_BIDDER":              "เฉพาะผู้รับงานที่เสนอราคาเท่านั้นที่ทำรายการนี้ได้",
+	"BID_NOT_YOUR_TURN":           "การเสนอราคากำลังรอให้อีกฝ่ายตอบกลับ",
+	"BID_CLOSED":                  "การเสนอราคานี้ถูกตอบรับ ปฏิเสธ หรือถอนไปแล้ว",
+	"BID_EXPIRED":                 "การเสนอราคานี้หมดอายุแล้ว",
+	"BID_CHANGED":                 "การเสนอราคาถูกแก้ไขระหว่างที่คุณกำลังตอบกลับ กรุณาโหลดใหม่แล้วลองอีกครั้ง",
+	"BID_INVALID_AMOUNT":          "จำนวนเงินต้องเป็นค่าบวก",
+	"BID_CURRENCY_MISMATCH":       "การเสนอราคาต้องใช้สกุลเงินเดียวกับงบประมาณของงาน",
+	"BID_MESSAGE_TOO_LONG":        "ข้อความต้องยาวไม่เกิน 1000 ตัวอักษร",
+	"BID_INVALID_SCHEDULE":        "กำหนดการที่เสนอต้องเริ่มในอนาคตและสิ้นสุดหลังเวลาเริ่ม",
+	"BID_INVALID_EXPIRY":          "ข้อเสนอต้องหมดอายุระหว่าง 1 ชั่วโมงถึง 30 วันนับจากนี้",
+	"BID_TOO_MANY_COUNTER_OFFERS": "มีการต่อรองราคาสำหรับการเสนอราคานี้มากเกินไป",
+}
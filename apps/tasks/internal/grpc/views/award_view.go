@@ -0,0 +1,22 @@
+package views
+
+import (
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/payments/api/proto/award"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/query"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+)
+
+func TaskAward(payload *query.TaskAwardDTO) *pb.TaskAward {
+	if payload == nil {
+		return nil
+	}
+	return &pb.TaskAward{
+		TaskId:    payload.TaskID,
+		BidId:     lg.PtrToStringValue(payload.BidID),
+		PosterId:  payload.PosterID,
+		TaskerId:  payload.TaskerID,
+		Amount:    payload.Price.Amount,
+		Currency:  payload.Price.Currency,
+		Completed: payload.Completed,
+	}
+}
@@ -0,0 +1,130 @@
+package views
+
+import (
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/proto/bid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/bid"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func domainBidStatusToProto(status bid.Status) pb.BidStatus {
+	switch status {
+	case bid.StatusPending:
+		return pb.BidStatus_BID_STATUS_PENDING
+	case bid.StatusCountered:
+		return pb.BidStatus_BID_STATUS_COUNTERED
+	case bid.StatusAccepted:
+		return pb.BidStatus_BID_STATUS_ACCEPTED
+	case bid.StatusRejected:
+		return pb.BidStatus_BID_STATUS_REJECTED
+	case bid.StatusWithdrawn:
+		return pb.BidStatus_BID_STATUS_WITHDRAWN
+	case bid.StatusExpired:
+		return pb.BidStatus_BID_STATUS_EXPIRED
+	default:
+		return pb.BidStatus_BID_STATUS_UNSPECIFIED
+	}
+}
+
+func domainPartyToProto(party bid.Party) pb.Party {
+	switch party {
+	case bid.PartyPoster:
+		return pb.Party_PARTY_POSTER
+	case bid.PartyTasker:
+		return pb.Party_PARTY_TASKER
+	default:
+		return pb.Party_PARTY_UNSPECIFIED
+	}
+}
+
+// BidTermsInput maps the terms of a place or counter request.
+func BidTermsInput(in *pb.BidTerms) command.BidTermsInput {
+	out := command.BidTermsInput{
+		Amount:   in.GetAmount(),
+		Currency: in.GetCurrency(),
+		Message:  lg.StringValueToPtr(in.GetMessage()),
+	}
+	if s := in.GetSchedule(); s != nil {
+		out.Schedule = &command.ScheduleInput{
+			StartAt: s.GetStartAt().AsTime(),
+			EndAt:   s.GetEndAt().AsTime(),
+		}
+	}
+	if in.GetExpiresAt() != nil {
+		expiresAt := in.GetExpiresAt().AsTime()
+		out.ExpiresAt = &expiresAt
+	}
+	return out
+}
+
+func BidStatusResponse(payload *command.BidDto) *pb.BidStatusResponse {
+	if payload == nil {
+		return nil
+	}
+	return &pb.BidStatusResponse{
+		BidId:  payload.BidID,
+		Status: domainBidStatusToProto(payload.Status),
+	}
+}
+
+func Bid(payload *query.BidDTO) *pb.Bid {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.Bid{
+		Id:        payload.ID,
+		TaskId:    payload.TaskID,
+		PosterId:  payload.PosterID,
+		TaskerId:  payload.TaskerID,
+		Status:    domainBidStatusToProto(payload.Status),
+		Offers:    make([]*pb.Offer, 0, len(payload.Offers)),
+		ExpiresAt: timestamppb.New(payload.ExpiresAt),
+		ClosedAt:  timestamp(payload.ClosedAt),
+		CreatedAt: timestamppb.New(payload.CreatedAt),
+		UpdatedAt: timestamppb.New(payload.UpdatedAt),
+	}
+	if payload.AwaitingParty != nil {
+		resp.AwaitingParty = domainPartyToProto(*payload.AwaitingParty)
+	}
+	for _, o := range payload.Offers {
+		offer := &pb.Offer{
+			Id:        o.ID,
+			Party:     domainPartyToProto(o.Party),
+			Amount:    o.Amount,
+			Currency:  o.Currency,
+			Message:   lg.PtrToStringValue(o.Message),
+			ExpiresAt: timestamppb.New(o.ExpiresAt),
+			CreatedAt: timestamppb.New(o.CreatedAt),
+		}
+		if o.Schedule != nil {
+			offer.Schedule = &pb.Schedule{
+				StartAt: timestamppb.New(o.Schedule.StartAt),
+				EndAt:   timestamppb.New(o.Schedule.EndAt),
+			}
+		}
+		resp.Offers = append(resp.Offers, offer)
+	}
+	return resp
+}
+
+func ListTaskBidsResponse(payload []*query.BidDTO) *pb.ListTaskBidsResponse {
+	bids := make([]*pb.Bid, 0, len(payload))
+	for _, b := range payload {
+		bids = append(bids, Bid(b))
+	}
+	return &pb.ListTaskBidsResponse{Bids: bids}
+}
+
+func ListBookingsResponse(payload []query.BookingDTO) *pb.ListBookingsResponse {
+	bookings := make([]*pb.Booking, 0, len(payload))
+	for _, b := range payload {
+		bookings = append(bookings, &pb.Booking{
+			TaskerId: b.TaskerID,
+			StartAt:  timestamppb.New(b.StartAt),
+			EndAt:    timestamppb.New(b.EndAt),
+		})
+	}
+	return &pb.ListBookingsResponse{Bookings: bookings}
+}
@@ -0,0 +1,203 @@
+package views
+
+import (
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/proto/review"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/review"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var reviewCategories = map[review.Category]pb.ReviewCategory{
+	review.CategoryQuality:         pb.ReviewCategory_REVIEW_CATEGORY_QUALITY,
+	review.CategoryPunctuality:     pb.ReviewCategory_REVIEW_CATEGORY_PUNCTUALITY,
+	review.CategoryCommunication:   pb.ReviewCategory_REVIEW_CATEGORY_COMMUNICATION,
+	review.CategoryProfessionalism: pb.ReviewCategory_REVIEW_CATEGORY_PROFESSIONALISM,
+	review.CategoryClarity:         pb.ReviewCategory_REVIEW_CATEGORY_CLARITY,
+	review.CategoryPayment:         pb.ReviewCategory_REVIEW_CATEGORY_PAYMENT,
+}
+
+func domainReviewStatusToProto(status review.Status) pb.ReviewStatus {
+	switch status {
+	case review.StatusPublished:
+		return pb.ReviewStatus_REVIEW_STATUS_PUBLISHED
+	case review.StatusFlagged:
+		return pb.ReviewStatus_REVIEW_STATUS_FLAGGED
+	case review.StatusRemoved:
+		return pb.ReviewStatus_REVIEW_STATUS_REMOVED
+	default:
+		return pb.ReviewStatus_REVIEW_STATUS_UNSPECIFIED
+	}
+}
+
+// ProtoReviewStatusToDomain maps a moderation decision; UNSPECIFIED maps to
+// "" and fails validation.
+func ProtoReviewStatusToDomain(status pb.ReviewStatus) review.Status {
+	switch status {
+	case pb.ReviewStatus_REVIEW_STATUS_PUBLISHED:
+		return review.StatusPublished
+	case pb.ReviewStatus_REVIEW_STATUS_FLAGGED:
+		return review.StatusFlagged
+	case pb.ReviewStatus_REVIEW_STATUS_REMOVED:
+		return review.StatusRemoved
+	default:
+		return ""
+	}
+}
+
+func domainRoleToProto(role review.Role) pb.Role {
+	switch role {
+	case review.RolePoster:
+		return pb.Role_ROLE_POSTER
+	case review.RoleTasker:
+		return pb.Role_ROLE_TASKER
+	default:
+		return pb.Role_ROLE_UNSPECIFIED
+	}
+}
+
+// ProtoRoleToDomain maps a role filter; UNSPECIFIED means "no filter".
+func ProtoRoleToDomain(role pb.Role) *review.Role {
+	var r review.Role
+	switch role {
+	case pb.Role_ROLE_POSTER:
+		r = review.RolePoster
+	case pb.Role_ROLE_TASKER:
+		r = review.RoleTasker
+	default:
+		return nil
+	}
+	return &r
+}
+
+func domainTrendToProto(trend review.Trend) pb.Trend {
+	switch trend {
+	case review.TrendStable:
+		return pb.Trend_TREND_STABLE
+	case review.TrendUp:
+		return pb.Trend_TREND_UP
+	case review.TrendDown:
+		return pb.Trend_TREND_DOWN
+	default:
+		return pb.Trend_TREND_UNSPECIFIED
+	}
+}
+
+// protoCategoryToDomain maps UNSPECIFIED and unknown categories to their
+// enum name so the domain rejects them.
+func protoCategoryToDomain(category pb.ReviewCategory) string {
+	for c, p := range reviewCategories {
+		if p == category {
+			return string(c)
+		}
+	}
+	return category.String()
+}
+
+// ReviewContentInput maps the content of a submit or edit request.
+func ReviewContentInput(in *pb.ReviewContent) command.ReviewContentInput {
+	out := command.ReviewContentInput{
+		Rating:  int(in.GetRating()),
+		Scores:  make([]command.ScoreInput, 0, len(in.GetScores())),
+		Comment: lg.StringValueToPtr(in.GetComment()),
+	}
+	for _, s := range in.GetScores() {
+		out.Scores = append(out.Scores, command.ScoreInput{
+			Category: protoCategoryToDomain(s.GetCategory()),
+			Score:    int(s.GetScore()),
+		})
+	}
+	return out
+}
+
+func ReviewStatusResponse(payload *command.ReviewDto) *pb.ReviewStatusResponse {
+	if payload == nil {
+		return nil
+	}
+	return &pb.ReviewStatusResponse{
+		ReviewId: payload.ReviewID,
+		Status:   domainReviewStatusToProto(payload.Status),
+	}
+}
+
+func Review(payload *query.ReviewDTO) *pb.Review {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.Review{
+		Id:             payload.ID,
+		TaskId:         payload.TaskID,
+		ReviewerId:     payload.ReviewerID,
+		RevieweeId:     payload.RevieweeID,
+		RevieweeRole:   domainRoleToProto(payload.RevieweeRole),
+		Rating:         int32(payload.Rating),
+		Scores:         make([]*pb.CategoryScore, 0, len(payload.Scores)),
+		Comment:        lg.PtrToStringValue(payload.Comment),
+		Status:         domainReviewStatusToProto(payload.Status),
+		FlagReason:     lg.PtrToStringValue(payload.FlagReason),
+		ModerationNote: lg.PtrToStringValue(payload.ModerationNote),
+		EditableUntil:  timestamppb.New(payload.EditableUntil),
+		CreatedAt:      timestamppb.New(payload.CreatedAt),
+		UpdatedAt:      timestamppb.New(payload.UpdatedAt),
+	}
+	for _, s := range payload.Scores {
+		resp.Scores = append(resp.Scores, &pb.CategoryScore{
+			Category: reviewCategories[s.Category],
+			Score:    int32(s.Score),
+		})
+	}
+	if payload.Reply != nil {
+		resp.Reply = &pb.Reply{
+			Body:      payload.Reply.Body,
+			CreatedAt: timestamppb.New(payload.Reply.CreatedAt),
+			UpdatedAt: timestamppb.New(payload.Reply.UpdatedAt),
+		}
+	}
+	return resp
+}
+
+func ListTaskReviewsResponse(payload []*query.ReviewDTO) *pb.ListTaskReviewsResponse {
+	resp := &pb.ListTaskReviewsResponse{Reviews: make([]*pb.Review, 0, len(payload))}
+	for _, r := range payload {
+		resp.Reviews = append(resp.Reviews, Review(r))
+	}
+	return resp
+}
+
+func ListUserReviewsResponse(payload *query.ListUserReviewsResultDTO) *pb.ListUserReviewsResponse {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.ListUserReviewsResponse{
+		Reviews:       make([]*pb.Review, 0, len(payload.Reviews)),
+		NextPageToken: payload.NextPageToken,
+	}
+	for i := range payload.Reviews {
+		resp.Reviews = append(resp.Reviews, Review(&payload.Reviews[i]))
+	}
+	return resp
+}
+
+func Reputation(payload *query.ReputationDTO) *pb.Reputation {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.Reputation{
+		UserId:           payload.UserID,
+		ReviewCount:      int32(payload.ReviewCount),
+		AverageRating:    payload.AverageRating,
+		BayesianAverage:  payload.BayesianAverage,
+		RecentAverage:    payload.RecentAverage,
+		Trend:            domainTrendToProto(payload.Trend),
+		CategoryAverages: make([]*pb.CategoryAverage, 0, len(payload.CategoryAverages)),
+		UpdatedAt:        timestamppb.New(payload.UpdatedAt),
+	}
+	for _, c := range payload.CategoryAverages {
+		resp.CategoryAverages = append(resp.CategoryAverages, &pb.CategoryAverage{
+			Category: reviewCategories[c.Category],
+			Average:  c.Average,
+		})
+	}
+	return resp
+}
@@ -0,0 +1,300 @@
+package views
+
+import (
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/proto/support"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/dispute"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var ticketStatuses = map[ticket.Status]pb.TicketStatus{
+	ticket.StatusOpen:          pb.TicketStatus_TICKET_STATUS_OPEN,
+	ticket.StatusInProgress:    pb.TicketStatus_TICKET_STATUS_IN_PROGRESS,
+	ticket.StatusWaitingOnUser: pb.TicketStatus_TICKET_STATUS_WAITING_ON_USER,
+	ticket.StatusResolved:      pb.TicketStatus_TICKET_STATUS_RESOLVED,
+	ticket.StatusClosed:        pb.TicketStatus_TICKET_STATUS_CLOSED,
+}
+
+var ticketCategories = map[ticket.Category]pb.TicketCategory{
+	ticket.CategoryAccount: pb.TicketCategory_TICKET_CATEGORY_ACCOUNT,
+	ticket.CategoryPayment: pb.TicketCategory_TICKET_CATEGORY_PAYMENT,
+	ticket.CategoryTask:    pb.TicketCategory_TICKET_CATEGORY_TASK,
+	ticket.CategorySafety:  pb.TicketCategory_TICKET_CATEGORY_SAFETY,
+	ticket.CategoryDispute: pb.TicketCategory_TICKET_CATEGORY_DISPUTE,
+	ticket.CategoryOther:   pb.TicketCategory_TICKET_CATEGORY_OTHER,
+}
+
+var ticketPriorities = map[ticket.Priority]pb.TicketPriority{
+	ticket.PriorityLow:    pb.TicketPriority_TICKET_PRIORITY_LOW,
+	ticket.PriorityNormal: pb.TicketPriority_TICKET_PRIORITY_NORMAL,
+	ticket.PriorityHigh:   pb.TicketPriority_TICKET_PRIORITY_HIGH,
+	ticket.PriorityUrgent: pb.TicketPriority_TICKET_PRIORITY_URGENT,
+}
+
+var disputeStatuses = map[dispute.Status]pb.DisputeStatus{
+	dispute.StatusOpen:        pb.DisputeStatus_DISPUTE_STATUS_OPEN,
+	dispute.StatusUnderReview: pb.DisputeStatus_DISPUTE_STATUS_UNDER_REVIEW,
+	dispute.StatusResolved:    pb.DisputeStatus_DISPUTE_STATUS_RESOLVED,
+	dispute.StatusWithdrawn:   pb.DisputeStatus_DISPUTE_STATUS_WITHDRAWN,
+}
+
+var disputeOutcomes = map[dispute.Outcome]pb.DisputeOutcome{
+	dispute.OutcomeRefund:        pb.DisputeOutcome_DISPUTE_OUTCOME_REFUND,
+	dispute.OutcomePartialRefund: pb.DisputeOutcome_DISPUTE_OUTCOME_PARTIAL_REFUND,
+	dispute.OutcomeRelease:       pb.DisputeOutcome_DISPUTE_OUTCOME_RELEASE,
+}
+
+// protoEnumToDomain finds the domain value of a proto enum; UNSPECIFIED and
+// unknown values map to false.
+func protoEnumToDomain[D comparable, P comparable](values map[D]P, p P) (D, bool) {
+	for d, v := range values {
+		if v == p {
+			return d, true
+		}
+	}
+	var zero D
+	return zero, false
+}
+
+// ProtoTicketCategoryToDomain maps the category of a new ticket;
+// UNSPECIFIED maps to "" and fails validation.
+func ProtoTicketCategoryToDomain(category pb.TicketCategory) ticket.Category {
+	c, _ := protoEnumToDomain(ticketCategories, category)
+	return c
+}
+
+// ProtoTicketCategoryFilterToDomain maps a category filter; UNSPECIFIED
+// means "no filter".
+func ProtoTicketCategoryFilterToDomain(category pb.TicketCategory) *ticket.Category {
+	c, ok := protoEnumToDomain(ticketCategories, category)
+	if !ok {
+		return nil
+	}
+	return &c
+}
+
+// ProtoTicketStatusToDomain maps an optional status; UNSPECIFIED maps to nil.
+func ProtoTicketStatusToDomain(status pb.TicketStatus) *ticket.Status {
+	s, ok := protoEnumToDomain(ticketStatuses, status)
+	if !ok {
+		return nil
+	}
+	return &s
+}
+
+// ProtoTicketPriorityToDomain maps an optional priority; UNSPECIFIED maps to
+// nil.
+func ProtoTicketPriorityToDomain(priority pb.TicketPriority) *ticket.Priority {
+	p, ok := protoEnumToDomain(ticketPriorities, priority)
+	if !ok {
+		return nil
+	}
+	return &p
+}
+
+// ProtoDisputeStatusToDomain maps a status filter; UNSPECIFIED means "no
+// filter".
+func ProtoDisputeStatusToDomain(status pb.DisputeStatus) *dispute.Status {
+	s, ok := protoEnumToDomain(disputeStatuses, status)
+	if !ok {
+		return nil
+	}
+	return &s
+}
+
+// ProtoDisputeOutcomeToDomain maps a decision; UNSPECIFIED maps to "" and
+// fails validation.
+func ProtoDisputeOutcomeToDomain(outcome pb.DisputeOutcome) dispute.Outcome {
+	o, _ := protoEnumToDomain(disputeOutcomes, outcome)
+	return o
+}
+
+func UserIDValueToPtr(v *wrapperspb.StringValue) *ids.UserID {
+	if v == nil {
+		return nil
+	}
+	id := ids.UserID(v.GetValue())
+	return &id
+}
+
+func TaskIDValueToPtr(v *wrapperspb.StringValue) *ids.TaskID {
+	if v == nil {
+		return nil
+	}
+	id := ids.TaskID(v.GetValue())
+	return &id
+}
+
+func Int64ValueToPtr(v *wrapperspb.Int64Value) *int64 {
+	if v == nil {
+		return nil
+	}
+	n := v.GetValue()
+	return &n
+}
+
+func ptrToInt64Value(n *int64) *wrapperspb.Int64Value {
+	if n == nil {
+		return nil
+	}
+	return wrapperspb.Int64(*n)
+}
+
+// AttachmentInputs maps the attachments of a request.
+func AttachmentInputs(in []*pb.Attachment) []command.FileInput {
+	out := make([]command.FileInput, 0, len(in))
+	for _, a := range in {
+		out = append(out, command.FileInput{
+			URL:         a.GetUrl(),
+			FileName:    a.GetFileName(),
+			ContentType: lg.StringValueToPtr(a.GetContentType()),
+			SizeBytes:   a.GetSizeBytes(),
+		})
+	}
+	return out
+}
+
+func attachments(files []query.FileDTO) []*pb.Attachment {
+	out := make([]*pb.Attachment, 0, len(files))
+	for _, f := range files {
+		out = append(out, &pb.Attachment{
+			Url:         f.URL,
+			FileName:    f.FileName,
+			ContentType: lg.PtrToStringValue(f.ContentType),
+			SizeBytes:   f.SizeBytes,
+		})
+	}
+	return out
+}
+
+func TicketStatusResponse(payload *command.TicketDto) *pb.TicketStatusResponse {
+	if payload == nil {
+		return nil
+	}
+	return &pb.TicketStatusResponse{
+		TicketId: payload.TicketID,
+		Status:   ticketStatuses[payload.Status],
+		Priority: ticketPriorities[payload.Priority],
+		SlaDueAt: timestamppb.New(payload.SLADueAt),
+	}
+}
+
+func Ticket(payload *query.TicketDTO) *pb.Ticket {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.Ticket{
+		Id:            payload.ID,
+		ReporterId:    payload.ReporterID,
+		Category:      ticketCategories[payload.Category],
+		Priority:      ticketPriorities[payload.Priority],
+		Subject:       payload.Subject,
+		Status:        ticketStatuses[payload.Status],
+		AssigneeId:    lg.PtrToStringValue(payload.AssigneeID),
+		TaskId:        lg.PtrToStringValue(payload.TaskID),
+		Messages:      make([]*pb.TicketMessage, 0, len(payload.Messages)),
+		SlaDueAt:      timestamppb.New(payload.SLADueAt),
+		SlaBreachedAt: timestamp(payload.SLABreachedAt),
+		ResolvedAt:    timestamp(payload.ResolvedAt),
+		ClosedAt:      timestamp(payload.ClosedAt),
+		CreatedAt:     timestamppb.New(payload.CreatedAt),
+		UpdatedAt:     timestamppb.New(payload.UpdatedAt),
+	}
+	for _, m := range payload.Messages {
+		resp.Messages = append(resp.Messages, &pb.TicketMessage{
+			Id:          m.ID,
+			AuthorId:    m.AuthorID,
+			FromStaff:   m.FromStaff,
+			Internal:    m.Internal,
+			Body:        m.Body,
+			Attachments: attachments(m.Attachments),
+			CreatedAt:   timestamppb.New(m.CreatedAt),
+		})
+	}
+	return resp
+}
+
+func ListTicketsResponse(payload *query.ListTicketsResultDTO) *pb.ListTicketsResponse {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.ListTicketsResponse{
+		Tickets:       make([]*pb.Ticket, 0, len(payload.Tickets)),
+		NextPageToken: payload.NextPageToken,
+	}
+	for i := range payload.Tickets {
+		resp.Tickets = append(resp.Tickets, Ticket(&payload.Tickets[i]))
+	}
+	return resp
+}
+
+func DisputeStatusResponse(payload *command.DisputeDto) *pb.DisputeStatusResponse {
+	if payload == nil {
+		return nil
+	}
+	return &pb.DisputeStatusResponse{
+		DisputeId: payload.DisputeID,
+		TicketId:  payload.TicketID,
+		Status:    disputeStatuses[payload.Status],
+	}
+}
+
+func Dispute(payload *query.DisputeDTO) *pb.Dispute {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.Dispute{
+		Id:         payload.ID,
+		TaskId:     payload.TaskID,
+		TicketId:   payload.TicketID,
+		PosterId:   payload.PosterID,
+		TaskerId:   payload.TaskerID,
+		OpenedBy:   payload.OpenedBy,
+		Reason:     payload.Reason,
+		Currency:   payload.Currency,
+		Status:     disputeStatuses[payload.Status],
+		ReviewerId: lg.PtrToStringValue(payload.ReviewerID),
+		Evidence:   make([]*pb.Evidence, 0, len(payload.Evidence)),
+		ClosedAt:   timestamp(payload.ClosedAt),
+		CreatedAt:  timestamppb.New(payload.CreatedAt),
+		UpdatedAt:  timestamppb.New(payload.UpdatedAt),
+	}
+	for _, e := range payload.Evidence {
+		resp.Evidence = append(resp.Evidence, &pb.Evidence{
+			Id:          e.ID,
+			SubmittedBy: e.SubmittedBy,
+			Statement:   e.Statement,
+			Attachments: attachments(e.Attachments),
+			CreatedAt:   timestamppb.New(e.CreatedAt),
+		})
+	}
+	if d := payload.Decision; d != nil {
+		resp.Decision = &pb.Decision{
+			Outcome:      disputeOutcomes[d.Outcome],
+			RefundAmount: ptrToInt64Value(d.RefundAmount),
+			Note:         d.Note,
+			DecidedBy:    d.DecidedBy,
+			DecidedAt:    timestamppb.New(d.DecidedAt),
+		}
+	}
+	return resp
+}
+
+func ListDisputesResponse(payload *query.ListDisputesResultDTO) *pb.ListDisputesResponse {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.ListDisputesResponse{
+		Disputes:      make([]*pb.Dispute, 0, len(payload.Disputes)),
+		NextPageToken: payload.NextPageToken,
+	}
+	for i := range payload.Disputes {
+		resp.Disputes = append(resp.Disputes, Dispute(&payload.Disputes[i]))
+	}
+	return resp
+}
@@ -0,0 +1,173 @@
+package views
+
+import (
+	"time"
+
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/proto/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func domainTaskStatusToProto(status task.Status) pb.TaskStatus {
+	switch status {
+	case task.StatusDraft:
+		return pb.TaskStatus_TASK_STATUS_DRAFT
+	case task.StatusOpen:
+		return pb.TaskStatus_TASK_STATUS_OPEN
+	case task.StatusAssigned:
+		return pb.TaskStatus_TASK_STATUS_ASSIGNED
+	case task.StatusInProgress:
+		return pb.TaskStatus_TASK_STATUS_IN_PROGRESS
+	case task.StatusCompleted:
+		return pb.TaskStatus_TASK_STATUS_COMPLETED
+	case task.StatusCancelled:
+		return pb.TaskStatus_TASK_STATUS_CANCELLED
+	default:
+		return pb.TaskStatus_TASK_STATUS_UNSPECIFIED
+	}
+}
+
+// ProtoTaskStatusToDomain maps a status filter; UNSPECIFIED means "no filter".
+func ProtoTaskStatusToDomain(status pb.TaskStatus) *task.Status {
+	var s task.Status
+	switch status {
+	case pb.TaskStatus_TASK_STATUS_DRAFT:
+		s = task.StatusDraft
+	case pb.TaskStatus_TASK_STATUS_OPEN:
+		s = task.StatusOpen
+	case pb.TaskStatus_TASK_STATUS_ASSIGNED:
+		s = task.StatusAssigned
+	case pb.TaskStatus_TASK_STATUS_IN_PROGRESS:
+		s = task.StatusInProgress
+	case pb.TaskStatus_TASK_STATUS_COMPLETED:
+		s = task.StatusCompleted
+	case pb.TaskStatus_TASK_STATUS_CANCELLED:
+		s = task.StatusCancelled
+	default:
+		return nil
+	}
+	return &s
+}
+
+// TaskInput maps the editable task fields of a create or update request.
+func TaskInput(in *pb.TaskInput) command.TaskInput {
+	out := command.TaskInput{
+		Title:        in.GetTitle(),
+		Description:  in.GetDescription(),
+		CategoryCode: in.GetCategoryCode(),
+		Budget: command.BudgetInput{
+			Amount:   in.GetBudget().GetAmount(),
+			Currency: in.GetBudget().GetCurrency(),
+		},
+		Attachments: make([]command.AttachmentInput, 0, len(in.GetAttachments())),
+	}
+	if l := in.GetLocation(); l != nil {
+		location := &command.LocationInput{
+			Province:   l.GetProvince(),
+			District:   lg.StringValueToPtr(l.GetDistrict()),
+			PostalCode: lg.StringValueToPtr(l.GetPostalCode()),
+		}
+		if p := l.GetPoint(); p != nil {
+			lat, lng := p.GetLatitude(), p.GetLongitude()
+			location.Latitude, location.Longitude = &lat, &lng
+		}
+		out.Location = location
+	}
+	if in.GetDueAt() != nil {
+		dueAt := in.GetDueAt().AsTime()
+		out.DueAt = &dueAt
+	}
+	for _, a := range in.GetAttachments() {
+		out.Attachments = append(out.Attachments, command.AttachmentInput{
+			ID:          a.GetId(),
+			URL:         a.GetUrl(),
+			FileName:    a.GetFileName(),
+			ContentType: lg.StringValueToPtr(a.GetContentType()),
+			SizeBytes:   a.GetSizeBytes(),
+		})
+	}
+	return out
+}
+
+func TaskStatusResponse(payload *command.TaskDto) *pb.TaskStatusResponse {
+	if payload == nil {
+		return nil
+	}
+	return &pb.TaskStatusResponse{
+		TaskId: payload.TaskID,
+		Status: domainTaskStatusToProto(payload.Status),
+	}
+}
+
+func Task(payload *query.TaskDTO) *pb.Task {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.Task{
+		Id:           payload.ID,
+		PosterId:     payload.PosterID,
+		Title:        payload.Title,
+		Description:  payload.Description,
+		CategoryCode: payload.CategoryCode,
+		Budget: &pb.Budget{
+			Amount:   payload.Budget.Amount,
+			Currency: payload.Budget.Currency,
+		},
+		DueAt:              timestamp(payload.DueAt),
+		Attachments:        make([]*pb.Attachment, 0, len(payload.Attachments)),
+		Status:             domainTaskStatusToProto(payload.Status),
+		AssigneeId:         lg.PtrToStringValue(payload.AssigneeID),
+		CancellationReason: lg.PtrToStringValue(payload.CancellationReason),
+		PublishedAt:        timestamp(payload.PublishedAt),
+		AssignedAt:         timestamp(payload.AssignedAt),
+		StartedAt:          timestamp(payload.StartedAt),
+		CompletedAt:        timestamp(payload.CompletedAt),
+		CancelledAt:        timestamp(payload.CancelledAt),
+		CreatedAt:          timestamppb.New(payload.CreatedAt),
+		UpdatedAt:          timestamppb.New(payload.UpdatedAt),
+	}
+	if l := payload.Location; l != nil {
+		resp.Location = &pb.TaskLocation{
+			Province:   l.Province,
+			District:   lg.PtrToStringValue(l.District),
+			PostalCode: lg.PtrToStringValue(l.PostalCode),
+		}
+		if l.Latitude != nil && l.Longitude != nil {
+			resp.Location.Point = &pb.GeoPoint{Latitude: *l.Latitude, Longitude: *l.Longitude}
+		}
+	}
+	for _, a := range payload.Attachments {
+		resp.Attachments = append(resp.Attachments, &pb.Attachment{
+			Id:          a.ID,
+			Url:         a.URL,
+			FileName:    a.FileName,
+			ContentType: lg.PtrToStringValue(a.ContentType),
+			SizeBytes:   a.SizeBytes,
+		})
+	}
+	return resp
+}
+
+func ListTasksResponse(payload *query.ListTasksResultDTO) *pb.ListTasksResponse {
+	if payload == nil {
+		return nil
+	}
+	tasks := make([]*pb.Task, 0, len(payload.Tasks))
+	for i := range payload.Tasks {
+		tasks = append(tasks, Task(&payload.Tasks[i]))
+	}
+	return &pb.ListTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: payload.NextPageToken,
+	}
+}
+
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
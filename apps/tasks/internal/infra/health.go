@@ -0,0 +1,22 @@
+package infra
+
+import (
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	lh "github.com/pratchaya-maneechot/service-exchange/libs/health"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+)
+
+// ProvideHealthRegistry checks the dependencies the service cannot serve
+// without. It runs once started from main.
+func ProvideHealthRegistry(cfg *config.Config, logger *slog.Logger, dbPool *lp.DBPool) *lh.Registry {
+	registry := lh.NewRegistry(lh.Config{
+		Interval: cfg.Health.Interval,
+		Timeout:  cfg.Health.Timeout,
+	}, logger)
+	registry.Register(
+		lh.Check{Name: "postgres", Run: dbPool.Ping, Critical: true},
+	)
+	return registry
+}
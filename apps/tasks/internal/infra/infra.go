@@ -0,0 +1,134 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/google/wire"
+	paymentsclient "github.com/pratchaya-maneechot/service-exchange/apps/payments/api/client"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/infra/payments"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/infra/persistence/postgres"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/infra/persistence/repositories"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	lh "github.com/pratchaya-maneechot/service-exchange/libs/health"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+)
+
+type Infra struct {
+	dbPool   *lp.DBPool
+	payments *paymentsclient.Client
+	logger   *slog.Logger
+	tracer   *sdktrace.TracerProvider
+}
+
+func NewInfra(
+	dbPool *lp.DBPool,
+	payments *paymentsclient.Client,
+	logger *slog.Logger,
+	tracer *sdktrace.TracerProvider,
+) *Infra {
+	return &Infra{
+		dbPool,
+		payments,
+		logger,
+		tracer,
+	}
+}
+
+func (i *Infra) Close(ctx context.Context) error {
+	var errs []error
+	if i.tracer != nil {
+		if err := i.tracer.Shutdown(ctx); err != nil {
+			i.logger.Error("Failed to shutdown OpenTelemetry TracerProvider", "error", err)
+			errs = append(errs, fmt.Errorf("tracer shutdown failed: %w", err))
+		}
+	}
+	if i.payments != nil {
+		if err := i.payments.Close(); err != nil {
+			i.logger.Error("Failed to close payments client", "error", err)
+			errs = append(errs, fmt.Errorf("payments client close failed: %w", err))
+		}
+	}
+	if i.dbPool != nil {
+		i.dbPool.Close()
+		i.logger.Info("PostgreSQL database pool closed.")
+	}
+	i.logger.Info("Infrastructure components closed.")
+
+	if len(errs) > 0 {
+		return fmt.Errorf("errors during infra close: %v", errs)
+	}
+	return nil
+}
+
+func ProvideMetricServer(cfg *config.Config, health *lh.Registry) *observability.MetricServer {
+	return observability.NewMetricServer(observability.MetricConfig{
+		Path:    cfg.Metrics.Path,
+		Addr:    cfg.Metrics.Address,
+		Enabled: cfg.Metrics.Enabled,
+		Health:  health,
+	})
+}
+
+func ProvideLogger(cfg *config.Config) *slog.Logger {
+	return observability.NewLogger(observability.LoggerConfig{
+		Level:     cfg.Logging.Level,
+		Format:    cfg.Logging.Format,
+		AddSource: !cfg.IsDevelopment(),
+	})
+}
+
+func ProvideTracer(ctx context.Context, cfg *config.Config) (*sdktrace.TracerProvider, error) {
+	return observability.NewTracer(ctx, observability.TracerConfig{
+		Name:        cfg.Name,
+		Version:     cfg.Version,
+		Environment: cfg.Environment,
+	})
+}
+
+func ProvideSLAPolicy(cfg *config.Config) ticket.SLAPolicy {
+	return ticket.SLAPolicy{
+		Low:    cfg.Support.SLA.Low,
+		Normal: cfg.Support.SLA.Normal,
+		High:   cfg.Support.SLA.High,
+		Urgent: cfg.Support.SLA.Urgent,
+	}
+}
+
+func ProvideMetricRecorder() observability.MetricsRecorder {
+	return observability.NewPrometheusMetricsRecorder()
+}
+
+func ProvideClientFactory(cfg *config.Config, logger *slog.Logger, recorder observability.MetricsRecorder) *lg.ClientFactory {
+	return lg.NewClientFactory(logger, recorder, cfg.Security.Identity())
+}
+
+func ProvidePaymentsClient(ctx context.Context, cfg *config.Config, factory *lg.ClientFactory) (*paymentsclient.Client, error) {
+	return paymentsclient.New(ctx, factory, cfg.Clients.Payments.Client(cfg.Security))
+}
+
+var InfraModuleSet = wire.NewSet(
+	postgres.NewDBConn,
+	repositories.NewPostgresTaskRepository,
+	repositories.NewPostgresBidRepository,
+	repositories.NewPostgresReviewRepository,
+	repositories.NewPostgresReputationRepository,
+	repositories.NewPostgresTicketRepository,
+	repositories.NewPostgresDisputeRepository,
+	ProvideClientFactory,
+	ProvidePaymentsClient,
+	payments.NewPaymentsSettler,
+	ProvideSLAPolicy,
+	ProvideMetricServer,
+	ProvideMetricRecorder,
+	ProvideHealthRegistry,
+	ProvideLogger,
+	ProvideTracer,
+	NewInfra,
+)
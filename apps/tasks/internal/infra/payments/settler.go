@@ -0,0 +1,76 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	paymentsclient "github.com/pratchaya-maneechot/service-exchange/apps/payments/api/client"
+	paymentpb "github.com/pratchaya-maneechot/service-exchange/apps/payments/api/proto/payment"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/dispute"
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// adminRole is the role PaymentService.SettleDispute requires of callers.
+const adminRole = "admin"
+
+var outcomes = map[dispute.Outcome]paymentpb.DisputeOutcome{
+	dispute.OutcomeRefund:        paymentpb.DisputeOutcome_DISPUTE_OUTCOME_REFUND,
+	dispute.OutcomePartialRefund: paymentpb.DisputeOutcome_DISPUTE_OUTCOME_PARTIAL_REFUND,
+	dispute.OutcomeRelease:       paymentpb.DisputeOutcome_DISPUTE_OUTCOME_RELEASE,
+}
+
+// paymentsSettler settles escrows with PaymentService.SettleDispute, on
+// behalf of the admin who decided the dispute. Every attempt for a dispute
+// uses the same idempotency key, so the escrow moves at most once however
+// often the decision is delivered.
+type paymentsSettler struct {
+	client *paymentsclient.Client
+	logger *slog.Logger
+}
+
+func NewPaymentsSettler(c *paymentsclient.Client, logger *slog.Logger) dispute.Settler {
+	return paymentsSettler{client: c, logger: logger.With(slog.String("component", "paymentsSettler"))}
+}
+
+func (s paymentsSettler) Settle(ctx context.Context, resolved dispute.DisputeResolved) error {
+	outcome, ok := outcomes[resolved.Outcome]
+	if !ok {
+		return fmt.Errorf("dispute %s has unknown outcome %q", resolved.DisputeID, resolved.Outcome)
+	}
+	req := &paymentpb.SettleDisputeRequest{
+		TaskId:         string(resolved.TaskID),
+		DisputeId:      string(resolved.DisputeID),
+		Outcome:        outcome,
+		IdempotencyKey: "dispute:" + string(resolved.DisputeID),
+	}
+	if resolved.RefundAmount != nil {
+		req.RefundAmount = wrapperspb.Int64(*resolved.RefundAmount)
+	}
+
+	// Retries run in the background, where there is no caller to pass on.
+	ctx = lg.ContextWithCaller(ctx, lg.Caller{UserID: string(resolved.DecidedBy), Roles: []string{adminRole}})
+	if _, err := s.client.SettleDispute(ctx, req); err != nil {
+		err = lg.ErrorFromGRPC(err)
+		attrs := []any{
+			slog.String("dispute_id", string(resolved.DisputeID)),
+			slog.String("task_id", string(resolved.TaskID)),
+			slog.String("outcome", string(resolved.Outcome)),
+			slog.Any("error", err),
+		}
+		switch {
+		case errs.HasReason(err, paymentsclient.ReasonEscrowNotFound):
+			// Nothing was paid for the task, so there is nothing to move.
+			s.logger.WarnContext(ctx, "Task of the resolved dispute has no escrow; nothing to settle.", attrs...)
+			return nil
+		case errs.HasReason(err, paymentsclient.ReasonEscrowNotHeld):
+			// Asking again cannot help; the money already left the escrow.
+			s.logger.ErrorContext(ctx, "Escrow of the resolved dispute was already released or refunded; check it by hand.", attrs...)
+			return nil
+		}
+		return fmt.Errorf("failed to settle dispute %s: %w", resolved.DisputeID, err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+CREATE TABLE tasks (
+    id UUID PRIMARY KEY,
+    poster_id UUID NOT NULL, -- User in the users service; not a foreign key across services
+    title VARCHAR(150) NOT NULL,
+    description TEXT NOT NULL,
+    category_code VARCHAR(64) NOT NULL, -- Skill category code from the users service catalogue
+    budget_amount BIGINT NOT NULL CHECK (budget_amount > 0), -- Minor currency units
+    currency CHAR(3) NOT NULL DEFAULT 'THB',
+    province VARCHAR(100), -- NULL means the task can be done remotely
+    district VARCHAR(100), -- Can be NULL
+    postal_code VARCHAR(10), -- Can be NULL
+    latitude DOUBLE PRECISION, -- Can be NULL
+    longitude DOUBLE PRECISION, -- Can be NULL
+    due_at TIMESTAMP WITH TIME ZONE, -- Can be NULL
+    status VARCHAR(20) NOT NULL DEFAULT 'DRAFT' CHECK (status IN ('DRAFT', 'OPEN', 'ASSIGNED', 'IN_PROGRESS', 'COMPLETED', 'CANCELLED')),
+    assignee_id UUID, -- Set once the task is assigned
+    cancellation_reason VARCHAR(500), -- Can be NULL
+    published_at TIMESTAMP WITH TIME ZONE,
+    assigned_at TIMESTAMP WITH TIME ZONE,
+    started_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    cancelled_at TIMESTAMP WITH TIME ZONE,
+    version INTEGER NOT NULL DEFAULT 1, -- Bumped on every write, for optimistic locking
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK ((latitude IS NULL) = (longitude IS NULL)),
+    CHECK (status IN ('DRAFT', 'OPEN', 'CANCELLED') OR assignee_id IS NOT NULL)
+);
+
+CREATE TABLE task_attachments (
+    id UUID PRIMARY KEY,
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100), -- Can be NULL
+    size_bytes BIGINT NOT NULL DEFAULT 0 CHECK (size_bytes >= 0),
+    position INTEGER NOT NULL -- Display order chosen by the poster
+);
+
+CREATE INDEX idx_tasks_poster_id ON tasks (poster_id, created_at DESC, id DESC);
+CREATE INDEX idx_tasks_assignee_id ON tasks (assignee_id, created_at DESC, id DESC) WHERE assignee_id IS NOT NULL;
+CREATE INDEX idx_tasks_status_created_at ON tasks (status, created_at DESC, id DESC);
+CREATE INDEX idx_tasks_category_code ON tasks (category_code);
+CREATE INDEX idx_task_attachments_task_id ON task_attachments (task_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS bid_offers;
+DROP TABLE IF EXISTS bids;
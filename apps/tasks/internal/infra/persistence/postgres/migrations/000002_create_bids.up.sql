@@ -0,0 +1,36 @@
+CREATE TABLE bids (
+    id UUID PRIMARY KEY,
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    poster_id UUID NOT NULL, -- Copied from the task so bids can be authorised on their own
+    tasker_id UUID NOT NULL, -- User in the users service; not a foreign key across services
+    currency CHAR(3) NOT NULL, -- Currency of the task budget when the bid was placed
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'COUNTERED', 'ACCEPTED', 'REJECTED', 'WITHDRAWN', 'EXPIRED')),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL, -- Expiry of the latest offer
+    closed_at TIMESTAMP WITH TIME ZONE, -- Set once the bid leaves negotiation
+    version INTEGER NOT NULL DEFAULT 1, -- Bumped on every write, for optimistic locking
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK (poster_id <> tasker_id)
+);
+
+CREATE TABLE bid_offers (
+    id UUID PRIMARY KEY,
+    bid_id UUID NOT NULL REFERENCES bids(id) ON DELETE CASCADE,
+    party VARCHAR(10) NOT NULL CHECK (party IN ('POSTER', 'TASKER')),
+    amount BIGINT NOT NULL CHECK (amount > 0), -- Minor currency units
+    currency CHAR(3) NOT NULL,
+    message VARCHAR(1000), -- Can be NULL
+    schedule_start_at TIMESTAMP WITH TIME ZONE, -- Can be NULL
+    schedule_end_at TIMESTAMP WITH TIME ZONE, -- Can be NULL
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK ((schedule_start_at IS NULL) = (schedule_end_at IS NULL))
+);
+
+-- A tasker negotiates at most one bid per task at a time
+CREATE UNIQUE INDEX uq_bids_open_task_tasker ON bids (task_id, tasker_id) WHERE status IN ('PENDING', 'COUNTERED');
+-- At most one bid per task is ever accepted
+CREATE UNIQUE INDEX uq_bids_accepted_task ON bids (task_id) WHERE status = 'ACCEPTED';
+CREATE INDEX idx_bids_task_id ON bids (task_id, created_at, id);
+CREATE INDEX idx_bids_tasker_id ON bids (tasker_id, created_at DESC);
+CREATE INDEX idx_bid_offers_bid_id ON bid_offers (bid_id, created_at);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS user_reputations;
+DROP TABLE IF EXISTS review_scores;
+DROP TABLE IF EXISTS reviews;
@@ -0,0 +1,44 @@
+CREATE TABLE reviews (
+    id UUID PRIMARY KEY,
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    reviewer_id UUID NOT NULL, -- User in the users service; not a foreign key across services
+    reviewee_id UUID NOT NULL,
+    reviewee_role VARCHAR(10) NOT NULL CHECK (reviewee_role IN ('POSTER', 'TASKER')),
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    comment VARCHAR(2000), -- Can be NULL
+    reply_body VARCHAR(1000), -- Can be NULL until the reviewee replies
+    reply_created_at TIMESTAMP WITH TIME ZONE,
+    reply_updated_at TIMESTAMP WITH TIME ZONE,
+    status VARCHAR(20) NOT NULL DEFAULT 'PUBLISHED' CHECK (status IN ('PUBLISHED', 'FLAGGED', 'REMOVED')),
+    flag_reason VARCHAR(500), -- Can be NULL
+    moderation_note VARCHAR(500), -- Can be NULL
+    moderated_at TIMESTAMP WITH TIME ZONE, -- Can be NULL
+    version INTEGER NOT NULL DEFAULT 1, -- Bumped on every write, for optimistic locking
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK (reviewer_id <> reviewee_id),
+    CHECK ((reply_body IS NULL) = (reply_created_at IS NULL))
+);
+
+CREATE TABLE review_scores (
+    review_id UUID NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    category VARCHAR(30) NOT NULL,
+    score SMALLINT NOT NULL CHECK (score BETWEEN 1 AND 5),
+    PRIMARY KEY (review_id, category)
+);
+
+-- Maintained in the same transaction as every review write; see ReviewRepository.Save
+CREATE TABLE user_reputations (
+    user_id UUID PRIMARY KEY,
+    review_count INTEGER NOT NULL DEFAULT 0,
+    average_rating DOUBLE PRECISION NOT NULL DEFAULT 0,
+    bayesian_average DOUBLE PRECISION NOT NULL DEFAULT 0,
+    recent_average DOUBLE PRECISION NOT NULL DEFAULT 0,
+    trend VARCHAR(10) NOT NULL DEFAULT 'STABLE' CHECK (trend IN ('UP', 'DOWN', 'STABLE')),
+    category_averages JSONB NOT NULL DEFAULT '{}', -- Category code to average score
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Each party reviews a task once
+CREATE UNIQUE INDEX uq_reviews_task_reviewer ON reviews (task_id, reviewer_id);
+CREATE INDEX idx_reviews_reviewee_id ON reviews (reviewee_id, created_at DESC, id DESC);
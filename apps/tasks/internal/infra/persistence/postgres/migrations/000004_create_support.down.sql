@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS dispute_evidence;
+DROP TABLE IF EXISTS disputes;
+DROP TABLE IF EXISTS ticket_messages;
+DROP TABLE IF EXISTS tickets;
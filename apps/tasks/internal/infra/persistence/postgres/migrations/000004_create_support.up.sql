@@ -0,0 +1,74 @@
+CREATE TABLE tickets (
+    id UUID PRIMARY KEY,
+    reporter_id UUID NOT NULL, -- User in the users service; not a foreign key across services
+    category VARCHAR(20) NOT NULL CHECK (category IN ('ACCOUNT', 'PAYMENT', 'TASK', 'SAFETY', 'DISPUTE', 'OTHER')),
+    priority VARCHAR(10) NOT NULL CHECK (priority IN ('LOW', 'NORMAL', 'HIGH', 'URGENT')),
+    subject VARCHAR(200) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'OPEN' CHECK (status IN ('OPEN', 'IN_PROGRESS', 'WAITING_ON_USER', 'RESOLVED', 'CLOSED')),
+    assignee_id UUID, -- Staff member working the ticket; NULL until assigned
+    task_id UUID REFERENCES tasks(id) ON DELETE SET NULL, -- Can be NULL
+    sla_due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    sla_breached_at TIMESTAMP WITH TIME ZONE, -- Set once when the SLA is missed
+    resolved_at TIMESTAMP WITH TIME ZONE, -- Can be NULL
+    closed_at TIMESTAMP WITH TIME ZONE, -- Can be NULL
+    version INTEGER NOT NULL DEFAULT 1, -- Bumped on every write, for optimistic locking
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Append-only thread of a ticket
+CREATE TABLE ticket_messages (
+    id UUID PRIMARY KEY,
+    ticket_id UUID NOT NULL REFERENCES tickets(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL,
+    from_staff BOOLEAN NOT NULL DEFAULT FALSE,
+    internal BOOLEAN NOT NULL DEFAULT FALSE, -- Staff notes hidden from the reporter
+    body VARCHAR(5000) NOT NULL,
+    attachments JSONB NOT NULL DEFAULT '[]', -- Metadata of files kept in object storage
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE disputes (
+    id UUID PRIMARY KEY,
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    ticket_id UUID NOT NULL REFERENCES tickets(id),
+    poster_id UUID NOT NULL, -- Copied from the task so disputes can be authorised on their own
+    tasker_id UUID NOT NULL,
+    opened_by UUID NOT NULL,
+    reason VARCHAR(2000) NOT NULL,
+    currency CHAR(3) NOT NULL, -- Currency of the task budget, for partial refunds
+    status VARCHAR(20) NOT NULL DEFAULT 'OPEN' CHECK (status IN ('OPEN', 'UNDER_REVIEW', 'RESOLVED', 'WITHDRAWN')),
+    reviewer_id UUID, -- Admin reviewing the dispute; NULL until under review
+    outcome VARCHAR(20) CHECK (outcome IN ('REFUND', 'PARTIAL_REFUND', 'RELEASE')), -- NULL until decided
+    refund_amount BIGINT CHECK (refund_amount > 0), -- Minor currency units; partial refunds only
+    decision_note VARCHAR(2000),
+    decided_by UUID,
+    decided_at TIMESTAMP WITH TIME ZONE,
+    closed_at TIMESTAMP WITH TIME ZONE, -- Set once decided or withdrawn
+    version INTEGER NOT NULL DEFAULT 1, -- Bumped on every write, for optimistic locking
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK (opened_by IN (poster_id, tasker_id)),
+    CHECK ((outcome IS NULL) = (decided_at IS NULL)),
+    CHECK ((outcome = 'PARTIAL_REFUND') = (refund_amount IS NOT NULL))
+);
+
+-- Append-only evidence of a dispute
+CREATE TABLE dispute_evidence (
+    id UUID PRIMARY KEY,
+    dispute_id UUID NOT NULL REFERENCES disputes(id) ON DELETE CASCADE,
+    submitted_by UUID NOT NULL,
+    statement VARCHAR(5000) NOT NULL,
+    attachments JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_tickets_reporter_id ON tickets (reporter_id, created_at DESC, id DESC);
+CREATE INDEX idx_tickets_created_at ON tickets (created_at DESC, id DESC);
+-- Tickets the SLA monitor still has to look at
+CREATE INDEX idx_tickets_sla_due ON tickets (sla_due_at) WHERE sla_breached_at IS NULL AND status IN ('OPEN', 'IN_PROGRESS');
+CREATE INDEX idx_ticket_messages_ticket_id ON ticket_messages (ticket_id, created_at, id);
+-- A task has at most one dispute waiting for a decision
+CREATE UNIQUE INDEX uq_disputes_pending_task ON disputes (task_id) WHERE status IN ('OPEN', 'UNDER_REVIEW');
+CREATE INDEX idx_disputes_created_at ON disputes (created_at DESC, id DESC);
+CREATE INDEX idx_dispute_evidence_dispute_id ON dispute_evidence (dispute_id, created_at, id);
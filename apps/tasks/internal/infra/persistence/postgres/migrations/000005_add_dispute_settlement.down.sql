@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_disputes_unsettled;
+
+ALTER TABLE disputes
+    DROP COLUMN IF EXISTS settled_at;
@@ -0,0 +1,6 @@
+-- Set once the payments service has settled the escrow the way the dispute was decided
+ALTER TABLE disputes
+    ADD COLUMN settled_at TIMESTAMP WITH TIME ZONE;
+
+-- Disputes decided before this migration are left to be settled again; settling is idempotent per dispute
+CREATE INDEX idx_disputes_unsettled ON disputes (decided_at) WHERE status = 'RESOLVED' AND settled_at IS NULL;
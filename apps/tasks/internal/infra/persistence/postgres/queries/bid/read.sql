@@ -0,0 +1,48 @@
+-- name: FindBidByID :one
+SELECT
+    id, task_id, poster_id, tasker_id, currency, status, expires_at, closed_at, version, created_at, updated_at
+FROM bids
+WHERE id = $1;
+
+-- name: ListBidsByTask :many
+SELECT
+    id, task_id, poster_id, tasker_id, currency, status, expires_at, closed_at, version, created_at, updated_at
+FROM bids
+WHERE task_id = $1
+ORDER BY created_at, id;
+
+-- name: FindBidOffers :many
+SELECT
+    id, bid_id, party, amount, currency, message, schedule_start_at, schedule_end_at, expires_at, created_at
+FROM bid_offers
+WHERE bid_id = $1
+ORDER BY created_at, id;
+
+-- name: FindBidOffersByBidIDs :many
+SELECT
+    id, bid_id, party, amount, currency, message, schedule_start_at, schedule_end_at, expires_at, created_at
+FROM bid_offers
+WHERE bid_id = ANY(sqlc.arg('bid_ids')::uuid[])
+ORDER BY bid_id, created_at, id;
+
+-- name: ListBookedSchedules :many
+-- Schedules of accepted bids on tasks still to be done that overlap the
+-- window; the last offer holds the schedule agreed on.
+SELECT
+    b.task_id, b.tasker_id, o.schedule_start_at, o.schedule_end_at
+FROM bids b
+JOIN tasks t ON t.id = b.task_id
+CROSS JOIN LATERAL (
+    SELECT schedule_start_at, schedule_end_at
+    FROM bid_offers
+    WHERE bid_id = b.id
+    ORDER BY created_at DESC, id DESC
+    LIMIT 1
+) o
+WHERE b.status = 'ACCEPTED'
+  AND b.tasker_id = ANY(sqlc.arg('tasker_ids')::uuid[])
+  AND t.status IN ('ASSIGNED', 'IN_PROGRESS')
+  AND t.assignee_id = b.tasker_id
+  AND o.schedule_start_at < sqlc.arg('window_end')
+  AND o.schedule_end_at > sqlc.arg('window_start')
+ORDER BY b.tasker_id, o.schedule_start_at;
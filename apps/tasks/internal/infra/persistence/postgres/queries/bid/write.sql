@@ -0,0 +1,36 @@
+-- name: UpsertBid :execrows
+-- Updates only apply when the stored version is the one the bid was loaded
+-- with; zero affected rows means somebody else changed the bid first.
+INSERT INTO bids (
+    id, task_id, poster_id, tasker_id, currency, status, expires_at, closed_at, version, created_at, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+)
+ON CONFLICT (id)
+DO UPDATE SET
+    status = EXCLUDED.status,
+    expires_at = EXCLUDED.expires_at,
+    closed_at = EXCLUDED.closed_at,
+    version = EXCLUDED.version,
+    updated_at = EXCLUDED.updated_at
+WHERE bids.version = EXCLUDED.version - 1;
+
+-- name: InsertBidOffer :exec
+INSERT INTO bid_offers (
+    id, bid_id, party, amount, currency, message, schedule_start_at, schedule_end_at, expires_at, created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: RejectOpenBidsOnTask :many
+UPDATE bids
+SET status = 'REJECTED',
+    closed_at = sqlc.arg('now'),
+    updated_at = sqlc.arg('now'),
+    version = version + 1
+WHERE task_id = sqlc.arg('task_id')
+  AND id <> sqlc.arg('accepted_bid_id')
+  AND status IN ('PENDING', 'COUNTERED')
+  AND expires_at > sqlc.arg('now')
+RETURNING id;
@@ -0,0 +1,37 @@
+-- name: FindDisputeByID :one
+SELECT
+    id, task_id, ticket_id, poster_id, tasker_id, opened_by, reason, currency, status, reviewer_id,
+    outcome, refund_amount, decision_note, decided_by, decided_at, closed_at, version, created_at, updated_at, settled_at
+FROM disputes
+WHERE id = $1;
+
+-- name: FindDisputeEvidence :many
+SELECT id, dispute_id, submitted_by, statement, attachments, created_at
+FROM dispute_evidence
+WHERE dispute_id = $1
+ORDER BY created_at, id;
+
+-- name: ListDisputes :many
+SELECT
+    id, task_id, ticket_id, poster_id, tasker_id, opened_by, reason, currency, status, reviewer_id,
+    outcome, refund_amount, decision_note, decided_by, decided_at, closed_at, version, created_at, updated_at, settled_at
+FROM disputes
+WHERE (sqlc.narg('party_id')::uuid IS NULL
+       OR poster_id = sqlc.narg('party_id')::uuid OR tasker_id = sqlc.narg('party_id')::uuid)
+  AND (sqlc.narg('task_id')::uuid IS NULL OR task_id = sqlc.narg('task_id')::uuid)
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status')::text)
+  AND (sqlc.narg('after_created_at')::timestamptz IS NULL
+       OR (created_at, id) < (sqlc.narg('after_created_at')::timestamptz, sqlc.narg('after_id')::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: ListUnsettledDisputes :many
+SELECT
+    id, task_id, ticket_id, poster_id, tasker_id, opened_by, reason, currency, status, reviewer_id,
+    outcome, refund_amount, decision_note, decided_by, decided_at, closed_at, version, created_at, updated_at, settled_at
+FROM disputes
+WHERE status = 'RESOLVED'
+  AND settled_at IS NULL
+  AND decided_at < sqlc.arg('decided_before')::timestamptz
+ORDER BY decided_at
+LIMIT sqlc.arg('row_limit')::int;
@@ -0,0 +1,37 @@
+-- name: UpsertDispute :execrows
+-- Updates only apply when the stored version is the one the dispute was
+-- loaded with; zero affected rows means somebody else changed it first.
+INSERT INTO disputes (
+    id, task_id, ticket_id, poster_id, tasker_id, opened_by, reason, currency, status, reviewer_id,
+    outcome, refund_amount, decision_note, decided_by, decided_at, closed_at, version, created_at, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19
+)
+ON CONFLICT (id)
+DO UPDATE SET
+    status = EXCLUDED.status,
+    reviewer_id = EXCLUDED.reviewer_id,
+    outcome = EXCLUDED.outcome,
+    refund_amount = EXCLUDED.refund_amount,
+    decision_note = EXCLUDED.decision_note,
+    decided_by = EXCLUDED.decided_by,
+    decided_at = EXCLUDED.decided_at,
+    closed_at = EXCLUDED.closed_at,
+    version = EXCLUDED.version,
+    updated_at = EXCLUDED.updated_at
+WHERE disputes.version = EXCLUDED.version - 1;
+
+-- name: InsertDisputeEvidence :exec
+INSERT INTO dispute_evidence (
+    id, dispute_id, submitted_by, statement, attachments, created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
+
+-- name: MarkDisputeSettled :exec
+-- Leaves version alone: settling moves money in the payments service and
+-- does not change the dispute itself.
+UPDATE disputes
+SET settled_at = sqlc.arg('settled_at')
+WHERE id = sqlc.arg('id')
+  AND settled_at IS NULL;
@@ -0,0 +1,62 @@
+-- name: FindReviewByID :one
+SELECT
+    id, task_id, reviewer_id, reviewee_id, reviewee_role, rating, comment, reply_body, reply_created_at, reply_updated_at,
+    status, flag_reason, moderation_note, moderated_at, version, created_at, updated_at
+FROM reviews
+WHERE id = $1;
+
+-- name: ListReviewsByTask :many
+SELECT
+    id, task_id, reviewer_id, reviewee_id, reviewee_role, rating, comment, reply_body, reply_created_at, reply_updated_at,
+    status, flag_reason, moderation_note, moderated_at, version, created_at, updated_at
+FROM reviews
+WHERE task_id = $1
+ORDER BY created_at, id;
+
+-- name: ListReviews :many
+SELECT
+    id, task_id, reviewer_id, reviewee_id, reviewee_role, rating, comment, reply_body, reply_created_at, reply_updated_at,
+    status, flag_reason, moderation_note, moderated_at, version, created_at, updated_at
+FROM reviews
+WHERE reviewee_id = sqlc.arg('reviewee_id')
+  AND (sqlc.narg('reviewee_role')::text IS NULL OR reviewee_role = sqlc.narg('reviewee_role')::text)
+  AND (sqlc.arg('include_removed')::bool OR status <> 'REMOVED')
+  AND (sqlc.narg('after_created_at')::timestamptz IS NULL
+       OR (created_at, id) < (sqlc.narg('after_created_at')::timestamptz, sqlc.narg('after_id')::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: FindReviewScoresByReviewIDs :many
+SELECT review_id, category, score
+FROM review_scores
+WHERE review_id = ANY(sqlc.arg('review_ids')::uuid[])
+ORDER BY review_id, category;
+
+-- name: GetReviewStats :one
+-- Sums over the reviews that count towards the reputation of a user; the
+-- recent_* columns only cover the latest recent_window of them.
+WITH counted AS (
+    SELECT rating, row_number() OVER (ORDER BY created_at DESC, id DESC) AS recency
+    FROM reviews
+    WHERE reviewee_id = sqlc.arg('reviewee_id') AND status <> 'REMOVED'
+)
+SELECT
+    count(*)::int AS review_count,
+    coalesce(sum(rating), 0)::bigint AS rating_sum,
+    (count(*) FILTER (WHERE recency <= sqlc.arg('recent_window')::int))::int AS recent_count,
+    coalesce(sum(rating) FILTER (WHERE recency <= sqlc.arg('recent_window')::int), 0)::bigint AS recent_sum
+FROM counted;
+
+-- name: GetReviewScoreStats :many
+SELECT s.category, count(*)::int AS score_count, sum(s.score)::bigint AS score_sum
+FROM review_scores s
+JOIN reviews r ON r.id = s.review_id
+WHERE r.reviewee_id = $1 AND r.status <> 'REMOVED'
+GROUP BY s.category
+ORDER BY s.category;
+
+-- name: FindReputationByUserID :one
+SELECT
+    user_id, review_count, average_rating, bayesian_average, recent_average, trend, category_averages, updated_at
+FROM user_reputations
+WHERE user_id = $1;
@@ -0,0 +1,46 @@
+-- name: UpsertReview :execrows
+-- Updates only apply when the stored version is the one the review was
+-- loaded with; zero affected rows means somebody else changed it first.
+INSERT INTO reviews (
+    id, task_id, reviewer_id, reviewee_id, reviewee_role, rating, comment, reply_body, reply_created_at, reply_updated_at,
+    status, flag_reason, moderation_note, moderated_at, version, created_at, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
+)
+ON CONFLICT (id)
+DO UPDATE SET
+    rating = EXCLUDED.rating,
+    comment = EXCLUDED.comment,
+    reply_body = EXCLUDED.reply_body,
+    reply_created_at = EXCLUDED.reply_created_at,
+    reply_updated_at = EXCLUDED.reply_updated_at,
+    status = EXCLUDED.status,
+    flag_reason = EXCLUDED.flag_reason,
+    moderation_note = EXCLUDED.moderation_note,
+    moderated_at = EXCLUDED.moderated_at,
+    version = EXCLUDED.version,
+    updated_at = EXCLUDED.updated_at
+WHERE reviews.version = EXCLUDED.version - 1;
+
+-- name: DeleteReviewScores :exec
+DELETE FROM review_scores WHERE review_id = $1;
+
+-- name: InsertReviewScore :exec
+INSERT INTO review_scores (review_id, category, score) VALUES ($1, $2, $3);
+
+-- name: LockReputation :exec
+-- Creates the reputation row if needed and locks it, so concurrent reviews
+-- of the same user recalculate it one after another.
+INSERT INTO user_reputations (user_id) VALUES ($1)
+ON CONFLICT (user_id) DO UPDATE SET user_id = EXCLUDED.user_id;
+
+-- name: UpdateReputation :exec
+UPDATE user_reputations
+SET review_count = $2,
+    average_rating = $3,
+    bayesian_average = $4,
+    recent_average = $5,
+    trend = $6,
+    category_averages = $7,
+    updated_at = $8
+WHERE user_id = $1;
@@ -0,0 +1,35 @@
+-- name: FindTaskByID :one
+SELECT
+    id, poster_id, title, description, category_code, budget_amount, currency,
+    province, district, postal_code, latitude, longitude, due_at, status, assignee_id, cancellation_reason,
+    published_at, assigned_at, started_at, completed_at, cancelled_at, version, created_at, updated_at
+FROM tasks
+WHERE id = $1;
+
+-- name: FindTaskAttachments :many
+SELECT id, task_id, url, file_name, content_type, size_bytes
+FROM task_attachments
+WHERE task_id = $1
+ORDER BY position;
+
+-- name: FindTaskAttachmentsByTaskIDs :many
+SELECT id, task_id, url, file_name, content_type, size_bytes
+FROM task_attachments
+WHERE task_id = ANY(sqlc.arg('task_ids')::uuid[])
+ORDER BY task_id, position;
+
+-- name: ListTasks :many
+SELECT
+    id, poster_id, title, description, category_code, budget_amount, currency,
+    province, district, postal_code, latitude, longitude, due_at, status, assignee_id, cancellation_reason,
+    published_at, assigned_at, started_at, completed_at, cancelled_at, version, created_at, updated_at
+FROM tasks
+WHERE (sqlc.narg('poster_id')::uuid IS NULL OR poster_id = sqlc.narg('poster_id')::uuid)
+  AND (sqlc.narg('assignee_id')::uuid IS NULL OR assignee_id = sqlc.narg('assignee_id')::uuid)
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status')::text)
+  AND (sqlc.narg('category_code')::text IS NULL OR category_code = sqlc.narg('category_code')::text)
+  AND (sqlc.arg('include_drafts')::bool OR status <> 'DRAFT')
+  AND (sqlc.narg('after_created_at')::timestamptz IS NULL
+       OR (created_at, id) < (sqlc.narg('after_created_at')::timestamptz, sqlc.narg('after_id')::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('page_limit')::int;
@@ -0,0 +1,56 @@
+-- name: UpsertTask :execrows
+-- Updates only apply when the stored version is the one the task was loaded
+-- with; zero affected rows means somebody else changed the task first.
+INSERT INTO tasks (
+    id, poster_id, title, description, category_code, budget_amount, currency,
+    province, district, postal_code, latitude, longitude, due_at, status, assignee_id, cancellation_reason,
+    published_at, assigned_at, started_at, completed_at, cancelled_at, version, created_at, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24
+)
+ON CONFLICT (id)
+DO UPDATE SET
+    title = EXCLUDED.title,
+    description = EXCLUDED.description,
+    category_code = EXCLUDED.category_code,
+    budget_amount = EXCLUDED.budget_amount,
+    currency = EXCLUDED.currency,
+    province = EXCLUDED.province,
+    district = EXCLUDED.district,
+    postal_code = EXCLUDED.postal_code,
+    latitude = EXCLUDED.latitude,
+    longitude = EXCLUDED.longitude,
+    due_at = EXCLUDED.due_at,
+    status = EXCLUDED.status,
+    assignee_id = EXCLUDED.assignee_id,
+    cancellation_reason = EXCLUDED.cancellation_reason,
+    published_at = EXCLUDED.published_at,
+    assigned_at = EXCLUDED.assigned_at,
+    started_at = EXCLUDED.started_at,
+    completed_at = EXCLUDED.completed_at,
+    cancelled_at = EXCLUDED.cancelled_at,
+    version = EXCLUDED.version,
+    updated_at = EXCLUDED.updated_at
+WHERE tasks.version = EXCLUDED.version - 1;
+
+-- name: DeleteTaskAttachments :exec
+DELETE FROM task_attachments WHERE task_id = $1;
+
+-- name: InsertTaskAttachment :exec
+INSERT INTO task_attachments (
+    id, task_id, url, file_name, content_type, size_bytes, position
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: AssignOpenTask :execrows
+-- Only applies while the task is still open; zero affected rows means it was
+-- assigned or cancelled in the meantime.
+UPDATE tasks
+SET status = 'ASSIGNED',
+    assignee_id = sqlc.arg('assignee_id'),
+    assigned_at = sqlc.arg('assigned_at'),
+    updated_at = sqlc.arg('assigned_at'),
+    version = version + 1
+WHERE id = sqlc.arg('id')
+  AND status = 'OPEN';
@@ -0,0 +1,38 @@
+-- name: FindTicketByID :one
+SELECT
+    id, reporter_id, category, priority, subject, status, assignee_id, task_id,
+    sla_due_at, sla_breached_at, resolved_at, closed_at, version, created_at, updated_at
+FROM tickets
+WHERE id = $1;
+
+-- name: FindTicketMessages :many
+SELECT id, ticket_id, author_id, from_staff, internal, body, attachments, created_at
+FROM ticket_messages
+WHERE ticket_id = $1
+ORDER BY created_at, id;
+
+-- name: ListTickets :many
+SELECT
+    id, reporter_id, category, priority, subject, status, assignee_id, task_id,
+    sla_due_at, sla_breached_at, resolved_at, closed_at, version, created_at, updated_at
+FROM tickets
+WHERE (sqlc.narg('reporter_id')::uuid IS NULL OR reporter_id = sqlc.narg('reporter_id')::uuid)
+  AND (sqlc.narg('assignee_id')::uuid IS NULL OR assignee_id = sqlc.narg('assignee_id')::uuid)
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status')::text)
+  AND (sqlc.narg('category')::text IS NULL OR category = sqlc.narg('category')::text)
+  AND (NOT sqlc.arg('breached_only')::bool OR sla_breached_at IS NOT NULL)
+  AND (sqlc.narg('after_created_at')::timestamptz IS NULL
+       OR (created_at, id) < (sqlc.narg('after_created_at')::timestamptz, sqlc.narg('after_id')::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: ListOverdueTickets :many
+SELECT
+    id, reporter_id, category, priority, subject, status, assignee_id, task_id,
+    sla_due_at, sla_breached_at, resolved_at, closed_at, version, created_at, updated_at
+FROM tickets
+WHERE sla_breached_at IS NULL
+  AND status IN ('OPEN', 'IN_PROGRESS')
+  AND sla_due_at < sqlc.arg('now')::timestamptz
+ORDER BY sla_due_at, id
+LIMIT sqlc.arg('page_limit')::int;
@@ -0,0 +1,28 @@
+-- name: UpsertTicket :execrows
+-- Updates only apply when the stored version is the one the ticket was
+-- loaded with; zero affected rows means somebody else changed it first.
+INSERT INTO tickets (
+    id, reporter_id, category, priority, subject, status, assignee_id, task_id,
+    sla_due_at, sla_breached_at, resolved_at, closed_at, version, created_at, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+)
+ON CONFLICT (id)
+DO UPDATE SET
+    priority = EXCLUDED.priority,
+    status = EXCLUDED.status,
+    assignee_id = EXCLUDED.assignee_id,
+    sla_due_at = EXCLUDED.sla_due_at,
+    sla_breached_at = EXCLUDED.sla_breached_at,
+    resolved_at = EXCLUDED.resolved_at,
+    closed_at = EXCLUDED.closed_at,
+    version = EXCLUDED.version,
+    updated_at = EXCLUDED.updated_at
+WHERE tickets.version = EXCLUDED.version - 1;
+
+-- name: InsertTicketMessage :exec
+INSERT INTO ticket_messages (
+    id, ticket_id, author_id, from_staff, internal, body, attachments, created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+);
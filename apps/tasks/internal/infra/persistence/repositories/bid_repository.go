@@ -0,0 +1,408 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/bid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	db "github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/infra/persistence/postgres/generated"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+	"github.com/pratchaya-maneechot/service-exchange/libs/utils"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Unique indexes of the bids table, see 000002_create_bids.up.sql.
+const (
+	openBidConstraint     = "uq_bids_open_task_tasker"
+	acceptedBidConstraint = "uq_bids_accepted_task"
+)
+
+type bidRepository struct {
+	db     *db.Queries
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+	config *config.Config
+	tracer trace.Tracer
+}
+
+func NewPostgresBidRepository(cfg *config.Config, dbPool *lp.DBPool, logger *slog.Logger) bid.BidRepository {
+	repoLogger := logger.With(slog.String("component", "bidRepository"))
+	return &bidRepository{
+		db:     db.New(dbPool.Pool),
+		pool:   dbPool.Pool,
+		logger: repoLogger,
+		config: cfg,
+		tracer: otel.Tracer(fmt.Sprintf("%s.repository", cfg.Name)),
+	}
+}
+
+func (r *bidRepository) FindByID(ctx context.Context, id ids.BidID) (*bid.Bid, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("bid_id", string(id)))
+
+	ctx, span := r.tracer.Start(ctx, "BidRepository.FindByID", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "read_by_id"),
+		attribute.String("db.bid_id", string(id)),
+	)
+
+	bidID := lp.ToUUID(string(id))
+	raw, err := r.db.FindBidByID(ctx, bidID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.SetStatus(codes.Ok, "Bid not found in DB")
+			span.SetAttributes(attribute.Bool("bid.found", false))
+			logger.Debug("Bid not found in DB.")
+			return nil, bid.ErrBidNotFound
+		}
+		span.SetStatus(codes.Error, "Failed to query bid from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query bid from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query bid: %w", lp.TranslateError(err))
+	}
+
+	offerRows, err := r.db.FindBidOffers(ctx, bidID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query bid offers from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query bid offers from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query bid offers: %w", lp.TranslateError(err))
+	}
+
+	span.SetStatus(codes.Ok, "Bid found")
+	span.SetAttributes(attribute.Bool("bid.found", true))
+	return toBid(raw, utils.ArrayMap(offerRows, toOffer)), nil
+}
+
+func (r *bidRepository) ListByTask(ctx context.Context, taskID ids.TaskID) ([]*bid.Bid, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(taskID)))
+
+	ctx, span := r.tracer.Start(ctx, "BidRepository.ListByTask", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "list_by_task"),
+		attribute.String("db.task_id", string(taskID)),
+	)
+
+	rows, err := r.db.ListBidsByTask(ctx, lp.ToUUID(string(taskID)))
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list bids from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to list bids from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to list bids: %w", lp.TranslateError(err))
+	}
+
+	bids := make([]*bid.Bid, 0, len(rows))
+	if len(rows) == 0 {
+		span.SetStatus(codes.Ok, "No bids listed")
+		return bids, nil
+	}
+
+	offerRows, err := r.db.FindBidOffersByBidIDs(ctx, utils.ArrayMap(rows, func(row db.Bid) pgtype.UUID { return row.ID }))
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query bid offers from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query offers of listed bids from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query bid offers: %w", lp.TranslateError(err))
+	}
+	offers := make(map[string][]bid.Offer, len(rows))
+	for _, row := range offerRows {
+		id := lp.FromUUID(row.BidID)
+		offers[id] = append(offers[id], toOffer(row))
+	}
+
+	for _, row := range rows {
+		bids = append(bids, toBid(row, offers[lp.FromUUID(row.ID)]))
+	}
+
+	span.SetStatus(codes.Ok, "Bids listed")
+	span.SetAttributes(attribute.Int("list.result_count", len(bids)))
+	return bids, nil
+}
+
+func (r *bidRepository) ListBookings(ctx context.Context, taskerIDs []ids.UserID, start, end time.Time) ([]bid.Booking, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.Int("tasker_count", len(taskerIDs)))
+
+	ctx, span := r.tracer.Start(ctx, "BidRepository.ListBookings", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "list_bookings"),
+		attribute.Int("db.tasker_count", len(taskerIDs)),
+	)
+
+	rows, err := r.db.ListBookedSchedules(ctx, db.ListBookedSchedulesParams{
+		TaskerIds:   utils.ArrayMap(taskerIDs, func(id ids.UserID) pgtype.UUID { return lp.ToUUID(string(id)) }),
+		WindowStart: lp.ToTimestamp(&start),
+		WindowEnd:   lp.ToTimestamp(&end),
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list bookings from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to list bookings from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to list bookings: %w", lp.TranslateError(err))
+	}
+
+	bookings := make([]bid.Booking, 0, len(rows))
+	for _, row := range rows {
+		bookings = append(bookings, bid.Booking{
+			TaskID:   ids.TaskID(lp.FromUUID(row.TaskID)),
+			TaskerID: ids.UserID(lp.FromUUID(row.TaskerID)),
+			Schedule: bid.Schedule{StartAt: row.ScheduleStartAt.Time, EndAt: row.ScheduleEndAt.Time},
+		})
+	}
+
+	span.SetStatus(codes.Ok, "Bookings listed")
+	span.SetAttributes(attribute.Int("list.result_count", len(bookings)))
+	return bookings, nil
+}
+
+func (r *bidRepository) Save(ctx context.Context, b *bid.Bid) error {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("bid_id", string(b.ID)))
+
+	ctx, span := r.tracer.Start(ctx, "BidRepository.Save", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.bid_id", string(b.ID)),
+		attribute.String("db.bid_status", string(b.Status)),
+	)
+
+	err := r.inTx(ctx, span, logger, func(qtx *db.Queries) error {
+		return r.save(ctx, qtx, span, logger, b)
+	})
+	if err != nil {
+		return err
+	}
+
+	b.Version++
+	span.SetStatus(codes.Ok, "Bid saved to DB")
+	logger.Info("Bid saved successfully to DB.", "status", b.Status)
+	return nil
+}
+
+func (r *bidRepository) SaveAccepted(ctx context.Context, b *bid.Bid) ([]ids.BidID, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("bid_id", string(b.ID)), slog.String("task_id", string(b.TaskID)))
+
+	ctx, span := r.tracer.Start(ctx, "BidRepository.SaveAccepted", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.bid_id", string(b.ID)),
+		attribute.String("db.task_id", string(b.TaskID)),
+	)
+
+	var rejected []ids.BidID
+	err := r.inTx(ctx, span, logger, func(qtx *db.Queries) error {
+		if err := r.save(ctx, qtx, span, logger, b); err != nil {
+			return err
+		}
+		rows, err := qtx.RejectOpenBidsOnTask(ctx, db.RejectOpenBidsOnTaskParams{
+			Now:           lp.ToTimestamp(b.ClosedAt),
+			TaskID:        lp.ToUUID(string(b.TaskID)),
+			AcceptedBidID: lp.ToUUID(string(b.ID)),
+		})
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to reject other bids in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to reject other bids on the task in DB", slog.Any("error", err))
+			return fmt.Errorf("failed to reject other bids: %w", lp.TranslateError(err))
+		}
+		rejected = utils.ArrayMap(rows, func(id pgtype.UUID) ids.BidID { return ids.BidID(lp.FromUUID(id)) })
+
+		assigned, err := qtx.AssignOpenTask(ctx, db.AssignOpenTaskParams{
+			AssigneeID: lp.ToUUID(string(b.TaskerID)),
+			AssignedAt: lp.ToTimestamp(b.ClosedAt),
+			ID:         lp.ToUUID(string(b.TaskID)),
+		})
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to assign task in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to assign the task of the accepted bid in DB", slog.Any("error", err))
+			return fmt.Errorf("failed to assign task: %w", lp.TranslateError(err))
+		}
+		if assigned == 0 {
+			span.SetStatus(codes.Error, "Task no longer open")
+			span.SetAttributes(attribute.String("error.type", "task_not_open"))
+			logger.Warn("Task was assigned or cancelled before the bid was accepted.")
+			return bid.ErrTaskNotOpen
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	b.Version++
+	span.SetStatus(codes.Ok, "Bid accepted in DB")
+	span.SetAttributes(attribute.Int("bid.rejected_count", len(rejected)))
+	logger.Info("Bid accepted, task assigned and other open bids rejected in DB.", "rejected", len(rejected))
+	return rejected, nil
+}
+
+// save writes the bid row, guarded by its version, and appends offers that
+// are not stored yet. Offers are never changed once made.
+func (r *bidRepository) save(ctx context.Context, qtx *db.Queries, span trace.Span, logger *slog.Logger, b *bid.Bid) error {
+	bidID := lp.ToUUID(string(b.ID))
+	affected, err := qtx.UpsertBid(ctx, db.UpsertBidParams{
+		ID:        bidID,
+		TaskID:    lp.ToUUID(string(b.TaskID)),
+		PosterID:  lp.ToUUID(string(b.PosterID)),
+		TaskerID:  lp.ToUUID(string(b.TaskerID)),
+		Currency:  b.Currency,
+		Status:    string(b.Status),
+		ExpiresAt: lp.ToTimestamp(&b.ExpiresAt),
+		ClosedAt:  lp.ToTimestamp(b.ClosedAt),
+		Version:   b.Version + 1,
+		CreatedAt: lp.ToTimestamp(&b.CreatedAt),
+		UpdatedAt: lp.ToTimestamp(&b.UpdatedAt),
+	})
+	if err != nil {
+		err = lp.TranslateError(err)
+		if errors.Is(err, lp.ErrUniqueViolation) {
+			constraint := lp.ConstraintName(err)
+			span.SetStatus(codes.Error, "Conflicting bid in DB")
+			span.SetAttributes(attribute.String("error.type", "db_unique_violation"))
+			logger.Warn("Bid conflicts with another bid on the task.", "constraint", constraint)
+			switch constraint {
+			case openBidConstraint:
+				return bid.ErrBidAlreadyPlaced
+			case acceptedBidConstraint:
+				return bid.ErrTaskAlreadyAwarded
+			}
+		}
+		span.SetStatus(codes.Error, "Failed to upsert bid in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to upsert bid in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to upsert bid: %w", lp.TranslateError(err))
+	}
+	if affected == 0 {
+		span.SetStatus(codes.Error, "Stale bid version")
+		span.SetAttributes(attribute.String("error.type", "db_version_conflict"))
+		logger.Warn("Bid was changed concurrently.", "version", b.Version)
+		return bid.ErrBidChanged
+	}
+
+	for _, o := range b.Offers {
+		params := db.InsertBidOfferParams{
+			ID:        lp.ToUUID(o.ID.String()),
+			BidID:     bidID,
+			Party:     string(o.Party),
+			Amount:    o.Amount,
+			Currency:  o.Currency,
+			Message:   o.Message,
+			ExpiresAt: lp.ToTimestamp(&o.ExpiresAt),
+			CreatedAt: lp.ToTimestamp(&o.CreatedAt),
+		}
+		if o.Schedule != nil {
+			params.ScheduleStartAt = lp.ToTimestamp(&o.Schedule.StartAt)
+			params.ScheduleEndAt = lp.ToTimestamp(&o.Schedule.EndAt)
+		}
+		if err := qtx.InsertBidOffer(ctx, params); err != nil {
+			span.SetStatus(codes.Error, "Failed to add bid offer in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to add bid offer in DB", slog.Any("error", err))
+			return fmt.Errorf("failed to add bid offer: %w", lp.TranslateError(err))
+		}
+	}
+	return nil
+}
+
+func (r *bidRepository) inTx(ctx context.Context, span trace.Span, logger *slog.Logger, fn func(qtx *db.Queries) error) (err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to begin transaction")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+		logger.Error("Failed to begin DB transaction", slog.Any("error", err))
+		return fmt.Errorf("failed to begin transaction: %w", lp.TranslateError(err))
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		} else if err != nil {
+			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+				logger.Error("Failed to rollback DB transaction", slog.Any("error", rollbackErr))
+			}
+		} else {
+			if commitErr := tx.Commit(ctx); commitErr != nil {
+				span.SetStatus(codes.Error, "Failed to commit transaction")
+				span.RecordError(commitErr)
+				span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+				logger.Error("Failed to commit DB transaction", slog.Any("error", commitErr))
+				err = fmt.Errorf("failed to commit transaction: %w", lp.TranslateError(commitErr))
+			}
+		}
+	}()
+
+	// Statements inside may wrap their errors; translating once more here
+	// makes sure serialization failures, deadlocks and constraint violations
+	// leave the transaction as the typed errors callers retry or report.
+	return lp.TranslateError(fn(r.db.WithTx(tx)))
+}
+
+func toBid(row db.Bid, offers []bid.Offer) *bid.Bid {
+	return bid.NewBidFromRepository(
+		lp.FromUUID(row.ID),
+		lp.FromUUID(row.TaskID),
+		lp.FromUUID(row.PosterID),
+		lp.FromUUID(row.TaskerID),
+		row.Currency,
+		bid.Status(row.Status),
+		offers,
+		*lp.ToTime(row.ExpiresAt),
+		lp.ToTime(row.ClosedAt),
+		row.Version,
+		*lp.ToTime(row.CreatedAt),
+		*lp.ToTime(row.UpdatedAt),
+	)
+}
+
+func toOffer(row db.BidOffer) bid.Offer {
+	var schedule *bid.Schedule
+	if row.ScheduleStartAt.Valid && row.ScheduleEndAt.Valid {
+		schedule = &bid.Schedule{StartAt: row.ScheduleStartAt.Time, EndAt: row.ScheduleEndAt.Time}
+	}
+	return bid.Offer{
+		ID:        uuid.UUID(row.ID.Bytes),
+		Party:     bid.Party(row.Party),
+		Amount:    row.Amount,
+		Currency:  row.Currency,
+		Message:   row.Message,
+		Schedule:  schedule,
+		ExpiresAt: *lp.ToTime(row.ExpiresAt),
+		CreatedAt: *lp.ToTime(row.CreatedAt),
+	}
+}
@@ -0,0 +1,394 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/dispute"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+	db "github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/infra/persistence/postgres/generated"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type disputeRepository struct {
+	db     *db.Queries
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+	config *config.Config
+	tracer trace.Tracer
+}
+
+func NewPostgresDisputeRepository(cfg *config.Config, dbPool *lp.DBPool, logger *slog.Logger) dispute.DisputeRepository {
+	repoLogger := logger.With(slog.String("component", "disputeRepository"))
+	return &disputeRepository{
+		db:     db.New(dbPool.Pool),
+		pool:   dbPool.Pool,
+		logger: repoLogger,
+		config: cfg,
+		tracer: otel.Tracer(fmt.Sprintf("%s.repository", cfg.Name)),
+	}
+}
+
+func (r *disputeRepository) FindByID(ctx context.Context, id ids.DisputeID) (*dispute.Dispute, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("dispute_id", string(id)))
+
+	ctx, span := r.tracer.Start(ctx, "DisputeRepository.FindByID", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "read_by_id"),
+		attribute.String("db.dispute_id", string(id)),
+	)
+
+	raw, err := r.db.FindDisputeByID(ctx, lp.ToUUID(string(id)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.SetStatus(codes.Ok, "Dispute not found in DB")
+			span.SetAttributes(attribute.Bool("dispute.found", false))
+			logger.Debug("Dispute not found in DB.")
+			return nil, dispute.ErrDisputeNotFound
+		}
+		span.SetStatus(codes.Error, "Failed to query dispute from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query dispute from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query dispute: %w", lp.TranslateError(err))
+	}
+
+	evidenceRows, err := r.db.FindDisputeEvidence(ctx, raw.ID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query dispute evidence from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query dispute evidence from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query dispute evidence: %w", lp.TranslateError(err))
+	}
+	evidence := make([]dispute.Evidence, 0, len(evidenceRows))
+	for _, row := range evidenceRows {
+		attachments, err := decodeAttachments(row.Attachments)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to decode dispute evidence")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_decode_error"))
+			logger.Error("Failed to decode dispute evidence", slog.Any("error", err))
+			return nil, err
+		}
+		evidence = append(evidence, dispute.Evidence{
+			ID:          ids.EvidenceID(lp.FromUUID(row.ID)),
+			SubmittedBy: ids.UserID(lp.FromUUID(row.SubmittedBy)),
+			Statement:   row.Statement,
+			Attachments: attachments,
+			CreatedAt:   *lp.ToTime(row.CreatedAt),
+		})
+	}
+
+	span.SetStatus(codes.Ok, "Dispute found")
+	span.SetAttributes(attribute.Bool("dispute.found", true), attribute.Int("dispute.evidence_count", len(evidence)))
+	return toDispute(raw, evidence), nil
+}
+
+func (r *disputeRepository) List(ctx context.Context, criteria dispute.ListCriteria) ([]*dispute.Dispute, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "DisputeRepository.List", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "list"),
+		attribute.Int("list.limit", criteria.Limit),
+	)
+
+	params := db.ListDisputesParams{PageLimit: int32(criteria.Limit)}
+	if criteria.PartyID != nil {
+		params.PartyID = lp.ToUUID(string(*criteria.PartyID))
+	}
+	if criteria.TaskID != nil {
+		params.TaskID = lp.ToUUID(string(*criteria.TaskID))
+	}
+	if criteria.Status != nil {
+		status := string(*criteria.Status)
+		params.Status = &status
+	}
+	if criteria.After != nil {
+		params.AfterCreatedAt = lp.ToTimestamp(&criteria.After.CreatedAt)
+		params.AfterID = lp.ToUUID(string(criteria.After.DisputeID))
+	}
+
+	rows, err := r.db.ListDisputes(ctx, params)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list disputes from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to list disputes from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to list disputes: %w", lp.TranslateError(err))
+	}
+
+	disputes := make([]*dispute.Dispute, 0, len(rows))
+	for _, row := range rows {
+		disputes = append(disputes, toDispute(row, nil))
+	}
+	span.SetStatus(codes.Ok, "Disputes listed")
+	span.SetAttributes(attribute.Int("list.result_count", len(disputes)))
+	return disputes, nil
+}
+
+func (r *disputeRepository) ListUnsettled(ctx context.Context, decidedBefore time.Time, limit int) ([]*dispute.Dispute, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "DisputeRepository.ListUnsettled", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "list_unsettled"),
+		attribute.Int("list.limit", limit),
+	)
+
+	rows, err := r.db.ListUnsettledDisputes(ctx, db.ListUnsettledDisputesParams{
+		DecidedBefore: lp.ToTimestamp(&decidedBefore),
+		RowLimit:      int32(limit),
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list unsettled disputes from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to list unsettled disputes from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to list unsettled disputes: %w", lp.TranslateError(err))
+	}
+
+	disputes := make([]*dispute.Dispute, 0, len(rows))
+	for _, row := range rows {
+		disputes = append(disputes, toDispute(row, nil))
+	}
+	span.SetStatus(codes.Ok, "Unsettled disputes listed")
+	span.SetAttributes(attribute.Int("list.result_count", len(disputes)))
+	return disputes, nil
+}
+
+func (r *disputeRepository) MarkSettled(ctx context.Context, id ids.DisputeID, at time.Time) error {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("dispute_id", string(id)))
+
+	ctx, span := r.tracer.Start(ctx, "DisputeRepository.MarkSettled", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.dispute_id", string(id)),
+	)
+
+	if err := r.db.MarkDisputeSettled(ctx, db.MarkDisputeSettledParams{
+		SettledAt: lp.ToTimestamp(&at),
+		ID:        lp.ToUUID(string(id)),
+	}); err != nil {
+		span.SetStatus(codes.Error, "Failed to mark dispute settled in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to mark dispute settled in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to mark dispute settled: %w", lp.TranslateError(err))
+	}
+
+	span.SetStatus(codes.Ok, "Dispute marked settled")
+	return nil
+}
+
+func (r *disputeRepository) Save(ctx context.Context, d *dispute.Dispute, t *ticket.Ticket) error {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("dispute_id", string(d.ID)))
+
+	ctx, span := r.tracer.Start(ctx, "DisputeRepository.Save", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.dispute_id", string(d.ID)),
+		attribute.String("db.dispute_status", string(d.Status)),
+		attribute.Bool("db.with_ticket", t != nil),
+	)
+
+	err := r.inTx(ctx, span, logger, func(qtx *db.Queries) error {
+		// The ticket goes first: a new dispute references it.
+		if t != nil {
+			if err := saveTicket(ctx, qtx, span, logger, t); err != nil {
+				return err
+			}
+		}
+		return r.save(ctx, qtx, span, logger, d)
+	})
+	if err != nil {
+		return err
+	}
+
+	d.Version++
+	d.ClearPendingEvidence()
+	if t != nil {
+		t.Version++
+		t.ClearPendingMessages()
+	}
+	span.SetStatus(codes.Ok, "Dispute saved to DB")
+	logger.Info("Dispute saved successfully to DB.", "status", d.Status)
+	return nil
+}
+
+// save writes the dispute row, guarded by its version, and appends its
+// pending evidence.
+func (r *disputeRepository) save(ctx context.Context, qtx *db.Queries, span trace.Span, logger *slog.Logger, d *dispute.Dispute) error {
+	disputeID := lp.ToUUID(string(d.ID))
+	params := db.UpsertDisputeParams{
+		ID:        disputeID,
+		TaskID:    lp.ToUUID(string(d.TaskID)),
+		TicketID:  lp.ToUUID(string(d.TicketID)),
+		PosterID:  lp.ToUUID(string(d.PosterID)),
+		TaskerID:  lp.ToUUID(string(d.TaskerID)),
+		OpenedBy:  lp.ToUUID(string(d.OpenedBy)),
+		Reason:    d.Reason,
+		Currency:  d.Currency,
+		Status:    string(d.Status),
+		ClosedAt:  lp.ToTimestamp(d.ClosedAt),
+		Version:   d.Version + 1,
+		CreatedAt: lp.ToTimestamp(&d.CreatedAt),
+		UpdatedAt: lp.ToTimestamp(&d.UpdatedAt),
+	}
+	if d.ReviewerID != nil {
+		params.ReviewerID = lp.ToUUID(string(*d.ReviewerID))
+	}
+	if d.Decision != nil {
+		outcome := string(d.Decision.Outcome)
+		params.Outcome = &outcome
+		params.RefundAmount = d.Decision.RefundAmount
+		params.DecisionNote = &d.Decision.Note
+		params.DecidedBy = lp.ToUUID(string(d.Decision.DecidedBy))
+		params.DecidedAt = lp.ToTimestamp(&d.Decision.DecidedAt)
+	}
+	affected, err := qtx.UpsertDispute(ctx, params)
+	if err != nil {
+		err = lp.TranslateError(err)
+		if errors.Is(err, lp.ErrUniqueViolation) {
+			span.SetStatus(codes.Error, "Duplicate pending dispute in DB")
+			span.SetAttributes(attribute.String("error.type", "db_unique_violation"))
+			logger.Warn("Task already has a pending dispute.", "constraint", lp.ConstraintName(err))
+			return dispute.ErrDisputeAlreadyOpen
+		}
+		span.SetStatus(codes.Error, "Failed to upsert dispute in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to upsert dispute in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to upsert dispute: %w", lp.TranslateError(err))
+	}
+	if affected == 0 {
+		span.SetStatus(codes.Error, "Stale dispute version")
+		span.SetAttributes(attribute.String("error.type", "db_version_conflict"))
+		logger.Warn("Dispute was changed concurrently.", "version", d.Version)
+		return dispute.ErrDisputeChanged
+	}
+
+	for _, e := range d.PendingEvidence() {
+		attachments, err := encodeAttachments(e.Attachments)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to encode evidence attachments")
+			span.RecordError(err)
+			return err
+		}
+		if err := qtx.InsertDisputeEvidence(ctx, db.InsertDisputeEvidenceParams{
+			ID:          lp.ToUUID(string(e.ID)),
+			DisputeID:   disputeID,
+			SubmittedBy: lp.ToUUID(string(e.SubmittedBy)),
+			Statement:   e.Statement,
+			Attachments: attachments,
+			CreatedAt:   lp.ToTimestamp(&e.CreatedAt),
+		}); err != nil {
+			span.SetStatus(codes.Error, "Failed to add dispute evidence in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to add dispute evidence in DB", slog.Any("error", err))
+			return fmt.Errorf("failed to add dispute evidence: %w", lp.TranslateError(err))
+		}
+	}
+	return nil
+}
+
+func (r *disputeRepository) inTx(ctx context.Context, span trace.Span, logger *slog.Logger, fn func(qtx *db.Queries) error) (err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to begin transaction")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+		logger.Error("Failed to begin DB transaction", slog.Any("error", err))
+		return fmt.Errorf("failed to begin transaction: %w", lp.TranslateError(err))
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		} else if err != nil {
+			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+				logger.Error("Failed to rollback DB transaction", slog.Any("error", rollbackErr))
+			}
+		} else {
+			if commitErr := tx.Commit(ctx); commitErr != nil {
+				span.SetStatus(codes.Error, "Failed to commit transaction")
+				span.RecordError(commitErr)
+				span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+				logger.Error("Failed to commit DB transaction", slog.Any("error", commitErr))
+				err = fmt.Errorf("failed to commit transaction: %w", lp.TranslateError(commitErr))
+			}
+		}
+	}()
+
+	// Statements inside may wrap their errors; translating once more here
+	// makes sure serialization failures, deadlocks and constraint violations
+	// leave the transaction as the typed errors callers retry or report.
+	return lp.TranslateError(fn(r.db.WithTx(tx)))
+}
+
+func toDispute(row db.Dispute, evidence []dispute.Evidence) *dispute.Dispute {
+	var reviewerID *ids.UserID
+	if row.ReviewerID.Valid {
+		id := ids.UserID(lp.FromUUID(row.ReviewerID))
+		reviewerID = &id
+	}
+	var decision *dispute.Decision
+	if row.Outcome != nil {
+		decision = &dispute.Decision{
+			Outcome:      dispute.Outcome(*row.Outcome),
+			RefundAmount: row.RefundAmount,
+			DecidedBy:    ids.UserID(lp.FromUUID(row.DecidedBy)),
+			DecidedAt:    *lp.ToTime(row.DecidedAt),
+		}
+		if row.DecisionNote != nil {
+			decision.Note = *row.DecisionNote
+		}
+	}
+	return dispute.NewDisputeFromRepository(
+		lp.FromUUID(row.ID),
+		lp.FromUUID(row.TaskID),
+		lp.FromUUID(row.TicketID),
+		lp.FromUUID(row.PosterID),
+		lp.FromUUID(row.TaskerID),
+		lp.FromUUID(row.OpenedBy),
+		row.Reason,
+		row.Currency,
+		dispute.Status(row.Status),
+		reviewerID,
+		evidence,
+		decision,
+		lp.ToTime(row.ClosedAt),
+		row.Version,
+		*lp.ToTime(row.CreatedAt),
+		*lp.ToTime(row.UpdatedAt),
+	)
+}
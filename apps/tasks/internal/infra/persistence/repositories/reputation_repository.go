@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/review"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	db "github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/infra/persistence/postgres/generated"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type reputationRepository struct {
+	db     *db.Queries
+	logger *slog.Logger
+	config *config.Config
+	tracer trace.Tracer
+}
+
+func NewPostgresReputationRepository(cfg *config.Config, dbPool *lp.DBPool, logger *slog.Logger) review.ReputationRepository {
+	repoLogger := logger.With(slog.String("component", "reputationRepository"))
+	return &reputationRepository{
+		db:     db.New(dbPool.Pool),
+		logger: repoLogger,
+		config: cfg,
+		tracer: otel.Tracer(fmt.Sprintf("%s.repository", cfg.Name)),
+	}
+}
+
+func (r *reputationRepository) FindByUserID(ctx context.Context, userID ids.UserID) (*review.Reputation, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(userID)))
+
+	ctx, span := r.tracer.Start(ctx, "ReputationRepository.FindByUserID", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "read_by_id"),
+		attribute.String("db.user_id", string(userID)),
+	)
+
+	row, err := r.db.FindReputationByUserID(ctx, lp.ToUUID(string(userID)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.SetStatus(codes.Ok, "Reputation not found in DB")
+			span.SetAttributes(attribute.Bool("reputation.found", false))
+			logger.Debug("Reputation not found in DB.")
+			return nil, review.ErrReputationNotFound
+		}
+		span.SetStatus(codes.Error, "Failed to query reputation from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query reputation from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query reputation: %w", lp.TranslateError(err))
+	}
+
+	categoryAverages := map[review.Category]float64{}
+	if err := json.Unmarshal(row.CategoryAverages, &categoryAverages); err != nil {
+		span.SetStatus(codes.Error, "Failed to decode category averages")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_decode_error"))
+		logger.Error("Failed to decode category averages of reputation", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to decode category averages: %w", err)
+	}
+
+	span.SetStatus(codes.Ok, "Reputation found")
+	span.SetAttributes(attribute.Bool("reputation.found", true))
+	return review.NewReputationFromRepository(
+		lp.FromUUID(row.UserID),
+		int(row.ReviewCount),
+		row.AverageRating,
+		row.BayesianAverage,
+		row.RecentAverage,
+		review.Trend(row.Trend),
+		categoryAverages,
+		*lp.ToTime(row.UpdatedAt),
+	), nil
+}
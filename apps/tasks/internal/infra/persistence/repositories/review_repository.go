@@ -0,0 +1,417 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/review"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	db "github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/infra/persistence/postgres/generated"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+	"github.com/pratchaya-maneechot/service-exchange/libs/utils"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type reviewRepository struct {
+	db     *db.Queries
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+	config *config.Config
+	tracer trace.Tracer
+}
+
+func NewPostgresReviewRepository(cfg *config.Config, dbPool *lp.DBPool, logger *slog.Logger) review.ReviewRepository {
+	repoLogger := logger.With(slog.String("component", "reviewRepository"))
+	return &reviewRepository{
+		db:     db.New(dbPool.Pool),
+		pool:   dbPool.Pool,
+		logger: repoLogger,
+		config: cfg,
+		tracer: otel.Tracer(fmt.Sprintf("%s.repository", cfg.Name)),
+	}
+}
+
+func (r *reviewRepository) FindByID(ctx context.Context, id ids.ReviewID) (*review.Review, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("review_id", string(id)))
+
+	ctx, span := r.tracer.Start(ctx, "ReviewRepository.FindByID", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "read_by_id"),
+		attribute.String("db.review_id", string(id)),
+	)
+
+	raw, err := r.db.FindReviewByID(ctx, lp.ToUUID(string(id)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.SetStatus(codes.Ok, "Review not found in DB")
+			span.SetAttributes(attribute.Bool("review.found", false))
+			logger.Debug("Review not found in DB.")
+			return nil, review.ErrReviewNotFound
+		}
+		span.SetStatus(codes.Error, "Failed to query review from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query review from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query review: %w", lp.TranslateError(err))
+	}
+
+	reviews, err := r.withScores(ctx, []db.Review{raw})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query review scores from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query review scores from DB", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Review found")
+	span.SetAttributes(attribute.Bool("review.found", true))
+	return reviews[0], nil
+}
+
+func (r *reviewRepository) ListByTask(ctx context.Context, taskID ids.TaskID) ([]*review.Review, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(taskID)))
+
+	ctx, span := r.tracer.Start(ctx, "ReviewRepository.ListByTask", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "list_by_task"),
+		attribute.String("db.task_id", string(taskID)),
+	)
+
+	rows, err := r.db.ListReviewsByTask(ctx, lp.ToUUID(string(taskID)))
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list reviews from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to list reviews of task from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to list reviews: %w", lp.TranslateError(err))
+	}
+
+	reviews, err := r.withScores(ctx, rows)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query review scores from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query scores of listed reviews from DB", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Reviews listed")
+	span.SetAttributes(attribute.Int("list.result_count", len(reviews)))
+	return reviews, nil
+}
+
+func (r *reviewRepository) List(ctx context.Context, criteria review.ListCriteria) ([]*review.Review, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("reviewee_id", string(criteria.RevieweeID)))
+
+	ctx, span := r.tracer.Start(ctx, "ReviewRepository.List", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "list"),
+		attribute.Int("list.limit", criteria.Limit),
+	)
+
+	params := db.ListReviewsParams{
+		RevieweeID:     lp.ToUUID(string(criteria.RevieweeID)),
+		IncludeRemoved: criteria.IncludeRemoved,
+		PageLimit:      int32(criteria.Limit),
+	}
+	if criteria.RevieweeRole != nil {
+		role := string(*criteria.RevieweeRole)
+		params.RevieweeRole = &role
+	}
+	if criteria.After != nil {
+		params.AfterCreatedAt = lp.ToTimestamp(&criteria.After.CreatedAt)
+		params.AfterID = lp.ToUUID(string(criteria.After.ReviewID))
+	}
+
+	rows, err := r.db.ListReviews(ctx, params)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list reviews from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to list reviews from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to list reviews: %w", lp.TranslateError(err))
+	}
+
+	reviews, err := r.withScores(ctx, rows)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query review scores from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query scores of listed reviews from DB", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Reviews listed")
+	span.SetAttributes(attribute.Int("list.result_count", len(reviews)))
+	return reviews, nil
+}
+
+func (r *reviewRepository) Save(ctx context.Context, rv *review.Review) error {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("review_id", string(rv.ID)))
+
+	ctx, span := r.tracer.Start(ctx, "ReviewRepository.Save", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.review_id", string(rv.ID)),
+		attribute.String("db.review_status", string(rv.Status)),
+	)
+
+	err := r.inTx(ctx, span, logger, func(qtx *db.Queries) error {
+		if err := r.save(ctx, qtx, span, logger, rv); err != nil {
+			return err
+		}
+		return r.refreshReputation(ctx, qtx, span, logger, rv.RevieweeID)
+	})
+	if err != nil {
+		return err
+	}
+
+	rv.Version++
+	span.SetStatus(codes.Ok, "Review saved to DB")
+	logger.Info("Review saved successfully to DB.", "status", rv.Status)
+	return nil
+}
+
+// save writes the review row, guarded by its version, and replaces its
+// category scores.
+func (r *reviewRepository) save(ctx context.Context, qtx *db.Queries, span trace.Span, logger *slog.Logger, rv *review.Review) error {
+	reviewID := lp.ToUUID(string(rv.ID))
+	params := db.UpsertReviewParams{
+		ID:             reviewID,
+		TaskID:         lp.ToUUID(string(rv.TaskID)),
+		ReviewerID:     lp.ToUUID(string(rv.ReviewerID)),
+		RevieweeID:     lp.ToUUID(string(rv.RevieweeID)),
+		RevieweeRole:   string(rv.RevieweeRole),
+		Rating:         int16(rv.Rating),
+		Comment:        rv.Comment,
+		Status:         string(rv.Status),
+		FlagReason:     rv.FlagReason,
+		ModerationNote: rv.ModerationNote,
+		ModeratedAt:    lp.ToTimestamp(rv.ModeratedAt),
+		Version:        rv.Version + 1,
+		CreatedAt:      lp.ToTimestamp(&rv.CreatedAt),
+		UpdatedAt:      lp.ToTimestamp(&rv.UpdatedAt),
+	}
+	if rv.Reply != nil {
+		params.ReplyBody = &rv.Reply.Body
+		params.ReplyCreatedAt = lp.ToTimestamp(&rv.Reply.CreatedAt)
+		params.ReplyUpdatedAt = lp.ToTimestamp(&rv.Reply.UpdatedAt)
+	}
+	affected, err := qtx.UpsertReview(ctx, params)
+	if err != nil {
+		err = lp.TranslateError(err)
+		if errors.Is(err, lp.ErrUniqueViolation) {
+			span.SetStatus(codes.Error, "Duplicate review in DB")
+			span.SetAttributes(attribute.String("error.type", "db_unique_violation"))
+			logger.Warn("Author already reviewed the task.", "constraint", lp.ConstraintName(err))
+			return review.ErrAlreadyReviewed
+		}
+		span.SetStatus(codes.Error, "Failed to upsert review in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to upsert review in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to upsert review: %w", lp.TranslateError(err))
+	}
+	if affected == 0 {
+		span.SetStatus(codes.Error, "Stale review version")
+		span.SetAttributes(attribute.String("error.type", "db_version_conflict"))
+		logger.Warn("Review was changed concurrently.", "version", rv.Version)
+		return review.ErrReviewChanged
+	}
+
+	if err := qtx.DeleteReviewScores(ctx, reviewID); err != nil {
+		span.SetStatus(codes.Error, "Failed to delete review scores in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to delete review scores in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to delete review scores: %w", lp.TranslateError(err))
+	}
+	for category, score := range rv.Scores {
+		if err := qtx.InsertReviewScore(ctx, db.InsertReviewScoreParams{
+			ReviewID: reviewID,
+			Category: string(category),
+			Score:    int16(score),
+		}); err != nil {
+			span.SetStatus(codes.Error, "Failed to add review score in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to add review score in DB", slog.Any("error", err))
+			return fmt.Errorf("failed to add review score: %w", lp.TranslateError(err))
+		}
+	}
+	return nil
+}
+
+// refreshReputation recalculates the reputation of a user from the reviews
+// they received, including the one saved in the same transaction.
+func (r *reviewRepository) refreshReputation(ctx context.Context, qtx *db.Queries, span trace.Span, logger *slog.Logger, userID ids.UserID) error {
+	id := lp.ToUUID(string(userID))
+	fail := func(msg string, err error) error {
+		span.SetStatus(codes.Error, "Failed to refresh reputation in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to refresh reputation in DB", slog.String("step", msg), slog.Any("error", err))
+		return fmt.Errorf("failed to %s: %w", msg, err)
+	}
+
+	if err := qtx.LockReputation(ctx, id); err != nil {
+		return fail("lock reputation", err)
+	}
+	totals, err := qtx.GetReviewStats(ctx, db.GetReviewStatsParams{RevieweeID: id, RecentWindow: review.RecentWindow})
+	if err != nil {
+		return fail("sum ratings", err)
+	}
+	scoreRows, err := qtx.GetReviewScoreStats(ctx, id)
+	if err != nil {
+		return fail("sum category scores", err)
+	}
+
+	stats := review.Stats{
+		ReviewCount: int(totals.ReviewCount),
+		RatingSum:   totals.RatingSum,
+		RecentCount: int(totals.RecentCount),
+		RecentSum:   totals.RecentSum,
+		ScoreCounts: make(map[review.Category]int, len(scoreRows)),
+		ScoreSums:   make(map[review.Category]int64, len(scoreRows)),
+	}
+	for _, row := range scoreRows {
+		stats.ScoreCounts[review.Category(row.Category)] = int(row.ScoreCount)
+		stats.ScoreSums[review.Category(row.Category)] = row.ScoreSum
+	}
+	rep := review.NewReputation(userID, stats, time.Now())
+
+	categoryAverages, err := json.Marshal(rep.CategoryAverages)
+	if err != nil {
+		return fail("encode category averages", err)
+	}
+	if err := qtx.UpdateReputation(ctx, db.UpdateReputationParams{
+		UserID:           id,
+		ReviewCount:      int32(rep.ReviewCount),
+		AverageRating:    rep.AverageRating,
+		BayesianAverage:  rep.BayesianAverage,
+		RecentAverage:    rep.RecentAverage,
+		Trend:            string(rep.Trend),
+		CategoryAverages: categoryAverages,
+		UpdatedAt:        lp.ToTimestamp(&rep.UpdatedAt),
+	}); err != nil {
+		return fail("update reputation", err)
+	}
+	span.SetAttributes(attribute.Int("reputation.review_count", rep.ReviewCount))
+	return nil
+}
+
+// withScores loads the category scores of the rows and builds the reviews.
+func (r *reviewRepository) withScores(ctx context.Context, rows []db.Review) ([]*review.Review, error) {
+	reviews := make([]*review.Review, 0, len(rows))
+	if len(rows) == 0 {
+		return reviews, nil
+	}
+	scoreRows, err := r.db.FindReviewScoresByReviewIDs(ctx, utils.ArrayMap(rows, func(row db.Review) pgtype.UUID { return row.ID }))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query review scores: %w", lp.TranslateError(err))
+	}
+	scores := make(map[string]map[review.Category]int, len(rows))
+	for _, row := range scoreRows {
+		id := lp.FromUUID(row.ReviewID)
+		if scores[id] == nil {
+			scores[id] = make(map[review.Category]int)
+		}
+		scores[id][review.Category(row.Category)] = int(row.Score)
+	}
+	for _, row := range rows {
+		reviews = append(reviews, toReview(row, scores[lp.FromUUID(row.ID)]))
+	}
+	return reviews, nil
+}
+
+func (r *reviewRepository) inTx(ctx context.Context, span trace.Span, logger *slog.Logger, fn func(qtx *db.Queries) error) (err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to begin transaction")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+		logger.Error("Failed to begin DB transaction", slog.Any("error", err))
+		return fmt.Errorf("failed to begin transaction: %w", lp.TranslateError(err))
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		} else if err != nil {
+			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+				logger.Error("Failed to rollback DB transaction", slog.Any("error", rollbackErr))
+			}
+		} else {
+			if commitErr := tx.Commit(ctx); commitErr != nil {
+				span.SetStatus(codes.Error, "Failed to commit transaction")
+				span.RecordError(commitErr)
+				span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+				logger.Error("Failed to commit DB transaction", slog.Any("error", commitErr))
+				err = fmt.Errorf("failed to commit transaction: %w", lp.TranslateError(commitErr))
+			}
+		}
+	}()
+
+	// Statements inside may wrap their errors; translating once more here
+	// makes sure serialization failures, deadlocks and constraint violations
+	// leave the transaction as the typed errors callers retry or report.
+	return lp.TranslateError(fn(r.db.WithTx(tx)))
+}
+
+func toReview(row db.Review, scores map[review.Category]int) *review.Review {
+	var reply *review.Reply
+	if row.ReplyBody != nil {
+		reply = &review.Reply{
+			Body:      *row.ReplyBody,
+			CreatedAt: *lp.ToTime(row.ReplyCreatedAt),
+			UpdatedAt: *lp.ToTime(row.ReplyUpdatedAt),
+		}
+	}
+	if scores == nil {
+		scores = map[review.Category]int{}
+	}
+	return review.NewReviewFromRepository(
+		lp.FromUUID(row.ID),
+		lp.FromUUID(row.TaskID),
+		lp.FromUUID(row.ReviewerID),
+		lp.FromUUID(row.RevieweeID),
+		review.Role(row.RevieweeRole),
+		int(row.Rating),
+		scores,
+		row.Comment,
+		reply,
+		review.Status(row.Status),
+		row.FlagReason,
+		row.ModerationNote,
+		lp.ToTime(row.ModeratedAt),
+		row.Version,
+		*lp.ToTime(row.CreatedAt),
+		*lp.ToTime(row.UpdatedAt),
+	)
+}
@@ -0,0 +1,337 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/task"
+	db "github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/infra/persistence/postgres/generated"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+	"github.com/pratchaya-maneechot/service-exchange/libs/utils"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type taskRepository struct {
+	db     *db.Queries
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+	config *config.Config
+	tracer trace.Tracer
+}
+
+func NewPostgresTaskRepository(cfg *config.Config, dbPool *lp.DBPool, logger *slog.Logger) task.TaskRepository {
+	repoLogger := logger.With(slog.String("component", "taskRepository"))
+	return &taskRepository{
+		db:     db.New(dbPool.Pool),
+		pool:   dbPool.Pool,
+		logger: repoLogger,
+		config: cfg,
+		tracer: otel.Tracer(fmt.Sprintf("%s.repository", cfg.Name)),
+	}
+}
+
+func (r *taskRepository) FindByID(ctx context.Context, id ids.TaskID) (*task.Task, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(id)))
+
+	ctx, span := r.tracer.Start(ctx, "TaskRepository.FindByID", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "read_by_id"),
+		attribute.String("db.task_id", string(id)),
+	)
+
+	taskID := lp.ToUUID(string(id))
+	raw, err := r.db.FindTaskByID(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.SetStatus(codes.Ok, "Task not found in DB")
+			span.SetAttributes(attribute.Bool("task.found", false))
+			logger.Debug("Task not found in DB.")
+			return nil, task.ErrTaskNotFound
+		}
+		span.SetStatus(codes.Error, "Failed to query task from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query task from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query task: %w", lp.TranslateError(err))
+	}
+
+	attachmentRows, err := r.db.FindTaskAttachments(ctx, taskID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query task attachments from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query task attachments from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query task attachments: %w", lp.TranslateError(err))
+	}
+	attachments := make([]task.Attachment, 0, len(attachmentRows))
+	for _, row := range attachmentRows {
+		attachments = append(attachments, toAttachment(db.FindTaskAttachmentsByTaskIDsRow(row)))
+	}
+
+	span.SetStatus(codes.Ok, "Task found")
+	span.SetAttributes(attribute.Bool("task.found", true))
+	return toTask(raw, attachments), nil
+}
+
+func (r *taskRepository) Save(ctx context.Context, t *task.Task) (err error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("task_id", string(t.ID)))
+
+	ctx, span := r.tracer.Start(ctx, "TaskRepository.Save", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.task_id", string(t.ID)),
+		attribute.String("db.task_status", string(t.Status)),
+	)
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to begin transaction")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+		logger.Error("Failed to begin DB transaction", slog.Any("error", err))
+		return fmt.Errorf("failed to begin transaction: %w", lp.TranslateError(err))
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		} else if err != nil {
+			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+				logger.Error("Failed to rollback DB transaction", slog.Any("error", rollbackErr))
+			}
+		} else {
+			if commitErr := tx.Commit(ctx); commitErr != nil {
+				span.SetStatus(codes.Error, "Failed to commit transaction")
+				span.RecordError(commitErr)
+				span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+				logger.Error("Failed to commit DB transaction", slog.Any("error", commitErr))
+				err = fmt.Errorf("failed to commit transaction: %w", lp.TranslateError(commitErr))
+				return
+			}
+			t.Version++
+		}
+	}()
+
+	qtx := r.db.WithTx(tx)
+	taskID := lp.ToUUID(string(t.ID))
+
+	params := db.UpsertTaskParams{
+		ID:                 taskID,
+		PosterID:           lp.ToUUID(string(t.PosterID)),
+		Title:              t.Title,
+		Description:        t.Description,
+		CategoryCode:       t.CategoryCode,
+		BudgetAmount:       t.Budget.Amount,
+		Currency:           t.Budget.Currency,
+		DueAt:              lp.ToTimestamp(t.DueAt),
+		Status:             string(t.Status),
+		CancellationReason: t.CancellationReason,
+		PublishedAt:        lp.ToTimestamp(t.PublishedAt),
+		AssignedAt:         lp.ToTimestamp(t.AssignedAt),
+		StartedAt:          lp.ToTimestamp(t.StartedAt),
+		CompletedAt:        lp.ToTimestamp(t.CompletedAt),
+		CancelledAt:        lp.ToTimestamp(t.CancelledAt),
+		Version:            t.Version + 1,
+		CreatedAt:          lp.ToTimestamp(&t.CreatedAt),
+		UpdatedAt:          lp.ToTimestamp(&t.UpdatedAt),
+	}
+	if t.Location != nil {
+		params.Province = &t.Location.Province
+		params.District = t.Location.District
+		params.PostalCode = t.Location.PostalCode
+		params.Latitude = t.Location.Latitude
+		params.Longitude = t.Location.Longitude
+	}
+	if t.AssigneeID != nil {
+		params.AssigneeID = lp.ToUUID(string(*t.AssigneeID))
+	}
+	affected, err := qtx.UpsertTask(ctx, params)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to upsert task in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to upsert task in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to upsert task: %w", lp.TranslateError(err))
+	}
+	if affected == 0 {
+		span.SetStatus(codes.Error, "Stale task version")
+		span.SetAttributes(attribute.String("error.type", "db_version_conflict"))
+		logger.Warn("Task was changed concurrently.", "version", t.Version)
+		return task.ErrTaskChanged
+	}
+
+	logger.Debug("Replacing task attachments in DB.")
+	if err = qtx.DeleteTaskAttachments(ctx, taskID); err != nil {
+		span.SetStatus(codes.Error, "Failed to delete old task attachments in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to delete old task attachments in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to delete old task attachments: %w", lp.TranslateError(err))
+	}
+	for i, att := range t.Attachments {
+		err = qtx.InsertTaskAttachment(ctx, db.InsertTaskAttachmentParams{
+			ID:          lp.ToUUID(att.ID.String()),
+			TaskID:      taskID,
+			Url:         att.URL,
+			FileName:    att.FileName,
+			ContentType: att.ContentType,
+			SizeBytes:   att.SizeBytes,
+			Position:    int32(i),
+		})
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to add task attachment in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to add task attachment in DB", slog.Any("error", err))
+			return fmt.Errorf("failed to add task attachment: %w", lp.TranslateError(err))
+		}
+	}
+
+	span.SetStatus(codes.Ok, "Task saved to DB")
+	logger.Info("Task saved successfully to DB.", "status", t.Status)
+	return nil
+}
+
+func (r *taskRepository) List(ctx context.Context, criteria task.ListCriteria) ([]*task.Task, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("method", "List"))
+
+	ctx, span := r.tracer.Start(ctx, "TaskRepository.List", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "list"),
+		attribute.Bool("list.include_drafts", criteria.IncludeDrafts),
+		attribute.Int("list.limit", criteria.Limit),
+	)
+
+	params := db.ListTasksParams{
+		CategoryCode:  criteria.CategoryCode,
+		IncludeDrafts: criteria.IncludeDrafts,
+		PageLimit:     int32(criteria.Limit),
+	}
+	if criteria.PosterID != nil {
+		params.PosterID = lp.ToUUID(string(*criteria.PosterID))
+	}
+	if criteria.AssigneeID != nil {
+		params.AssigneeID = lp.ToUUID(string(*criteria.AssigneeID))
+	}
+	if criteria.Status != nil {
+		s := string(*criteria.Status)
+		params.Status = &s
+	}
+	if criteria.After != nil {
+		params.AfterCreatedAt = lp.ToTimestamp(&criteria.After.CreatedAt)
+		params.AfterID = lp.ToUUID(string(criteria.After.TaskID))
+	}
+
+	rows, err := r.db.ListTasks(ctx, params)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list tasks from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to list tasks from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to list tasks: %w", lp.TranslateError(err))
+	}
+
+	tasks := make([]*task.Task, 0, len(rows))
+	if len(rows) == 0 {
+		span.SetStatus(codes.Ok, "No tasks listed")
+		return tasks, nil
+	}
+
+	attachmentRows, err := r.db.FindTaskAttachmentsByTaskIDs(ctx, utils.ArrayMap(rows, func(row db.Task) pgtype.UUID { return row.ID }))
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query task attachments from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query attachments of listed tasks from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query task attachments: %w", lp.TranslateError(err))
+	}
+	attachments := make(map[string][]task.Attachment, len(rows))
+	for _, row := range attachmentRows {
+		id := lp.FromUUID(row.TaskID)
+		attachments[id] = append(attachments[id], toAttachment(row))
+	}
+
+	for _, row := range rows {
+		atts := attachments[lp.FromUUID(row.ID)]
+		if atts == nil {
+			atts = []task.Attachment{}
+		}
+		tasks = append(tasks, toTask(row, atts))
+	}
+
+	span.SetStatus(codes.Ok, "Tasks listed")
+	span.SetAttributes(attribute.Int("list.result_count", len(tasks)))
+	return tasks, nil
+}
+
+func toTask(row db.Task, attachments []task.Attachment) *task.Task {
+	var location *task.Location
+	if row.Province != nil {
+		location = &task.Location{
+			Province:   *row.Province,
+			District:   row.District,
+			PostalCode: row.PostalCode,
+			Latitude:   row.Latitude,
+			Longitude:  row.Longitude,
+		}
+	}
+	var assigneeID *ids.UserID
+	if row.AssigneeID.Valid {
+		id := ids.UserID(lp.FromUUID(row.AssigneeID))
+		assigneeID = &id
+	}
+	return task.NewTaskFromRepository(
+		lp.FromUUID(row.ID),
+		lp.FromUUID(row.PosterID),
+		row.Title,
+		row.Description,
+		row.CategoryCode,
+		task.Budget{Amount: row.BudgetAmount, Currency: row.Currency},
+		location,
+		lp.ToTime(row.DueAt),
+		attachments,
+		task.Status(row.Status),
+		assigneeID,
+		row.CancellationReason,
+		lp.ToTime(row.PublishedAt),
+		lp.ToTime(row.AssignedAt),
+		lp.ToTime(row.StartedAt),
+		lp.ToTime(row.CompletedAt),
+		lp.ToTime(row.CancelledAt),
+		row.Version,
+		*lp.ToTime(row.CreatedAt),
+		*lp.ToTime(row.UpdatedAt),
+	)
+}
+
+func toAttachment(row db.FindTaskAttachmentsByTaskIDsRow) task.Attachment {
+	return task.Attachment{
+		ID:          uuid.UUID(row.ID.Bytes),
+		URL:         row.Url,
+		FileName:    row.FileName,
+		ContentType: row.ContentType,
+		SizeBytes:   row.SizeBytes,
+	}
+}
@@ -0,0 +1,392 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/ticket"
+	db "github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/infra/persistence/postgres/generated"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ticketRepository struct {
+	db     *db.Queries
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+	config *config.Config
+	tracer trace.Tracer
+}
+
+func NewPostgresTicketRepository(cfg *config.Config, dbPool *lp.DBPool, logger *slog.Logger) ticket.TicketRepository {
+	repoLogger := logger.With(slog.String("component", "ticketRepository"))
+	return &ticketRepository{
+		db:     db.New(dbPool.Pool),
+		pool:   dbPool.Pool,
+		logger: repoLogger,
+		config: cfg,
+		tracer: otel.Tracer(fmt.Sprintf("%s.repository", cfg.Name)),
+	}
+}
+
+func (r *ticketRepository) FindByID(ctx context.Context, id ids.TicketID) (*ticket.Ticket, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("ticket_id", string(id)))
+
+	ctx, span := r.tracer.Start(ctx, "TicketRepository.FindByID", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "read_by_id"),
+		attribute.String("db.ticket_id", string(id)),
+	)
+
+	raw, err := r.db.FindTicketByID(ctx, lp.ToUUID(string(id)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.SetStatus(codes.Ok, "Ticket not found in DB")
+			span.SetAttributes(attribute.Bool("ticket.found", false))
+			logger.Debug("Ticket not found in DB.")
+			return nil, ticket.ErrTicketNotFound
+		}
+		span.SetStatus(codes.Error, "Failed to query ticket from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query ticket from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query ticket: %w", lp.TranslateError(err))
+	}
+
+	messageRows, err := r.db.FindTicketMessages(ctx, raw.ID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query ticket messages from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query ticket messages from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query ticket messages: %w", lp.TranslateError(err))
+	}
+	messages := make([]ticket.Message, 0, len(messageRows))
+	for _, row := range messageRows {
+		m, err := toTicketMessage(row)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to decode ticket message")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_decode_error"))
+			logger.Error("Failed to decode ticket message", slog.Any("error", err))
+			return nil, err
+		}
+		messages = append(messages, m)
+	}
+
+	span.SetStatus(codes.Ok, "Ticket found")
+	span.SetAttributes(attribute.Bool("ticket.found", true), attribute.Int("ticket.message_count", len(messages)))
+	return toTicket(raw, messages), nil
+}
+
+func (r *ticketRepository) List(ctx context.Context, criteria ticket.ListCriteria) ([]*ticket.Ticket, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "TicketRepository.List", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "list"),
+		attribute.Int("list.limit", criteria.Limit),
+	)
+
+	params := db.ListTicketsParams{
+		BreachedOnly: criteria.BreachedOnly,
+		PageLimit:    int32(criteria.Limit),
+	}
+	if criteria.ReporterID != nil {
+		params.ReporterID = lp.ToUUID(string(*criteria.ReporterID))
+	}
+	if criteria.AssigneeID != nil {
+		params.AssigneeID = lp.ToUUID(string(*criteria.AssigneeID))
+	}
+	if criteria.Status != nil {
+		status := string(*criteria.Status)
+		params.Status = &status
+	}
+	if criteria.Category != nil {
+		category := string(*criteria.Category)
+		params.Category = &category
+	}
+	if criteria.After != nil {
+		params.AfterCreatedAt = lp.ToTimestamp(&criteria.After.CreatedAt)
+		params.AfterID = lp.ToUUID(string(criteria.After.TicketID))
+	}
+
+	rows, err := r.db.ListTickets(ctx, params)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list tickets from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to list tickets from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to list tickets: %w", lp.TranslateError(err))
+	}
+
+	tickets := make([]*ticket.Ticket, 0, len(rows))
+	for _, row := range rows {
+		tickets = append(tickets, toTicket(row, nil))
+	}
+	span.SetStatus(codes.Ok, "Tickets listed")
+	span.SetAttributes(attribute.Int("list.result_count", len(tickets)))
+	return tickets, nil
+}
+
+func (r *ticketRepository) ListOverdue(ctx context.Context, now time.Time, limit int) ([]*ticket.Ticket, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "TicketRepository.ListOverdue", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "list_overdue"),
+		attribute.Int("list.limit", limit),
+	)
+
+	rows, err := r.db.ListOverdueTickets(ctx, db.ListOverdueTicketsParams{
+		Now:       lp.ToTimestamp(&now),
+		PageLimit: int32(limit),
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list overdue tickets from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to list overdue tickets from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to list overdue tickets: %w", lp.TranslateError(err))
+	}
+
+	tickets := make([]*ticket.Ticket, 0, len(rows))
+	for _, row := range rows {
+		tickets = append(tickets, toTicket(row, nil))
+	}
+	span.SetStatus(codes.Ok, "Overdue tickets listed")
+	span.SetAttributes(attribute.Int("list.result_count", len(tickets)))
+	return tickets, nil
+}
+
+func (r *ticketRepository) Save(ctx context.Context, t *ticket.Ticket) error {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("ticket_id", string(t.ID)))
+
+	ctx, span := r.tracer.Start(ctx, "TicketRepository.Save", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.ticket_id", string(t.ID)),
+		attribute.String("db.ticket_status", string(t.Status)),
+	)
+
+	err := r.inTx(ctx, span, logger, func(qtx *db.Queries) error {
+		return saveTicket(ctx, qtx, span, logger, t)
+	})
+	if err != nil {
+		return err
+	}
+
+	t.Version++
+	t.ClearPendingMessages()
+	span.SetStatus(codes.Ok, "Ticket saved to DB")
+	logger.Info("Ticket saved successfully to DB.", "status", t.Status)
+	return nil
+}
+
+func (r *ticketRepository) inTx(ctx context.Context, span trace.Span, logger *slog.Logger, fn func(qtx *db.Queries) error) (err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to begin transaction")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+		logger.Error("Failed to begin DB transaction", slog.Any("error", err))
+		return fmt.Errorf("failed to begin transaction: %w", lp.TranslateError(err))
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		} else if err != nil {
+			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+				logger.Error("Failed to rollback DB transaction", slog.Any("error", rollbackErr))
+			}
+		} else {
+			if commitErr := tx.Commit(ctx); commitErr != nil {
+				span.SetStatus(codes.Error, "Failed to commit transaction")
+				span.RecordError(commitErr)
+				span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+				logger.Error("Failed to commit DB transaction", slog.Any("error", commitErr))
+				err = fmt.Errorf("failed to commit transaction: %w", lp.TranslateError(commitErr))
+			}
+		}
+	}()
+
+	// Statements inside may wrap their errors; translating once more here
+	// makes sure serialization failures, deadlocks and constraint violations
+	// leave the transaction as the typed errors callers retry or report.
+	return lp.TranslateError(fn(r.db.WithTx(tx)))
+}
+
+// saveTicket writes the ticket row, guarded by its version, and appends its
+// pending messages. The dispute repository calls it too, so a dispute and
+// its ticket are written in one transaction. The caller bumps the version
+// and clears the pending messages after the commit.
+func saveTicket(ctx context.Context, qtx *db.Queries, span trace.Span, logger *slog.Logger, t *ticket.Ticket) error {
+	ticketID := lp.ToUUID(string(t.ID))
+	params := db.UpsertTicketParams{
+		ID:            ticketID,
+		ReporterID:    lp.ToUUID(string(t.ReporterID)),
+		Category:      string(t.Category),
+		Priority:      string(t.Priority),
+		Subject:       t.Subject,
+		Status:        string(t.Status),
+		SlaDueAt:      lp.ToTimestamp(&t.SLADueAt),
+		SlaBreachedAt: lp.ToTimestamp(t.SLABreachedAt),
+		ResolvedAt:    lp.ToTimestamp(t.ResolvedAt),
+		ClosedAt:      lp.ToTimestamp(t.ClosedAt),
+		Version:       t.Version + 1,
+		CreatedAt:     lp.ToTimestamp(&t.CreatedAt),
+		UpdatedAt:     lp.ToTimestamp(&t.UpdatedAt),
+	}
+	if t.AssigneeID != nil {
+		params.AssigneeID = lp.ToUUID(string(*t.AssigneeID))
+	}
+	if t.TaskID != nil {
+		params.TaskID = lp.ToUUID(string(*t.TaskID))
+	}
+	affected, err := qtx.UpsertTicket(ctx, params)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to upsert ticket in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to upsert ticket in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to upsert ticket: %w", lp.TranslateError(err))
+	}
+	if affected == 0 {
+		span.SetStatus(codes.Error, "Stale ticket version")
+		span.SetAttributes(attribute.String("error.type", "db_version_conflict"))
+		logger.Warn("Ticket was changed concurrently.", "version", t.Version)
+		return ticket.ErrTicketChanged
+	}
+
+	for _, m := range t.PendingMessages() {
+		attachments, err := encodeAttachments(m.Attachments)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to encode message attachments")
+			span.RecordError(err)
+			return err
+		}
+		if err := qtx.InsertTicketMessage(ctx, db.InsertTicketMessageParams{
+			ID:          lp.ToUUID(string(m.ID)),
+			TicketID:    ticketID,
+			AuthorID:    lp.ToUUID(string(m.AuthorID)),
+			FromStaff:   m.FromStaff,
+			Internal:    m.Internal,
+			Body:        m.Body,
+			Attachments: attachments,
+			CreatedAt:   lp.ToTimestamp(&m.CreatedAt),
+		}); err != nil {
+			span.SetStatus(codes.Error, "Failed to add ticket message in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to add ticket message in DB", slog.Any("error", err))
+			return fmt.Errorf("failed to add ticket message: %w", lp.TranslateError(err))
+		}
+	}
+	return nil
+}
+
+// attachmentRecord is how an attachment is stored in the attachments JSONB
+// columns of ticket messages and dispute evidence.
+type attachmentRecord struct {
+	URL         string  `json:"url"`
+	FileName    string  `json:"fileName"`
+	ContentType *string `json:"contentType,omitempty"`
+	SizeBytes   int64   `json:"sizeBytes"`
+}
+
+func encodeAttachments(atts []ticket.Attachment) ([]byte, error) {
+	records := make([]attachmentRecord, 0, len(atts))
+	for _, a := range atts {
+		records = append(records, attachmentRecord(a))
+	}
+	raw, err := json.Marshal(records)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode attachments: %w", err)
+	}
+	return raw, nil
+}
+
+func decodeAttachments(raw []byte) ([]ticket.Attachment, error) {
+	var records []attachmentRecord
+	if err := json.Unmarshal(raw, &records); err != nil {
+		return nil, fmt.Errorf("failed to decode attachments: %w", err)
+	}
+	atts := make([]ticket.Attachment, 0, len(records))
+	for _, rec := range records {
+		atts = append(atts, ticket.Attachment(rec))
+	}
+	return atts, nil
+}
+
+func toTicketMessage(row db.TicketMessage) (ticket.Message, error) {
+	attachments, err := decodeAttachments(row.Attachments)
+	if err != nil {
+		return ticket.Message{}, err
+	}
+	return ticket.Message{
+		ID:          ids.MessageID(lp.FromUUID(row.ID)),
+		AuthorID:    ids.UserID(lp.FromUUID(row.AuthorID)),
+		FromStaff:   row.FromStaff,
+		Internal:    row.Internal,
+		Body:        row.Body,
+		Attachments: attachments,
+		CreatedAt:   *lp.ToTime(row.CreatedAt),
+	}, nil
+}
+
+func toTicket(row db.Ticket, messages []ticket.Message) *ticket.Ticket {
+	var assigneeID *ids.UserID
+	if row.AssigneeID.Valid {
+		id := ids.UserID(lp.FromUUID(row.AssigneeID))
+		assigneeID = &id
+	}
+	var taskID *ids.TaskID
+	if row.TaskID.Valid {
+		id := ids.TaskID(lp.FromUUID(row.TaskID))
+		taskID = &id
+	}
+	return ticket.NewTicketFromRepository(
+		lp.FromUUID(row.ID),
+		lp.FromUUID(row.ReporterID),
+		ticket.Category(row.Category),
+		ticket.Priority(row.Priority),
+		row.Subject,
+		ticket.Status(row.Status),
+		assigneeID,
+		taskID,
+		messages,
+		*lp.ToTime(row.SlaDueAt),
+		lp.ToTime(row.SlaBreachedAt),
+		lp.ToTime(row.ResolvedAt),
+		lp.ToTime(row.ClosedAt),
+		row.Version,
+		*lp.ToTime(row.CreatedAt),
+		*lp.ToTime(row.UpdatedAt),
+	)
+}
@@ -0,0 +1,180 @@
+//go:build wireinject
+// +build wireinject
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/wire"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/domain/dispute"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/grpc"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/infra"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/worker"
+	"github.com/pratchaya-maneechot/service-exchange/libs/bus"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	lh "github.com/pratchaya-maneechot/service-exchange/libs/health"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+)
+
+func ProvideConfig() (*config.Config, error) {
+	return config.Load()
+}
+
+type Internal struct {
+	Config     *config.Config
+	Server     *lg.GRPCServer
+	Logger     *slog.Logger
+	App        *app.App
+	Bus        *bus.Bus
+	SLAMonitor *worker.SLAMonitor
+	// SettlementRetry settles the escrow of resolved disputes that could
+	// not be settled when they were decided.
+	SettlementRetry *worker.SettlementRetry
+	MetricServer    *observability.MetricServer
+	// Health runs the dependency checks behind /readyz and the gRPC health
+	// service.
+	Health  *lh.Registry
+	Cleanup func()
+}
+
+func NewInternal(
+	cfg *config.Config,
+	gs *lg.GRPCServer,
+	appModule *app.App,
+	bBus *bus.Bus,
+	vd *validator.Validate,
+	slaMonitor *worker.SLAMonitor,
+	settlementRetry *worker.SettlementRetry,
+	logger *slog.Logger,
+	metricServer *observability.MetricServer,
+	health *lh.Registry,
+	cleanup func(),
+) *Internal {
+
+	bBus.CommandBus.Use(lg.ValidateCommands(vd))
+	bBus.QueryBus.Use(lg.ValidateQueries(vd))
+
+	bBus.CommandBus.RegisterHandler(command.CreateTaskCommand{}, appModule.CreateTaskCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.UpdateTaskCommand{}, appModule.UpdateTaskCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.PublishTaskCommand{}, appModule.PublishTaskCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.AssignTaskCommand{}, appModule.AssignTaskCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.StartTaskCommand{}, appModule.StartTaskCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.CompleteTaskCommand{}, appModule.CompleteTaskCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.CancelTaskCommand{}, appModule.CancelTaskCommandHandler)
+	bBus.QueryBus.RegisterHandler(query.GetTaskQuery{}, appModule.GetTaskQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.ListTasksQuery{}, appModule.ListTasksQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.GetTaskAwardQuery{}, appModule.GetTaskAwardQueryHandler)
+	bBus.CommandBus.RegisterHandler(command.PlaceBidCommand{}, appModule.PlaceBidCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.CounterBidCommand{}, appModule.CounterBidCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.AcceptBidCommand{}, appModule.AcceptBidCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.RejectBidCommand{}, appModule.RejectBidCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.WithdrawBidCommand{}, appModule.WithdrawBidCommandHandler)
+	bBus.QueryBus.RegisterHandler(query.GetBidQuery{}, appModule.GetBidQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.ListTaskBidsQuery{}, appModule.ListTaskBidsQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.ListBookingsQuery{}, appModule.ListBookingsQueryHandler)
+	bBus.CommandBus.RegisterHandler(command.SubmitReviewCommand{}, appModule.SubmitReviewCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.EditReviewCommand{}, appModule.EditReviewCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.ReplyToReviewCommand{}, appModule.ReplyToReviewCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.FlagReviewCommand{}, appModule.FlagReviewCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.ModerateReviewCommand{}, appModule.ModerateReviewCommandHandler)
+	bBus.QueryBus.RegisterHandler(query.GetReviewQuery{}, appModule.GetReviewQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.ListTaskReviewsQuery{}, appModule.ListTaskReviewsQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.ListUserReviewsQuery{}, appModule.ListUserReviewsQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.GetReputationQuery{}, appModule.GetReputationQueryHandler)
+	bBus.CommandBus.RegisterHandler(command.OpenTicketCommand{}, appModule.OpenTicketCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.ReplyToTicketCommand{}, appModule.ReplyToTicketCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.AssignTicketCommand{}, appModule.AssignTicketCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.UpdateTicketCommand{}, appModule.UpdateTicketCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.DetectSLABreachesCommand{}, appModule.DetectSLABreachesCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.RetryDisputeSettlementsCommand{}, appModule.RetryDisputeSettlementsCommandHandler)
+	bBus.QueryBus.RegisterHandler(query.GetTicketQuery{}, appModule.GetTicketQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.ListTicketsQuery{}, appModule.ListTicketsQueryHandler)
+	bBus.CommandBus.RegisterHandler(command.OpenDisputeCommand{}, appModule.OpenDisputeCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.SubmitDisputeEvidenceCommand{}, appModule.SubmitDisputeEvidenceCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.StartDisputeReviewCommand{}, appModule.StartDisputeReviewCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.ResolveDisputeCommand{}, appModule.ResolveDisputeCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.WithdrawDisputeCommand{}, appModule.WithdrawDisputeCommandHandler)
+	bBus.QueryBus.RegisterHandler(query.GetDisputeQuery{}, appModule.GetDisputeQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.ListDisputesQuery{}, appModule.ListDisputesQueryHandler)
+	bBus.EventBus.Subscribe(dispute.DisputeResolved{}, appModule.SettleResolvedDisputeHandler)
+
+	return &Internal{
+		Config:          cfg,
+		Server:          gs,
+		App:             appModule,
+		Bus:             bBus,
+		SLAMonitor:      slaMonitor,
+		SettlementRetry: settlementRetry,
+		Logger:          logger,
+		MetricServer:    metricServer,
+		Health:          health,
+		Cleanup:         cleanup,
+	}
+}
+
+func InitializeApp(parentCtx context.Context) (*Internal, error) {
+	wire.Build(
+		app.AppModuleSet,
+		bus.BusModuleSet,
+		infra.InfraModuleSet,
+		grpc.NewGRPCServer,
+		worker.NewSLAMonitor,
+		worker.NewSettlementRetry,
+		NewInternal,
+		lg.ProvideValidator,
+		ProvideAppCleanup,
+		ProvideConfig,
+	)
+	return &Internal{}, nil
+}
+
+func ProvideAppCleanup(
+	infraModule *infra.Infra,
+	server *lg.GRPCServer,
+	metricServer *observability.MetricServer,
+	logger *slog.Logger,
+) func() {
+	return func() {
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		var shutdownErrors []error
+
+		if metricServer != nil {
+			if err := metricServer.Stop(cleanupCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				shutdownErrors = append(shutdownErrors, fmt.Errorf("failed to stop metrics server: %w", err))
+				logger.Error("Failed to stop metrics server", "error", err)
+			} else if err == nil {
+				logger.Info("Metrics server stopped.")
+			}
+		}
+
+		// gRPC server is typically stopped by context cancellation in its Start() method,
+		// so an explicit server.Stop() might not be needed here if Start() is blocking.
+		// If you had a separate server.Stop() method, you'd call it here.
+
+		if err := infraModule.Close(cleanupCtx); err != nil {
+			shutdownErrors = append(shutdownErrors, fmt.Errorf("failed to stop infra: %w", err))
+			logger.Error("Failed to stop infrastructure", "error", err)
+		} else {
+			logger.Info("Infrastructure components closed.")
+		}
+
+		if len(shutdownErrors) > 0 {
+			logger.Error("Errors encountered during application shutdown", "errors", shutdownErrors)
+		} else {
+			logger.Info("Application shutdown completed successfully.")
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/libs/bus"
+)
+
+// SettlementRetry sends a RetryDisputeSettlementsCommand on every tick, so
+// the escrow of every resolved dispute is eventually settled. Running it on
+// several replicas is safe: payments settle each dispute once, however
+// often they are asked.
+type SettlementRetry struct {
+	bus      bus.Bus
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+func NewSettlementRetry(cfg *config.Config, b bus.Bus, logger *slog.Logger) *SettlementRetry {
+	return &SettlementRetry{
+		bus:      b,
+		interval: cfg.Support.SettlementRetry.Interval,
+		logger:   logger.With(slog.String("component", "SettlementRetry")),
+	}
+}
+
+// Run blocks until ctx is cancelled.
+func (r *SettlementRetry) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	r.logger.Info("Settlement retry started.", "interval", r.interval)
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Settlement retry stopped.")
+			return
+		case <-ticker.C:
+			if _, err := r.bus.CommandBus.Dispatch(ctx, command.RetryDisputeSettlementsCommand{}); err != nil {
+				r.logger.Error("Failed to retry dispute settlements", "error", err)
+			}
+		}
+	}
+}
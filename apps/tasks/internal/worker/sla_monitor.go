@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/tasks/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/libs/bus"
+)
+
+// SLAMonitor sends a DetectSLABreachesCommand on every tick, which marks
+// tickets past their SLA due time as breached. Running it on several
+// replicas is safe: a ticket is marked once, and the version check turns
+// away a second replica marking it at the same time.
+type SLAMonitor struct {
+	bus      bus.Bus
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+func NewSLAMonitor(cfg *config.Config, b bus.Bus, logger *slog.Logger) *SLAMonitor {
+	return &SLAMonitor{
+		bus:      b,
+		interval: cfg.Support.BreachCheck.Interval,
+		logger:   logger.With(slog.String("component", "SLAMonitor")),
+	}
+}
+
+// Run blocks until ctx is cancelled.
+func (m *SLAMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	m.logger.Info("SLA monitor started.", "interval", m.interval)
+	for {
+		select {
+		case <-ctx.Done():
+			m.logger.Info("SLA monitor stopped.")
+			return
+		case <-ticker.C:
+			if _, err := m.bus.CommandBus.Dispatch(ctx, command.DetectSLABreachesCommand{}); err != nil {
+				m.logger.Error("Failed to detect SLA breaches", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Package client is how other services call UserService: the client
+// generated from user.proto, over a connection made by a
+// grpc.ClientFactory, so calls get a default deadline, the caller's
+// identity, retries of the methods user.proto marks idempotent, and a
+// circuit breaker.
+//
+// Calls fail with gRPC statuses. lg.ErrorFromGRPC turns them back into
+// application errors, which errs.HasReason tells apart by the reasons
+// below.
+package client
+
+import (
+	"context"
+
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/users/api/proto/user"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/role"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/tasker"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/grpc"
+)
+
+// Reasons of the errors callers usually handle.
+var (
+	ReasonUserNotFound          = user.ErrUserNotFound.Reason
+	ReasonProfileAccessDenied   = user.ErrProfileAccessDenied.Reason
+	ReasonTaskerProfileNotFound = tasker.ErrTaskerProfileNotFound.Reason
+	// ReasonRoleCacheNotLoaded is returned while the service starts; it is
+	// UNAVAILABLE, so idempotent calls retry it.
+	ReasonRoleCacheNotLoaded = role.ErrRoleCacheNotLoaded.Reason
+)
+
+type Client struct {
+	pb.UserServiceClient
+	conn *grpc.ClientConn
+}
+
+// New returns a client of the UserService at cfg.Target, usually
+// lg.DefaultConfigClient of it.
+func New(ctx context.Context, factory *lg.ClientFactory, cfg lg.ConfigClient) (*Client, error) {
+	conn, err := factory.NewClient(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{UserServiceClient: pb.NewUserServiceClient(conn), conn: conn}, nil
+}
+
+// Close closes the connection; calls in flight fail.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
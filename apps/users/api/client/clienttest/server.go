@@ -0,0 +1,202 @@
+// Package clienttest serves a fake UserService in process, for the tests of
+// services that call it through package client.
+package clienttest
+
+import (
+	"context"
+	"net"
+	"path"
+	"sync"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/api/client"
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/users/api/proto/user"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/tasker"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+// Call is a call the fake received.
+type Call struct {
+	// Caller is the identity the call was made on behalf of, if any.
+	Caller  lg.Caller
+	Request proto.Message
+}
+
+// Server keeps user and tasker profiles in memory and serves the reads of
+// UserService from them. Other methods fail with UNIMPLEMENTED unless
+// FailNext says otherwise. Errors are the service's own, so callers can
+// tell them apart the same way.
+type Server struct {
+	pb.UnimplementedUserServiceServer
+
+	listener *bufconn.Listener
+	server   *grpc.Server
+
+	mu       sync.Mutex
+	profiles map[string]*pb.UserProfile
+	taskers  map[string]*pb.TaskerProfile
+	failures map[string][]error
+	calls    map[string][]Call
+}
+
+// NewServer starts a fake with no profiles, taking callers from identity
+// tokens checked with identity; the factory given to Dial must sign them
+// with the same keys. Stop it with Close.
+func NewServer(identity lg.IdentityKeys) *Server {
+	s := &Server{
+		listener: bufconn.Listen(1 << 20),
+		profiles: make(map[string]*pb.UserProfile),
+		taskers:  make(map[string]*pb.TaskerProfile),
+		failures: make(map[string][]error),
+		calls:    make(map[string][]Call),
+	}
+	s.server = grpc.NewServer(grpc.ChainUnaryInterceptor(lg.UnaryCallerInterceptor(identity), s.intercept))
+	pb.RegisterUserServiceServer(s.server, s)
+	go s.server.Serve(s.listener)
+	return s
+}
+
+// Dial returns a client of the fake made by factory, with everything of cfg
+// but its target and TLS, so the tests go through the same retries,
+// deadlines and breaker as the service does.
+func (s *Server) Dial(ctx context.Context, factory *lg.ClientFactory, cfg lg.ConfigClient) (*client.Client, error) {
+	cfg.Target = "passthrough:///users"
+	cfg.TLS = nil
+	cfg.Options = append(cfg.Options, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return s.listener.DialContext(ctx)
+	}))
+	return client.New(ctx, factory, cfg)
+}
+
+// Close stops the fake; calls in flight fail.
+func (s *Server) Close() {
+	s.server.Stop()
+}
+
+// AddUser stores the profile, replacing the one with the same user ID.
+func (s *Server) AddUser(p *pb.UserProfile) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.profiles[p.GetUserId()] = proto.Clone(p).(*pb.UserProfile)
+}
+
+// AddTaskerProfile stores the tasker profile, replacing the one with the
+// same user ID.
+func (s *Server) AddTaskerProfile(p *pb.TaskerProfile) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.taskers[p.GetUserId()] = proto.Clone(p).(*pb.TaskerProfile)
+}
+
+// FailNext makes the next calls of method, such as GetUserProfile, fail
+// with errs in turn before it answers normally again. Application errors
+// are sent the way the service sends them; gRPC statuses as they are.
+func (s *Server) FailNext(method string, errs ...error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[method] = append(s.failures[method], errs...)
+}
+
+// Calls returns the calls of method received so far, failed ones included.
+func (s *Server) Calls(method string) []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Call(nil), s.calls[method]...)
+}
+
+// intercept records the call and fails it when FailNext asked to.
+func (s *Server) intercept(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	method := path.Base(info.FullMethod)
+	caller, _ := lg.CallerFromCtx(ctx)
+
+	s.mu.Lock()
+	s.calls[method] = append(s.calls[method], Call{Caller: caller, Request: req.(proto.Message)})
+	var err error
+	if queued := s.failures[method]; len(queued) > 0 {
+		err, s.failures[method] = queued[0], queued[1:]
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	return handler(ctx, req)
+}
+
+func (s *Server) ResolveLineUser(ctx context.Context, req *pb.ResolveLineUserRequest) (*pb.ResolvedUser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range s.profiles {
+		if p.GetLineUserId() == req.GetLineUserId() {
+			return &pb.ResolvedUser{UserId: p.GetUserId(), Roles: p.GetRoles()}, nil
+		}
+	}
+	return nil, lg.NewGRPCErrCode(ctx, user.ErrUserNotFound)
+}
+
+func (s *Server) GetUserProfile(ctx context.Context, req *pb.GetUserProfileRequest) (*pb.UserProfile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.profiles[req.GetUserId()]
+	if !ok {
+		return nil, lg.NewGRPCErrCode(ctx, user.ErrUserNotFound)
+	}
+	return proto.Clone(p).(*pb.UserProfile), nil
+}
+
+func (s *Server) GetPublicProfile(ctx context.Context, req *pb.GetPublicProfileRequest) (*pb.PublicProfile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.profiles[req.GetUserId()]
+	if !ok {
+		return nil, lg.NewGRPCErrCode(ctx, user.ErrUserNotFound)
+	}
+	return &pb.PublicProfile{
+		UserId:      p.GetUserId(),
+		DisplayName: p.GetDisplayName(),
+		FirstName:   p.GetFirstName(),
+		LastName:    p.GetLastName(),
+		Bio:         p.GetBio(),
+		AvatarUrl:   p.GetAvatarUrl(),
+		IsVerified:  p.GetIsVerified(),
+		Roles:       p.GetRoles(),
+		MemberSince: p.GetCreatedAt(),
+		Reputation:  p.GetReputation(),
+	}, nil
+}
+
+func (s *Server) BatchGetUserProfiles(ctx context.Context, req *pb.BatchGetUserProfilesRequest) (*pb.BatchGetUserProfilesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := &pb.BatchGetUserProfilesResponse{}
+	seen := make(map[string]bool)
+	for _, id := range req.GetUserIds() {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if p, ok := s.profiles[id]; ok {
+			resp.Profiles = append(resp.Profiles, proto.Clone(p).(*pb.UserProfile))
+		} else {
+			resp.NotFoundUserIds = append(resp.NotFoundUserIds, id)
+		}
+	}
+	return resp, nil
+}
+
+func (s *Server) GetTaskerProfile(ctx context.Context, req *pb.GetTaskerProfileRequest) (*pb.TaskerProfile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.taskers[req.GetUserId()]
+	if !ok {
+		return nil, lg.NewGRPCErrCode(ctx, tasker.ErrTaskerProfileNotFound)
+	}
+	return proto.Clone(p).(*pb.TaskerProfile), nil
+}
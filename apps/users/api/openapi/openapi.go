@@ -0,0 +1,9 @@
+// Package openapi holds the OpenAPI document of the HTTP/JSON gateway,
+// generated from the google.api.http annotations of user.proto by
+// tools/scripts/generate-proto.sh.
+package openapi
+
+import _ "embed"
+
+//go:embed openapi.yaml
+var Spec []byte
@@ -23,6 +23,8 @@ func main() {
 	defer app.Cleanup()
 	logger := app.Logger
 	logger.Info("Application initialized successfully.")
+	go app.Health.Start(rootCtx)
+	go app.ExportRecovery.Run(rootCtx)
 	if app.MetricServer != nil {
 		go func() {
 			if err := app.MetricServer.Start(); err != nil && !errors.Is(err, http.ErrServerClosed) { // Add http.ErrServerClosed check
@@ -30,6 +32,13 @@ func main() {
 			}
 		}()
 	}
+	if app.GatewayServer != nil {
+		go func() {
+			if err := app.GatewayServer.Start(rootCtx); err != nil {
+				logger.Error("failed to start HTTP gateway", "error", err)
+			}
+		}()
+	}
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 	go func() {
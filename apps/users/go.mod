@@ -3,9 +3,11 @@ module github.com/pratchaya-maneechot/service-exchange/apps/users
 go 1.24.3
 
 require (
+	github.com/pratchaya-maneechot/service-exchange/apps/tasks v0.0.0-20250807073234-f4c462af416f
 	github.com/pratchaya-maneechot/service-exchange/libs/bus v0.0.0-20250807073234-f4c462af416f
 	github.com/pratchaya-maneechot/service-exchange/libs/errors v0.0.0-20250807073234-f4c462af416f
 	github.com/pratchaya-maneechot/service-exchange/libs/grpc v0.0.0-20250807073234-f4c462af416f
+	github.com/pratchaya-maneechot/service-exchange/libs/health v0.0.0-20250807073234-f4c462af416f
 	github.com/pratchaya-maneechot/service-exchange/libs/infra v0.0.0-20250807073234-f4c462af416f
 	github.com/pratchaya-maneechot/service-exchange/libs/utils v0.0.0-20250807073234-f4c462af416f
 	go.opentelemetry.io/otel v1.36.0
@@ -22,7 +24,7 @@ require (
 	github.com/go-playground/locales v0.14.1 // indirect
 	github.com/go-playground/universal-translator v0.18.1 // indirect
 	github.com/go-viper/mapstructure/v2 v2.2.1 // indirect
-	github.com/grpc-ecosystem/grpc-gateway/v2 v2.26.3 // indirect
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.26.3
 	github.com/jackc/pgpassfile v1.0.0 // indirect
 	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
 	github.com/jackc/puddle/v2 v2.2.2 // indirect
@@ -44,11 +46,12 @@ require (
 	go.uber.org/multierr v1.9.0 // indirect
 	golang.org/x/crypto v0.38.0 // indirect
 	golang.org/x/sync v0.14.0 // indirect
-	google.golang.org/genproto/googleapis/api v0.0.0-20250519155744-55703ea1f237 // indirect
+	google.golang.org/genproto/googleapis/api v0.0.0-20250519155744-55703ea1f237
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
 require (
+	buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go v1.36.10-20250912141014-52f32327d4b0.1
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/cespare/xxhash/v2 v2.3.0 // indirect
 	github.com/go-playground/validator/v10 v10.26.0
@@ -67,5 +70,5 @@ require (
 	golang.org/x/text v0.25.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250519155744-55703ea1f237 // indirect
 	google.golang.org/grpc v1.72.1
-	google.golang.org/protobuf v1.36.6
+	google.golang.org/protobuf v1.36.10
 )
@@ -14,10 +14,31 @@ import (
 )
 
 type App struct {
-	GetUserProfileQueryHandler      *query.GetUserProfileQueryHandler
-	RegisterUserCommandHandler      *command.RegisterUserCommandHandler
-	UpdateUserProfileCommandHandler *command.UpdateUserProfileCommandHandler
-	RoleCacheService                *role.RoleCacheService
+	GetUserProfileQueryHandler               *query.GetUserProfileQueryHandler
+	GetPublicProfileQueryHandler             *query.GetPublicProfileQueryHandler
+	GetDataExportStatusQueryHandler          *query.GetDataExportStatusQueryHandler
+	SearchUsersQueryHandler                  *query.SearchUsersQueryHandler
+	BatchGetUserProfilesQueryHandler         *query.BatchGetUserProfilesQueryHandler
+	GetTaskerProfileQueryHandler             *query.GetTaskerProfileQueryHandler
+	ListSkillCategoriesQueryHandler          *query.ListSkillCategoriesQueryHandler
+	GetTaskerAvailabilityQueryHandler        *query.GetTaskerAvailabilityQueryHandler
+	FindAvailableTaskersQueryHandler         *query.FindAvailableTaskersQueryHandler
+	FindTaskersNearQueryHandler              *query.FindTaskersNearQueryHandler
+	ResolveLineUserQueryHandler              *query.ResolveLineUserQueryHandler
+	RegisterUserCommandHandler               *command.RegisterUserCommandHandler
+	UpdateUserProfileCommandHandler          *command.UpdateUserProfileCommandHandler
+	ExportUserDataCommandHandler             *command.ExportUserDataCommandHandler
+	FailInterruptedExportsCommandHandler     *command.FailInterruptedExportsCommandHandler
+	PurgeExpiredExportsCommandHandler        *command.PurgeExpiredExportsCommandHandler
+	CreateTaskerProfileCommandHandler        *command.CreateTaskerProfileCommandHandler
+	UpdateTaskerProfileCommandHandler        *command.UpdateTaskerProfileCommandHandler
+	UpsertSkillCategoryCommandHandler        *command.UpsertSkillCategoryCommandHandler
+	SetTaskerAvailabilityCommandHandler      *command.SetTaskerAvailabilityCommandHandler
+	ReviewIdentityVerificationCommandHandler *command.ReviewIdentityVerificationCommandHandler
+	NotifyUserCreatedHandler                 *command.NotifyUserCreatedHandler
+	NotifyVerificationDecidedHandler         *command.NotifyVerificationDecidedHandler
+	UserProfileWatchers                      *query.UserProfileWatchers
+	RoleCacheService                         *role.RoleCacheService
 }
 
 func ProvideRoleCacheService(
@@ -35,8 +56,29 @@ func ProvideRoleCacheService(
 
 var AppModuleSet = wire.NewSet(
 	query.NewGetUserProfileQueryHandler,
+	query.NewGetPublicProfileQueryHandler,
+	query.NewGetDataExportStatusQueryHandler,
+	query.NewSearchUsersQueryHandler,
+	query.NewBatchGetUserProfilesQueryHandler,
+	query.NewGetTaskerProfileQueryHandler,
+	query.NewListSkillCategoriesQueryHandler,
+	query.NewGetTaskerAvailabilityQueryHandler,
+	query.NewFindAvailableTaskersQueryHandler,
+	query.NewFindTaskersNearQueryHandler,
+	query.NewResolveLineUserQueryHandler,
+	query.NewUserProfileWatchers,
 	command.NewRegisterUserCommandHandler,
 	command.NewUpdateUserProfileCommandHandler,
+	command.NewExportUserDataCommandHandler,
+	command.NewFailInterruptedExportsCommandHandler,
+	command.NewPurgeExpiredExportsCommandHandler,
+	command.NewCreateTaskerProfileCommandHandler,
+	command.NewUpdateTaskerProfileCommandHandler,
+	command.NewUpsertSkillCategoryCommandHandler,
+	command.NewSetTaskerAvailabilityCommandHandler,
+	command.NewReviewIdentityVerificationCommandHandler,
+	command.NewNotifyUserCreatedHandler,
+	command.NewNotifyVerificationDecidedHandler,
 	ProvideRoleCacheService,
 	wire.Struct(new(App), "*"),
 )
@@ -0,0 +1,260 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/geo"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/role"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/tasker"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type HourlyRateInput struct {
+	MinAmount int64  `json:"minAmount" validate:"gte=0"`
+	MaxAmount int64  `json:"maxAmount" validate:"gtefield=MinAmount"`
+	Currency  string `json:"currency" validate:"required,len=3"`
+}
+
+// ServiceAreaInput is geocoded when Latitude and Longitude are not given.
+type ServiceAreaInput struct {
+	Province   string   `json:"province" validate:"required"`
+	District   *string  `json:"district,omitempty"`
+	PostalCode *string  `json:"postalCode,omitempty"`
+	Latitude   *float64 `json:"latitude,omitempty" validate:"required_with=Longitude"`
+	Longitude  *float64 `json:"longitude,omitempty" validate:"required_with=Latitude"`
+}
+
+type PortfolioItemInput struct {
+	// ID is empty for new items and kept for existing ones so image links stay stable.
+	ID          string     `json:"id,omitempty" validate:"omitempty,uuid"`
+	Title       string     `json:"title" validate:"required"`
+	Description *string    `json:"description,omitempty"`
+	ImageURLs   []string   `json:"imageUrls,omitempty" validate:"dive,url"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+// TaskerProfileInput is the full editable state of a tasker profile.
+type TaskerProfileInput struct {
+	Headline          *string              `json:"headline,omitempty"`
+	SkillCodes        []string             `json:"skillCodes" validate:"required,min=1"`
+	HourlyRate        HourlyRateInput      `json:"hourlyRate"`
+	ServiceAreas      []ServiceAreaInput   `json:"serviceAreas,omitempty" validate:"dive"`
+	Portfolio         []PortfolioItemInput `json:"portfolio,omitempty" validate:"dive"`
+	YearsOfExperience int                  `json:"yearsOfExperience" validate:"gte=0"`
+	IsVisible         bool                 `json:"isVisible"`
+}
+
+// toDetails maps the input to domain details, geocoding service areas that
+// came without coordinates.
+func (in TaskerProfileInput) toDetails(ctx context.Context, geocoder geo.Geocoder) (tasker.TaskerDetails, error) {
+	d := tasker.TaskerDetails{
+		Headline:   in.Headline,
+		SkillCodes: in.SkillCodes,
+		HourlyRate: tasker.HourlyRateRange{
+			MinAmount: in.HourlyRate.MinAmount,
+			MaxAmount: in.HourlyRate.MaxAmount,
+			Currency:  in.HourlyRate.Currency,
+		},
+		ServiceAreas:      make([]tasker.ServiceArea, 0, len(in.ServiceAreas)),
+		Portfolio:         make([]tasker.PortfolioItem, 0, len(in.Portfolio)),
+		YearsOfExperience: in.YearsOfExperience,
+		IsVisible:         in.IsVisible,
+	}
+	for _, a := range in.ServiceAreas {
+		area, err := AddressInput{
+			District:   a.District,
+			Province:   a.Province,
+			PostalCode: a.PostalCode,
+			Latitude:   a.Latitude,
+			Longitude:  a.Longitude,
+		}.toAddress(ctx, geocoder)
+		if err != nil {
+			return tasker.TaskerDetails{}, err
+		}
+		d.ServiceAreas = append(d.ServiceAreas, tasker.ServiceArea{
+			Province:   area.Province,
+			District:   area.District,
+			PostalCode: area.PostalCode,
+			Location:   area.Location,
+		})
+	}
+	for _, p := range in.Portfolio {
+		id, _ := uuid.Parse(p.ID)
+		d.Portfolio = append(d.Portfolio, tasker.PortfolioItem{
+			ID:          id,
+			Title:       p.Title,
+			Description: p.Description,
+			ImageURLs:   p.ImageURLs,
+			CompletedAt: p.CompletedAt,
+		})
+	}
+	return d, nil
+}
+
+type CreateTaskerProfileCommand struct {
+	UserID  ids.UserID         `json:"userId" validate:"required"`
+	Profile TaskerProfileInput `json:"profile"`
+	Viewer  user.Viewer        `json:"-"`
+}
+
+type TaskerProfileDto struct {
+	UserID string `json:"userId"`
+}
+
+type CreateTaskerProfileCommandHandler struct {
+	userRepo   user.UserRepository
+	taskerRepo tasker.TaskerProfileRepository
+	catalogue  tasker.SkillCatalogueRepository
+	geocoder   geo.Geocoder
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewCreateTaskerProfileCommandHandler(
+	userRepo user.UserRepository,
+	taskerRepo tasker.TaskerProfileRepository,
+	catalogue tasker.SkillCatalogueRepository,
+	geocoder geo.Geocoder,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *CreateTaskerProfileCommandHandler {
+	return &CreateTaskerProfileCommandHandler{
+		userRepo:   userRepo,
+		taskerRepo: taskerRepo,
+		catalogue:  catalogue,
+		geocoder:   geocoder,
+		logger:     logger.With(slog.String("component", "CreateTaskerProfileCommandHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *CreateTaskerProfileCommandHandler) Handle(ctx context.Context, cmd CreateTaskerProfileCommand) (*TaskerProfileDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(cmd.UserID)))
+
+	ctx, span := h.tracer.Start(ctx, "CreateTaskerProfileCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", string(cmd.UserID)),
+		attribute.Int("tasker.skill_count", len(cmd.Profile.SkillCodes)),
+	)
+
+	if _, err := ensureTaskerEligible(ctx, h.userRepo, cmd.Viewer, cmd.UserID); err != nil {
+		span.SetStatus(codes.Error, "Tasker profile not allowed")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "tasker_eligibility"))
+		logger.Warn("Rejected tasker profile creation", "viewer_id", string(cmd.Viewer.UserID), slog.Any("error", err))
+		return nil, err
+	}
+
+	if _, err := h.taskerRepo.FindByUserID(ctx, cmd.UserID); err == nil {
+		span.SetStatus(codes.Error, "Tasker profile already exists")
+		span.SetAttributes(attribute.String("error.type", string(tasker.ErrTaskerProfileAlreadyExists.Code)))
+		logger.Warn(tasker.ErrTaskerProfileAlreadyExists.Message)
+		return nil, tasker.ErrTaskerProfileAlreadyExists
+	} else if !errors.Is(err, tasker.ErrTaskerProfileNotFound) {
+		span.SetStatus(codes.Error, "Failed to check existing tasker profile")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to check existing tasker profile", slog.Any("error", err))
+		return nil, err
+	}
+
+	details, err := cmd.Profile.toDetails(ctx, h.geocoder)
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid service area")
+		span.SetAttributes(attribute.String("error.type", "invalid_service_area"))
+		logger.Warn("Rejected tasker service areas", slog.Any("error", err))
+		return nil, err
+	}
+
+	profile, err := tasker.NewTaskerProfile(cmd.UserID, details)
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid tasker profile")
+		span.SetAttributes(attribute.String("error.type", "invalid_tasker_profile"))
+		logger.Warn("Rejected invalid tasker profile", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := ensureActiveSkills(ctx, h.catalogue, profile.SkillCodes); err != nil {
+		span.SetStatus(codes.Error, "Unknown skill category")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "unknown_skill_category"))
+		logger.Warn("Rejected tasker profile skills", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := h.taskerRepo.Save(ctx, profile); err != nil {
+		span.SetStatus(codes.Error, "Failed to save tasker profile")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Error("Failed to save tasker profile to repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Tasker profile created")
+	logger.Info("Tasker profile created successfully.", "skills", profile.SkillCodes)
+
+	return &TaskerProfileDto{UserID: string(profile.UserID)}, nil
+}
+
+// ensureTaskerEligible checks that the viewer acts for the user, and that the
+// user holds the TASKER role and has an approved identity verification. It
+// returns the loaded user.
+func ensureTaskerEligible(ctx context.Context, userRepo user.UserRepository, viewer user.Viewer, userID ids.UserID) (*user.User, error) {
+	if !viewer.CanViewPrivateProfile(userID) {
+		return nil, user.ErrProfileAccessDenied
+	}
+
+	u, err := userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !u.HasRole(role.RoleNameTasker) {
+		return nil, tasker.ErrTaskerRoleRequired
+	}
+
+	verifications, err := userRepo.FindIdentityVerifications(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	u.RestoreIdentityVerifications(verifications)
+	if !u.IsVerified() {
+		return nil, tasker.ErrVerificationRequired
+	}
+	return u, nil
+}
+
+// ensureActiveSkills rejects codes that are missing from the catalogue or have
+// been deactivated.
+func ensureActiveSkills(ctx context.Context, catalogue tasker.SkillCatalogueRepository, skillCodes []string) error {
+	categories, err := catalogue.FindByCodes(ctx, skillCodes)
+	if err != nil {
+		return err
+	}
+	active := make(map[string]bool, len(categories))
+	for _, c := range categories {
+		active[c.Code] = c.IsActive
+	}
+	for _, code := range skillCodes {
+		if !active[code] {
+			return tasker.ErrUnknownSkillCategory
+		}
+	}
+	return nil
+}
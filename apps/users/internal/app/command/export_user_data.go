@@ -0,0 +1,454 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/availability"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/dataexport"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/geo"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/role"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/tasker"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"github.com/pratchaya-maneechot/service-exchange/libs/utils"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ExportUserDataCommand struct {
+	UserID ids.UserID `validate:"required,uuid"`
+	Format string     `validate:"omitempty,oneof=JSON ZIP"`
+	// Viewer is who asked; only the user or an admin may export their data.
+	Viewer user.Viewer `json:"-"`
+}
+
+type ExportUserDataDto struct {
+	ExportID string `json:"exportId"`
+	Status   string `json:"status"`
+}
+
+type ExportUserDataCommandHandler struct {
+	userRepo     user.UserRepository
+	taskerRepo   tasker.TaskerProfileRepository
+	scheduleRepo availability.ScheduleRepository
+	exportRepo   dataexport.DataExportRepository
+	storage      dataexport.ArchiveStorage
+	logger       *slog.Logger
+	config       *config.Config
+	tracer       trace.Tracer
+}
+
+func NewExportUserDataCommandHandler(
+	userRepo user.UserRepository,
+	taskerRepo tasker.TaskerProfileRepository,
+	scheduleRepo availability.ScheduleRepository,
+	exportRepo dataexport.DataExportRepository,
+	storage dataexport.ArchiveStorage,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ExportUserDataCommandHandler {
+	return &ExportUserDataCommandHandler{
+		userRepo:     userRepo,
+		taskerRepo:   taskerRepo,
+		scheduleRepo: scheduleRepo,
+		exportRepo:   exportRepo,
+		storage:      storage,
+		logger:       logger.With(slog.String("component", "ExportUserDataCommandHandler")),
+		config:       cfg,
+		tracer:       otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+// Handle records a pending export job and hands the actual gathering off to
+// a background goroutine. Callers poll GetDataExportStatus for the result.
+func (h *ExportUserDataCommandHandler) Handle(ctx context.Context, cmd ExportUserDataCommand) (*ExportUserDataDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(cmd.UserID)))
+
+	ctx, span := h.tracer.Start(ctx, "ExportUserDataCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", string(cmd.UserID)))
+
+	// Checked before loading so callers cannot probe which IDs exist.
+	if !cmd.Viewer.CanViewPrivateProfile(cmd.UserID) {
+		span.SetStatus(codes.Error, "Data export access denied")
+		span.SetAttributes(attribute.String("error.type", string(dataexport.ErrDataExportAccessDenied.Code)))
+		logger.Warn(dataexport.ErrDataExportAccessDenied.Message, "viewer_id", string(cmd.Viewer.UserID))
+		return nil, dataexport.ErrDataExportAccessDenied
+	}
+
+	format := dataexport.ExportFormat(cmd.Format)
+	if format == "" {
+		format = dataexport.ExportFormatJSON
+	}
+
+	if _, err := h.userRepo.FindByID(ctx, cmd.UserID); err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve user")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to retrieve user for data export", slog.Any("error", err))
+		return nil, err
+	}
+
+	job, err := dataexport.NewDataExport(cmd.UserID, format)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to create data export job")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "domain_creation_error"))
+		logger.Warn("Failed to create data export job", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := h.exportRepo.Save(ctx, job); err != nil {
+		span.SetStatus(codes.Error, "Failed to save data export job")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Error("Failed to save data export job", slog.Any("error", err))
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("export.id", string(job.ID)))
+
+	// The job must outlive the RPC, so detach from its cancellation but keep
+	// the trace and logger values for correlation.
+	jobCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), h.config.Export.JobTimeout)
+	go func() {
+		defer cancel()
+		h.run(jobCtx, job)
+	}()
+
+	span.SetStatus(codes.Ok, "Data export scheduled")
+	logger.Info("Data export scheduled.", "export_id", job.ID, "format", job.Format)
+
+	return &ExportUserDataDto{
+		ExportID: string(job.ID),
+		Status:   string(job.Status),
+	}, nil
+}
+
+func (h *ExportUserDataCommandHandler) run(ctx context.Context, job *dataexport.DataExport) {
+	logger := observability.LoggerFromCtx(ctx).With(
+		slog.String("export_id", string(job.ID)),
+		slog.String("user_id", string(job.UserID)),
+	)
+
+	ctx, span := h.tracer.Start(ctx, "ExportUserDataCommandHandler.run", trace.WithSpanKind(trace.SpanKindInternal))
+	defer span.End()
+
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("Panic while running data export", "panic", r)
+			h.fail(ctx, job, fmt.Sprintf("panic: %v", r))
+		}
+	}()
+
+	if err := job.Start(); err != nil {
+		span.SetStatus(codes.Error, "Invalid data export state")
+		span.RecordError(err)
+		logger.Error("Data export job cannot be started", slog.Any("error", err))
+		return
+	}
+	if err := h.exportRepo.Save(ctx, job); err != nil {
+		span.SetStatus(codes.Error, "Failed to mark data export running")
+		span.RecordError(err)
+		logger.Error("Failed to mark data export running", slog.Any("error", err))
+		return
+	}
+
+	archive, err := h.gather(ctx, job)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to gather user data")
+		span.RecordError(err)
+		logger.Error("Failed to gather user data for export", slog.Any("error", err))
+		h.fail(ctx, job, err.Error())
+		return
+	}
+
+	data, contentType, err := archive.Encode(job.Format)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to encode archive")
+		span.RecordError(err)
+		logger.Error("Failed to encode data export archive", slog.Any("error", err))
+		h.fail(ctx, job, err.Error())
+		return
+	}
+
+	key := fmt.Sprintf("%s/%s.%s", job.UserID, job.ID, job.Format.FileExtension())
+	if err := h.storage.Put(ctx, key, contentType, data); err != nil {
+		span.SetStatus(codes.Error, "Failed to store archive")
+		span.RecordError(err)
+		logger.Error("Failed to store data export archive", slog.Any("error", err))
+		h.fail(ctx, job, err.Error())
+		return
+	}
+
+	if err := job.Complete(key, int64(len(data)), h.config.Export.RetentionPeriod); err != nil {
+		span.SetStatus(codes.Error, "Invalid data export state")
+		span.RecordError(err)
+		logger.Error("Data export job cannot be completed", slog.Any("error", err))
+		return
+	}
+	if err := h.exportRepo.Save(ctx, job); err != nil {
+		span.SetStatus(codes.Error, "Failed to mark data export completed")
+		span.RecordError(err)
+		logger.Error("Failed to mark data export completed", slog.Any("error", err))
+		return
+	}
+
+	span.SetStatus(codes.Ok, "Data export completed")
+	span.SetAttributes(attribute.Int64("export.size_bytes", job.SizeBytes))
+	logger.Info("Data export completed.", "size_bytes", job.SizeBytes)
+}
+
+func (h *ExportUserDataCommandHandler) gather(ctx context.Context, job *dataexport.DataExport) (*dataexport.Archive, error) {
+	u, err := h.userRepo.FindByID(ctx, job.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load user: %w", err)
+	}
+	verifications, err := h.userRepo.FindIdentityVerifications(ctx, job.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load identity verifications: %w", err)
+	}
+	// Only taskers have a profile and a schedule.
+	profile, err := h.taskerRepo.FindByUserID(ctx, job.UserID)
+	if err != nil && !errors.Is(err, tasker.ErrTaskerProfileNotFound) {
+		return nil, fmt.Errorf("failed to load tasker profile: %w", err)
+	}
+	schedule, err := h.scheduleRepo.FindByUserID(ctx, job.UserID)
+	if err != nil && !errors.Is(err, availability.ErrScheduleNotFound) {
+		return nil, fmt.Errorf("failed to load availability: %w", err)
+	}
+	acquisition, err := h.userRepo.FindAcquisition(ctx, job.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load acquisition: %w", err)
+	}
+
+	// Timestamps are rendered in the user's own time zone so the archive
+	// reads naturally; the offsets keep them unambiguous.
+	loc := u.Profile.Location()
+	inLoc := func(t *time.Time) *time.Time {
+		if t == nil {
+			return nil
+		}
+		local := t.In(loc)
+		return &local
+	}
+
+	return &dataexport.Archive{
+		SchemaVersion: job.SchemaVersion,
+		ExportID:      string(job.ID),
+		GeneratedAt:   time.Now().In(loc),
+		User: dataexport.ArchiveUser{
+			ID:          string(u.ID),
+			LineUserID:  u.LineUserID,
+			Email:       u.Email,
+			Status:      string(u.Status),
+			CreatedAt:   u.CreatedAt.In(loc),
+			UpdatedAt:   u.UpdatedAt.In(loc),
+			LastLoginAt: inLoc(u.LastLoginAt),
+		},
+		Profile: dataexport.ArchiveProfile{
+			DisplayName:       u.Profile.DisplayName,
+			FirstName:         u.Profile.FirstName,
+			LastName:          u.Profile.LastName,
+			Bio:               u.Profile.Bio,
+			AvatarURL:         u.Profile.AvatarURL,
+			PhoneNumber:       u.Profile.PhoneNumber,
+			Address:           u.Profile.Address,
+			StructuredAddress: archiveAddress(u.Profile.StructuredAddress),
+			Preferences:       u.Profile.Preferences,
+			Locale:            u.Profile.Locale(),
+			Timezone:          u.Profile.Timezone(),
+		},
+		Roles: utils.ArrayMap(u.Roles, func(r role.Role) string {
+			return string(r.Name)
+		}),
+		IdentityVerifications: utils.ArrayMap(verifications, func(iv user.IdentityVerification) dataexport.ArchiveIdentityVerification {
+			return dataexport.ArchiveIdentityVerification{
+				ID:              iv.ID.String(),
+				DocumentType:    string(iv.DocumentType),
+				DocumentNumber:  dataexport.RedactDocumentNumber(iv.DocumentNumber),
+				DocumentURLs:    iv.DocumentURLs,
+				Status:          string(iv.Status),
+				SubmittedAt:     iv.SubmittedAt.In(loc),
+				VerifiedAt:      inLoc(iv.VerifiedAt),
+				RejectionReason: iv.RejectionReason,
+			}
+		}),
+		TaskerProfile: archiveTaskerProfile(profile, loc),
+		Availability:  archiveAvailability(schedule, loc),
+		Acquisition:   archiveAcquisition(acquisition, loc),
+		// Signing in with LINE keeps no sessions on the server; the section
+		// is always present so the schema stays stable.
+		Sessions:     []dataexport.ArchiveSession{},
+		AuditHistory: auditHistory(u, verifications, loc),
+	}, nil
+}
+
+// auditHistory rebuilds what happened to the account, oldest first, from
+// the timestamps the service keeps.
+func auditHistory(u *user.User, verifications []user.IdentityVerification, loc *time.Location) []dataexport.ArchiveAuditEntry {
+	entries := []dataexport.ArchiveAuditEntry{{
+		Action:     dataexport.AuditActionAccountCreated,
+		OccurredAt: u.CreatedAt.In(loc),
+	}}
+	if u.LastLoginAt != nil {
+		entries = append(entries, dataexport.ArchiveAuditEntry{
+			Action:     dataexport.AuditActionSignedIn,
+			OccurredAt: u.LastLoginAt.In(loc),
+		})
+	}
+	for _, iv := range verifications {
+		details := map[string]any{
+			"verificationId": iv.ID.String(),
+			"documentType":   string(iv.DocumentType),
+			"documentNumber": dataexport.RedactDocumentNumber(iv.DocumentNumber),
+		}
+		entries = append(entries, dataexport.ArchiveAuditEntry{
+			Action:     dataexport.AuditActionVerificationSubmitted,
+			OccurredAt: iv.SubmittedAt.In(loc),
+			Details:    details,
+		})
+		if iv.VerifiedAt == nil {
+			continue
+		}
+		// Only the decision is exported; the reviewing admin's ID is not
+		// the user's data.
+		reviewed := dataexport.ArchiveAuditEntry{
+			Action:     dataexport.AuditActionVerificationApproved,
+			OccurredAt: iv.VerifiedAt.In(loc),
+			Details:    map[string]any{"verificationId": iv.ID.String()},
+		}
+		if iv.Status == user.VerificationStatusRejected {
+			reviewed.Action = dataexport.AuditActionVerificationRejected
+			if iv.RejectionReason != "" {
+				reviewed.Details["rejectionReason"] = iv.RejectionReason
+			}
+		}
+		entries = append(entries, reviewed)
+	}
+	slices.SortStableFunc(entries, func(a, b dataexport.ArchiveAuditEntry) int {
+		return a.OccurredAt.Compare(b.OccurredAt)
+	})
+	return entries
+}
+
+func (h *ExportUserDataCommandHandler) fail(ctx context.Context, job *dataexport.DataExport, reason string) {
+	if err := job.Fail(reason); err != nil {
+		h.logger.Error("Data export job cannot be failed", "export_id", job.ID, slog.Any("error", err))
+		return
+	}
+	if err := h.exportRepo.Save(ctx, job); err != nil {
+		h.logger.Error("Failed to mark data export failed", "export_id", job.ID, slog.Any("error", err))
+	}
+}
+
+func archiveAddress(a *geo.Address) *dataexport.ArchiveAddress {
+	if a == nil {
+		return nil
+	}
+	out := &dataexport.ArchiveAddress{
+		SubDistrict: a.SubDistrict,
+		District:    a.District,
+		Province:    a.Province,
+		PostalCode:  a.PostalCode,
+		CountryCode: a.CountryCode,
+	}
+	if a.Location != nil {
+		out.Latitude = &a.Location.Latitude
+		out.Longitude = &a.Location.Longitude
+	}
+	return out
+}
+
+func archiveTaskerProfile(p *tasker.TaskerProfile, loc *time.Location) *dataexport.ArchiveTaskerProfile {
+	if p == nil {
+		return nil
+	}
+	return &dataexport.ArchiveTaskerProfile{
+		Headline:   p.Headline,
+		SkillCodes: p.SkillCodes,
+		HourlyRate: dataexport.ArchiveHourlyRate{
+			MinAmount: p.HourlyRate.MinAmount,
+			MaxAmount: p.HourlyRate.MaxAmount,
+			Currency:  p.HourlyRate.Currency,
+		},
+		ServiceAreas: utils.ArrayMap(p.ServiceAreas, func(a tasker.ServiceArea) dataexport.ArchiveServiceArea {
+			out := dataexport.ArchiveServiceArea{
+				Province:   a.Province,
+				District:   a.District,
+				PostalCode: a.PostalCode,
+			}
+			if a.Location != nil {
+				out.Latitude = &a.Location.Latitude
+				out.Longitude = &a.Location.Longitude
+			}
+			return out
+		}),
+		Portfolio: utils.ArrayMap(p.Portfolio, func(item tasker.PortfolioItem) dataexport.ArchivePortfolioItem {
+			out := dataexport.ArchivePortfolioItem{
+				ID:          item.ID.String(),
+				Title:       item.Title,
+				Description: item.Description,
+				ImageURLs:   item.ImageURLs,
+			}
+			if item.CompletedAt != nil {
+				completedAt := item.CompletedAt.In(loc)
+				out.CompletedAt = &completedAt
+			}
+			return out
+		}),
+		YearsOfExperience: p.YearsOfExperience,
+		IsVisible:         p.IsVisible,
+		CreatedAt:         p.CreatedAt.In(loc),
+		UpdatedAt:         p.UpdatedAt.In(loc),
+	}
+}
+
+func archiveAvailability(s *availability.Schedule, loc *time.Location) *dataexport.ArchiveAvailability {
+	if s == nil {
+		return nil
+	}
+	return &dataexport.ArchiveAvailability{
+		Timezone: s.Timezone,
+		Slots: utils.ArrayMap(s.Slots, func(slot availability.RecurringSlot) dataexport.ArchiveAvailabilitySlot {
+			return dataexport.ArchiveAvailabilitySlot{
+				RRule:           slot.Rule.String(),
+				StartDate:       availability.FormatDate(slot.StartDate),
+				StartTime:       slot.StartTime(),
+				DurationMinutes: slot.DurationMinutes,
+			}
+		}),
+		Exceptions: utils.ArrayMap(s.Exceptions, func(e availability.Exception) dataexport.ArchiveAvailabilityException {
+			return dataexport.ArchiveAvailabilityException{
+				Kind:     string(e.Kind),
+				StartsAt: e.Period.Start.In(loc),
+				EndsAt:   e.Period.End.In(loc),
+				Reason:   e.Reason,
+			}
+		}),
+		UpdatedAt: s.UpdatedAt.In(loc),
+	}
+}
+
+func archiveAcquisition(a *user.Acquisition, loc *time.Location) *dataexport.ArchiveAcquisition {
+	if a == nil {
+		return nil
+	}
+	return &dataexport.ArchiveAcquisition{
+		ReferralCode: a.ReferralCode,
+		Channel:      a.Channel,
+		Campaign:     a.Campaign,
+		Metadata:     a.Metadata,
+		CreatedAt:    a.CreatedAt.In(loc),
+	}
+}
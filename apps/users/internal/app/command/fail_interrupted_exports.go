@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/dataexport"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// interruptedExportGrace is how long past its job timeout an export is left
+// alone, so a job saving its result right at the deadline is not failed
+// under it.
+const interruptedExportGrace = time.Minute
+
+// interruptedExportBatchSize bounds the jobs failed per command.
+const interruptedExportBatchSize = 100
+
+// FailInterruptedExportsCommand fails the export jobs that are still
+// pending or running past their job timeout. Jobs run in the process that
+// scheduled them, so a restart leaves them that way for good; their users
+// can then ask for a new export. The export recovery sends it at startup and
+// on every tick.
+type FailInterruptedExportsCommand struct{}
+
+type FailInterruptedExportsDto struct {
+	Failed int `json:"failed"`
+}
+
+type FailInterruptedExportsCommandHandler struct {
+	exportRepo dataexport.DataExportRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewFailInterruptedExportsCommandHandler(
+	exportRepo dataexport.DataExportRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *FailInterruptedExportsCommandHandler {
+	return &FailInterruptedExportsCommandHandler{
+		exportRepo: exportRepo,
+		logger:     logger.With(slog.String("component", "FailInterruptedExportsCommandHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *FailInterruptedExportsCommandHandler) Handle(ctx context.Context, cmd FailInterruptedExportsCommand) (*FailInterruptedExportsDto, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := h.tracer.Start(ctx, "FailInterruptedExportsCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	// Jobs are cancelled after the job timeout, so none requested earlier
+	// can still be running anywhere.
+	cutoff := time.Now().Add(-h.config.Export.JobTimeout - interruptedExportGrace)
+	jobs, err := h.exportRepo.ListUnfinished(ctx, cutoff, interruptedExportBatchSize)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list unfinished data exports")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to list unfinished data exports", slog.Any("error", err))
+		return nil, err
+	}
+
+	dto := &FailInterruptedExportsDto{}
+	for _, job := range jobs {
+		if err := job.Fail("the export was interrupted before it finished; request a new one"); err != nil {
+			continue
+		}
+		if err := h.exportRepo.Save(ctx, job); err != nil {
+			// Looked at again on the next tick.
+			span.RecordError(err)
+			logger.Error("Failed to mark interrupted data export failed", slog.String("export_id", string(job.ID)), slog.Any("error", err))
+			continue
+		}
+		dto.Failed++
+		logger.Warn("Data export was interrupted and marked failed.",
+			slog.String("export_id", string(job.ID)),
+			slog.String("user_id", string(job.UserID)),
+			slog.Time("requested_at", job.RequestedAt),
+		)
+	}
+
+	span.SetStatus(codes.Ok, "Interrupted data exports failed")
+	span.SetAttributes(
+		attribute.Int("exports.checked", len(jobs)),
+		attribute.Int("exports.failed", dto.Failed),
+	)
+	return dto, nil
+}
@@ -0,0 +1,152 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NotifyUserCreatedHandler welcomes users once they registered. It
+// subscribes to user.UserCreated.
+type NotifyUserCreatedHandler struct {
+	userRepo user.UserRepository
+	notifier user.Notifier
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewNotifyUserCreatedHandler(
+	userRepo user.UserRepository,
+	notifier user.Notifier,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *NotifyUserCreatedHandler {
+	return &NotifyUserCreatedHandler{
+		userRepo: userRepo,
+		notifier: notifier,
+		logger:   logger.With(slog.String("component", "NotifyUserCreatedHandler")),
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.event-handler", cfg.Name)),
+	}
+}
+
+func (h *NotifyUserCreatedHandler) Handle(ctx context.Context, evt user.UserCreated) error {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(evt.UserID)))
+
+	ctx, span := h.tracer.Start(ctx, "NotifyUserCreatedHandler.Handle", trace.WithSpanKind(trace.SpanKindConsumer))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", string(evt.UserID)))
+
+	u, err := h.userRepo.FindByID(ctx, evt.UserID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to load user")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to load registered user", slog.Any("error", err))
+		return err
+	}
+
+	notice := user.Notice{
+		ID:   user.NoticeUserCreated + ":" + string(evt.UserID),
+		Type: user.NoticeUserCreated,
+		Data: map[string]any{"displayName": u.Profile.DisplayName},
+	}
+	if err := h.notifier.Notify(ctx, u, notice); err != nil {
+		span.SetStatus(codes.Error, "Failed to notify user")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "notify_error"))
+		logger.Error("Failed to welcome registered user", slog.Any("error", err))
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "User notified")
+	return nil
+}
+
+// NotifyVerificationDecidedHandler tells users whether their identity
+// verification was approved or rejected, and why. It subscribes to
+// user.IdentityVerificationDecided.
+type NotifyVerificationDecidedHandler struct {
+	userRepo user.UserRepository
+	notifier user.Notifier
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewNotifyVerificationDecidedHandler(
+	userRepo user.UserRepository,
+	notifier user.Notifier,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *NotifyVerificationDecidedHandler {
+	return &NotifyVerificationDecidedHandler{
+		userRepo: userRepo,
+		notifier: notifier,
+		logger:   logger.With(slog.String("component", "NotifyVerificationDecidedHandler")),
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.event-handler", cfg.Name)),
+	}
+}
+
+func (h *NotifyVerificationDecidedHandler) Handle(ctx context.Context, evt user.IdentityVerificationDecided) error {
+	logger := observability.LoggerFromCtx(ctx).With(
+		slog.String("user_id", string(evt.UserID)),
+		slog.String("verification_id", evt.VerificationID),
+	)
+
+	ctx, span := h.tracer.Start(ctx, "NotifyVerificationDecidedHandler.Handle", trace.WithSpanKind(trace.SpanKindConsumer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", string(evt.UserID)),
+		attribute.String("identity_verification.id", evt.VerificationID),
+		attribute.String("identity_verification.status", string(evt.Status)),
+	)
+
+	notice := user.Notice{
+		Type: user.NoticeIdentityVerificationApproved,
+		Data: map[string]any{"documentType": string(evt.DocumentType)},
+	}
+	switch evt.Status {
+	case user.VerificationStatusApproved:
+	case user.VerificationStatusRejected:
+		notice.Type = user.NoticeIdentityVerificationRejected
+		notice.Data["reason"] = evt.RejectionReason
+	default:
+		logger.Warn("Verification was not decided; nothing to tell", "status", string(evt.Status))
+		span.SetStatus(codes.Ok, "Nothing to notify")
+		return nil
+	}
+	notice.ID = notice.Type + ":" + evt.VerificationID
+
+	u, err := h.userRepo.FindByID(ctx, evt.UserID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to load user")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to load user of verification", slog.Any("error", err))
+		return err
+	}
+	if err := h.notifier.Notify(ctx, u, notice); err != nil {
+		span.SetStatus(codes.Error, "Failed to notify user")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "notify_error"))
+		logger.Error("Failed to tell user about verification decision", slog.Any("error", err))
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "User notified")
+	return nil
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/dataexport"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// expiredExportBatchSize bounds the archives deleted per command.
+const expiredExportBatchSize = 100
+
+// PurgeExpiredExportsCommand deletes the archives of exports whose retention
+// period is over, so personal data is not kept longer than promised. The
+// export recovery sends it at startup and on every tick.
+type PurgeExpiredExportsCommand struct{}
+
+type PurgeExpiredExportsDto struct {
+	Purged int `json:"purged"`
+}
+
+type PurgeExpiredExportsCommandHandler struct {
+	exportRepo dataexport.DataExportRepository
+	storage    dataexport.ArchiveStorage
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewPurgeExpiredExportsCommandHandler(
+	exportRepo dataexport.DataExportRepository,
+	storage dataexport.ArchiveStorage,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *PurgeExpiredExportsCommandHandler {
+	return &PurgeExpiredExportsCommandHandler{
+		exportRepo: exportRepo,
+		storage:    storage,
+		logger:     logger.With(slog.String("component", "PurgeExpiredExportsCommandHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *PurgeExpiredExportsCommandHandler) Handle(ctx context.Context, cmd PurgeExpiredExportsCommand) (*PurgeExpiredExportsDto, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := h.tracer.Start(ctx, "PurgeExpiredExportsCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	now := time.Now()
+	jobs, err := h.exportRepo.ListExpired(ctx, now, expiredExportBatchSize)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list expired data exports")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to list expired data exports", slog.Any("error", err))
+		return nil, err
+	}
+
+	dto := &PurgeExpiredExportsDto{}
+	for _, job := range jobs {
+		key := *job.StorageKey
+		if err := job.Purge(now); err != nil {
+			continue
+		}
+		// The archive goes first: a job saved without its key is never
+		// looked at again. Deleting twice is harmless.
+		if err := h.storage.Delete(ctx, key); err != nil {
+			span.RecordError(err)
+			logger.Error("Failed to delete expired data export archive", slog.String("export_id", string(job.ID)), slog.Any("error", err))
+			continue
+		}
+		if err := h.exportRepo.Save(ctx, job); err != nil {
+			// Looked at again on the next tick.
+			span.RecordError(err)
+			logger.Error("Failed to mark expired data export purged", slog.String("export_id", string(job.ID)), slog.Any("error", err))
+			continue
+		}
+		dto.Purged++
+		logger.Info("Expired data export archive deleted.",
+			slog.String("export_id", string(job.ID)),
+			slog.String("user_id", string(job.UserID)),
+		)
+	}
+
+	span.SetStatus(codes.Ok, "Expired data exports purged")
+	span.SetAttributes(
+		attribute.Int("exports.checked", len(jobs)),
+		attribute.Int("exports.purged", dto.Purged),
+	)
+	return dto, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"log/slog"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/role"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	"github.com/pratchaya-maneechot/service-exchange/libs/bus/event"
 	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
 
 	"go.opentelemetry.io/otel"
@@ -25,10 +27,17 @@ type RegisterUserCommand struct {
 	Password    *string `validate:"omitempty,min=8,max=128,password_strength"`
 	DisplayName string  `validate:"required,min=1,max=100,printascii"`
 	AvatarURL   *string `validate:"omitempty,url,max=500"`
-	// Additional fields that might be useful
-	Locale   *string                `validate:"omitempty,len=2"`
-	Timezone *string                `validate:"omitempty,timezone"`
-	Metadata map[string]interface{} `validate:"omitempty"`
+	// Locale and Timezone are stored as the language and timezone preferences.
+	Locale   *string `validate:"omitempty,len=2"`
+	Timezone *string `validate:"omitempty,timezone"`
+	// Acquisition attribution, stored once in user_acquisitions.
+	ReferralCode *string                `validate:"omitempty,max=64"`
+	Channel      *string                `validate:"omitempty,max=64"`
+	Campaign     *string                `validate:"omitempty,max=128"`
+	Metadata     map[string]interface{} `validate:"omitempty"`
+	// Viewer is who asked; only the API gateway registers LINE accounts,
+	// from the follow events LINE sends it.
+	Viewer user.Viewer `json:"-"`
 }
 
 type RegisterUserDto struct {
@@ -38,6 +47,7 @@ type RegisterUserDto struct {
 type RegisterUserCommandHandler struct {
 	userRepo     user.UserRepository
 	roleCacheSvc *role.RoleCacheService
+	eventBus     event.EventBus
 	logger       *slog.Logger
 	config       *config.Config
 	tracer       trace.Tracer
@@ -46,12 +56,14 @@ type RegisterUserCommandHandler struct {
 func NewRegisterUserCommandHandler(
 	userRepo user.UserRepository,
 	rcs *role.RoleCacheService,
+	eventBus event.EventBus,
 	logger *slog.Logger,
 	cfg *config.Config,
 ) *RegisterUserCommandHandler {
 	return &RegisterUserCommandHandler{
 		userRepo:     userRepo,
 		roleCacheSvc: rcs,
+		eventBus:     eventBus,
 		logger:       logger.With(slog.String("component", "RegisterUserCommandHandler")),
 		config:       cfg,
 		tracer:       otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
@@ -66,6 +78,13 @@ func (h *RegisterUserCommandHandler) Handle(ctx context.Context, cmd RegisterUse
 
 	span.SetAttributes(attribute.String("user.line_user_id", cmd.LineUserID))
 
+	if !cmd.Viewer.IsGateway() {
+		span.SetStatus(codes.Error, "Gateway role required")
+		span.SetAttributes(attribute.String("error.type", string(user.ErrRegistrationGatewayRequired.Code)))
+		logger.Warn(user.ErrRegistrationGatewayRequired.Message, "viewer_id", string(cmd.Viewer.UserID))
+		return nil, user.ErrRegistrationGatewayRequired
+	}
+
 	existing, err := h.userRepo.ExistsByLineUserID(ctx, cmd.LineUserID)
 	if err != nil {
 		span.SetStatus(codes.Error, "Failed to check existing user")
@@ -91,6 +110,24 @@ func (h *RegisterUserCommandHandler) Handle(ctx context.Context, cmd RegisterUse
 	}
 	span.SetAttributes(attribute.String("user.id", string(domUser.ID)))
 
+	domUser.Profile.WithDisplayName(cmd.DisplayName).WithAvatarURL(cmd.AvatarURL)
+
+	initialPreferences := make(map[string]any)
+	if cmd.Locale != nil {
+		initialPreferences[user.PreferenceLanguage] = strings.ToLower(*cmd.Locale)
+	}
+	if cmd.Timezone != nil {
+		initialPreferences[user.PreferenceTimezone] = *cmd.Timezone
+	}
+	if err = domUser.UpdatePreferences(initialPreferences); err != nil {
+		span.SetStatus(codes.Error, "Invalid locale or timezone")
+		span.SetAttributes(attribute.String("error.type", "invalid_preferences"))
+		logger.Warn("Rejected registration locale or timezone", slog.Any("error", err))
+		return nil, err
+	}
+
+	domUser.RecordAcquisition(user.NewAcquisition(domUser.ID, cmd.ReferralCode, cmd.Channel, cmd.Campaign, cmd.Metadata))
+
 	defaultRole, err := h.roleCacheSvc.GetRoleByName(role.RoleNamePoster)
 	if err != nil {
 		span.SetStatus(codes.Error, "Failed to get default role")
@@ -121,9 +158,18 @@ func (h *RegisterUserCommandHandler) Handle(ctx context.Context, cmd RegisterUse
 		return nil, err
 	}
 
-	span.SetStatus(codes.Ok, "User registered")
 	logger.Info("User registered successfully.", "user_id", domUser.ID)
 
+	// The user is registered at this point; a subscriber failing does not
+	// undo it, so it is reported rather than returned.
+	if err := h.eventBus.Publish(ctx, user.NewUserCreated(domUser)); err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "event_publish_error"))
+		logger.Error("Failed to handle UserCreated", slog.Any("error", err))
+	}
+
+	span.SetStatus(codes.Ok, "User registered")
+
 	return &RegisterUserDto{
 		UserID: string(domUser.ID),
 	}, nil
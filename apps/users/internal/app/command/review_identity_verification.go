@@ -0,0 +1,138 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	"github.com/pratchaya-maneechot/service-exchange/libs/bus/event"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ReviewIdentityVerificationCommand approves or rejects a pending identity
+// verification of a user.
+type ReviewIdentityVerificationCommand struct {
+	UserID          ids.UserID `json:"userId" validate:"required,uuid"`
+	VerificationID  string     `json:"verificationId" validate:"required,uuid"`
+	Approve         bool       `json:"approve"`
+	RejectionReason string     `json:"rejectionReason,omitempty" validate:"max=1000"`
+	// Viewer is who asked; only admins decide verifications.
+	Viewer user.Viewer `json:"-"`
+}
+
+type ReviewIdentityVerificationDto struct {
+	VerificationID string `json:"verificationId"`
+	Status         string `json:"status"`
+}
+
+type ReviewIdentityVerificationCommandHandler struct {
+	userRepo user.UserRepository
+	eventBus event.EventBus
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewReviewIdentityVerificationCommandHandler(
+	userRepo user.UserRepository,
+	eventBus event.EventBus,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ReviewIdentityVerificationCommandHandler {
+	return &ReviewIdentityVerificationCommandHandler{
+		userRepo: userRepo,
+		eventBus: eventBus,
+		logger:   logger.With(slog.String("component", "ReviewIdentityVerificationCommandHandler")),
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *ReviewIdentityVerificationCommandHandler) Handle(ctx context.Context, cmd ReviewIdentityVerificationCommand) (*ReviewIdentityVerificationDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(
+		slog.String("user_id", string(cmd.UserID)),
+		slog.String("verification_id", cmd.VerificationID),
+	)
+
+	ctx, span := h.tracer.Start(ctx, "ReviewIdentityVerificationCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", string(cmd.UserID)),
+		attribute.String("identity_verification.id", cmd.VerificationID),
+		attribute.Bool("identity_verification.approve", cmd.Approve),
+	)
+
+	if !cmd.Viewer.IsAdmin() {
+		span.SetStatus(codes.Error, "Admin role required")
+		span.SetAttributes(attribute.String("error.type", string(user.ErrAdminRequired.Code)))
+		logger.Warn(user.ErrAdminRequired.Message, "viewer_id", string(cmd.Viewer.UserID))
+		return nil, user.ErrAdminRequired
+	}
+
+	verifications, err := h.userRepo.FindIdentityVerifications(ctx, cmd.UserID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to load identity verifications")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to load identity verifications", slog.Any("error", err))
+		return nil, err
+	}
+	var iv *user.IdentityVerification
+	for i := range verifications {
+		if verifications[i].ID.String() == cmd.VerificationID {
+			iv = &verifications[i]
+			break
+		}
+	}
+	if iv == nil {
+		span.SetStatus(codes.Error, "Identity verification not found")
+		span.SetAttributes(attribute.String("error.type", string(user.ErrIdentityVerificationNotFound.Code)))
+		logger.Warn(user.ErrIdentityVerificationNotFound.Message)
+		return nil, user.ErrIdentityVerificationNotFound
+	}
+
+	if cmd.Approve {
+		err = iv.Approve(cmd.Viewer.UserID)
+	} else {
+		err = iv.Reject(cmd.Viewer.UserID, cmd.RejectionReason)
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid verification decision")
+		span.SetAttributes(attribute.String("error.type", "invalid_verification_decision"))
+		logger.Warn("Rejected verification decision", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := h.userRepo.SaveVerificationDecision(ctx, iv); err != nil {
+		span.SetStatus(codes.Error, "Failed to save verification decision")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Error("Failed to save verification decision", slog.Any("error", err))
+		return nil, err
+	}
+
+	logger.Info("Identity verification decided.", "status", string(iv.Status), "reviewer_id", string(cmd.Viewer.UserID))
+
+	// The decision is saved at this point; a subscriber failing does not
+	// undo it, so it is reported rather than returned.
+	if err := h.eventBus.Publish(ctx, user.NewIdentityVerificationDecided(iv)); err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "event_publish_error"))
+		logger.Error("Failed to handle IdentityVerificationDecided", slog.Any("error", err))
+	}
+
+	span.SetStatus(codes.Ok, "Identity verification decided")
+	return &ReviewIdentityVerificationDto{
+		VerificationID: cmd.VerificationID,
+		Status:         string(iv.Status),
+	}, nil
+}
@@ -0,0 +1,235 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/availability"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/tasker"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type AvailabilitySlotInput struct {
+	// RRule is an RFC 5545 recurrence such as "FREQ=WEEKLY;BYDAY=MO,TU,WE".
+	RRule string `json:"rrule" validate:"required"`
+	// StartDate is the local date of the first occurrence, YYYY-MM-DD.
+	StartDate string `json:"startDate" validate:"required"`
+	// StartTime is the local wall-clock start, HH:MM.
+	StartTime       string `json:"startTime" validate:"required"`
+	DurationMinutes int    `json:"durationMinutes" validate:"gte=15,lte=1440"`
+}
+
+type AvailabilityExceptionInput struct {
+	Kind     availability.ExceptionKind `json:"kind" validate:"required"`
+	StartsAt time.Time                  `json:"startsAt" validate:"required"`
+	EndsAt   time.Time                  `json:"endsAt" validate:"required"`
+	Reason   *string                    `json:"reason,omitempty"`
+}
+
+// SetTaskerAvailabilityCommand replaces a tasker's availability schedule.
+// Timezone defaults to the user's timezone preference.
+type SetTaskerAvailabilityCommand struct {
+	UserID     ids.UserID                   `json:"userId" validate:"required"`
+	Timezone   *string                      `json:"timezone,omitempty"`
+	Slots      []AvailabilitySlotInput      `json:"slots" validate:"dive"`
+	Exceptions []AvailabilityExceptionInput `json:"exceptions,omitempty" validate:"dive"`
+	// BlackoutDates block whole local days, YYYY-MM-DD.
+	BlackoutDates []string    `json:"blackoutDates,omitempty"`
+	Viewer        user.Viewer `json:"-"`
+}
+
+type SetTaskerAvailabilityDto struct {
+	UserID string `json:"userId"`
+}
+
+type SetTaskerAvailabilityCommandHandler struct {
+	userRepo     user.UserRepository
+	taskerRepo   tasker.TaskerProfileRepository
+	scheduleRepo availability.ScheduleRepository
+	bookings     availability.BookingCalendar
+	logger       *slog.Logger
+	config       *config.Config
+	tracer       trace.Tracer
+}
+
+func NewSetTaskerAvailabilityCommandHandler(
+	userRepo user.UserRepository,
+	taskerRepo tasker.TaskerProfileRepository,
+	scheduleRepo availability.ScheduleRepository,
+	bookings availability.BookingCalendar,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *SetTaskerAvailabilityCommandHandler {
+	return &SetTaskerAvailabilityCommandHandler{
+		userRepo:     userRepo,
+		taskerRepo:   taskerRepo,
+		scheduleRepo: scheduleRepo,
+		bookings:     bookings,
+		logger:       logger.With(slog.String("component", "SetTaskerAvailabilityCommandHandler")),
+		config:       cfg,
+		tracer:       otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *SetTaskerAvailabilityCommandHandler) Handle(ctx context.Context, cmd SetTaskerAvailabilityCommand) (*SetTaskerAvailabilityDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(cmd.UserID)))
+
+	ctx, span := h.tracer.Start(ctx, "SetTaskerAvailabilityCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", string(cmd.UserID)),
+		attribute.Int("availability.slot_count", len(cmd.Slots)),
+		attribute.Int("availability.exception_count", len(cmd.Exceptions)+len(cmd.BlackoutDates)),
+	)
+
+	u, err := ensureTaskerEligible(ctx, h.userRepo, cmd.Viewer, cmd.UserID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Availability not allowed")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "tasker_eligibility"))
+		logger.Warn("Rejected availability update", "viewer_id", string(cmd.Viewer.UserID), slog.Any("error", err))
+		return nil, err
+	}
+	if _, err := h.taskerRepo.FindByUserID(ctx, u.ID); err != nil {
+		span.SetStatus(codes.Error, "Tasker profile required")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "tasker_profile_lookup"))
+		logger.Warn("Availability requires a tasker profile", slog.Any("error", err))
+		return nil, err
+	}
+
+	timezone := u.Profile.Timezone()
+	if cmd.Timezone != nil {
+		timezone = *cmd.Timezone
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid timezone")
+		span.SetAttributes(attribute.String("error.type", "invalid_timezone"))
+		logger.Warn("Rejected availability timezone", "timezone", timezone)
+		return nil, availability.ErrInvalidTimezone
+	}
+
+	slots, exceptions, err := buildAvailability(cmd, loc)
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid availability")
+		span.SetAttributes(attribute.String("error.type", "invalid_availability"))
+		logger.Warn("Rejected invalid availability", slog.Any("error", err))
+		return nil, err
+	}
+
+	schedule, err := h.scheduleRepo.FindByUserID(ctx, u.ID)
+	switch {
+	case errors.Is(err, availability.ErrScheduleNotFound):
+		schedule, err = availability.NewSchedule(u.ID, timezone, slots, exceptions)
+	case err == nil:
+		err = schedule.Replace(timezone, slots, exceptions)
+	default:
+		span.SetStatus(codes.Error, "Failed to retrieve availability schedule")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to retrieve availability schedule", slog.Any("error", err))
+		return nil, err
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid availability")
+		span.SetAttributes(attribute.String("error.type", "invalid_availability"))
+		logger.Warn("Rejected invalid availability", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := h.checkBlackoutConflicts(ctx, schedule); err != nil {
+		span.SetStatus(codes.Error, "Blackout conflicts with bookings")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "blackout_conflict"))
+		logger.Warn("Rejected availability with conflicting blackout", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := h.scheduleRepo.Save(ctx, schedule); err != nil {
+		span.SetStatus(codes.Error, "Failed to save availability schedule")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Error("Failed to save availability schedule to repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Availability schedule saved")
+	logger.Info("Availability schedule saved successfully.", "timezone", schedule.Timezone)
+
+	return &SetTaskerAvailabilityDto{UserID: string(schedule.UserID)}, nil
+}
+
+// checkBlackoutConflicts refuses blackouts that would cancel booked tasks;
+// those have to be rescheduled or cancelled first.
+func (h *SetTaskerAvailabilityCommandHandler) checkBlackoutConflicts(ctx context.Context, s *availability.Schedule) error {
+	var extent *availability.Interval
+	for _, e := range s.Exceptions {
+		if e.Kind != availability.ExceptionKindBlackout {
+			continue
+		}
+		if extent == nil {
+			p := e.Period
+			extent = &p
+			continue
+		}
+		if e.Period.Start.Before(extent.Start) {
+			extent.Start = e.Period.Start
+		}
+		if e.Period.End.After(extent.End) {
+			extent.End = e.Period.End
+		}
+	}
+	if extent == nil {
+		return nil
+	}
+
+	booked, err := h.bookings.BookedIntervals(ctx, []ids.UserID{s.UserID}, *extent)
+	if err != nil {
+		return err
+	}
+	if len(s.BlackoutConflicts(booked[s.UserID])) > 0 {
+		return availability.ErrBlackoutConflict
+	}
+	return nil
+}
+
+func buildAvailability(cmd SetTaskerAvailabilityCommand, loc *time.Location) ([]availability.RecurringSlot, []availability.Exception, error) {
+	slots := make([]availability.RecurringSlot, 0, len(cmd.Slots))
+	for _, in := range cmd.Slots {
+		slot, err := availability.NewRecurringSlot(in.RRule, in.StartDate, in.StartTime, in.DurationMinutes)
+		if err != nil {
+			return nil, nil, err
+		}
+		slots = append(slots, slot)
+	}
+
+	exceptions := make([]availability.Exception, 0, len(cmd.Exceptions)+len(cmd.BlackoutDates))
+	for _, in := range cmd.Exceptions {
+		e, err := availability.NewException(in.Kind, in.StartsAt, in.EndsAt, in.Reason)
+		if err != nil {
+			return nil, nil, err
+		}
+		exceptions = append(exceptions, e)
+	}
+	for _, date := range cmd.BlackoutDates {
+		e, err := availability.NewBlackoutDate(date, loc, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		exceptions = append(exceptions, e)
+	}
+	return slots, exceptions, nil
+}
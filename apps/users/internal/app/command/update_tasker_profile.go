@@ -0,0 +1,128 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/geo"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/tasker"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// UpdateTaskerProfileCommand replaces the editable state of an existing tasker
+// profile.
+type UpdateTaskerProfileCommand struct {
+	UserID  ids.UserID         `json:"userId" validate:"required"`
+	Profile TaskerProfileInput `json:"profile"`
+	Viewer  user.Viewer        `json:"-"`
+}
+
+type UpdateTaskerProfileCommandHandler struct {
+	userRepo   user.UserRepository
+	taskerRepo tasker.TaskerProfileRepository
+	catalogue  tasker.SkillCatalogueRepository
+	geocoder   geo.Geocoder
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewUpdateTaskerProfileCommandHandler(
+	userRepo user.UserRepository,
+	taskerRepo tasker.TaskerProfileRepository,
+	catalogue tasker.SkillCatalogueRepository,
+	geocoder geo.Geocoder,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *UpdateTaskerProfileCommandHandler {
+	return &UpdateTaskerProfileCommandHandler{
+		userRepo:   userRepo,
+		taskerRepo: taskerRepo,
+		catalogue:  catalogue,
+		geocoder:   geocoder,
+		logger:     logger.With(slog.String("component", "UpdateTaskerProfileCommandHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *UpdateTaskerProfileCommandHandler) Handle(ctx context.Context, cmd UpdateTaskerProfileCommand) (*TaskerProfileDto, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(cmd.UserID)))
+
+	ctx, span := h.tracer.Start(ctx, "UpdateTaskerProfileCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", string(cmd.UserID)),
+		attribute.Int("tasker.skill_count", len(cmd.Profile.SkillCodes)),
+	)
+
+	if _, err := ensureTaskerEligible(ctx, h.userRepo, cmd.Viewer, cmd.UserID); err != nil {
+		span.SetStatus(codes.Error, "Tasker profile not allowed")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "tasker_eligibility"))
+		logger.Warn("Rejected tasker profile update", "viewer_id", string(cmd.Viewer.UserID), slog.Any("error", err))
+		return nil, err
+	}
+
+	profile, err := h.taskerRepo.FindByUserID(ctx, cmd.UserID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve tasker profile")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Warn("Failed to retrieve tasker profile for update", slog.Any("error", err))
+		return nil, err
+	}
+	previousSkills := profile.SkillCodes
+
+	details, err := cmd.Profile.toDetails(ctx, h.geocoder)
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid service area")
+		span.SetAttributes(attribute.String("error.type", "invalid_service_area"))
+		logger.Warn("Rejected tasker service areas", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := profile.Update(details); err != nil {
+		span.SetStatus(codes.Error, "Invalid tasker profile")
+		span.SetAttributes(attribute.String("error.type", "invalid_tasker_profile"))
+		logger.Warn("Rejected invalid tasker profile", slog.Any("error", err))
+		return nil, err
+	}
+
+	// Skills already on the profile may be kept after an admin deactivates
+	// their category; only newly added ones must be active.
+	added := slices.DeleteFunc(slices.Clone(profile.SkillCodes), func(code string) bool {
+		return slices.Contains(previousSkills, code)
+	})
+	if err := ensureActiveSkills(ctx, h.catalogue, added); err != nil {
+		span.SetStatus(codes.Error, "Unknown skill category")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "unknown_skill_category"))
+		logger.Warn("Rejected tasker profile skills", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := h.taskerRepo.Save(ctx, profile); err != nil {
+		span.SetStatus(codes.Error, "Failed to save tasker profile")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Error("Failed to save tasker profile to repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Tasker profile updated")
+	logger.Info("Tasker profile updated successfully.", "skills", profile.SkillCodes)
+
+	return &TaskerProfileDto{UserID: string(profile.UserID)}, nil
+}
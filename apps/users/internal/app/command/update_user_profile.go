@@ -6,8 +6,10 @@ import (
 	"log/slog"
 
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/geo"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	"github.com/pratchaya-maneechot/service-exchange/libs/bus/event"
 	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
 
 	"go.opentelemetry.io/otel"
@@ -17,15 +19,60 @@ import (
 )
 
 type UpdateUserProfileCommand struct {
-	UserID      ids.UserID     `json:"-"`
-	DisplayName *string        `json:"displayName,omitempty"`
-	FirstName   *string        `json:"firstName,omitempty"`
-	LastName    *string        `json:"lastName,omitempty"`
-	Bio         *string        `json:"bio,omitempty"`
-	AvatarURL   *string        `json:"avatarUrl,omitempty"`
-	PhoneNumber *string        `json:"phoneNumber,omitempty" validate:"omitempty,e164"`
-	Address     *string        `json:"address,omitempty"`
+	UserID      ids.UserID `json:"-"`
+	DisplayName *string    `json:"displayName,omitempty" validate:"omitempty,min=1,max=100"`
+	FirstName   *string    `json:"firstName,omitempty" validate:"omitempty,max=255"`
+	LastName    *string    `json:"lastName,omitempty" validate:"omitempty,max=255"`
+	Bio         *string    `json:"bio,omitempty"`
+	AvatarURL   *string    `json:"avatarUrl,omitempty" validate:"omitempty,url,max=500"`
+	PhoneNumber *string    `json:"phoneNumber,omitempty" validate:"omitempty,e164"`
+	Address     *string    `json:"address,omitempty"`
+	// StructuredAddress replaces the structured address when set and is left
+	// unchanged when nil. Addresses without coordinates are geocoded.
+	StructuredAddress *AddressInput `json:"structuredAddress,omitempty"`
+	// Preferences is a merge patch: listed keys are set, null values reset a key to its default.
 	Preferences map[string]any `json:"preferences,omitempty"`
+	// Viewer is who asked; only the user or an admin may update the profile.
+	Viewer user.Viewer `json:"-"`
+}
+
+// AddressInput is a structured address; Latitude and Longitude are optional
+// but must be given together.
+type AddressInput struct {
+	SubDistrict *string  `json:"subDistrict,omitempty"`
+	District    *string  `json:"district,omitempty"`
+	Province    string   `json:"province" validate:"required"`
+	PostalCode  *string  `json:"postalCode,omitempty"`
+	CountryCode string   `json:"countryCode,omitempty" validate:"omitempty,len=2"`
+	Latitude    *float64 `json:"latitude,omitempty" validate:"required_with=Longitude"`
+	Longitude   *float64 `json:"longitude,omitempty" validate:"required_with=Latitude"`
+}
+
+// toAddress validates the input and geocodes it when no coordinates were given.
+func (in AddressInput) toAddress(ctx context.Context, geocoder geo.Geocoder) (*geo.Address, error) {
+	location, err := toLocation(in.Latitude, in.Longitude)
+	if err != nil {
+		return nil, err
+	}
+	a, err := geo.NewAddress(in.SubDistrict, in.District, in.Province, in.PostalCode, in.CountryCode, location)
+	if err != nil {
+		return nil, err
+	}
+	if err := geo.Locate(ctx, geocoder, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func toLocation(latitude, longitude *float64) (*geo.Point, error) {
+	if latitude == nil || longitude == nil {
+		return nil, nil
+	}
+	p, err := geo.NewPoint(*latitude, *longitude)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
 }
 
 type UpdateUserProfileDto struct {
@@ -34,6 +81,8 @@ type UpdateUserProfileDto struct {
 
 type UpdateUserProfileCommandHandler struct {
 	userRepo user.UserRepository
+	geocoder geo.Geocoder
+	eventBus event.EventBus
 	logger   *slog.Logger
 	config   *config.Config
 	tracer   trace.Tracer
@@ -41,11 +90,15 @@ type UpdateUserProfileCommandHandler struct {
 
 func NewUpdateUserProfileCommandHandler(
 	userRepo user.UserRepository,
+	geocoder geo.Geocoder,
+	eventBus event.EventBus,
 	logger *slog.Logger,
 	cfg *config.Config,
 ) *UpdateUserProfileCommandHandler {
 	return &UpdateUserProfileCommandHandler{
 		userRepo: userRepo,
+		geocoder: geocoder,
+		eventBus: eventBus,
 		logger:   logger.With(slog.String("component", "UpdateUserProfileCommandHandler")),
 		config:   cfg,
 		tracer:   otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
@@ -63,6 +116,13 @@ func (h *UpdateUserProfileCommandHandler) Handle(ctx context.Context, cmd Update
 		span.SetAttributes(attribute.String("profile.display_name", *cmd.DisplayName))
 	}
 
+	if !cmd.Viewer.CanViewPrivateProfile(cmd.UserID) {
+		span.SetStatus(codes.Error, "Profile update denied")
+		span.SetAttributes(attribute.String("error.type", string(user.ErrProfileUpdateDenied.Code)))
+		logger.Warn(user.ErrProfileUpdateDenied.Message, "viewer_id", string(cmd.Viewer.UserID))
+		return nil, user.ErrProfileUpdateDenied
+	}
+
 	domUser, err := h.userRepo.FindByID(ctx, cmd.UserID)
 	if err != nil {
 		span.SetStatus(codes.Error, "Failed to retrieve user")
@@ -86,8 +146,25 @@ func (h *UpdateUserProfileCommandHandler) Handle(ctx context.Context, cmd Update
 		cmd.AvatarURL,
 		cmd.PhoneNumber,
 		cmd.Address,
-		cmd.Preferences,
 	)
+	if cmd.StructuredAddress != nil {
+		address, err := cmd.StructuredAddress.toAddress(ctx, h.geocoder)
+		if err != nil {
+			span.SetStatus(codes.Error, "Invalid address")
+			span.SetAttributes(attribute.String("error.type", "invalid_address"))
+			logger.Warn("Rejected structured address", "user_id", domUser.ID, slog.Any("error", err))
+			return nil, err
+		}
+		domUser.SetStructuredAddress(address)
+	}
+	if cmd.Preferences != nil {
+		if err := domUser.UpdatePreferences(cmd.Preferences); err != nil {
+			span.SetStatus(codes.Error, "Invalid preferences")
+			span.SetAttributes(attribute.String("error.type", "invalid_preferences"))
+			logger.Warn("Rejected preferences update", "user_id", domUser.ID, slog.Any("error", err))
+			return nil, err
+		}
+	}
 
 	if err = h.userRepo.Save(ctx, domUser); err != nil {
 		span.SetStatus(codes.Error, "Failed to save user profile")
@@ -97,9 +174,18 @@ func (h *UpdateUserProfileCommandHandler) Handle(ctx context.Context, cmd Update
 		return nil, err
 	}
 
-	span.SetStatus(codes.Ok, "User profile updated")
 	logger.Info("User profile updated successfully.", "user_id", domUser.ID)
 
+	// The update is committed at this point; a subscriber failing does not
+	// undo it, so it is reported rather than returned.
+	if err := h.eventBus.Publish(ctx, user.NewUserChanged(domUser)); err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "event_publish_error"))
+		logger.Error("Failed to handle UserChanged", slog.Any("error", err))
+	}
+
+	span.SetStatus(codes.Ok, "User profile updated")
+
 	return &UpdateUserProfileDto{
 		UserID: string(domUser.ID),
 	}, nil
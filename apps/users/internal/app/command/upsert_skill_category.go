@@ -0,0 +1,108 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/tasker"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// UpsertSkillCategoryCommand creates a catalogue entry or updates the one with
+// the same code. Admin only.
+type UpsertSkillCategoryCommand struct {
+	Code        string      `json:"code" validate:"required"`
+	Name        string      `json:"name" validate:"required"`
+	Description *string     `json:"description,omitempty"`
+	IsActive    bool        `json:"isActive"`
+	Viewer      user.Viewer `json:"-"`
+}
+
+type UpsertSkillCategoryDto struct {
+	Code string `json:"code"`
+}
+
+type UpsertSkillCategoryCommandHandler struct {
+	catalogue tasker.SkillCatalogueRepository
+	logger    *slog.Logger
+	config    *config.Config
+	tracer    trace.Tracer
+}
+
+func NewUpsertSkillCategoryCommandHandler(
+	catalogue tasker.SkillCatalogueRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *UpsertSkillCategoryCommandHandler {
+	return &UpsertSkillCategoryCommandHandler{
+		catalogue: catalogue,
+		logger:    logger.With(slog.String("component", "UpsertSkillCategoryCommandHandler")),
+		config:    cfg,
+		tracer:    otel.Tracer(fmt.Sprintf("%s.command-handler", cfg.Name)),
+	}
+}
+
+func (h *UpsertSkillCategoryCommandHandler) Handle(ctx context.Context, cmd UpsertSkillCategoryCommand) (*UpsertSkillCategoryDto, error) {
+	code := strings.ToLower(strings.TrimSpace(cmd.Code))
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("skill_code", code))
+
+	ctx, span := h.tracer.Start(ctx, "UpsertSkillCategoryCommandHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("skill.code", code),
+		attribute.Bool("skill.is_active", cmd.IsActive),
+	)
+
+	if !cmd.Viewer.IsAdmin() {
+		span.SetStatus(codes.Error, "Admin role required")
+		span.SetAttributes(attribute.String("error.type", string(user.ErrAdminRequired.Code)))
+		logger.Warn(user.ErrAdminRequired.Message, "viewer_id", string(cmd.Viewer.UserID))
+		return nil, user.ErrAdminRequired
+	}
+
+	existing, err := h.catalogue.FindByCodes(ctx, []string{code})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve skill category")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to retrieve skill category", slog.Any("error", err))
+		return nil, err
+	}
+
+	var category *tasker.SkillCategory
+	if len(existing) > 0 {
+		category = &existing[0]
+		err = category.Update(cmd.Name, cmd.Description, cmd.IsActive)
+	} else {
+		category, err = tasker.NewSkillCategory(code, cmd.Name, cmd.Description, cmd.IsActive)
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid skill category")
+		span.SetAttributes(attribute.String("error.type", "invalid_skill_category"))
+		logger.Warn("Rejected invalid skill category", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := h.catalogue.Save(ctx, category); err != nil {
+		span.SetStatus(codes.Error, "Failed to save skill category")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_write_error"))
+		logger.Error("Failed to save skill category to repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Skill category saved")
+	logger.Info("Skill category saved successfully.", "created", len(existing) == 0)
+
+	return &UpsertSkillCategoryDto{Code: category.Code}, nil
+}
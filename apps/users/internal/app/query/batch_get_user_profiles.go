@@ -0,0 +1,147 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrNoUserIDs       = errs.NewWithReason(errs.CodeInvalidArgument, "NO_USER_IDS", "at least one user ID is required")
+	ErrTooManyUserIDs  = errs.NewWithReason(errs.CodeInvalidArgument, "TOO_MANY_USER_IDS", "too many user IDs requested in a single batch")
+	ErrEmptyUserIDItem = errs.NewWithReason(errs.CodeInvalidArgument, "EMPTY_USER_ID_ITEM", "user IDs must not be empty")
+)
+
+type BatchGetUserProfilesQuery struct {
+	UserIDs []ids.UserID `json:"userIds"`
+	Viewer  user.Viewer  `json:"-"`
+}
+
+// BatchGetUserProfilesDTO holds the profiles found, in request order with
+// duplicates removed, and the requested IDs that do not exist.
+type BatchGetUserProfilesDTO struct {
+	Profiles        []UserProfileDTO `json:"profiles"`
+	NotFoundUserIDs []string         `json:"notFoundUserIds"`
+}
+
+type BatchGetUserProfilesQueryHandler struct {
+	userRepo user.UserRepository
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewBatchGetUserProfilesQueryHandler(
+	userRepo user.UserRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *BatchGetUserProfilesQueryHandler {
+	handlerLogger := logger.With(slog.String("component", "BatchGetUserProfilesQueryHandler"))
+	return &BatchGetUserProfilesQueryHandler{
+		userRepo: userRepo,
+		logger:   handlerLogger,
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *BatchGetUserProfilesQueryHandler) Handle(ctx context.Context, qry BatchGetUserProfilesQuery) (*BatchGetUserProfilesDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.Int("requested_count", len(qry.UserIDs)))
+
+	ctx, span := h.tracer.Start(ctx, "BatchGetUserProfilesQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("query.user_id_count", len(qry.UserIDs)))
+
+	requested, err := h.normalize(qry.UserIDs)
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid batch request")
+		span.SetAttributes(attribute.String("error.type", "invalid_argument"))
+		logger.Warn("Rejected batch user profile request", slog.Any("error", err))
+		return nil, err
+	}
+
+	// Full profiles follow the GetUserProfile rule: owner or admin only.
+	for _, id := range requested {
+		if !qry.Viewer.CanViewPrivateProfile(id) {
+			span.SetStatus(codes.Error, "Private profile access denied")
+			span.SetAttributes(attribute.String("error.type", string(user.ErrProfileAccessDenied.Code)))
+			logger.Warn(user.ErrProfileAccessDenied.Message, "viewer_id", string(qry.Viewer.UserID))
+			return nil, user.ErrProfileAccessDenied
+		}
+	}
+
+	users, err := h.userRepo.FindByIDs(ctx, requested)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve user profiles")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to retrieve user profiles from repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	byID := make(map[ids.UserID]*user.User, len(users))
+	for i := range users {
+		byID[users[i].ID] = &users[i]
+	}
+
+	resp := &BatchGetUserProfilesDTO{
+		Profiles:        make([]UserProfileDTO, 0, len(users)),
+		NotFoundUserIDs: []string{},
+	}
+	for _, id := range requested {
+		u, ok := byID[id]
+		if !ok {
+			resp.NotFoundUserIDs = append(resp.NotFoundUserIDs, string(id))
+			continue
+		}
+		resp.Profiles = append(resp.Profiles, *newUserProfileDTO(u))
+	}
+
+	span.SetStatus(codes.Ok, "User profiles retrieved")
+	span.SetAttributes(
+		attribute.Int("user.found_count", len(resp.Profiles)),
+		attribute.Int("user.not_found_count", len(resp.NotFoundUserIDs)),
+	)
+	logger.Info("User profiles retrieved successfully.", "found", len(resp.Profiles), "not_found", len(resp.NotFoundUserIDs))
+
+	return resp, nil
+}
+
+// normalize lower-cases and de-duplicates the requested IDs, keeping the
+// order of first appearance, and enforces the configured batch limit.
+func (h *BatchGetUserProfilesQueryHandler) normalize(userIDs []ids.UserID) ([]ids.UserID, error) {
+	if len(userIDs) == 0 {
+		return nil, ErrNoUserIDs
+	}
+
+	seen := make(map[ids.UserID]struct{}, len(userIDs))
+	result := make([]ids.UserID, 0, len(userIDs))
+	for _, id := range userIDs {
+		normalized := ids.UserID(strings.ToLower(strings.TrimSpace(string(id))))
+		if normalized == "" {
+			return nil, ErrEmptyUserIDItem
+		}
+		if _, dup := seen[normalized]; dup {
+			continue
+		}
+		seen[normalized] = struct{}{}
+		result = append(result, normalized)
+	}
+
+	if len(result) > h.config.Query.BatchGetMaxIDs {
+		return nil, ErrTooManyUserIDs
+	}
+	return result, nil
+}
@@ -0,0 +1,210 @@
+package query
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/availability"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultAvailablePageSize = 20
+	maxAvailablePageSize     = 50
+	// maxAvailableScan bounds how many candidate schedules one request expands;
+	// when it is reached the caller gets a partial page and a token to go on.
+	maxAvailableScan = 500
+)
+
+// FindAvailableTaskersQuery finds visible taskers offering a skill who are free
+// during the window. With DurationMinutes set, a free stretch of that length
+// anywhere in the window is enough; otherwise the whole window must be free.
+type FindAvailableTaskersQuery struct {
+	SkillCode       string    `json:"skillCode"`
+	WindowStart     time.Time `json:"windowStart"`
+	WindowEnd       time.Time `json:"windowEnd"`
+	DurationMinutes int       `json:"durationMinutes,omitempty"`
+	PageSize        int       `json:"-"`
+	PageToken       string    `json:"-"`
+}
+
+type AvailableTaskerDTO struct {
+	UserID        string        `json:"userId"`
+	Timezone      string        `json:"timezone"`
+	FreeIntervals []IntervalDTO `json:"freeIntervals"`
+}
+
+type FindAvailableTaskersDTO struct {
+	Taskers       []AvailableTaskerDTO `json:"taskers"`
+	NextPageToken string               `json:"nextPageToken"`
+}
+
+type FindAvailableTaskersQueryHandler struct {
+	scheduleRepo availability.ScheduleRepository
+	bookings     availability.BookingCalendar
+	logger       *slog.Logger
+	config       *config.Config
+	tracer       trace.Tracer
+}
+
+func NewFindAvailableTaskersQueryHandler(
+	scheduleRepo availability.ScheduleRepository,
+	bookings availability.BookingCalendar,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *FindAvailableTaskersQueryHandler {
+	return &FindAvailableTaskersQueryHandler{
+		scheduleRepo: scheduleRepo,
+		bookings:     bookings,
+		logger:       logger.With(slog.String("component", "FindAvailableTaskersQueryHandler")),
+		config:       cfg,
+		tracer:       otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *FindAvailableTaskersQueryHandler) Handle(ctx context.Context, qry FindAvailableTaskersQuery) (*FindAvailableTaskersDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("query", "FindAvailableTaskers"))
+
+	ctx, span := h.tracer.Start(ctx, "FindAvailableTaskersQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	qry.SkillCode = strings.ToLower(strings.TrimSpace(qry.SkillCode))
+	if qry.SkillCode == "" {
+		span.SetStatus(codes.Error, "Skill code required")
+		return nil, availability.ErrSkillRequired
+	}
+	window := availability.Interval{Start: qry.WindowStart, End: qry.WindowEnd}
+	if err := availability.ValidateWindow(window); err != nil {
+		span.SetStatus(codes.Error, "Invalid window")
+		return nil, err
+	}
+	if qry.PageSize < 0 {
+		span.SetStatus(codes.Error, "Invalid page size")
+		return nil, ErrInvalidPageSize
+	}
+	pageSize := qry.PageSize
+	if pageSize == 0 {
+		pageSize = defaultAvailablePageSize
+	}
+	pageSize = min(pageSize, maxAvailablePageSize)
+
+	fingerprint, err := qry.fingerprint()
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to fingerprint query")
+		span.RecordError(err)
+		return nil, err
+	}
+	var after *ids.UserID
+	if qry.PageToken != "" {
+		cursor, err := decodePageToken(qry.PageToken, fingerprint)
+		if err != nil {
+			span.SetStatus(codes.Error, "Invalid page token")
+			logger.Warn("Rejected available taskers page token", slog.Any("error", err))
+			return nil, ErrInvalidPageToken
+		}
+		id := ids.UserID(cursor.UserID)
+		after = &id
+	}
+
+	span.SetAttributes(
+		attribute.String("query.skill_code", qry.SkillCode),
+		attribute.String("query.window_start", window.Start.Format(time.RFC3339)),
+		attribute.String("query.window_end", window.End.Format(time.RFC3339)),
+		attribute.Int("query.page_size", pageSize),
+	)
+
+	resp := &FindAvailableTaskersDTO{Taskers: make([]AvailableTaskerDTO, 0, pageSize)}
+	need := time.Duration(qry.DurationMinutes) * time.Minute
+	scanned := 0
+	exhausted := false
+	for len(resp.Taskers) < pageSize && scanned < maxAvailableScan {
+		batch := min(pageSize*2, maxAvailableScan-scanned)
+		schedules, err := h.scheduleRepo.FindBySkill(ctx, qry.SkillCode, window, after, batch)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to load availability schedules")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+			logger.Error("Failed to load availability schedules", slog.Any("error", err))
+			return nil, err
+		}
+
+		userIDs := make([]ids.UserID, 0, len(schedules))
+		for _, s := range schedules {
+			userIDs = append(userIDs, s.UserID)
+		}
+		booked, err := h.bookings.BookedIntervals(ctx, userIDs, window)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to retrieve bookings")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "booking_lookup_error"))
+			logger.Error("Failed to retrieve booked intervals", slog.Any("error", err))
+			return nil, err
+		}
+
+		consumed := 0
+		for i := range schedules {
+			s := &schedules[i]
+			consumed++
+			last := s.UserID
+			after = &last
+			if !s.HasFreeSpan(window, booked[s.UserID], need) {
+				continue
+			}
+			resp.Taskers = append(resp.Taskers, AvailableTaskerDTO{
+				UserID:        string(s.UserID),
+				Timezone:      s.Timezone,
+				FreeIntervals: newIntervalDTOs(s.FreeIntervals(window, booked[s.UserID])),
+			})
+			if len(resp.Taskers) == pageSize {
+				break
+			}
+		}
+		scanned += consumed
+		if len(schedules) < batch && consumed == len(schedules) {
+			exhausted = true
+			break
+		}
+	}
+
+	if !exhausted && after != nil {
+		token, err := encodePageToken(user.SearchCursor{UserID: string(*after)}, fingerprint)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to encode page token")
+			span.RecordError(err)
+			return nil, err
+		}
+		resp.NextPageToken = token
+	}
+
+	span.SetStatus(codes.Ok, "Available taskers found")
+	span.SetAttributes(
+		attribute.Int("query.result_count", len(resp.Taskers)),
+		attribute.Int("query.scanned_count", scanned),
+	)
+	logger.Info("Available taskers found.", "count", len(resp.Taskers), "scanned", scanned)
+	return resp, nil
+}
+
+// fingerprint ties page tokens to the skill, window and duration they were
+// issued for.
+func (q FindAvailableTaskersQuery) fingerprint() (string, error) {
+	payload, err := json.Marshal(q)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal available taskers query: %w", err)
+	}
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:8]), nil
+}
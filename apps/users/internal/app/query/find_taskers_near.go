@@ -0,0 +1,115 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/geo"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/tasker"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// FindTaskersNearQuery finds visible taskers with a service area within
+// RadiusMeters of a point, nearest first. SkillCode is optional and Limit
+// defaults to 20.
+type FindTaskersNearQuery struct {
+	Latitude     *float64 `json:"latitude" validate:"required"`
+	Longitude    *float64 `json:"longitude" validate:"required"`
+	RadiusMeters int      `json:"radiusMeters"`
+	SkillCode    *string  `json:"skillCode,omitempty"`
+	Limit        int      `json:"limit,omitempty"`
+}
+
+type NearbyTaskerDTO struct {
+	UserID         string         `json:"userId"`
+	Headline       *string        `json:"headline,omitempty"`
+	NearestArea    ServiceAreaDTO `json:"nearestArea"`
+	DistanceMeters float64        `json:"distanceMeters"`
+}
+
+type FindTaskersNearDTO struct {
+	Taskers []NearbyTaskerDTO `json:"taskers"`
+}
+
+type FindTaskersNearQueryHandler struct {
+	taskerRepo tasker.TaskerProfileRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewFindTaskersNearQueryHandler(
+	taskerRepo tasker.TaskerProfileRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *FindTaskersNearQueryHandler {
+	return &FindTaskersNearQueryHandler{
+		taskerRepo: taskerRepo,
+		logger:     logger.With(slog.String("component", "FindTaskersNearQueryHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *FindTaskersNearQueryHandler) Handle(ctx context.Context, qry FindTaskersNearQuery) (*FindTaskersNearDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("query", "FindTaskersNear"))
+
+	ctx, span := h.tracer.Start(ctx, "FindTaskersNearQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	if qry.Latitude == nil || qry.Longitude == nil {
+		span.SetStatus(codes.Error, "Search location required")
+		span.SetAttributes(attribute.String("error.type", "invalid_argument"))
+		return nil, geo.ErrLocationRequired
+	}
+	search, err := tasker.NewProximitySearch(
+		geo.Point{Latitude: *qry.Latitude, Longitude: *qry.Longitude},
+		qry.RadiusMeters,
+		qry.SkillCode,
+		qry.Limit,
+	)
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid proximity search")
+		span.SetAttributes(attribute.String("error.type", "invalid_argument"))
+		logger.Warn("Rejected proximity search", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Int("query.radius_meters", search.RadiusMeters),
+		attribute.Int("query.limit", search.Limit),
+	)
+	if search.SkillCode != nil {
+		span.SetAttributes(attribute.String("query.skill_code", *search.SkillCode))
+	}
+
+	nearby, err := h.taskerRepo.FindNear(ctx, search)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to find taskers near point")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to find taskers near point", slog.Any("error", err))
+		return nil, err
+	}
+
+	resp := &FindTaskersNearDTO{Taskers: make([]NearbyTaskerDTO, 0, len(nearby))}
+	for _, t := range nearby {
+		resp.Taskers = append(resp.Taskers, NearbyTaskerDTO{
+			UserID:         string(t.UserID),
+			Headline:       t.Headline,
+			NearestArea:    newServiceAreaDTO(t.NearestArea, false),
+			DistanceMeters: t.DistanceMeters,
+		})
+	}
+
+	span.SetStatus(codes.Ok, "Taskers near point found")
+	span.SetAttributes(attribute.Int("query.result_count", len(resp.Taskers)))
+	logger.Info("Taskers near point found.", "count", len(resp.Taskers))
+	return resp, nil
+}
@@ -0,0 +1,135 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/dataexport"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GetDataExportStatusQuery struct {
+	ExportID ids.DataExportID `json:"exportId"`
+	UserID   ids.UserID       `json:"userId"`
+	// Viewer is who asked; only the user or an admin may see their exports.
+	Viewer user.Viewer `json:"-"`
+}
+
+type DataExportStatusDTO struct {
+	ExportID          string     `json:"exportId"`
+	UserID            string     `json:"userId"`
+	Format            string     `json:"format"`
+	Status            string     `json:"status"`
+	SchemaVersion     int        `json:"schemaVersion"`
+	SizeBytes         int64      `json:"sizeBytes"`
+	FailureReason     *string    `json:"failureReason,omitempty"`
+	RequestedAt       time.Time  `json:"requestedAt"`
+	CompletedAt       *time.Time `json:"completedAt,omitempty"`
+	ExpiresAt         *time.Time `json:"expiresAt,omitempty"`
+	DownloadURL       *string    `json:"downloadUrl,omitempty"`
+	DownloadExpiresAt *time.Time `json:"downloadExpiresAt,omitempty"`
+}
+
+type GetDataExportStatusQueryHandler struct {
+	exportRepo dataexport.DataExportRepository
+	storage    dataexport.ArchiveStorage
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewGetDataExportStatusQueryHandler(
+	exportRepo dataexport.DataExportRepository,
+	storage dataexport.ArchiveStorage,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *GetDataExportStatusQueryHandler {
+	return &GetDataExportStatusQueryHandler{
+		exportRepo: exportRepo,
+		storage:    storage,
+		logger:     logger.With(slog.String("component", "GetDataExportStatusQueryHandler")),
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *GetDataExportStatusQueryHandler) Handle(ctx context.Context, qry GetDataExportStatusQuery) (*DataExportStatusDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("export_id", string(qry.ExportID)))
+
+	ctx, span := h.tracer.Start(ctx, "GetDataExportStatusQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("query.export_id", string(qry.ExportID)))
+
+	// Checked before loading so callers cannot probe which IDs exist.
+	if !qry.Viewer.CanViewPrivateProfile(qry.UserID) {
+		span.SetStatus(codes.Error, "Data export access denied")
+		span.SetAttributes(attribute.String("error.type", string(dataexport.ErrDataExportAccessDenied.Code)))
+		logger.Warn(dataexport.ErrDataExportAccessDenied.Message, "viewer_id", string(qry.Viewer.UserID))
+		return nil, dataexport.ErrDataExportAccessDenied
+	}
+
+	job, err := h.exportRepo.FindByID(ctx, qry.ExportID)
+	if err != nil {
+		if errors.Is(err, dataexport.ErrDataExportNotFound) {
+			span.SetStatus(codes.Ok, "Data export not found")
+			logger.Warn(dataexport.ErrDataExportNotFound.Message)
+			return nil, err
+		}
+		span.SetStatus(codes.Error, "Failed to retrieve data export")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to retrieve data export from repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	// Jobs are only visible to the user they belong to; report someone
+	// else's job as missing rather than leaking its existence.
+	if job.UserID != qry.UserID {
+		span.SetStatus(codes.Ok, "Data export belongs to another user")
+		logger.Warn("Data export requested by a different user", "owner_id", job.UserID, "user_id", qry.UserID)
+		return nil, dataexport.ErrDataExportNotFound
+	}
+
+	resp := &DataExportStatusDTO{
+		ExportID:      string(job.ID),
+		UserID:        string(job.UserID),
+		Format:        string(job.Format),
+		Status:        string(job.Status),
+		SchemaVersion: job.SchemaVersion,
+		SizeBytes:     job.SizeBytes,
+		FailureReason: job.FailureReason,
+		RequestedAt:   job.RequestedAt,
+		CompletedAt:   job.CompletedAt,
+		ExpiresAt:     job.ExpiresAt,
+	}
+
+	now := time.Now()
+	if job.IsDownloadable(now) {
+		// Never hand out a reference that outlives the archive itself.
+		ttl := min(h.config.Export.DownloadURLTTL, job.ExpiresAt.Sub(now))
+		url, expiresAt, err := h.storage.DownloadURL(ctx, *job.StorageKey, ttl)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to create download URL")
+			span.RecordError(err)
+			logger.Error("Failed to create download URL for data export", slog.Any("error", err))
+			return nil, err
+		}
+		resp.DownloadURL = &url
+		resp.DownloadExpiresAt = &expiresAt
+	}
+
+	span.SetStatus(codes.Ok, "Data export status retrieved")
+	span.SetAttributes(attribute.String("export.status", string(job.Status)))
+	return resp, nil
+}
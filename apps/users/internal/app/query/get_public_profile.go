@@ -0,0 +1,143 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/role"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"github.com/pratchaya-maneechot/service-exchange/libs/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GetPublicProfileQuery struct {
+	UserID ids.UserID  `json:"userId"`
+	Viewer user.Viewer `json:"-"`
+}
+
+type PublicProfileDTO struct {
+	UserID      string         `json:"userId"`
+	DisplayName string         `json:"displayName"`
+	FirstName   *string        `json:"firstName,omitempty"`
+	LastName    *string        `json:"lastName,omitempty"`
+	Bio         *string        `json:"bio,omitempty"`
+	AvatarURL   *string        `json:"avatarUrl,omitempty"`
+	IsVerified  bool           `json:"isVerified"`
+	Roles       []string       `json:"roles"`
+	MemberSince time.Time      `json:"memberSince"`
+	Reputation  *ReputationDTO `json:"reputation,omitempty"`
+}
+
+type GetPublicProfileQueryHandler struct {
+	userRepo     user.UserRepository
+	counterparts user.CounterpartChecker
+	reputations  user.ReputationReader
+	logger       *slog.Logger
+	config       *config.Config
+	tracer       trace.Tracer
+}
+
+func NewGetPublicProfileQueryHandler(
+	userRepo user.UserRepository,
+	counterparts user.CounterpartChecker,
+	reputations user.ReputationReader,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *GetPublicProfileQueryHandler {
+	handlerLogger := logger.With(slog.String("component", "GetPublicProfileQueryHandler"))
+	return &GetPublicProfileQueryHandler{
+		userRepo:     userRepo,
+		counterparts: counterparts,
+		reputations:  reputations,
+		logger:       handlerLogger,
+		config:       cfg,
+		tracer:       otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *GetPublicProfileQueryHandler) Handle(ctx context.Context, qry GetPublicProfileQuery) (*PublicProfileDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(qry.UserID)))
+
+	ctx, span := h.tracer.Start(ctx, "GetPublicProfileQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("query.user_id", string(qry.UserID)),
+		attribute.Bool("query.anonymous_viewer", qry.Viewer.UserID == ""),
+	)
+
+	u, err := h.userRepo.FindByID(ctx, qry.UserID)
+	if err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			span.SetStatus(codes.Ok, "User not found")
+			span.SetAttributes(attribute.String("error.type", string(user.ErrUserNotFound.Code)))
+			logger.Warn(user.ErrUserNotFound.Message, "user_id", string(qry.UserID))
+			return nil, user.ErrUserNotFound
+		}
+		span.SetStatus(codes.Error, "Failed to retrieve user")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to retrieve user from repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	verifications, err := h.userRepo.FindIdentityVerifications(ctx, u.ID)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve identity verifications")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to retrieve identity verifications", slog.Any("error", err))
+		return nil, err
+	}
+	u.RestoreIdentityVerifications(verifications)
+
+	viewer := qry.Viewer
+	if viewer.UserID != "" && !viewer.CanViewPrivateProfile(u.ID) {
+		viewer.IsCounterpart, err = h.counterparts.AreCounterparts(ctx, viewer.UserID, u.ID)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to resolve counterpart relationship")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "counterpart_lookup_error"))
+			logger.Error("Failed to resolve counterpart relationship", "viewer_id", string(viewer.UserID), slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	pp := u.PublicProfile(viewer)
+
+	// The reputation is a secondary read from the tasks service; the profile
+	// is served without it rather than not at all.
+	rep, err := h.reputations.ReputationOf(ctx, u.ID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "reputation_lookup_error"))
+		logger.Error("Failed to retrieve reputation; serving the profile without it", slog.Any("error", err))
+		rep = nil
+	}
+
+	span.SetStatus(codes.Ok, "Public profile retrieved")
+	span.SetAttributes(attribute.Bool("viewer.is_counterpart", viewer.IsCounterpart))
+	logger.Info("Public profile retrieved successfully.", "user_id", string(u.ID))
+
+	return &PublicProfileDTO{
+		UserID:      string(pp.UserID),
+		DisplayName: pp.DisplayName,
+		FirstName:   pp.FirstName,
+		LastName:    pp.LastName,
+		Bio:         pp.Bio,
+		AvatarURL:   pp.AvatarURL,
+		IsVerified:  pp.IsVerified,
+		Roles:       utils.ArrayMap(pp.Roles, func(r role.RoleName) string { return string(r) }),
+		MemberSince: pp.MemberSince,
+		Reputation:  newReputationDTO(rep),
+	}, nil
+}
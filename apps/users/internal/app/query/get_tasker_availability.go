@@ -0,0 +1,191 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/availability"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/tasker"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const defaultAvailabilityWindow = 7 * 24 * time.Hour
+
+// GetTaskerAvailabilityQuery returns a tasker's schedule and their free time
+// in a window, which defaults to the next seven days.
+type GetTaskerAvailabilityQuery struct {
+	UserID ids.UserID  `json:"userId"`
+	From   *time.Time  `json:"from,omitempty"`
+	To     *time.Time  `json:"to,omitempty"`
+	Viewer user.Viewer `json:"-"`
+}
+
+type IntervalDTO struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+type AvailabilitySlotDTO struct {
+	ID              string `json:"id"`
+	RRule           string `json:"rrule"`
+	StartDate       string `json:"startDate"`
+	StartTime       string `json:"startTime"`
+	DurationMinutes int    `json:"durationMinutes"`
+}
+
+type AvailabilityExceptionDTO struct {
+	ID       string    `json:"id"`
+	Kind     string    `json:"kind"`
+	StartsAt time.Time `json:"startsAt"`
+	EndsAt   time.Time `json:"endsAt"`
+	Reason   *string   `json:"reason,omitempty"`
+}
+
+type TaskerAvailabilityDTO struct {
+	UserID        string                     `json:"userId"`
+	Timezone      string                     `json:"timezone"`
+	Slots         []AvailabilitySlotDTO      `json:"slots"`
+	Exceptions    []AvailabilityExceptionDTO `json:"exceptions"`
+	FreeIntervals []IntervalDTO              `json:"freeIntervals"`
+	UpdatedAt     time.Time                  `json:"updatedAt"`
+}
+
+type GetTaskerAvailabilityQueryHandler struct {
+	taskerRepo   tasker.TaskerProfileRepository
+	scheduleRepo availability.ScheduleRepository
+	bookings     availability.BookingCalendar
+	logger       *slog.Logger
+	config       *config.Config
+	tracer       trace.Tracer
+}
+
+func NewGetTaskerAvailabilityQueryHandler(
+	taskerRepo tasker.TaskerProfileRepository,
+	scheduleRepo availability.ScheduleRepository,
+	bookings availability.BookingCalendar,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *GetTaskerAvailabilityQueryHandler {
+	handlerLogger := logger.With(slog.String("component", "GetTaskerAvailabilityQueryHandler"))
+	return &GetTaskerAvailabilityQueryHandler{
+		taskerRepo:   taskerRepo,
+		scheduleRepo: scheduleRepo,
+		bookings:     bookings,
+		logger:       handlerLogger,
+		config:       cfg,
+		tracer:       otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *GetTaskerAvailabilityQueryHandler) Handle(ctx context.Context, qry GetTaskerAvailabilityQuery) (*TaskerAvailabilityDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(qry.UserID)))
+
+	ctx, span := h.tracer.Start(ctx, "GetTaskerAvailabilityQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("query.user_id", string(qry.UserID)))
+
+	window := availability.Interval{Start: time.Now().UTC()}
+	if qry.From != nil {
+		window.Start = *qry.From
+	}
+	window.End = window.Start.Add(defaultAvailabilityWindow)
+	if qry.To != nil {
+		window.End = *qry.To
+	}
+	if err := availability.ValidateWindow(window); err != nil {
+		span.SetStatus(codes.Error, "Invalid window")
+		span.SetAttributes(attribute.String("error.type", "invalid_argument"))
+		logger.Warn("Rejected availability window", slog.Any("error", err))
+		return nil, err
+	}
+
+	profile, err := h.taskerRepo.FindByUserID(ctx, qry.UserID)
+	if err == nil && !profile.IsVisible && !qry.Viewer.CanViewPrivateProfile(profile.UserID) {
+		err = tasker.ErrTaskerProfileNotFound
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve tasker profile")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "tasker_profile_lookup"))
+		logger.Warn("Failed to retrieve tasker profile for availability", slog.Any("error", err))
+		return nil, err
+	}
+
+	schedule, err := h.scheduleRepo.FindByUserID(ctx, qry.UserID)
+	if err != nil {
+		if errors.Is(err, availability.ErrScheduleNotFound) {
+			span.SetStatus(codes.Ok, "Availability schedule not found")
+			span.SetAttributes(attribute.String("error.type", string(availability.ErrScheduleNotFound.Code)))
+			logger.Warn(availability.ErrScheduleNotFound.Message)
+			return nil, availability.ErrScheduleNotFound
+		}
+		span.SetStatus(codes.Error, "Failed to retrieve availability schedule")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to retrieve availability schedule from repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	booked, err := h.bookings.BookedIntervals(ctx, []ids.UserID{schedule.UserID}, window)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to retrieve bookings")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "booking_lookup_error"))
+		logger.Error("Failed to retrieve booked intervals", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Availability retrieved")
+	logger.Info("Tasker availability retrieved successfully.")
+
+	return newTaskerAvailabilityDTO(schedule, schedule.FreeIntervals(window, booked[schedule.UserID])), nil
+}
+
+func newTaskerAvailabilityDTO(s *availability.Schedule, free []availability.Interval) *TaskerAvailabilityDTO {
+	dto := &TaskerAvailabilityDTO{
+		UserID:        string(s.UserID),
+		Timezone:      s.Timezone,
+		Slots:         make([]AvailabilitySlotDTO, 0, len(s.Slots)),
+		Exceptions:    make([]AvailabilityExceptionDTO, 0, len(s.Exceptions)),
+		FreeIntervals: newIntervalDTOs(free),
+		UpdatedAt:     s.UpdatedAt,
+	}
+	for _, slot := range s.Slots {
+		dto.Slots = append(dto.Slots, AvailabilitySlotDTO{
+			ID:              slot.ID.String(),
+			RRule:           slot.Rule.String(),
+			StartDate:       availability.FormatDate(slot.StartDate),
+			StartTime:       slot.StartTime(),
+			DurationMinutes: slot.DurationMinutes,
+		})
+	}
+	for _, e := range s.Exceptions {
+		dto.Exceptions = append(dto.Exceptions, AvailabilityExceptionDTO{
+			ID:       e.ID.String(),
+			Kind:     string(e.Kind),
+			StartsAt: e.Period.Start,
+			EndsAt:   e.Period.End,
+			Reason:   e.Reason,
+		})
+	}
+	return dto
+}
+
+func newIntervalDTOs(ivs []availability.Interval) []IntervalDTO {
+	dtos := make([]IntervalDTO, 0, len(ivs))
+	for _, iv := range ivs {
+		dtos = append(dtos, IntervalDTO{Start: iv.Start, End: iv.End})
+	}
+	return dtos
+}
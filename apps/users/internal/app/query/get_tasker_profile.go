@@ -0,0 +1,182 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/tasker"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GetTaskerProfileQuery struct {
+	UserID ids.UserID  `json:"userId"`
+	Viewer user.Viewer `json:"-"`
+}
+
+type HourlyRateDTO struct {
+	MinAmount int64  `json:"minAmount"`
+	MaxAmount int64  `json:"maxAmount"`
+	Currency  string `json:"currency"`
+}
+
+type ServiceAreaDTO struct {
+	Province   string  `json:"province"`
+	District   *string `json:"district,omitempty"`
+	PostalCode *string `json:"postalCode,omitempty"`
+	// Location is only shown to the owner and admins.
+	Location *GeoPointDTO `json:"location,omitempty"`
+}
+
+type PortfolioItemDTO struct {
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Description *string    `json:"description,omitempty"`
+	ImageURLs   []string   `json:"imageUrls"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+type TaskerProfileDTO struct {
+	UserID            string             `json:"userId"`
+	Headline          *string            `json:"headline,omitempty"`
+	Skills            []SkillCategoryDTO `json:"skills"`
+	HourlyRate        HourlyRateDTO      `json:"hourlyRate"`
+	ServiceAreas      []ServiceAreaDTO   `json:"serviceAreas"`
+	Portfolio         []PortfolioItemDTO `json:"portfolio"`
+	YearsOfExperience int                `json:"yearsOfExperience"`
+	IsVisible         bool               `json:"isVisible"`
+	CreatedAt         time.Time          `json:"createdAt"`
+	UpdatedAt         time.Time          `json:"updatedAt"`
+}
+
+type GetTaskerProfileQueryHandler struct {
+	taskerRepo tasker.TaskerProfileRepository
+	catalogue  tasker.SkillCatalogueRepository
+	logger     *slog.Logger
+	config     *config.Config
+	tracer     trace.Tracer
+}
+
+func NewGetTaskerProfileQueryHandler(
+	taskerRepo tasker.TaskerProfileRepository,
+	catalogue tasker.SkillCatalogueRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *GetTaskerProfileQueryHandler {
+	handlerLogger := logger.With(slog.String("component", "GetTaskerProfileQueryHandler"))
+	return &GetTaskerProfileQueryHandler{
+		taskerRepo: taskerRepo,
+		catalogue:  catalogue,
+		logger:     handlerLogger,
+		config:     cfg,
+		tracer:     otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *GetTaskerProfileQueryHandler) Handle(ctx context.Context, qry GetTaskerProfileQuery) (*TaskerProfileDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(qry.UserID)))
+
+	ctx, span := h.tracer.Start(ctx, "GetTaskerProfileQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("query.user_id", string(qry.UserID)))
+
+	profile, err := h.taskerRepo.FindByUserID(ctx, qry.UserID)
+	if err != nil {
+		if errors.Is(err, tasker.ErrTaskerProfileNotFound) {
+			span.SetStatus(codes.Ok, "Tasker profile not found")
+			span.SetAttributes(attribute.String("error.type", string(tasker.ErrTaskerProfileNotFound.Code)))
+			logger.Warn(tasker.ErrTaskerProfileNotFound.Message)
+			return nil, tasker.ErrTaskerProfileNotFound
+		}
+		span.SetStatus(codes.Error, "Failed to retrieve tasker profile")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to retrieve tasker profile from repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	// A hidden profile looks the same as a missing one to everybody but its
+	// owner and admins.
+	if !profile.IsVisible && !qry.Viewer.CanViewPrivateProfile(profile.UserID) {
+		span.SetStatus(codes.Ok, "Tasker profile hidden")
+		span.SetAttributes(attribute.String("error.type", string(tasker.ErrTaskerProfileNotFound.Code)))
+		logger.Debug("Tasker profile is hidden from viewer.", "viewer_id", string(qry.Viewer.UserID))
+		return nil, tasker.ErrTaskerProfileNotFound
+	}
+
+	categories, err := h.catalogue.FindByCodes(ctx, profile.SkillCodes)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to resolve skill categories")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to resolve skill categories", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Tasker profile retrieved")
+	logger.Info("Tasker profile retrieved successfully.")
+
+	return newTaskerProfileDTO(profile, categories, qry.Viewer.CanViewPrivateProfile(profile.UserID)), nil
+}
+
+func newTaskerProfileDTO(p *tasker.TaskerProfile, categories []tasker.SkillCategory, withLocations bool) *TaskerProfileDTO {
+	byCode := make(map[string]tasker.SkillCategory, len(categories))
+	for _, c := range categories {
+		byCode[c.Code] = c
+	}
+
+	dto := &TaskerProfileDTO{
+		UserID:   string(p.UserID),
+		Headline: p.Headline,
+		Skills:   make([]SkillCategoryDTO, 0, len(p.SkillCodes)),
+		HourlyRate: HourlyRateDTO{
+			MinAmount: p.HourlyRate.MinAmount,
+			MaxAmount: p.HourlyRate.MaxAmount,
+			Currency:  p.HourlyRate.Currency,
+		},
+		ServiceAreas:      make([]ServiceAreaDTO, 0, len(p.ServiceAreas)),
+		Portfolio:         make([]PortfolioItemDTO, 0, len(p.Portfolio)),
+		YearsOfExperience: p.YearsOfExperience,
+		IsVisible:         p.IsVisible,
+		CreatedAt:         p.CreatedAt,
+		UpdatedAt:         p.UpdatedAt,
+	}
+	for _, code := range p.SkillCodes {
+		if c, ok := byCode[code]; ok {
+			dto.Skills = append(dto.Skills, newSkillCategoryDTO(c))
+		} else {
+			dto.Skills = append(dto.Skills, SkillCategoryDTO{Code: code, Name: code})
+		}
+	}
+	for _, a := range p.ServiceAreas {
+		dto.ServiceAreas = append(dto.ServiceAreas, newServiceAreaDTO(a, withLocations))
+	}
+	for _, item := range p.Portfolio {
+		dto.Portfolio = append(dto.Portfolio, PortfolioItemDTO{
+			ID:          item.ID.String(),
+			Title:       item.Title,
+			Description: item.Description,
+			ImageURLs:   item.ImageURLs,
+			CompletedAt: item.CompletedAt,
+		})
+	}
+	return dto
+}
+
+func newServiceAreaDTO(a tasker.ServiceArea, withLocation bool) ServiceAreaDTO {
+	dto := ServiceAreaDTO{Province: a.Province, District: a.District, PostalCode: a.PostalCode}
+	if withLocation {
+		dto.Location = newGeoPointDTO(a.Location)
+	}
+	return dto
+}
@@ -5,12 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/geo"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/role"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	busquery "github.com/pratchaya-maneechot/service-exchange/libs/bus/query"
 	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
 	"github.com/pratchaya-maneechot/service-exchange/libs/utils"
 	"go.opentelemetry.io/otel"
@@ -20,46 +23,79 @@ import (
 )
 
 type GetUserProfileQuery struct {
-	UserID ids.UserID `json:"userId"`
+	UserID ids.UserID  `json:"userId"`
+	Viewer user.Viewer `json:"-"`
 }
 
 type UserProfileDTO struct {
-	UserID      string          `json:"userId"`
-	LineUserID  string          `json:"lineUserId"`
-	Email       *string         `json:"email"`
-	DisplayName string          `json:"displayName"`
-	FirstName   *string         `json:"firstName,omitempty"`
-	LastName    *string         `json:"lastName,omitempty"`
-	Bio         *string         `json:"bio,omitempty"`
-	AvatarURL   *string         `json:"avatarUrl,omitempty"`
-	PhoneNumber *string         `json:"phoneNumber,omitempty"`
-	Address     *string         `json:"address,omitempty"`
-	Preferences map[string]any  `json:"preferences"`
-	Status      user.UserStatus `json:"status"`
-	IsVerified  bool            `json:"isVerified"`
-	LastLoginAt *time.Time      `json:"lastLoginAt,omitempty"`
-	CreatedAt   time.Time       `json:"createdAt"`
-	Roles       []string        `json:"roles"`
+	UserID      string  `json:"userId"`
+	LineUserID  string  `json:"lineUserId"`
+	Email       *string `json:"email"`
+	DisplayName string  `json:"displayName"`
+	FirstName   *string `json:"firstName,omitempty"`
+	LastName    *string `json:"lastName,omitempty"`
+	Bio         *string `json:"bio,omitempty"`
+	AvatarURL   *string `json:"avatarUrl,omitempty"`
+	PhoneNumber *string `json:"phoneNumber,omitempty"`
+	Address     *string `json:"address,omitempty"`
+	// StructuredAddress is the geocoded administrative address, if any.
+	StructuredAddress *AddressDTO     `json:"structuredAddress,omitempty"`
+	Preferences       map[string]any  `json:"preferences"`
+	Locale            string          `json:"locale"`
+	Timezone          string          `json:"timezone"`
+	Status            user.UserStatus `json:"status"`
+	IsVerified        bool            `json:"isVerified"`
+	LastLoginAt       *time.Time      `json:"lastLoginAt,omitempty"`
+	CreatedAt         time.Time       `json:"createdAt"`
+	Roles             []string        `json:"roles"`
+	Reputation        *ReputationDTO  `json:"reputation,omitempty"`
+}
+
+type ReputationDTO struct {
+	ReviewCount     int     `json:"reviewCount"`
+	AverageRating   float64 `json:"averageRating"`
+	BayesianAverage float64 `json:"bayesianAverage"`
+	RecentAverage   float64 `json:"recentAverage"`
+	Trend           string  `json:"trend"`
+}
+
+type GeoPointDTO struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type AddressDTO struct {
+	SubDistrict *string      `json:"subDistrict,omitempty"`
+	District    *string      `json:"district,omitempty"`
+	Province    string       `json:"province"`
+	PostalCode  *string      `json:"postalCode,omitempty"`
+	CountryCode string       `json:"countryCode"`
+	Location    *GeoPointDTO `json:"location,omitempty"`
 }
 
 type GetUserProfileQueryHandler struct {
-	userRepo user.UserRepository
-	logger   *slog.Logger
-	config   *config.Config
-	tracer   trace.Tracer
+	userRepo    user.UserRepository
+	reputations user.ReputationReader
+	loader      *busquery.Coalescer[ids.UserID, *user.User]
+	logger      *slog.Logger
+	config      *config.Config
+	tracer      trace.Tracer
 }
 
 func NewGetUserProfileQueryHandler(
 	userRepo user.UserRepository,
+	reputations user.ReputationReader,
 	logger *slog.Logger,
 	cfg *config.Config,
 ) *GetUserProfileQueryHandler {
 	handlerLogger := logger.With(slog.String("component", "GetUserProfileQueryHandler"))
 	return &GetUserProfileQueryHandler{
-		userRepo: userRepo,
-		logger:   handlerLogger,
-		config:   cfg,
-		tracer:   otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+		userRepo:    userRepo,
+		reputations: reputations,
+		loader:      busquery.NewCoalescer(usersByIDLoader(userRepo), cfg.Query.CoalesceWindow, cfg.Query.CoalesceMaxBatch, cfg.Query.CoalesceTimeout),
+		logger:      handlerLogger,
+		config:      cfg,
+		tracer:      otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
 	}
 }
 
@@ -71,7 +107,18 @@ func (h *GetUserProfileQueryHandler) Handle(ctx context.Context, qry GetUserProf
 
 	span.SetAttributes(attribute.String("query.user_id", string(qry.UserID)))
 
-	u, err := h.userRepo.FindByID(ctx, qry.UserID)
+	// Checked before loading so callers cannot probe which IDs exist.
+	if !qry.Viewer.CanViewPrivateProfile(qry.UserID) {
+		span.SetStatus(codes.Error, "Private profile access denied")
+		span.SetAttributes(attribute.String("error.type", string(user.ErrProfileAccessDenied.Code)))
+		logger.Warn(user.ErrProfileAccessDenied.Message, "viewer_id", string(qry.Viewer.UserID))
+		return nil, user.ErrProfileAccessDenied
+	}
+
+	u, found, err := h.loader.Load(ctx, qry.UserID)
+	if err == nil && !found {
+		err = user.ErrUserNotFound
+	}
 	if err != nil {
 		if errors.Is(err, user.ErrUserNotFound) {
 			span.SetStatus(codes.Ok, "User profile not found")
@@ -88,29 +135,104 @@ func (h *GetUserProfileQueryHandler) Handle(ctx context.Context, qry GetUserProf
 		return nil, err
 	}
 
+	// The reputation is a secondary read from the tasks service; the profile
+	// is served without it rather than not at all.
+	rep, err := h.reputations.ReputationOf(ctx, u.ID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "reputation_lookup_error"))
+		logger.Error("Failed to retrieve reputation; serving the profile without it", slog.Any("error", err))
+		rep = nil
+	}
+
 	span.SetStatus(codes.Ok, "User profile retrieved")
 	span.SetAttributes(attribute.Bool("user.found", true))
 	logger.Info("User profile retrieved successfully.", "user_id", string(u.ID))
 
-	resp := &UserProfileDTO{
-		UserID:      string(u.ID),
-		LineUserID:  u.LineUserID,
-		Email:       u.Email,
-		DisplayName: u.Profile.DisplayName,
-		FirstName:   u.Profile.FirstName,
-		LastName:    u.Profile.LastName,
-		Bio:         u.Profile.Bio,
-		AvatarURL:   u.Profile.AvatarURL,
-		PhoneNumber: u.Profile.PhoneNumber,
-		Address:     u.Profile.Address,
-		Preferences: u.Profile.Preferences,
-		Status:      u.Status,
-		IsVerified:  u.IsVerified(),
-		LastLoginAt: u.LastLoginAt,
-		CreatedAt:   u.CreatedAt,
+	resp := newUserProfileDTO(u)
+	resp.Reputation = newReputationDTO(rep)
+	return resp, nil
+}
+
+// usersByIDLoader adapts FindByIDs to a coalescer batch, so concurrent
+// single-profile lookups share one users query and one roles query.
+func usersByIDLoader(userRepo user.UserRepository) busquery.BatchFunc[ids.UserID, *user.User] {
+	return func(ctx context.Context, userIDs []ids.UserID) (map[ids.UserID]*user.User, error) {
+		users, err := userRepo.FindByIDs(ctx, userIDs)
+		if err != nil {
+			return nil, err
+		}
+		byID := make(map[ids.UserID]*user.User, len(users))
+		for i := range users {
+			byID[users[i].ID] = &users[i]
+		}
+		// Stored IDs are canonical lower-case UUIDs; key the result by what was asked for.
+		result := make(map[ids.UserID]*user.User, len(users))
+		for _, id := range userIDs {
+			if u, ok := byID[ids.UserID(strings.ToLower(string(id)))]; ok {
+				result[id] = u
+			}
+		}
+		return result, nil
+	}
+}
+
+func newUserProfileDTO(u *user.User) *UserProfileDTO {
+	return &UserProfileDTO{
+		UserID:            string(u.ID),
+		LineUserID:        u.LineUserID,
+		Email:             u.Email,
+		DisplayName:       u.Profile.DisplayName,
+		FirstName:         u.Profile.FirstName,
+		LastName:          u.Profile.LastName,
+		Bio:               u.Profile.Bio,
+		AvatarURL:         u.Profile.AvatarURL,
+		PhoneNumber:       u.Profile.PhoneNumber,
+		Address:           u.Profile.Address,
+		StructuredAddress: newAddressDTO(u.Profile.StructuredAddress),
+		Preferences:       u.Profile.EffectivePreferences(),
+		Locale:            u.Profile.Locale(),
+		Timezone:          u.Profile.Timezone(),
+		Status:            u.Status,
+		IsVerified:        u.IsVerified(),
+		LastLoginAt:       u.LastLoginAt,
+		CreatedAt:         u.CreatedAt,
 		Roles: utils.ArrayMap(u.Roles, func(r role.Role) string {
 			return string(r.Name)
 		}),
 	}
-	return resp, nil
+}
+
+func newReputationDTO(r *user.Reputation) *ReputationDTO {
+	if r == nil {
+		return nil
+	}
+	return &ReputationDTO{
+		ReviewCount:     r.ReviewCount,
+		AverageRating:   r.AverageRating,
+		BayesianAverage: r.BayesianAverage,
+		RecentAverage:   r.RecentAverage,
+		Trend:           r.Trend,
+	}
+}
+
+func newAddressDTO(a *geo.Address) *AddressDTO {
+	if a == nil {
+		return nil
+	}
+	return &AddressDTO{
+		SubDistrict: a.SubDistrict,
+		District:    a.District,
+		Province:    a.Province,
+		PostalCode:  a.PostalCode,
+		CountryCode: a.CountryCode,
+		Location:    newGeoPointDTO(a.Location),
+	}
+}
+
+func newGeoPointDTO(p *geo.Point) *GeoPointDTO {
+	if p == nil {
+		return nil
+	}
+	return &GeoPointDTO{Latitude: p.Latitude, Longitude: p.Longitude}
 }
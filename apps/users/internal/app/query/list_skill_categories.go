@@ -0,0 +1,98 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/tasker"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListSkillCategoriesQuery lists the skill catalogue. Inactive entries are
+// only returned to admins.
+type ListSkillCategoriesQuery struct {
+	IncludeInactive bool        `json:"includeInactive"`
+	Viewer          user.Viewer `json:"-"`
+}
+
+type SkillCategoryDTO struct {
+	Code        string  `json:"code"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	IsActive    bool    `json:"isActive"`
+}
+
+type ListSkillCategoriesDTO struct {
+	Categories []SkillCategoryDTO `json:"categories"`
+}
+
+type ListSkillCategoriesQueryHandler struct {
+	catalogue tasker.SkillCatalogueRepository
+	logger    *slog.Logger
+	config    *config.Config
+	tracer    trace.Tracer
+}
+
+func NewListSkillCategoriesQueryHandler(
+	catalogue tasker.SkillCatalogueRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ListSkillCategoriesQueryHandler {
+	handlerLogger := logger.With(slog.String("component", "ListSkillCategoriesQueryHandler"))
+	return &ListSkillCategoriesQueryHandler{
+		catalogue: catalogue,
+		logger:    handlerLogger,
+		config:    cfg,
+		tracer:    otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *ListSkillCategoriesQueryHandler) Handle(ctx context.Context, qry ListSkillCategoriesQuery) (*ListSkillCategoriesDTO, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := h.tracer.Start(ctx, "ListSkillCategoriesQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(attribute.Bool("query.include_inactive", qry.IncludeInactive))
+
+	if qry.IncludeInactive && !qry.Viewer.IsAdmin() {
+		span.SetStatus(codes.Error, "Admin role required")
+		span.SetAttributes(attribute.String("error.type", string(user.ErrAdminRequired.Code)))
+		logger.Warn(user.ErrAdminRequired.Message, "viewer_id", string(qry.Viewer.UserID))
+		return nil, user.ErrAdminRequired
+	}
+
+	categories, err := h.catalogue.List(ctx, qry.IncludeInactive)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list skill categories")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to list skill categories from repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	resp := &ListSkillCategoriesDTO{Categories: make([]SkillCategoryDTO, 0, len(categories))}
+	for _, c := range categories {
+		resp.Categories = append(resp.Categories, newSkillCategoryDTO(c))
+	}
+
+	span.SetStatus(codes.Ok, "Skill categories listed")
+	span.SetAttributes(attribute.Int("skill_category.count", len(resp.Categories)))
+	return resp, nil
+}
+
+func newSkillCategoryDTO(c tasker.SkillCategory) SkillCategoryDTO {
+	return SkillCategoryDTO{
+		Code:        c.Code,
+		Name:        c.Name,
+		Description: c.Description,
+		IsActive:    c.IsActive,
+	}
+}
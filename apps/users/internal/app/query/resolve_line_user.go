@@ -0,0 +1,88 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/role"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"github.com/pratchaya-maneechot/service-exchange/libs/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ResolveLineUserQuery asks who a LINE account is, so the gateway can sign
+// calls on its behalf with the user ID and roles the services check.
+type ResolveLineUserQuery struct {
+	LineUserID string      `json:"lineUserId" validate:"required"`
+	Viewer     user.Viewer `json:"-"`
+}
+
+type ResolvedUserDTO struct {
+	UserID string   `json:"userId"`
+	Roles  []string `json:"roles"`
+}
+
+type ResolveLineUserQueryHandler struct {
+	userRepo user.UserRepository
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewResolveLineUserQueryHandler(
+	userRepo user.UserRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *ResolveLineUserQueryHandler {
+	handlerLogger := logger.With(slog.String("component", "ResolveLineUserQueryHandler"))
+	return &ResolveLineUserQueryHandler{
+		userRepo: userRepo,
+		logger:   handlerLogger,
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *ResolveLineUserQueryHandler) Handle(ctx context.Context, qry ResolveLineUserQuery) (*ResolvedUserDTO, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := h.tracer.Start(ctx, "ResolveLineUserQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	// Checked before loading so callers cannot probe which accounts exist.
+	if !qry.Viewer.IsGateway() {
+		span.SetStatus(codes.Error, "Gateway role required")
+		span.SetAttributes(attribute.String("error.type", string(user.ErrGatewayRequired.Code)))
+		logger.Warn(user.ErrGatewayRequired.Message, "viewer_id", string(qry.Viewer.UserID))
+		return nil, user.ErrGatewayRequired
+	}
+
+	u, err := h.userRepo.FindByLineUserID(ctx, qry.LineUserID)
+	if err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			span.SetStatus(codes.Ok, "LINE account not registered")
+			span.SetAttributes(attribute.Bool("user.found", false))
+			logger.Debug("LINE account is not registered.")
+			return nil, user.ErrUserNotFound
+		}
+		span.SetStatus(codes.Error, "Failed to retrieve user by LINE user ID")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to retrieve user by LINE user ID", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "LINE account resolved")
+	span.SetAttributes(attribute.Bool("user.found", true), attribute.String("query.user_id", string(u.ID)))
+	return &ResolvedUserDTO{
+		UserID: string(u.ID),
+		Roles:  utils.ArrayMap(u.Roles, func(r role.Role) string { return string(r.Name) }),
+	}, nil
+}
@@ -0,0 +1,247 @@
+package query
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/role"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
+var (
+	ErrInvalidPageSize  = errs.NewWithReason(errs.CodeInvalidArgument, "INVALID_PAGE_SIZE", "page_size must not be negative")
+	ErrInvalidPageToken = errs.NewWithReason(errs.CodeInvalidArgument, "INVALID_PAGE_TOKEN", "page_token is invalid or does not match the request")
+	ErrInvalidOrderBy   = errs.NewWithReason(errs.CodeInvalidArgument, "INVALID_ORDER_BY", "order_by must be one of: created_at, display_name, optionally followed by asc or desc")
+)
+
+type SearchUsersQuery struct {
+	Status             *user.UserStatus         `json:"status,omitempty"`
+	RoleName           *role.RoleName           `json:"role,omitempty"`
+	VerificationStatus *user.VerificationStatus `json:"verificationStatus,omitempty"`
+	CreatedFrom        *time.Time               `json:"createdFrom,omitempty"`
+	CreatedTo          *time.Time               `json:"createdTo,omitempty"`
+	EmailPrefix        *string                  `json:"emailPrefix,omitempty"`
+	DisplayNamePrefix  *string                  `json:"displayNamePrefix,omitempty"`
+	OrderBy            string                   `json:"orderBy,omitempty"`
+	PageSize           int                      `json:"-"`
+	PageToken          string                   `json:"-"`
+	Viewer             user.Viewer              `json:"-"`
+}
+
+type SearchUsersResultDTO struct {
+	Users         []UserProfileDTO `json:"users"`
+	NextPageToken string           `json:"nextPageToken"`
+}
+
+// pageToken is the decoded form of the opaque token handed to clients. The
+// filter fingerprint makes sure a token is only replayed against the same
+// search it was issued for (AIP-158).
+type pageToken struct {
+	SortValue string `json:"v"`
+	UserID    string `json:"id"`
+	Filter    string `json:"f"`
+}
+
+type SearchUsersQueryHandler struct {
+	userRepo user.UserRepository
+	logger   *slog.Logger
+	config   *config.Config
+	tracer   trace.Tracer
+}
+
+func NewSearchUsersQueryHandler(
+	userRepo user.UserRepository,
+	logger *slog.Logger,
+	cfg *config.Config,
+) *SearchUsersQueryHandler {
+	return &SearchUsersQueryHandler{
+		userRepo: userRepo,
+		logger:   logger.With(slog.String("component", "SearchUsersQueryHandler")),
+		config:   cfg,
+		tracer:   otel.Tracer(fmt.Sprintf("%s.query-handler", cfg.Name)),
+	}
+}
+
+func (h *SearchUsersQueryHandler) Handle(ctx context.Context, qry SearchUsersQuery) (*SearchUsersResultDTO, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("query", "SearchUsers"))
+
+	ctx, span := h.tracer.Start(ctx, "SearchUsersQueryHandler.Handle", trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	if !qry.Viewer.IsAdmin() {
+		span.SetStatus(codes.Error, "Admin role required")
+		span.SetAttributes(attribute.String("error.type", string(user.ErrAdminRequired.Code)))
+		logger.Warn("Rejected user search from non-admin caller", "viewer_id", string(qry.Viewer.UserID))
+		return nil, user.ErrAdminRequired
+	}
+
+	if qry.PageSize < 0 {
+		span.SetStatus(codes.Error, "Invalid page size")
+		return nil, ErrInvalidPageSize
+	}
+	pageSize := qry.PageSize
+	if pageSize == 0 {
+		pageSize = defaultSearchPageSize
+	}
+	pageSize = min(pageSize, maxSearchPageSize)
+
+	sortField, sortDesc, err := parseOrderBy(qry.OrderBy)
+	if err != nil {
+		span.SetStatus(codes.Error, "Invalid order_by")
+		return nil, err
+	}
+
+	fingerprint, err := qry.fingerprint()
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to fingerprint search")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	criteria := user.SearchCriteria{
+		Status:             qry.Status,
+		RoleName:           qry.RoleName,
+		VerificationStatus: qry.VerificationStatus,
+		CreatedFrom:        qry.CreatedFrom,
+		CreatedTo:          qry.CreatedTo,
+		EmailPrefix:        qry.EmailPrefix,
+		DisplayNamePrefix:  qry.DisplayNamePrefix,
+		SortField:          sortField,
+		SortDesc:           sortDesc,
+		// One extra row tells us whether another page exists.
+		Limit: pageSize + 1,
+	}
+	if qry.PageToken != "" {
+		cursor, err := decodePageToken(qry.PageToken, fingerprint)
+		if err != nil {
+			span.SetStatus(codes.Error, "Invalid page token")
+			logger.Warn("Rejected search page token", slog.Any("error", err))
+			return nil, ErrInvalidPageToken
+		}
+		criteria.After = cursor
+	}
+
+	span.SetAttributes(
+		attribute.Int("query.page_size", pageSize),
+		attribute.String("query.order_by", qry.OrderBy),
+		attribute.Bool("query.has_page_token", qry.PageToken != ""),
+	)
+
+	users, err := h.userRepo.Search(ctx, criteria)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to search users")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "repository_read_error"))
+		logger.Error("Failed to search users in repository", slog.Any("error", err))
+		return nil, err
+	}
+
+	resp := &SearchUsersResultDTO{Users: make([]UserProfileDTO, 0, min(len(users), pageSize))}
+	if len(users) > pageSize {
+		users = users[:pageSize]
+		last := user.CursorFor(&users[len(users)-1], sortField)
+		token, err := encodePageToken(last, fingerprint)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to encode page token")
+			span.RecordError(err)
+			return nil, err
+		}
+		resp.NextPageToken = token
+	}
+	for i := range users {
+		resp.Users = append(resp.Users, *newUserProfileDTO(&users[i]))
+	}
+
+	span.SetStatus(codes.Ok, "Users searched")
+	span.SetAttributes(attribute.Int("query.result_count", len(resp.Users)))
+	logger.Info("Users searched successfully.", "count", len(resp.Users))
+	return resp, nil
+}
+
+// parseOrderBy accepts an AIP-132 style "field [asc|desc]" clause. The
+// default is newest users first.
+func parseOrderBy(orderBy string) (user.SearchSortField, bool, error) {
+	parts := strings.Fields(strings.ToLower(orderBy))
+	if len(parts) == 0 {
+		return user.SearchSortCreatedAt, true, nil
+	}
+	if len(parts) > 2 {
+		return "", false, ErrInvalidOrderBy
+	}
+
+	var field user.SearchSortField
+	switch user.SearchSortField(parts[0]) {
+	case user.SearchSortCreatedAt, user.SearchSortDisplayName:
+		field = user.SearchSortField(parts[0])
+	default:
+		return "", false, ErrInvalidOrderBy
+	}
+
+	desc := false
+	if len(parts) == 2 {
+		switch parts[1] {
+		case "asc":
+		case "desc":
+			desc = true
+		default:
+			return "", false, ErrInvalidOrderBy
+		}
+	}
+	return field, desc, nil
+}
+
+// fingerprint hashes every parameter except the paging fields so a token
+// cannot be reused with different filters or ordering.
+func (q SearchUsersQuery) fingerprint() (string, error) {
+	payload, err := json.Marshal(q)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal search query: %w", err)
+	}
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+func encodePageToken(cursor user.SearchCursor, fingerprint string) (string, error) {
+	payload, err := json.Marshal(pageToken{SortValue: cursor.SortValue, UserID: cursor.UserID, Filter: fingerprint})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal page token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(payload), nil
+}
+
+func decodePageToken(token string, fingerprint string) (*user.SearchCursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode page token: %w", err)
+	}
+	var pt pageToken
+	if err := json.Unmarshal(payload, &pt); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal page token: %w", err)
+	}
+	if pt.Filter != fingerprint {
+		return nil, fmt.Errorf("page token was issued for a different search")
+	}
+	if pt.UserID == "" {
+		return nil, fmt.Errorf("page token is missing the user ID")
+	}
+	return &user.SearchCursor{SortValue: pt.SortValue, UserID: pt.UserID}, nil
+}
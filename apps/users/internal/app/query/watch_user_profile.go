@@ -0,0 +1,85 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+)
+
+// UserProfileWatchers tells the streams watching a user's profile when it
+// changes. It subscribes to UserChanged; the streams read the profile again
+// with GetUserProfileQuery, so they get the same view and access checks as
+// a single read.
+type UserProfileWatchers struct {
+	mu       sync.Mutex
+	watchers map[ids.UserID]map[*ProfileWatch]struct{}
+	logger   *slog.Logger
+}
+
+// ProfileWatch is one stream's subscription to a profile. A change is a
+// signal rather than a copy of the profile: signals raised while the stream
+// has not taken the last one are merged, so a slow client holds up neither
+// the publisher nor other streams, and still ends up with the latest
+// profile.
+type ProfileWatch struct {
+	userID  ids.UserID
+	changes chan struct{}
+	owner   *UserProfileWatchers
+}
+
+func NewUserProfileWatchers(logger *slog.Logger) *UserProfileWatchers {
+	return &UserProfileWatchers{
+		watchers: make(map[ids.UserID]map[*ProfileWatch]struct{}),
+		logger:   logger.With(slog.String("component", "UserProfileWatchers")),
+	}
+}
+
+// Watch subscribes to changes of the user's profile until the watch is
+// closed.
+func (w *UserProfileWatchers) Watch(userID ids.UserID) *ProfileWatch {
+	pw := &ProfileWatch{userID: userID, changes: make(chan struct{}, 1), owner: w}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.watchers[userID] == nil {
+		w.watchers[userID] = make(map[*ProfileWatch]struct{})
+	}
+	w.watchers[userID][pw] = struct{}{}
+	return pw
+}
+
+// Handle signals every watch of the changed user.
+func (w *UserProfileWatchers) Handle(ctx context.Context, evt user.UserChanged) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for pw := range w.watchers[evt.UserID] {
+		select {
+		case pw.changes <- struct{}{}:
+		default:
+			// Already signalled; the stream will read the latest profile.
+		}
+	}
+	if n := len(w.watchers[evt.UserID]); n > 0 {
+		w.logger.Debug("Signalled profile watchers", "user_id", evt.UserID, "watchers", n)
+	}
+	return nil
+}
+
+// Changes receives a value after the profile changed.
+func (pw *ProfileWatch) Changes() <-chan struct{} {
+	return pw.changes
+}
+
+// Close ends the subscription.
+func (pw *ProfileWatch) Close() {
+	w := pw.owner
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.watchers[pw.userID], pw)
+	if len(w.watchers[pw.userID]) == 0 {
+		delete(w.watchers, pw.userID)
+	}
+}
@@ -3,23 +3,43 @@ package config
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/joho/godotenv"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	Environment string         `mapstructure:"environment" validate:"required,oneof=development staging production"`
-	Name        string         `mapstructure:"name" validate:"required"`
-	Version     string         `mapstructure:"version" validate:"required"`
-	Server      ServerConfig   `mapstructure:"server" validate:"required"`
-	Database    DatabaseConfig `mapstructure:"database" validate:"required"`
-	Logging     LoggingConfig  `mapstructure:"logging" validate:"required"`
-	Metrics     MetricsConfig  `mapstructure:"metrics" validate:"required"`
-	Security    SecurityConfig `mapstructure:"security" validate:"required"`
+	Environment string            `mapstructure:"environment" validate:"required,oneof=development staging production"`
+	Name        string            `mapstructure:"name" validate:"required"`
+	Version     string            `mapstructure:"version" validate:"required"`
+	Server      ServerConfig      `mapstructure:"server" validate:"required"`
+	Database    DatabaseConfig    `mapstructure:"database" validate:"required"`
+	Logging     LoggingConfig     `mapstructure:"logging" validate:"required"`
+	Metrics     MetricsConfig     `mapstructure:"metrics" validate:"required"`
+	Security    lg.ConfigSecurity `mapstructure:"security" validate:"required"`
+	Export      ExportConfig      `mapstructure:"export" validate:"required"`
+	Query       QueryConfig       `mapstructure:"query" validate:"required"`
+	Geocoding   GeocodingConfig   `mapstructure:"geocoding"`
+	Watch       WatchConfig       `mapstructure:"watch" validate:"required"`
+	Gateway     GatewayConfig     `mapstructure:"gateway"`
+	Health      HealthConfig      `mapstructure:"health" validate:"required"`
+	Clients     ClientsConfig     `mapstructure:"clients" validate:"required"`
+}
+
+// ClientsConfig names the services this one calls.
+type ClientsConfig struct {
+	// Tasks is asked when taskers are booked, so their availability leaves
+	// that time out, for the reputations shown on profiles, and whether two
+	// users were matched on a task.
+	Tasks lg.ConfigTarget `mapstructure:"tasks" validate:"required"`
+	// Notifications is told about registrations and identity verification
+	// decisions, which it passes on to the users.
+	Notifications lg.ConfigTarget `mapstructure:"notifications" validate:"required"`
 }
 
 type ServerConfig struct {
@@ -67,16 +87,64 @@ type MetricsConfig struct {
 	Path    string `mapstructure:"path" validate:"required_if=Enabled true"`
 }
 
-type SecurityConfig struct {
-	EnableTLS      bool     `mapstructure:"enable_tls"`
-	TLSCertFile    string   `mapstructure:"tls_cert_file" validate:"required_if=EnableTLS true"`
-	TLSKeyFile     string   `mapstructure:"tls_key_file" validate:"required_if=EnableTLS true"`
-	TrustedProxies []string `mapstructure:"trusted_proxies" validate:"omitempty"`
-	RateLimitRPS   int      `mapstructure:"rate_limit_rps" validate:"gte=0"`
-	RateLimitBurst int      `mapstructure:"rate_limit_burst" validate:"gte=0"`
-	MaxRequestSize int64    `mapstructure:"max_request_size" validate:"gte=0"`
-	EnableCORS     bool     `mapstructure:"enable_cors"`
-	AllowedOrigins []string `mapstructure:"allowed_origins" validate:"required_if=EnableCORS true"`
+// developmentExportSigningKey is the export.signing_key config.yml ships
+// with, so the service starts locally without secrets. Anyone can forge
+// download URLs with it, so production refuses it.
+const developmentExportSigningKey = "development-only-export-signing-key"
+
+type ExportConfig struct {
+	StorageDir string `mapstructure:"storage_dir" validate:"required"`
+	// DownloadBaseURL is where archives are downloaded from: the /exports
+	// path of the HTTP gateway, or a proxy in front of it.
+	DownloadBaseURL string `mapstructure:"download_base_url" validate:"required,url"`
+	// SigningKey signs download URLs, with at least 32 bytes; set it with
+	// EXPORT_SIGNING_KEY outside development.
+	SigningKey      string        `mapstructure:"signing_key" validate:"required,min=32"`
+	DownloadURLTTL  time.Duration `mapstructure:"download_url_ttl" validate:"required,gt=0"`
+	RetentionPeriod time.Duration `mapstructure:"retention_period" validate:"required,gt=0"`
+	JobTimeout      time.Duration `mapstructure:"job_timeout" validate:"required,gt=0"`
+}
+
+type QueryConfig struct {
+	CoalesceWindow   time.Duration `mapstructure:"coalesce_window" validate:"gte=0"`
+	CoalesceMaxBatch int           `mapstructure:"coalesce_max_batch" validate:"gte=0"`
+	// CoalesceTimeout bounds a coalesced batch, which outlives the callers
+	// that share it.
+	CoalesceTimeout time.Duration `mapstructure:"coalesce_timeout" validate:"required,gt=0"`
+	BatchGetMaxIDs  int           `mapstructure:"batch_get_max_ids" validate:"required,min=1,max=1000"`
+}
+
+// WatchConfig bounds WatchUserProfile streams.
+type WatchConfig struct {
+	// MaxDuration ends a stream after this long; clients reconnect, which
+	// spreads long-lived streams over replicas as they come and go.
+	MaxDuration time.Duration `mapstructure:"max_duration" validate:"required,gt=0"`
+	// SendTimeout ends a stream whose client has not taken a change for
+	// this long.
+	SendTimeout time.Duration `mapstructure:"send_timeout" validate:"required,gt=0"`
+}
+
+// GatewayConfig serves UserService as HTTP/JSON next to gRPC. Its CORS and
+// request size limits come from SecurityConfig.
+type GatewayConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Address string `mapstructure:"address" validate:"required_if=Enabled true"`
+	// TLSServerName is the name on the server certificate, which the
+	// gateway checks when it calls the gRPC server over TLS.
+	TLSServerName string `mapstructure:"tls_server_name"`
+}
+
+// HealthConfig sets how often the service checks its dependencies, which
+// decides whether it reports itself ready.
+type HealthConfig struct {
+	Interval time.Duration `mapstructure:"interval" validate:"required,gt=0"`
+	// Timeout bounds each check, such as the database ping.
+	Timeout time.Duration `mapstructure:"timeout" validate:"required,gt=0"`
+}
+
+type GeocodingConfig struct {
+	// FixtureFile replaces the embedded place fixture; empty uses the built-in one.
+	FixtureFile string `mapstructure:"fixture_file" validate:"omitempty,file"`
 }
 
 func Load() (*Config, error) {
@@ -129,6 +197,17 @@ func (c *Config) validate() error {
 		if !c.Security.EnableTLS {
 			return fmt.Errorf("security.enable_tls must be true in production")
 		}
+		if c.Export.SigningKey == developmentExportSigningKey {
+			return fmt.Errorf("export.signing_key must be set with EXPORT_SIGNING_KEY in production")
+		}
+	}
+
+	if c.Gateway.Enabled && c.Security.EnableCORS && slices.Contains(c.Security.AllowedOrigins, "*") {
+		return fmt.Errorf("security.allowed_origins must list origins rather than \"*\" when the gateway is enabled")
+	}
+
+	if c.Gateway.Enabled && c.Security.EnableTLS && c.Gateway.TLSServerName == "" {
+		return fmt.Errorf("gateway.tls_server_name is required when security.enable_tls is true")
 	}
 
 	if c.Database.MaxIdleConns > c.Database.MaxOpenConns {
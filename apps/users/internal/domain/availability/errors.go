@@ -0,0 +1,25 @@
+package availability
+
+import (
+	"fmt"
+
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+)
+
+var (
+	ErrScheduleNotFound  = errs.NewWithReason(errs.CodeNotFound, "AVAILABILITY_SCHEDULE_NOT_FOUND", "availability schedule not found")
+	ErrInvalidTimezone   = errs.NewWithReason(errs.CodeInvalidArgument, "AVAILABILITY_INVALID_TIMEZONE", "timezone must be an IANA time zone such as Asia/Bangkok")
+	ErrInvalidDate       = errs.NewWithReason(errs.CodeInvalidArgument, "AVAILABILITY_INVALID_DATE", "dates must use the YYYY-MM-DD format")
+	ErrInvalidStartTime  = errs.NewWithReason(errs.CodeInvalidArgument, "AVAILABILITY_INVALID_START_TIME", "start time must use the HH:MM format")
+	ErrInvalidDuration   = errs.NewWithReason(errs.CodeInvalidArgument, "AVAILABILITY_INVALID_DURATION", "slot duration must be between 15 minutes and 24 hours")
+	ErrTooManySlots      = errs.NewWithReason(errs.CodeInvalidArgument, "AVAILABILITY_TOO_MANY_SLOTS", "too many recurring slots")
+	ErrTooManyExceptions = errs.NewWithReason(errs.CodeInvalidArgument, "AVAILABILITY_TOO_MANY_EXCEPTIONS", "too many availability exceptions")
+	ErrInvalidException  = errs.NewWithReason(errs.CodeInvalidArgument, "AVAILABILITY_INVALID_EXCEPTION", "exceptions need a known kind and an end after their start, at most 31 days later")
+	ErrInvalidWindow     = errs.NewWithReason(errs.CodeInvalidArgument, "AVAILABILITY_INVALID_WINDOW", "the window must end after it starts and span at most 31 days")
+	ErrBlackoutConflict  = errs.NewWithReason(errs.CodeConflict, "AVAILABILITY_BLACKOUT_CONFLICT", "a blackout overlaps a booked task")
+	ErrSkillRequired     = errs.NewWithReason(errs.CodeInvalidArgument, "AVAILABILITY_SKILL_REQUIRED", "a skill code is required")
+)
+
+func invalidRule(reason string) error {
+	return errs.NewWithReason(errs.CodeInvalidArgument, "AVAILABILITY_INVALID_RECURRENCE_RULE", fmt.Sprintf("recurrence rule %s", reason))
+}
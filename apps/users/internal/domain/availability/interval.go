@@ -0,0 +1,86 @@
+package availability
+
+import (
+	"slices"
+	"time"
+)
+
+// Interval is a half-open span of absolute time [Start, End).
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+func (i Interval) IsValid() bool {
+	return i.End.After(i.Start)
+}
+
+func (i Interval) Overlaps(o Interval) bool {
+	return i.Start.Before(o.End) && o.Start.Before(i.End)
+}
+
+func (i Interval) Contains(o Interval) bool {
+	return !o.Start.Before(i.Start) && !o.End.After(i.End)
+}
+
+// normalize sorts the intervals and merges any that overlap or touch.
+func normalize(ivs []Interval) []Interval {
+	if len(ivs) == 0 {
+		return ivs
+	}
+	sorted := slices.Clone(ivs)
+	slices.SortFunc(sorted, func(a, b Interval) int { return a.Start.Compare(b.Start) })
+
+	merged := []Interval{sorted[0]}
+	for _, iv := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if !iv.Start.After(last.End) {
+			if iv.End.After(last.End) {
+				last.End = iv.End
+			}
+			continue
+		}
+		merged = append(merged, iv)
+	}
+	return merged
+}
+
+// subtract removes every span in cut from the normalized intervals in base.
+func subtract(base, cut []Interval) []Interval {
+	result := base
+	for _, c := range cut {
+		next := make([]Interval, 0, len(result))
+		for _, b := range result {
+			if !b.Overlaps(c) {
+				next = append(next, b)
+				continue
+			}
+			if b.Start.Before(c.Start) {
+				next = append(next, Interval{Start: b.Start, End: c.Start})
+			}
+			if c.End.Before(b.End) {
+				next = append(next, Interval{Start: c.End, End: b.End})
+			}
+		}
+		result = next
+	}
+	return result
+}
+
+// clip trims the intervals to the window and drops those outside it.
+func clip(ivs []Interval, window Interval) []Interval {
+	result := make([]Interval, 0, len(ivs))
+	for _, iv := range ivs {
+		if !iv.Overlaps(window) {
+			continue
+		}
+		if iv.Start.Before(window.Start) {
+			iv.Start = window.Start
+		}
+		if iv.End.After(window.End) {
+			iv.End = window.End
+		}
+		result = append(result, iv)
+	}
+	return result
+}
@@ -0,0 +1,21 @@
+package availability
+
+import (
+	"context"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+)
+
+// ScheduleRepository provides access to availability schedules.
+type ScheduleRepository interface {
+	// FindByUserID retrieves a tasker's schedule.
+	FindByUserID(ctx context.Context, userID ids.UserID) (*Schedule, error)
+
+	// FindBySkill returns the schedules of visible taskers offering the skill,
+	// ordered by user ID after the given one, with only the exceptions that
+	// overlap the window loaded.
+	FindBySkill(ctx context.Context, skillCode string, window Interval, afterUserID *ids.UserID, limit int) ([]Schedule, error)
+
+	// Save persists a schedule, replacing its slots and exceptions.
+	Save(ctx context.Context, schedule *Schedule) error
+}
@@ -0,0 +1,210 @@
+package availability
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the RRULE FREQ part. Only the frequencies a weekly calendar
+// needs are supported.
+type Frequency string
+
+const (
+	FrequencyDaily  Frequency = "DAILY"
+	FrequencyWeekly Frequency = "WEEKLY"
+)
+
+const (
+	maxInterval = 52
+	maxCount    = 1000
+	dateLayout  = "2006-01-02"
+	untilLayout = "20060102"
+)
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// RecurrenceRule is the subset of an RFC 5545 RRULE used for availability:
+// FREQ (DAILY or WEEKLY), INTERVAL, BYDAY, UNTIL (a date) and COUNT. Weeks
+// start on Monday. Dates are civil dates in the tasker's time zone, held as
+// midnight UTC so date arithmetic never crosses a DST transition.
+type RecurrenceRule struct {
+	Freq     Frequency
+	Interval int
+	ByDay    []time.Weekday
+	Until    *time.Time
+	Count    int
+}
+
+// ParseRecurrenceRule parses e.g. "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;UNTIL=20261231".
+// An optional "RRULE:" prefix is accepted.
+func ParseRecurrenceRule(s string) (RecurrenceRule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	r := RecurrenceRule{Interval: 1}
+	if s == "" {
+		return r, invalidRule("is empty")
+	}
+
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return r, invalidRule(fmt.Sprintf("has a malformed part %q", part))
+		}
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			r.Freq = Frequency(strings.ToUpper(value))
+			if r.Freq != FrequencyDaily && r.Freq != FrequencyWeekly {
+				return r, invalidRule("FREQ must be DAILY or WEEKLY")
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 || n > maxInterval {
+				return r, invalidRule(fmt.Sprintf("INTERVAL must be between 1 and %d", maxInterval))
+			}
+			r.Interval = n
+		case "BYDAY":
+			for _, code := range strings.Split(strings.ToUpper(value), ",") {
+				wd, ok := weekdayCodes[code]
+				if !ok {
+					return r, invalidRule(fmt.Sprintf("BYDAY has an unknown day %q", code))
+				}
+				if !slices.Contains(r.ByDay, wd) {
+					r.ByDay = append(r.ByDay, wd)
+				}
+			}
+		case "UNTIL":
+			// Only the date part matters: availability ends with that local day.
+			if len(value) < len(untilLayout) {
+				return r, invalidRule("UNTIL must be a date such as 20261231")
+			}
+			until, err := time.Parse(untilLayout, value[:len(untilLayout)])
+			if err != nil {
+				return r, invalidRule("UNTIL must be a date such as 20261231")
+			}
+			r.Until = &until
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 || n > maxCount {
+				return r, invalidRule(fmt.Sprintf("COUNT must be between 1 and %d", maxCount))
+			}
+			r.Count = n
+		default:
+			return r, invalidRule(fmt.Sprintf("part %s is not supported", key))
+		}
+	}
+
+	if r.Freq == "" {
+		return r, invalidRule("FREQ is required")
+	}
+	if r.Until != nil && r.Count > 0 {
+		return r, invalidRule("UNTIL and COUNT cannot both be set")
+	}
+	return r, nil
+}
+
+// String formats the rule in canonical RRULE form, which is what gets stored.
+func (r RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := slices.Clone(r.ByDay)
+		slices.SortFunc(days, func(a, b time.Weekday) int { return mondayIndex(a) - mondayIndex(b) })
+		codes := make([]string, 0, len(days))
+		for _, wd := range days {
+			codes = append(codes, strings.ToUpper(wd.String()[:2]))
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.Format(untilLayout))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	return strings.Join(parts, ";")
+}
+
+// occurrences returns the civil dates on or after dtstart that match the rule
+// and fall within [from, to]. COUNT is applied from dtstart, so dates before
+// from are still walked to keep the count right.
+func (r RecurrenceRule) occurrences(dtstart, from, to time.Time) []time.Time {
+	byDay := r.ByDay
+	if len(byDay) == 0 && r.Freq == FrequencyWeekly {
+		byDay = []time.Weekday{dtstart.Weekday()}
+	}
+	last := to
+	if r.Until != nil && r.Until.Before(last) {
+		last = *r.Until
+	}
+
+	interval := max(r.Interval, 1)
+	weekStart := dtstart.AddDate(0, 0, -mondayIndex(dtstart.Weekday()))
+
+	var dates []time.Time
+	seen := 0
+	for d := dtstart; !d.After(last); d = d.AddDate(0, 0, 1) {
+		if r.Count == 0 && d.Before(from) {
+			// Without COUNT nothing before the window affects the result.
+			d = from.AddDate(0, 0, -1)
+			continue
+		}
+		if len(byDay) > 0 && !slices.Contains(byDay, d.Weekday()) {
+			continue
+		}
+		days := int(d.Sub(dtstart).Hours() / 24)
+		switch r.Freq {
+		case FrequencyDaily:
+			if days%interval != 0 {
+				continue
+			}
+		case FrequencyWeekly:
+			if (int(d.Sub(weekStart).Hours()/24)/7)%interval != 0 {
+				continue
+			}
+		}
+		seen++
+		if r.Count > 0 && seen > r.Count {
+			break
+		}
+		if !d.Before(from) {
+			dates = append(dates, d)
+		}
+	}
+	return dates
+}
+
+// ParseDate parses a YYYY-MM-DD civil date.
+func ParseDate(s string) (time.Time, error) {
+	d, err := time.Parse(dateLayout, strings.TrimSpace(s))
+	if err != nil {
+		return time.Time{}, ErrInvalidDate
+	}
+	return d, nil
+}
+
+// FormatDate formats a civil date as YYYY-MM-DD.
+func FormatDate(d time.Time) string {
+	return d.Format(dateLayout)
+}
+
+// civilDate returns the calendar date of t in loc as midnight UTC.
+func civilDate(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func mondayIndex(wd time.Weekday) int {
+	return (int(wd) + 6) % 7
+}
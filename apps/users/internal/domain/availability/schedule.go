@@ -0,0 +1,262 @@
+package availability
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+)
+
+const (
+	MaxSlots          = 50
+	MaxExceptions     = 200
+	MaxWindow         = 31 * 24 * time.Hour
+	minSlotDuration   = 15
+	maxSlotDuration   = 24 * 60
+	maxExceptionSpan  = 31 * 24 * time.Hour
+	startTimeLayout   = "15:04"
+	expansionLeadDays = 1
+)
+
+// RecurringSlot is a block of wall-clock time repeated by a rule, e.g. 09:00
+// for eight hours every weekday. Times are in the schedule's time zone, so the
+// slot keeps its local hours across DST changes.
+type RecurringSlot struct {
+	ID              uuid.UUID
+	Rule            RecurrenceRule
+	StartDate       time.Time
+	StartMinute     int
+	DurationMinutes int
+}
+
+func NewRecurringSlot(rrule, startDate, startTime string, durationMinutes int) (RecurringSlot, error) {
+	rule, err := ParseRecurrenceRule(rrule)
+	if err != nil {
+		return RecurringSlot{}, err
+	}
+	date, err := ParseDate(startDate)
+	if err != nil {
+		return RecurringSlot{}, err
+	}
+	clock, err := time.Parse(startTimeLayout, strings.TrimSpace(startTime))
+	if err != nil {
+		return RecurringSlot{}, ErrInvalidStartTime
+	}
+	if durationMinutes < minSlotDuration || durationMinutes > maxSlotDuration {
+		return RecurringSlot{}, ErrInvalidDuration
+	}
+	return RecurringSlot{
+		ID:              uuid.New(),
+		Rule:            rule,
+		StartDate:       date,
+		StartMinute:     clock.Hour()*60 + clock.Minute(),
+		DurationMinutes: durationMinutes,
+	}, nil
+}
+
+// StartTime formats the slot's local start as HH:MM.
+func (s RecurringSlot) StartTime() string {
+	return fmt.Sprintf("%02d:%02d", s.StartMinute/60, s.StartMinute%60)
+}
+
+// expand returns the slot's occurrences in loc that start on a local date
+// between from and to. Start and end are built from the wall clock with
+// time.Date, so a 09:00-17:00 slot is 09:00-17:00 on both sides of a DST
+// change; a start inside a spring-forward gap is moved past the gap.
+func (s RecurringSlot) expand(loc *time.Location, from, to time.Time) []Interval {
+	dates := s.Rule.occurrences(s.StartDate, from, to)
+	result := make([]Interval, 0, len(dates))
+	for _, d := range dates {
+		start := time.Date(d.Year(), d.Month(), d.Day(), 0, s.StartMinute, 0, 0, loc)
+		end := time.Date(d.Year(), d.Month(), d.Day(), 0, s.StartMinute+s.DurationMinutes, 0, 0, loc)
+		if end.After(start) {
+			result = append(result, Interval{Start: start, End: end})
+		}
+	}
+	return result
+}
+
+// ExceptionKind tells whether a one-off exception adds or removes time.
+type ExceptionKind string
+
+const (
+	ExceptionKindAvailable ExceptionKind = "AVAILABLE"
+	ExceptionKindBlackout  ExceptionKind = "BLACKOUT"
+)
+
+func (k ExceptionKind) IsValid() bool {
+	return k == ExceptionKindAvailable || k == ExceptionKindBlackout
+}
+
+// Exception is a one-off change to the recurring schedule.
+type Exception struct {
+	ID     uuid.UUID
+	Kind   ExceptionKind
+	Period Interval
+	Reason *string
+}
+
+func NewException(kind ExceptionKind, startsAt, endsAt time.Time, reason *string) (Exception, error) {
+	period := Interval{Start: startsAt.UTC(), End: endsAt.UTC()}
+	if !kind.IsValid() || !period.IsValid() || period.End.Sub(period.Start) > maxExceptionSpan {
+		return Exception{}, ErrInvalidException
+	}
+	return Exception{ID: uuid.New(), Kind: kind, Period: period, Reason: reason}, nil
+}
+
+// NewBlackoutDate blocks a whole local day. The day is 23 or 25 hours long
+// when it contains a DST change.
+func NewBlackoutDate(date string, loc *time.Location, reason *string) (Exception, error) {
+	d, err := ParseDate(date)
+	if err != nil {
+		return Exception{}, err
+	}
+	start := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, loc)
+	end := time.Date(d.Year(), d.Month(), d.Day()+1, 0, 0, 0, 0, loc)
+	return NewException(ExceptionKindBlackout, start, end, reason)
+}
+
+// Schedule is a tasker's availability: recurring slots in their time zone plus
+// one-off exceptions.
+type Schedule struct {
+	UserID     ids.UserID
+	Timezone   string
+	Slots      []RecurringSlot
+	Exceptions []Exception
+	UpdatedAt  time.Time
+	location   *time.Location
+}
+
+func NewSchedule(userID ids.UserID, timezone string, slots []RecurringSlot, exceptions []Exception) (*Schedule, error) {
+	s := &Schedule{UserID: userID}
+	if err := s.Replace(timezone, slots, exceptions); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func NewScheduleFromRepository(
+	userID string,
+	timezone string,
+	slots []RecurringSlot,
+	exceptions []Exception,
+	updatedAt time.Time,
+) *Schedule {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return &Schedule{
+		UserID:     ids.UserID(userID),
+		Timezone:   timezone,
+		Slots:      slots,
+		Exceptions: exceptions,
+		UpdatedAt:  updatedAt,
+		location:   loc,
+	}
+}
+
+// Replace swaps the whole schedule after validating it.
+func (s *Schedule) Replace(timezone string, slots []RecurringSlot, exceptions []Exception) error {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "" {
+		return ErrInvalidTimezone
+	}
+	if len(slots) > MaxSlots {
+		return ErrTooManySlots
+	}
+	if len(exceptions) > MaxExceptions {
+		return ErrTooManyExceptions
+	}
+	s.Timezone = timezone
+	s.location = loc
+	s.Slots = slots
+	s.Exceptions = exceptions
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// Location is the schedule's time zone.
+func (s *Schedule) Location() *time.Location {
+	if s.location == nil {
+		return time.UTC
+	}
+	return s.location
+}
+
+// AvailableIntervals returns when the tasker is free within the window:
+// recurring slots and AVAILABLE exceptions, minus blackouts, merged and
+// clipped to the window. Booked tasks are not taken into account here.
+func (s *Schedule) AvailableIntervals(window Interval) []Interval {
+	loc := s.Location()
+	// Start a day early so overnight slots that began the previous evening
+	// are included.
+	from := civilDate(window.Start, loc).AddDate(0, 0, -expansionLeadDays)
+	to := civilDate(window.End, loc)
+
+	var open, blocked []Interval
+	for _, slot := range s.Slots {
+		open = append(open, slot.expand(loc, from, to)...)
+	}
+	for _, e := range s.Exceptions {
+		if !e.Period.Overlaps(window) {
+			continue
+		}
+		switch e.Kind {
+		case ExceptionKindAvailable:
+			open = append(open, e.Period)
+		case ExceptionKindBlackout:
+			blocked = append(blocked, e.Period)
+		}
+	}
+	return clip(subtract(normalize(open), normalize(blocked)), window)
+}
+
+// FreeIntervals is AvailableIntervals with booked time removed.
+func (s *Schedule) FreeIntervals(window Interval, booked []Interval) []Interval {
+	return subtract(s.AvailableIntervals(window), normalize(booked))
+}
+
+// HasFreeSpan reports whether the tasker has at least d of uninterrupted free
+// time inside the window; a zero d asks for the whole window.
+func (s *Schedule) HasFreeSpan(window Interval, booked []Interval, d time.Duration) bool {
+	if d <= 0 {
+		d = window.End.Sub(window.Start)
+	}
+	for _, iv := range s.FreeIntervals(window, booked) {
+		if iv.End.Sub(iv.Start) >= d {
+			return true
+		}
+	}
+	return false
+}
+
+// BlackoutConflicts returns the booked intervals that a blackout would cancel.
+func (s *Schedule) BlackoutConflicts(booked []Interval) []Interval {
+	var conflicts []Interval
+	for _, b := range booked {
+		for _, e := range s.Exceptions {
+			if e.Kind == ExceptionKindBlackout && e.Period.Overlaps(b) {
+				conflicts = append(conflicts, b)
+				break
+			}
+		}
+	}
+	return conflicts
+}
+
+// ValidateWindow checks a search window's bounds.
+func ValidateWindow(window Interval) error {
+	if !window.IsValid() || window.End.Sub(window.Start) > MaxWindow {
+		return ErrInvalidWindow
+	}
+	return nil
+}
+
+// BookingCalendar reports the time taskers have already committed to tasks.
+type BookingCalendar interface {
+	BookedIntervals(ctx context.Context, userIDs []ids.UserID, window Interval) (map[ids.UserID][]Interval, error)
+}
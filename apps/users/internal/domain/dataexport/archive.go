@@ -0,0 +1,228 @@
+package dataexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ArchiveSchemaVersion is bumped whenever the archive layout changes in a
+// way that consumers need to know about.
+const ArchiveSchemaVersion = 1
+
+const archiveJSONFileName = "user-data.json"
+
+type Archive struct {
+	SchemaVersion         int                           `json:"schemaVersion"`
+	ExportID              string                        `json:"exportId"`
+	GeneratedAt           time.Time                     `json:"generatedAt"`
+	User                  ArchiveUser                   `json:"user"`
+	Profile               ArchiveProfile                `json:"profile"`
+	Roles                 []string                      `json:"roles"`
+	IdentityVerifications []ArchiveIdentityVerification `json:"identityVerifications"`
+	// TaskerProfile, Availability and Acquisition are left out for users
+	// who have none.
+	TaskerProfile *ArchiveTaskerProfile `json:"taskerProfile,omitempty"`
+	Availability  *ArchiveAvailability  `json:"availability,omitempty"`
+	Acquisition   *ArchiveAcquisition   `json:"acquisition,omitempty"`
+	Sessions      []ArchiveSession      `json:"sessions"`
+	AuditHistory  []ArchiveAuditEntry   `json:"auditHistory"`
+}
+
+type ArchiveUser struct {
+	ID          string     `json:"id"`
+	LineUserID  string     `json:"lineUserId"`
+	Email       *string    `json:"email,omitempty"`
+	Status      string     `json:"status"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	LastLoginAt *time.Time `json:"lastLoginAt,omitempty"`
+}
+
+type ArchiveProfile struct {
+	DisplayName       string          `json:"displayName"`
+	FirstName         *string         `json:"firstName,omitempty"`
+	LastName          *string         `json:"lastName,omitempty"`
+	Bio               *string         `json:"bio,omitempty"`
+	AvatarURL         *string         `json:"avatarUrl,omitempty"`
+	PhoneNumber       *string         `json:"phoneNumber,omitempty"`
+	Address           *string         `json:"address,omitempty"`
+	StructuredAddress *ArchiveAddress `json:"structuredAddress,omitempty"`
+	Preferences       map[string]any  `json:"preferences"`
+	Locale            string          `json:"locale"`
+	Timezone          string          `json:"timezone"`
+}
+
+type ArchiveAddress struct {
+	SubDistrict *string  `json:"subDistrict,omitempty"`
+	District    *string  `json:"district,omitempty"`
+	Province    string   `json:"province"`
+	PostalCode  *string  `json:"postalCode,omitempty"`
+	CountryCode string   `json:"countryCode"`
+	Latitude    *float64 `json:"latitude,omitempty"`
+	Longitude   *float64 `json:"longitude,omitempty"`
+}
+
+type ArchiveIdentityVerification struct {
+	ID              string     `json:"id"`
+	DocumentType    string     `json:"documentType"`
+	DocumentNumber  string     `json:"documentNumber"`
+	DocumentURLs    []string   `json:"documentUrls"`
+	Status          string     `json:"status"`
+	SubmittedAt     time.Time  `json:"submittedAt"`
+	VerifiedAt      *time.Time `json:"verifiedAt,omitempty"`
+	RejectionReason string     `json:"rejectionReason,omitempty"`
+}
+
+type ArchiveTaskerProfile struct {
+	Headline          *string                `json:"headline,omitempty"`
+	SkillCodes        []string               `json:"skillCodes"`
+	HourlyRate        ArchiveHourlyRate      `json:"hourlyRate"`
+	ServiceAreas      []ArchiveServiceArea   `json:"serviceAreas"`
+	Portfolio         []ArchivePortfolioItem `json:"portfolio"`
+	YearsOfExperience int                    `json:"yearsOfExperience"`
+	IsVisible         bool                   `json:"isVisible"`
+	CreatedAt         time.Time              `json:"createdAt"`
+	UpdatedAt         time.Time              `json:"updatedAt"`
+}
+
+// ArchiveHourlyRate is in minor currency units, as stored.
+type ArchiveHourlyRate struct {
+	MinAmount int64  `json:"minAmount"`
+	MaxAmount int64  `json:"maxAmount"`
+	Currency  string `json:"currency"`
+}
+
+type ArchiveServiceArea struct {
+	Province   string   `json:"province"`
+	District   *string  `json:"district,omitempty"`
+	PostalCode *string  `json:"postalCode,omitempty"`
+	Latitude   *float64 `json:"latitude,omitempty"`
+	Longitude  *float64 `json:"longitude,omitempty"`
+}
+
+type ArchivePortfolioItem struct {
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Description *string    `json:"description,omitempty"`
+	ImageURLs   []string   `json:"imageUrls"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+type ArchiveAvailability struct {
+	Timezone   string                         `json:"timezone"`
+	Slots      []ArchiveAvailabilitySlot      `json:"slots"`
+	Exceptions []ArchiveAvailabilityException `json:"exceptions"`
+	UpdatedAt  time.Time                      `json:"updatedAt"`
+}
+
+// ArchiveAvailabilitySlot keeps the wall-clock form the tasker entered,
+// in the schedule's time zone.
+type ArchiveAvailabilitySlot struct {
+	RRule           string `json:"rrule"`
+	StartDate       string `json:"startDate"`
+	StartTime       string `json:"startTime"`
+	DurationMinutes int    `json:"durationMinutes"`
+}
+
+type ArchiveAvailabilityException struct {
+	Kind     string    `json:"kind"`
+	StartsAt time.Time `json:"startsAt"`
+	EndsAt   time.Time `json:"endsAt"`
+	Reason   *string   `json:"reason,omitempty"`
+}
+
+// ArchiveAcquisition is how the user found the service, as recorded at
+// registration.
+type ArchiveAcquisition struct {
+	ReferralCode *string        `json:"referralCode,omitempty"`
+	Channel      *string        `json:"channel,omitempty"`
+	Campaign     *string        `json:"campaign,omitempty"`
+	Metadata     map[string]any `json:"metadata,omitempty"`
+	CreatedAt    time.Time      `json:"createdAt"`
+}
+
+// ArchiveSession is reserved for server-side sessions. Users sign in with
+// LINE, which keeps no sessions here, so the section is always empty; it is
+// kept so the schema need not change if sessions are ever stored.
+type ArchiveSession struct {
+	ID         string     `json:"id"`
+	UserAgent  string     `json:"userAgent,omitempty"`
+	IPAddress  string     `json:"ipAddress,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastSeenAt *time.Time `json:"lastSeenAt,omitempty"`
+}
+
+// ArchiveAuditEntry is one thing that happened to the account. Details
+// never name other people, such as the admin who reviewed a document, and
+// carry document numbers only redacted.
+type ArchiveAuditEntry struct {
+	Action     string         `json:"action"`
+	OccurredAt time.Time      `json:"occurredAt"`
+	Details    map[string]any `json:"details,omitempty"`
+}
+
+// Actions of the audit history.
+const (
+	AuditActionAccountCreated        = "ACCOUNT_CREATED"
+	AuditActionSignedIn              = "SIGNED_IN"
+	AuditActionVerificationSubmitted = "IDENTITY_VERIFICATION_SUBMITTED"
+	AuditActionVerificationApproved  = "IDENTITY_VERIFICATION_APPROVED"
+	AuditActionVerificationRejected  = "IDENTITY_VERIFICATION_REJECTED"
+)
+
+// RedactDocumentNumber masks every character except the last four so the
+// archive proves which document was used without reproducing it in full.
+func RedactDocumentNumber(number string) string {
+	const visible = 4
+	runes := []rune(number)
+	if len(runes) <= visible {
+		return strings.Repeat("*", len(runes))
+	}
+	return strings.Repeat("*", len(runes)-visible) + string(runes[len(runes)-visible:])
+}
+
+// Encode renders the archive in the requested format and returns the bytes
+// together with their content type.
+func (a *Archive) Encode(format ExportFormat) ([]byte, string, error) {
+	payload, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal archive: %w", err)
+	}
+
+	switch format {
+	case ExportFormatJSON:
+		return payload, "application/json", nil
+	case ExportFormatZIP:
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		w, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     archiveJSONFileName,
+			Method:   zip.Deflate,
+			Modified: a.GeneratedAt,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to create zip entry: %w", err)
+		}
+		if _, err := w.Write(payload); err != nil {
+			return nil, "", fmt.Errorf("failed to write zip entry: %w", err)
+		}
+		if err := zw.Close(); err != nil {
+			return nil, "", fmt.Errorf("failed to finalize zip archive: %w", err)
+		}
+		return buf.Bytes(), "application/zip", nil
+	default:
+		return nil, "", ErrUnsupportedExportFormat
+	}
+}
+
+// FileExtension returns the extension used for stored archives of this format.
+func (f ExportFormat) FileExtension() string {
+	if f == ExportFormatZIP {
+		return "zip"
+	}
+	return "json"
+}
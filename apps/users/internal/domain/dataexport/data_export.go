@@ -0,0 +1,140 @@
+package dataexport
+
+import (
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+)
+
+type ExportStatus string
+
+const (
+	ExportStatusPending   ExportStatus = "PENDING"
+	ExportStatusRunning   ExportStatus = "RUNNING"
+	ExportStatusCompleted ExportStatus = "COMPLETED"
+	ExportStatusFailed    ExportStatus = "FAILED"
+)
+
+type ExportFormat string
+
+const (
+	ExportFormatJSON ExportFormat = "JSON"
+	ExportFormatZIP  ExportFormat = "ZIP"
+)
+
+func (f ExportFormat) IsValid() bool {
+	return f == ExportFormatJSON || f == ExportFormatZIP
+}
+
+// DataExport tracks a single data portability job for a user.
+type DataExport struct {
+	ID            ids.DataExportID
+	UserID        ids.UserID
+	Format        ExportFormat
+	Status        ExportStatus
+	SchemaVersion int
+	StorageKey    *string
+	SizeBytes     int64
+	FailureReason *string
+	RequestedAt   time.Time
+	StartedAt     *time.Time
+	CompletedAt   *time.Time
+	ExpiresAt     *time.Time
+}
+
+func NewDataExport(userID ids.UserID, format ExportFormat) (*DataExport, error) {
+	if !format.IsValid() {
+		return nil, ErrUnsupportedExportFormat
+	}
+	return &DataExport{
+		ID:            ids.NewDataExportID(),
+		UserID:        userID,
+		Format:        format,
+		Status:        ExportStatusPending,
+		SchemaVersion: ArchiveSchemaVersion,
+		RequestedAt:   time.Now(),
+	}, nil
+}
+
+func NewDataExportFromRepository(
+	id string,
+	userID string,
+	format string,
+	status string,
+	schemaVersion int,
+	storageKey *string,
+	sizeBytes int64,
+	failureReason *string,
+	requestedAt time.Time,
+	startedAt *time.Time,
+	completedAt *time.Time,
+	expiresAt *time.Time,
+) *DataExport {
+	return &DataExport{
+		ID:            ids.DataExportID(id),
+		UserID:        ids.UserID(userID),
+		Format:        ExportFormat(format),
+		Status:        ExportStatus(status),
+		SchemaVersion: schemaVersion,
+		StorageKey:    storageKey,
+		SizeBytes:     sizeBytes,
+		FailureReason: failureReason,
+		RequestedAt:   requestedAt,
+		StartedAt:     startedAt,
+		CompletedAt:   completedAt,
+		ExpiresAt:     expiresAt,
+	}
+}
+
+func (e *DataExport) Start() error {
+	if e.Status != ExportStatusPending {
+		return ErrInvalidExportStatusTransition
+	}
+	now := time.Now()
+	e.Status = ExportStatusRunning
+	e.StartedAt = &now
+	return nil
+}
+
+func (e *DataExport) Complete(storageKey string, sizeBytes int64, retention time.Duration) error {
+	if e.Status != ExportStatusRunning {
+		return ErrInvalidExportStatusTransition
+	}
+	now := time.Now()
+	expiresAt := now.Add(retention)
+	e.Status = ExportStatusCompleted
+	e.StorageKey = &storageKey
+	e.SizeBytes = sizeBytes
+	e.CompletedAt = &now
+	e.ExpiresAt = &expiresAt
+	return nil
+}
+
+func (e *DataExport) Fail(reason string) error {
+	if e.Status == ExportStatusCompleted || e.Status == ExportStatusFailed {
+		return ErrInvalidExportStatusTransition
+	}
+	now := time.Now()
+	e.Status = ExportStatusFailed
+	e.FailureReason = &reason
+	e.CompletedAt = &now
+	return nil
+}
+
+// Purge drops the archive once its retention period is over, after the
+// storage deleted it. The job stays COMPLETED so its history is kept.
+func (e *DataExport) Purge(at time.Time) error {
+	if e.Status != ExportStatusCompleted || e.StorageKey == nil || e.ExpiresAt == nil || at.Before(*e.ExpiresAt) {
+		return ErrInvalidExportStatusTransition
+	}
+	e.StorageKey = nil
+	return nil
+}
+
+// IsDownloadable reports whether the archive can still be handed out at the given time.
+func (e *DataExport) IsDownloadable(at time.Time) bool {
+	return e.Status == ExportStatusCompleted &&
+		e.StorageKey != nil &&
+		e.ExpiresAt != nil &&
+		at.Before(*e.ExpiresAt)
+}
@@ -0,0 +1,10 @@
+package dataexport
+
+import errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+
+var (
+	ErrDataExportNotFound            = errs.NewWithReason(errs.CodeNotFound, "DATA_EXPORT_NOT_FOUND", "data export not found")
+	ErrDataExportAccessDenied        = errs.NewWithReason(errs.CodeForbidden, "DATA_EXPORT_ACCESS_DENIED", "only the user or an admin can export their data")
+	ErrUnsupportedExportFormat       = errs.NewWithReason(errs.CodeInvalidArgument, "DATA_EXPORT_UNSUPPORTED_EXPORT_FORMAT", "unsupported data export format")
+	ErrInvalidExportStatusTransition = errs.NewWithReason(errs.CodeFailedPrecondition, "DATA_EXPORT_INVALID_EXPORT_STATUS_TRANSITION", "invalid data export status transition")
+)
@@ -0,0 +1,25 @@
+package dataexport
+
+import (
+	"context"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+)
+
+// DataExportRepository is the interface that provides access to DataExport jobs.
+type DataExportRepository interface {
+	// FindByID retrieves a DataExport job by its ID.
+	FindByID(ctx context.Context, id ids.DataExportID) (*DataExport, error)
+
+	// ListUnfinished retrieves up to limit jobs requested before the given
+	// time that are still pending or running, oldest first.
+	ListUnfinished(ctx context.Context, requestedBefore time.Time, limit int) ([]*DataExport, error)
+
+	// ListExpired retrieves up to limit completed jobs whose archive expired
+	// before the given time and is still stored, oldest first.
+	ListExpired(ctx context.Context, expiredBefore time.Time, limit int) ([]*DataExport, error)
+
+	// Save persists a DataExport job (either creating or updating).
+	Save(ctx context.Context, export *DataExport) error
+}
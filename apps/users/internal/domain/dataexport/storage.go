@@ -0,0 +1,21 @@
+package dataexport
+
+import (
+	"context"
+	"time"
+)
+
+// ArchiveStorage is where finished export archives are written to and
+// handed out from. Implementations decide how download references are
+// produced, but they must stop working after the given TTL.
+type ArchiveStorage interface {
+	// Put stores the archive under key, replacing any previous object.
+	Put(ctx context.Context, key string, contentType string, data []byte) error
+
+	// DownloadURL returns a time-limited reference to the object stored under key.
+	DownloadURL(ctx context.Context, key string, ttl time.Duration) (string, time.Time, error)
+
+	// Delete removes the object stored under key; a missing object is not
+	// an error.
+	Delete(ctx context.Context, key string) error
+}
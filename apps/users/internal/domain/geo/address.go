@@ -0,0 +1,65 @@
+package geo
+
+import (
+	"regexp"
+	"strings"
+)
+
+// DefaultCountryCode is assumed when an address does not name a country.
+const DefaultCountryCode = "TH"
+
+var (
+	countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+	postalCodePattern  = regexp.MustCompile(`^[0-9A-Za-z -]{3,10}$`)
+)
+
+// Address is the administrative part of a location. Street-level detail stays
+// in the free-text profile address; this is what proximity matching uses.
+type Address struct {
+	SubDistrict *string
+	District    *string
+	Province    string
+	PostalCode  *string
+	CountryCode string
+	// Location is nil until the address has been geocoded.
+	Location *Point
+}
+
+// NewAddress trims and validates the parts of an address. An empty country
+// code defaults to DefaultCountryCode.
+func NewAddress(subDistrict, district *string, province string, postalCode *string, countryCode string, location *Point) (*Address, error) {
+	a := &Address{
+		SubDistrict: trimmed(subDistrict),
+		District:    trimmed(district),
+		Province:    strings.TrimSpace(province),
+		PostalCode:  trimmed(postalCode),
+		CountryCode: strings.ToUpper(strings.TrimSpace(countryCode)),
+		Location:    location,
+	}
+	if a.CountryCode == "" {
+		a.CountryCode = DefaultCountryCode
+	}
+	if a.Province == "" || !countryCodePattern.MatchString(a.CountryCode) {
+		return nil, ErrInvalidAddress
+	}
+	if a.PostalCode != nil && !postalCodePattern.MatchString(*a.PostalCode) {
+		return nil, ErrInvalidPostalCode
+	}
+	if location != nil {
+		if _, err := NewPoint(location.Latitude, location.Longitude); err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
+func trimmed(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	v := strings.TrimSpace(*s)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
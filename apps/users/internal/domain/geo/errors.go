@@ -0,0 +1,12 @@
+package geo
+
+import errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+
+var (
+	ErrInvalidCoordinates = errs.NewWithReason(errs.CodeInvalidArgument, "GEO_INVALID_COORDINATES", "latitude must be between -90 and 90 and longitude between -180 and 180")
+	ErrInvalidAddress     = errs.NewWithReason(errs.CodeInvalidArgument, "GEO_INVALID_ADDRESS", "addresses need a province and a two-letter country code")
+	ErrInvalidPostalCode  = errs.NewWithReason(errs.CodeInvalidArgument, "GEO_INVALID_POSTAL_CODE", "postal code must be 3-10 letters, digits, spaces or dashes")
+	ErrInvalidRadius      = errs.NewWithReason(errs.CodeInvalidArgument, "GEO_INVALID_RADIUS", "search radius must be between 1 metre and 100 km")
+	ErrAddressNotFound    = errs.NewWithReason(errs.CodeInvalidArgument, "GEO_ADDRESS_NOT_FOUND", "address could not be located; provide coordinates")
+	ErrLocationRequired   = errs.NewWithReason(errs.CodeInvalidArgument, "GEO_LOCATION_REQUIRED", "a search location is required")
+)
@@ -0,0 +1,22 @@
+package geo
+
+import "context"
+
+// Geocoder resolves an address to a point. Implementations return
+// ErrAddressNotFound when nothing matches.
+type Geocoder interface {
+	Geocode(ctx context.Context, address Address) (Point, error)
+}
+
+// Locate fills in the location of an address that does not have one yet.
+func Locate(ctx context.Context, g Geocoder, a *Address) error {
+	if a.Location != nil {
+		return nil
+	}
+	p, err := g.Geocode(ctx, *a)
+	if err != nil {
+		return err
+	}
+	a.Location = &p
+	return nil
+}
@@ -0,0 +1,28 @@
+package geo
+
+import "math"
+
+// MaxSearchRadiusMeters bounds proximity searches so they stay on the index.
+const MaxSearchRadiusMeters = 100_000
+
+// Point is a WGS 84 coordinate, stored as geography(POINT, 4326).
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+func NewPoint(latitude, longitude float64) (Point, error) {
+	if math.IsNaN(latitude) || math.IsNaN(longitude) ||
+		latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 {
+		return Point{}, ErrInvalidCoordinates
+	}
+	return Point{Latitude: latitude, Longitude: longitude}, nil
+}
+
+// ValidateRadius checks a search radius in metres.
+func ValidateRadius(meters int) error {
+	if meters <= 0 || meters > MaxSearchRadiusMeters {
+		return ErrInvalidRadius
+	}
+	return nil
+}
@@ -3,5 +3,6 @@ package role
 import errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
 
 var (
-	ErrRoleNotFound = errs.New(errs.CodeNotFound, "role not found")
+	ErrRoleNotFound       = errs.NewWithReason(errs.CodeNotFound, "ROLE_NOT_FOUND", "role not found")
+	ErrRoleCacheNotLoaded = errs.NewWithReason(errs.CodeUnavailable, "ROLE_CACHE_NOT_LOADED", "roles have not been loaded yet")
 )
@@ -6,6 +6,9 @@ const (
 	RoleNamePoster RoleName = "POSTER"
 	RoleNameTasker RoleName = "TASKER"
 	RoleNameAdmin  RoleName = "ADMIN"
+	// RoleNameGateway is held by no user. The API gateway asserts it on the
+	// calls it makes for itself, such as resolving who signed in.
+	RoleNameGateway RoleName = "GATEWAY"
 )
 
 type Role struct {
@@ -20,6 +20,7 @@ type RoleCacheService struct {
 
 	rolesByName map[RoleName]Role
 	rolesByID   map[uint]Role
+	loadedAt    time.Time
 	rolesMutex  sync.RWMutex
 	initOnce    sync.Once
 
@@ -44,16 +45,20 @@ func NewRoleCacheService(
 	}
 }
 
+// initRetryInterval is how soon a failed load is retried while the cache
+// has never been loaded.
+const initRetryInterval = 30 * time.Second
+
 func (rcm *RoleCacheService) InitAndStartRefresh(parentCtx context.Context) error { // Renamed param for clarity
 	var initErr error
 	rcm.initOnce.Do(func() {
 		initErr = rcm.loadRolesFromDB(parentCtx) // Initial load can use parentCtx
 		if initErr != nil {
-			rcm.logger.Error("Failed initial load of roles from DB", "error", initErr)
-			return
+			rcm.logger.Error("Failed initial load of roles from DB", "error", initErr, "retry_in", initRetryInterval)
+		} else {
+			rcm.logger.Info("Initial roles loaded successfully.")
+			rcm.metricsRecorder.RecordRoleCacheInitSuccess()
 		}
-		rcm.logger.Info("Initial roles loaded successfully.")
-		rcm.metricsRecorder.RecordRoleCacheInitSuccess()
 
 		rcm.wg.Add(1)
 		go func() {
@@ -62,12 +67,15 @@ func (rcm *RoleCacheService) InitAndStartRefresh(parentCtx context.Context) erro
 			refreshCtx, refreshCancel := context.WithCancel(context.Background())
 			defer refreshCancel() // Ensure this child context is cancelled when goroutine exits
 
-			ticker := time.NewTicker(rcm.refreshInterval)
-			defer ticker.Stop()
+			// Until the first load succeeds the service cannot register
+			// users, so it is retried sooner than the cache is refreshed.
+			timer := time.NewTimer(rcm.nextLoadIn())
+			defer timer.Stop()
 
 			for {
 				select {
-				case <-ticker.C:
+				case <-timer.C:
+					initialized := rcm.CheckLoaded(refreshCtx) == nil
 					rcm.logger.Info("Refreshing roles cache...")
 					// Pass the refreshCtx to loadRolesFromDB
 					if err := rcm.loadRolesFromDB(refreshCtx); err != nil {
@@ -76,7 +84,11 @@ func (rcm *RoleCacheService) InitAndStartRefresh(parentCtx context.Context) erro
 					} else {
 						rcm.logger.Info("Roles cache refreshed successfully.")
 						rcm.metricsRecorder.RecordRoleCacheRefreshSuccess()
+						if !initialized {
+							rcm.metricsRecorder.RecordRoleCacheInitSuccess()
+						}
 					}
+					timer.Reset(rcm.nextLoadIn())
 				case <-rcm.stopRefreshChan: // Explicit stop signal
 					rcm.logger.Info("Stopping roles cache refresh goroutine by stop signal.")
 					return
@@ -90,6 +102,25 @@ func (rcm *RoleCacheService) InitAndStartRefresh(parentCtx context.Context) erro
 	return initErr
 }
 
+func (rcm *RoleCacheService) nextLoadIn() time.Duration {
+	if rcm.CheckLoaded(context.Background()) != nil {
+		return initRetryInterval
+	}
+	return rcm.refreshInterval
+}
+
+// CheckLoaded is the health check of the cache: it fails until the roles
+// have been loaded once, as users cannot be registered without them. A
+// failed refresh leaves the loaded roles in use, so it does not count.
+func (rcm *RoleCacheService) CheckLoaded(ctx context.Context) error {
+	rcm.rolesMutex.RLock()
+	defer rcm.rolesMutex.RUnlock()
+	if rcm.loadedAt.IsZero() {
+		return ErrRoleCacheNotLoaded
+	}
+	return nil
+}
+
 func (rcm *RoleCacheService) Stop() {
 	rcm.logger.Info("Signaling roles cache refresh goroutine to stop.")
 	close(rcm.stopRefreshChan)
@@ -120,6 +151,7 @@ func (rcm *RoleCacheService) loadRolesFromDB(ctx context.Context) error {
 	rcm.rolesMutex.Lock()
 	rcm.rolesByName = newRolesByName
 	rcm.rolesByID = newRolesByID
+	rcm.loadedAt = time.Now()
 	rcm.rolesMutex.Unlock()
 
 	span.SetStatus(codes.Ok, "Roles loaded from DB successfully")
@@ -131,9 +163,9 @@ func (rcm *RoleCacheService) loadRolesFromDB(ctx context.Context) error {
 func (rcm *RoleCacheService) GetRoleByName(name RoleName) (Role, error) {
 	rcm.rolesMutex.RLock()
 	defer rcm.rolesMutex.RUnlock()
-	if rcm.rolesByName == nil {
+	if rcm.loadedAt.IsZero() {
 		rcm.metricsRecorder.RecordRoleCacheMiss("uninitialized")
-		return Role{}, fmt.Errorf("role cache not initialized")
+		return Role{}, ErrRoleCacheNotLoaded
 	}
 	role, ok := rcm.rolesByName[name]
 	if !ok {
@@ -148,9 +180,9 @@ func (rcm *RoleCacheService) GetRoleByID(id uint) (Role, error) {
 	rcm.rolesMutex.RLock()
 	defer rcm.rolesMutex.RUnlock()
 
-	if rcm.rolesByID == nil {
+	if rcm.loadedAt.IsZero() {
 		rcm.metricsRecorder.RecordRoleCacheMiss("uninitialized")
-		return Role{}, fmt.Errorf("role cache not initialized")
+		return Role{}, ErrRoleCacheNotLoaded
 	}
 	role, ok := rcm.rolesByID[id]
 	if !ok {
@@ -0,0 +1,9 @@
+package ids
+
+import "github.com/pratchaya-maneechot/service-exchange/libs/utils"
+
+type DataExportID string
+
+func NewDataExportID() DataExportID {
+	return DataExportID(utils.UUID())
+}
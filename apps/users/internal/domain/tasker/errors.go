@@ -0,0 +1,23 @@
+package tasker
+
+import errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+
+var (
+	ErrTaskerProfileNotFound      = errs.NewWithReason(errs.CodeNotFound, "TASKER_PROFILE_NOT_FOUND", "tasker profile not found")
+	ErrTaskerProfileAlreadyExists = errs.NewWithReason(errs.CodeAlreadyExists, "TASKER_PROFILE_EXISTS", "tasker profile already exists")
+	ErrTaskerRoleRequired         = errs.NewWithReason(errs.CodeForbidden, "TASKER_ROLE_REQUIRED", "the TASKER role is required to manage a tasker profile")
+	ErrVerificationRequired       = errs.NewWithReason(errs.CodeForbidden, "TASKER_VERIFICATION_REQUIRED", "an approved identity verification is required to manage a tasker profile")
+	ErrNoSkills                   = errs.NewWithReason(errs.CodeInvalidArgument, "TASKER_NO_SKILLS", "at least one skill is required")
+	ErrTooManySkills              = errs.NewWithReason(errs.CodeInvalidArgument, "TASKER_TOO_MANY_SKILLS", "too many skills")
+	ErrUnknownSkillCategory       = errs.NewWithReason(errs.CodeInvalidArgument, "TASKER_UNKNOWN_SKILL_CATEGORY", "unknown or inactive skill category")
+	ErrInvalidHourlyRate          = errs.NewWithReason(errs.CodeInvalidArgument, "TASKER_INVALID_HOURLY_RATE", "hourly rate must be a non-negative range in a three-letter currency")
+	ErrInvalidServiceArea         = errs.NewWithReason(errs.CodeInvalidArgument, "TASKER_INVALID_SERVICE_AREA", "service areas need a province")
+	ErrTooManyServiceAreas        = errs.NewWithReason(errs.CodeInvalidArgument, "TASKER_TOO_MANY_SERVICE_AREAS", "too many service areas")
+	ErrInvalidPortfolioItem       = errs.NewWithReason(errs.CodeInvalidArgument, "TASKER_INVALID_PORTFOLIO_ITEM", "portfolio items need a title of at most 150 characters")
+	ErrTooManyPortfolioItems      = errs.NewWithReason(errs.CodeInvalidArgument, "TASKER_TOO_MANY_PORTFOLIO_ITEMS", "too many portfolio items")
+	ErrInvalidYearsOfExperience   = errs.NewWithReason(errs.CodeInvalidArgument, "TASKER_INVALID_YEARS_OF_EXPERIENCE", "years of experience must be between 0 and 80")
+	ErrInvalidHeadline            = errs.NewWithReason(errs.CodeInvalidArgument, "TASKER_INVALID_HEADLINE", "headline must be at most 150 characters")
+	ErrSkillCategoryNotFound      = errs.NewWithReason(errs.CodeNotFound, "TASKER_SKILL_CATEGORY_NOT_FOUND", "skill category not found")
+	ErrInvalidSkillCode           = errs.NewWithReason(errs.CodeInvalidArgument, "TASKER_INVALID_SKILL_CODE", "skill code must be 2-64 lower-case letters, digits or underscores, starting with a letter")
+	ErrInvalidSkillName           = errs.NewWithReason(errs.CodeInvalidArgument, "TASKER_INVALID_SKILL_NAME", "skill name is required and must be at most 100 characters")
+)
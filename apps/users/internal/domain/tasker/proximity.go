@@ -0,0 +1,57 @@
+package tasker
+
+import (
+	"strings"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/geo"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+)
+
+const (
+	DefaultProximityLimit = 20
+	MaxProximityLimit     = 100
+)
+
+// ProximitySearch finds taskers whose service areas lie within RadiusMeters
+// of Point, optionally restricted to one skill.
+type ProximitySearch struct {
+	Point        geo.Point
+	RadiusMeters int
+	SkillCode    *string
+	Limit        int
+}
+
+// NewProximitySearch validates the search and applies the default limit.
+func NewProximitySearch(point geo.Point, radiusMeters int, skillCode *string, limit int) (ProximitySearch, error) {
+	if _, err := geo.NewPoint(point.Latitude, point.Longitude); err != nil {
+		return ProximitySearch{}, err
+	}
+	if err := geo.ValidateRadius(radiusMeters); err != nil {
+		return ProximitySearch{}, err
+	}
+	if skillCode != nil {
+		code := strings.ToLower(strings.TrimSpace(*skillCode))
+		skillCode = &code
+		if code == "" {
+			skillCode = nil
+		}
+	}
+	if limit <= 0 {
+		limit = DefaultProximityLimit
+	}
+	return ProximitySearch{
+		Point:        point,
+		RadiusMeters: radiusMeters,
+		SkillCode:    skillCode,
+		Limit:        min(limit, MaxProximityLimit),
+	}, nil
+}
+
+// NearbyTasker is a proximity search hit: the tasker and their service area
+// nearest to the search point.
+type NearbyTasker struct {
+	UserID         ids.UserID
+	Headline       *string
+	NearestArea    ServiceArea
+	DistanceMeters float64
+}
@@ -0,0 +1,33 @@
+package tasker
+
+import (
+	"context"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+)
+
+// TaskerProfileRepository provides access to TaskerProfile aggregates.
+type TaskerProfileRepository interface {
+	// FindByUserID retrieves the tasker profile of a user.
+	FindByUserID(ctx context.Context, userID ids.UserID) (*TaskerProfile, error)
+
+	// Save persists a TaskerProfile aggregate (either creating or updating),
+	// replacing its skills, service areas and portfolio.
+	Save(ctx context.Context, profile *TaskerProfile) error
+
+	// FindNear returns visible taskers with a service area within the search
+	// radius, nearest first.
+	FindNear(ctx context.Context, search ProximitySearch) ([]NearbyTasker, error)
+}
+
+// SkillCatalogueRepository provides access to the skill category catalogue.
+type SkillCatalogueRepository interface {
+	// List returns the catalogue ordered by name, optionally including inactive entries.
+	List(ctx context.Context, includeInactive bool) ([]SkillCategory, error)
+
+	// FindByCodes returns the categories with the given codes; unknown codes are skipped.
+	FindByCodes(ctx context.Context, codes []string) ([]SkillCategory, error)
+
+	// Save creates or updates a category.
+	Save(ctx context.Context, category *SkillCategory) error
+}
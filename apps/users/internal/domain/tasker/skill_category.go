@@ -0,0 +1,73 @@
+package tasker
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+var skillCodePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,63}$`)
+
+// SkillCategory is an entry in the admin-managed catalogue of work a tasker can
+// offer. Categories are never deleted, only deactivated, so existing tasker
+// profiles keep resolving.
+type SkillCategory struct {
+	Code        string
+	Name        string
+	Description *string
+	IsActive    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewSkillCategory(code, name string, description *string, isActive bool) (*SkillCategory, error) {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if !skillCodePattern.MatchString(code) {
+		return nil, ErrInvalidSkillCode
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return nil, ErrInvalidSkillName
+	}
+	now := time.Now()
+	return &SkillCategory{
+		Code:        code,
+		Name:        name,
+		Description: description,
+		IsActive:    isActive,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+func NewSkillCategoryFromRepository(
+	code string,
+	name string,
+	description *string,
+	isActive bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *SkillCategory {
+	return &SkillCategory{
+		Code:        code,
+		Name:        name,
+		Description: description,
+		IsActive:    isActive,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+}
+
+// Update changes the display fields and availability of a category; the code
+// is its identity and cannot change.
+func (c *SkillCategory) Update(name string, description *string, isActive bool) error {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return ErrInvalidSkillName
+	}
+	c.Name = name
+	c.Description = description
+	c.IsActive = isActive
+	c.UpdatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,192 @@
+package tasker
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/geo"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+)
+
+const (
+	MaxSkills            = 20
+	MaxServiceAreas      = 20
+	MaxPortfolioItems    = 30
+	MaxYearsOfExperience = 80
+	maxHeadlineLength    = 150
+	maxTitleLength       = 150
+)
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// HourlyRateRange is what a tasker charges per hour, in minor currency units
+// (satang for THB).
+type HourlyRateRange struct {
+	MinAmount int64
+	MaxAmount int64
+	Currency  string
+}
+
+func (r HourlyRateRange) validate() error {
+	if r.MinAmount < 0 || r.MaxAmount < r.MinAmount || !currencyPattern.MatchString(r.Currency) {
+		return ErrInvalidHourlyRate
+	}
+	return nil
+}
+
+// ServiceArea is a place a tasker is willing to work. Location is its
+// geocoded centre and is what proximity searches measure from.
+type ServiceArea struct {
+	Province   string
+	District   *string
+	PostalCode *string
+	Location   *geo.Point
+}
+
+// PortfolioItem showcases a past job.
+type PortfolioItem struct {
+	ID          uuid.UUID
+	Title       string
+	Description *string
+	ImageURLs   []string
+	CompletedAt *time.Time
+}
+
+// TaskerDetails is the editable part of a tasker profile; create and update
+// take the whole set so the aggregate is always validated as a unit.
+type TaskerDetails struct {
+	Headline          *string
+	SkillCodes        []string
+	HourlyRate        HourlyRateRange
+	ServiceAreas      []ServiceArea
+	Portfolio         []PortfolioItem
+	YearsOfExperience int
+	IsVisible         bool
+}
+
+// TaskerProfile describes what a user holding the TASKER role offers.
+type TaskerProfile struct {
+	UserID            ids.UserID
+	Headline          *string
+	SkillCodes        []string
+	HourlyRate        HourlyRateRange
+	ServiceAreas      []ServiceArea
+	Portfolio         []PortfolioItem
+	YearsOfExperience int
+	IsVisible         bool
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func NewTaskerProfile(userID ids.UserID, details TaskerDetails) (*TaskerProfile, error) {
+	now := time.Now()
+	p := &TaskerProfile{
+		UserID:    userID,
+		CreatedAt: now,
+	}
+	if err := p.Update(details); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func NewTaskerProfileFromRepository(
+	userID string,
+	headline *string,
+	skillCodes []string,
+	hourlyRate HourlyRateRange,
+	serviceAreas []ServiceArea,
+	portfolio []PortfolioItem,
+	yearsOfExperience int,
+	isVisible bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *TaskerProfile {
+	return &TaskerProfile{
+		UserID:            ids.UserID(userID),
+		Headline:          headline,
+		SkillCodes:        skillCodes,
+		HourlyRate:        hourlyRate,
+		ServiceAreas:      serviceAreas,
+		Portfolio:         portfolio,
+		YearsOfExperience: yearsOfExperience,
+		IsVisible:         isVisible,
+		CreatedAt:         createdAt,
+		UpdatedAt:         updatedAt,
+	}
+}
+
+// Update replaces the editable details after validating them. Skill codes are
+// normalised here; whether they exist in the catalogue is checked by the caller.
+func (p *TaskerProfile) Update(d TaskerDetails) error {
+	if d.Headline != nil && len([]rune(*d.Headline)) > maxHeadlineLength {
+		return ErrInvalidHeadline
+	}
+
+	skills := make([]string, 0, len(d.SkillCodes))
+	for _, code := range d.SkillCodes {
+		code = strings.ToLower(strings.TrimSpace(code))
+		if code != "" && !slices.Contains(skills, code) {
+			skills = append(skills, code)
+		}
+	}
+	if len(skills) == 0 {
+		return ErrNoSkills
+	}
+	if len(skills) > MaxSkills {
+		return ErrTooManySkills
+	}
+
+	if err := d.HourlyRate.validate(); err != nil {
+		return err
+	}
+
+	if len(d.ServiceAreas) > MaxServiceAreas {
+		return ErrTooManyServiceAreas
+	}
+	for _, a := range d.ServiceAreas {
+		if strings.TrimSpace(a.Province) == "" {
+			return ErrInvalidServiceArea
+		}
+		if a.Location != nil {
+			if _, err := geo.NewPoint(a.Location.Latitude, a.Location.Longitude); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(d.Portfolio) > MaxPortfolioItems {
+		return ErrTooManyPortfolioItems
+	}
+	portfolio := make([]PortfolioItem, 0, len(d.Portfolio))
+	for _, item := range d.Portfolio {
+		item.Title = strings.TrimSpace(item.Title)
+		if item.Title == "" || len([]rune(item.Title)) > maxTitleLength {
+			return ErrInvalidPortfolioItem
+		}
+		if item.ID == uuid.Nil {
+			item.ID = uuid.New()
+		}
+		if item.ImageURLs == nil {
+			item.ImageURLs = []string{}
+		}
+		portfolio = append(portfolio, item)
+	}
+
+	if d.YearsOfExperience < 0 || d.YearsOfExperience > MaxYearsOfExperience {
+		return ErrInvalidYearsOfExperience
+	}
+
+	p.Headline = d.Headline
+	p.SkillCodes = skills
+	p.HourlyRate = d.HourlyRate
+	p.ServiceAreas = d.ServiceAreas
+	p.Portfolio = portfolio
+	p.YearsOfExperience = d.YearsOfExperience
+	p.IsVisible = d.IsVisible
+	p.UpdatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,37 @@
+package user
+
+import (
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+)
+
+// Acquisition records how a user found the service. It is captured once at
+// registration and never changed, so analytics can attribute sign-ups.
+type Acquisition struct {
+	UserID       ids.UserID
+	ReferralCode *string
+	Channel      *string
+	Campaign     *string
+	Metadata     map[string]any
+	CreatedAt    time.Time
+}
+
+func NewAcquisition(userID ids.UserID, referralCode, channel, campaign *string, metadata map[string]any) *Acquisition {
+	if metadata == nil {
+		metadata = make(map[string]any)
+	}
+	return &Acquisition{
+		UserID:       userID,
+		ReferralCode: referralCode,
+		Channel:      channel,
+		Campaign:     campaign,
+		Metadata:     metadata,
+		CreatedAt:    time.Now(),
+	}
+}
+
+// IsEmpty reports whether there is nothing worth storing.
+func (a *Acquisition) IsEmpty() bool {
+	return a.ReferralCode == nil && a.Channel == nil && a.Campaign == nil && len(a.Metadata) == 0
+}
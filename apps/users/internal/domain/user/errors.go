@@ -3,14 +3,22 @@ package user
 import errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
 
 var (
-	ErrUserNotFound                        = errs.New(errs.CodeNotFound, "user not found")
-	ErrLineUserIDAlreadyExists             = errs.New(errs.CodeAlreadyExists, "LINE user ID already exists")
-	ErrEmailAlreadyExists                  = errs.New(errs.CodeAlreadyExists, "email already exists")
-	ErrLineUserAlreadyExists               = errs.New(errs.CodeAlreadyExists, "line user already exists")
-	ErrMissingLineIDOrEmail                = errs.New(errs.CodeInvalidArgument, "either LINE user ID or email must be provided")
-	ErrInvalidCredentials                  = errs.New(errs.CodeUnauthorized, "invalid credentials")
-	ErrRoleAlreadyAssigned                 = errs.New(errs.CodeAlreadyExists, "role already assigned to user")
-	ErrInvalidVerificationStatusTransition = errs.New(errs.CodeInvalidArgument, "invalid identity verification status transition")
-	ErrMissingDocumentURLs                 = errs.New(errs.CodeInvalidArgument, "document URLs are required for identity verification")
-	ErrMissingDocumentType                 = errs.New(errs.CodeInvalidArgument, "document type is required for identity verification")
+	ErrUserNotFound                        = errs.NewWithReason(errs.CodeNotFound, "USER_NOT_FOUND", "user not found")
+	ErrLineUserIDAlreadyExists             = errs.NewWithReason(errs.CodeAlreadyExists, "USER_LINE_USER_ID_EXISTS", "LINE user ID already exists")
+	ErrEmailAlreadyExists                  = errs.NewWithReason(errs.CodeAlreadyExists, "USER_EMAIL_EXISTS", "email already exists")
+	ErrLineUserAlreadyExists               = errs.NewWithReason(errs.CodeAlreadyExists, "USER_LINE_ID_EXISTS", "line user already exists")
+	ErrMissingLineIDOrEmail                = errs.NewWithReason(errs.CodeInvalidArgument, "USER_MISSING_LINE_ID_OR_EMAIL", "either LINE user ID or email must be provided")
+	ErrInvalidCredentials                  = errs.NewWithReason(errs.CodeUnauthorized, "USER_INVALID_CREDENTIALS", "invalid credentials")
+	ErrRoleAlreadyAssigned                 = errs.NewWithReason(errs.CodeAlreadyExists, "USER_ROLE_ALREADY_ASSIGNED", "role already assigned to user")
+	ErrInvalidVerificationStatusTransition = errs.NewWithReason(errs.CodeFailedPrecondition, "USER_INVALID_VERIFICATION_STATUS_TRANSITION", "invalid identity verification status transition")
+	ErrMissingDocumentURLs                 = errs.NewWithReason(errs.CodeInvalidArgument, "USER_MISSING_DOCUMENT_URLS", "document URLs are required for identity verification")
+	ErrMissingDocumentType                 = errs.NewWithReason(errs.CodeInvalidArgument, "USER_MISSING_DOCUMENT_TYPE", "document type is required for identity verification")
+	ErrInvalidSearchCursor                 = errs.NewWithReason(errs.CodeInvalidArgument, "USER_INVALID_SEARCH_CURSOR", "invalid search cursor")
+	ErrProfileAccessDenied                 = errs.NewWithReason(errs.CodeForbidden, "USER_PROFILE_ACCESS_DENIED", "only the profile owner or an admin can view the full profile")
+	ErrAdminRequired                       = errs.NewWithReason(errs.CodeForbidden, "USER_ADMIN_REQUIRED", "admin role required")
+	ErrGatewayRequired                     = errs.NewWithReason(errs.CodeForbidden, "USER_GATEWAY_REQUIRED", "only the API gateway can resolve LINE accounts")
+	ErrRegistrationGatewayRequired         = errs.NewWithReason(errs.CodeForbidden, "USER_REGISTRATION_GATEWAY_REQUIRED", "only the API gateway can register LINE accounts")
+	ErrIdentityVerificationNotFound        = errs.NewWithReason(errs.CodeNotFound, "USER_IDENTITY_VERIFICATION_NOT_FOUND", "identity verification not found")
+	ErrMissingRejectionReason              = errs.NewWithReason(errs.CodeInvalidArgument, "USER_MISSING_REJECTION_REASON", "a reason is required to reject an identity verification")
+	ErrProfileUpdateDenied                 = errs.NewWithReason(errs.CodeForbidden, "USER_PROFILE_UPDATE_DENIED", "only the profile owner or an admin can update the profile")
 )
@@ -1,6 +1,54 @@
 package user
 
+import (
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+)
+
+// UserCreated is published after a user registered.
 type UserCreated struct {
-	Id      string
-	Payload User
+	UserID    ids.UserID
+	CreatedAt time.Time
+}
+
+func NewUserCreated(u *User) UserCreated {
+	return UserCreated{UserID: u.ID, CreatedAt: u.CreatedAt}
+}
+
+// IdentityVerificationDecided is published after an admin approved or
+// rejected an identity verification.
+type IdentityVerificationDecided struct {
+	VerificationID  string
+	UserID          ids.UserID
+	DocumentType    DocumentType
+	Status          VerificationStatus
+	RejectionReason string
+	DecidedAt       time.Time
+}
+
+func NewIdentityVerificationDecided(iv *IdentityVerification) IdentityVerificationDecided {
+	e := IdentityVerificationDecided{
+		VerificationID:  iv.ID.String(),
+		UserID:          iv.UserID,
+		DocumentType:    iv.DocumentType,
+		Status:          iv.Status,
+		RejectionReason: iv.RejectionReason,
+	}
+	if iv.VerifiedAt != nil {
+		e.DecidedAt = *iv.VerifiedAt
+	}
+	return e
+}
+
+// UserChanged is published after a user's profile or status was saved, so
+// that whoever watches the profile can read it again.
+type UserChanged struct {
+	UserID    ids.UserID
+	Status    UserStatus
+	ChangedAt time.Time
+}
+
+func NewUserChanged(u *User) UserChanged {
+	return UserChanged{UserID: u.ID, Status: u.Status, ChangedAt: u.UpdatedAt}
 }
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -108,6 +109,10 @@ func (iv *IdentityVerification) Reject(reviewerID ids.UserID, reason string) err
 	if iv.Status != VerificationStatusPending {
 		return ErrInvalidVerificationStatusTransition
 	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return ErrMissingRejectionReason
+	}
 	iv.Status = VerificationStatusRejected
 	now := time.Now()
 	iv.VerifiedAt = &now
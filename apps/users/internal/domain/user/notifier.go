@@ -0,0 +1,26 @@
+package user
+
+import "context"
+
+// Notice types, named after the events of the notifications service they
+// are delivered as.
+const (
+	NoticeUserCreated                  = "UserCreated"
+	NoticeIdentityVerificationApproved = "IdentityVerificationApproved"
+	NoticeIdentityVerificationRejected = "IdentityVerificationRejected"
+)
+
+// Notice is something that happened to a user's account that they are told
+// about, with the data its message is filled in with.
+type Notice struct {
+	// ID is unique per notice; delivering it again does nothing.
+	ID   string
+	Type string
+	Data map[string]any
+}
+
+// Notifier tells users about their account through the channels they
+// prefer. It is implemented by the notifications service.
+type Notifier interface {
+	Notify(ctx context.Context, u *User, n Notice) error
+}
@@ -0,0 +1,220 @@
+package user
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+)
+
+// PreferenceType is the JSON shape a preference value must have.
+type PreferenceType string
+
+const (
+	PreferenceTypeString     PreferenceType = "string"
+	PreferenceTypeBool       PreferenceType = "bool"
+	PreferenceTypeNumber     PreferenceType = "number"
+	PreferenceTypeStringList PreferenceType = "string_list"
+	PreferenceTypeObject     PreferenceType = "object"
+)
+
+// PreferenceDefinition registers one preference key. Values are kept in their
+// JSON-decoded form (string, bool, float64, []any, map[string]any) so they
+// round-trip through JSONB and google.protobuf.Struct unchanged.
+type PreferenceDefinition struct {
+	Key     string
+	Type    PreferenceType
+	Default any
+	// AllowedValues restricts strings, and each element of a string list.
+	AllowedValues []string
+	// Validate adds checks the type alone cannot express, e.g. object keys.
+	Validate func(value any) error
+}
+
+// PreferenceSchema is the registry of known preference keys.
+type PreferenceSchema struct {
+	definitions map[string]PreferenceDefinition
+}
+
+func NewPreferenceSchema(defs ...PreferenceDefinition) *PreferenceSchema {
+	s := &PreferenceSchema{definitions: make(map[string]PreferenceDefinition, len(defs))}
+	for _, d := range defs {
+		if _, dup := s.definitions[d.Key]; dup {
+			panic(fmt.Sprintf("preference %q registered twice", d.Key))
+		}
+		s.definitions[d.Key] = d
+	}
+	return s
+}
+
+const (
+	PreferenceLanguage             = "language"
+	PreferenceNotificationChannels = "notificationChannels"
+	PreferenceDistanceUnit         = "distanceUnit"
+	PreferenceMarketingOptIn       = "marketingOptIn"
+	PreferenceTimezone             = "timezone"
+
+	DefaultLocale   = "th"
+	DefaultTimezone = "Asia/Bangkok"
+)
+
+// Preferences is the schema every profile is validated against.
+var Preferences = NewPreferenceSchema(
+	PreferenceDefinition{
+		Key:           PreferenceLanguage,
+		Type:          PreferenceTypeString,
+		Default:       DefaultLocale,
+		AllowedValues: []string{"th", "en"},
+	},
+	PreferenceDefinition{
+		Key:           PreferenceNotificationChannels,
+		Type:          PreferenceTypeStringList,
+		Default:       []any{"line"},
+		AllowedValues: []string{"line", "email", "sms"},
+	},
+	PreferenceDefinition{
+		Key:           PreferenceDistanceUnit,
+		Type:          PreferenceTypeString,
+		Default:       "km",
+		AllowedValues: []string{"km", "mi"},
+	},
+	PreferenceDefinition{
+		Key:     PreferenceMarketingOptIn,
+		Type:    PreferenceTypeBool,
+		Default: false,
+	},
+	PreferenceDefinition{
+		Key:      PreferenceTimezone,
+		Type:     PreferenceTypeString,
+		Default:  DefaultTimezone,
+		Validate: validateTimezonePreference,
+	},
+	PreferenceDefinition{
+		Key:      VisibilityPreferenceKey,
+		Type:     PreferenceTypeObject,
+		Default:  map[string]any{},
+		Validate: validateVisibilityPreference,
+	},
+)
+
+// Keys returns the registered keys in a stable order.
+func (s *PreferenceSchema) Keys() []string {
+	return slices.Sorted(maps.Keys(s.definitions))
+}
+
+// Validate checks every entry against its definition. Unknown keys are rejected.
+func (s *PreferenceSchema) Validate(prefs map[string]any) error {
+	for key, value := range prefs {
+		def, ok := s.definitions[key]
+		if !ok {
+			return invalidPreference(key, "is not a known preference")
+		}
+		if err := def.check(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Apply merges a patch into the stored preferences and validates the result.
+// A nil value in the patch removes the key so its default applies again.
+func (s *PreferenceSchema) Apply(stored, patch map[string]any) (map[string]any, error) {
+	merged := make(map[string]any, len(stored)+len(patch))
+	maps.Copy(merged, stored)
+	for key, value := range patch {
+		if value == nil {
+			delete(merged, key)
+			continue
+		}
+		merged[key] = value
+	}
+	if err := s.Validate(merged); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
+// WithDefaults returns the stored preferences with every missing key filled in
+// from its default. Defaults are not persisted so changing one reaches
+// everybody who never overrode it.
+func (s *PreferenceSchema) WithDefaults(stored map[string]any) map[string]any {
+	effective := make(map[string]any, len(s.definitions))
+	for key, def := range s.definitions {
+		effective[key] = def.Default
+	}
+	for key, value := range stored {
+		if _, ok := s.definitions[key]; ok {
+			effective[key] = value
+		}
+	}
+	return effective
+}
+
+func (d PreferenceDefinition) check(value any) error {
+	switch d.Type {
+	case PreferenceTypeString:
+		v, ok := value.(string)
+		if !ok {
+			return invalidPreference(d.Key, "must be a string")
+		}
+		if len(d.AllowedValues) > 0 && !slices.Contains(d.AllowedValues, v) {
+			return invalidPreference(d.Key, fmt.Sprintf("must be one of %v", d.AllowedValues))
+		}
+	case PreferenceTypeBool:
+		if _, ok := value.(bool); !ok {
+			return invalidPreference(d.Key, "must be a boolean")
+		}
+	case PreferenceTypeNumber:
+		if _, ok := value.(float64); !ok {
+			return invalidPreference(d.Key, "must be a number")
+		}
+	case PreferenceTypeStringList:
+		list, ok := value.([]any)
+		if !ok {
+			return invalidPreference(d.Key, "must be a list of strings")
+		}
+		for _, item := range list {
+			v, ok := item.(string)
+			if !ok {
+				return invalidPreference(d.Key, "must be a list of strings")
+			}
+			if len(d.AllowedValues) > 0 && !slices.Contains(d.AllowedValues, v) {
+				return invalidPreference(d.Key, fmt.Sprintf("items must be in %v", d.AllowedValues))
+			}
+		}
+	case PreferenceTypeObject:
+		if _, ok := value.(map[string]any); !ok {
+			return invalidPreference(d.Key, "must be an object")
+		}
+	}
+	if d.Validate != nil {
+		return d.Validate(value)
+	}
+	return nil
+}
+
+func validateVisibilityPreference(value any) error {
+	for field, audience := range value.(map[string]any) {
+		if _, known := defaultFieldAudience[ProfileField(field)]; !known {
+			return invalidPreference(VisibilityPreferenceKey+"."+field, "is not a profile field with adjustable visibility")
+		}
+		a, ok := audience.(string)
+		if !ok || !Audience(a).IsValid() {
+			return invalidPreference(VisibilityPreferenceKey+"."+field, "must be PUBLIC, COUNTERPARTS or PRIVATE")
+		}
+	}
+	return nil
+}
+
+func validateTimezonePreference(value any) error {
+	if _, err := time.LoadLocation(value.(string)); err != nil {
+		return invalidPreference(PreferenceTimezone, "must be an IANA time zone such as Asia/Bangkok")
+	}
+	return nil
+}
+
+func invalidPreference(key, reason string) error {
+	return errs.NewWithReason(errs.CodeInvalidArgument, "USER_INVALID_PREFERENCE", fmt.Sprintf("preference %q %s", key, reason))
+}
@@ -1,6 +1,11 @@
 package user
 
-import "github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+import (
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/geo"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+)
 
 type Profile struct {
 	UserID      ids.UserID
@@ -11,7 +16,10 @@ type Profile struct {
 	AvatarURL   *string
 	PhoneNumber *string
 	Address     *string
-	Preferences map[string]any
+	// StructuredAddress is nil when the user has not given one; once set it
+	// can be replaced but not removed.
+	StructuredAddress *geo.Address
+	Preferences       map[string]any
 }
 
 func NewProfile(userID ids.UserID, defaultDisplayName string) *Profile {
@@ -22,10 +30,26 @@ func NewProfile(userID ids.UserID, defaultDisplayName string) *Profile {
 	}
 }
 
-func NewProfileFromRepository(userID string, defaultDisplayName string, preferences map[string]any) Profile {
+func NewProfileFromRepository(
+	userID string,
+	displayName string,
+	firstName *string,
+	lastName *string,
+	bio *string,
+	avatarURL *string,
+	phoneNumber *string,
+	address *string,
+	preferences map[string]any,
+) Profile {
 	return Profile{
 		UserID:      ids.UserID(userID),
-		DisplayName: defaultDisplayName,
+		DisplayName: displayName,
+		FirstName:   firstName,
+		LastName:    lastName,
+		Bio:         bio,
+		AvatarURL:   avatarURL,
+		PhoneNumber: phoneNumber,
+		Address:     address,
 		Preferences: preferences,
 	}
 }
@@ -34,3 +58,44 @@ func (p *Profile) WithFirstName(name string) *Profile {
 	p.FirstName = &name
 	return p
 }
+
+func (p *Profile) WithDisplayName(name string) *Profile {
+	p.DisplayName = name
+	return p
+}
+
+func (p *Profile) WithAvatarURL(url *string) *Profile {
+	p.AvatarURL = url
+	return p
+}
+
+// Locale is the user's language for messages, from the language preference.
+func (p *Profile) Locale() string {
+	if v, ok := p.EffectivePreferences()[PreferenceLanguage].(string); ok {
+		return v
+	}
+	return DefaultLocale
+}
+
+// Timezone is the IANA zone name used to render times for the user.
+func (p *Profile) Timezone() string {
+	if v, ok := p.EffectivePreferences()[PreferenceTimezone].(string); ok {
+		return v
+	}
+	return DefaultTimezone
+}
+
+// Location resolves Timezone, falling back to UTC if the zone database lacks it.
+func (p *Profile) Location() *time.Location {
+	loc, err := time.LoadLocation(p.Timezone())
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// EffectivePreferences returns the stored preferences with schema defaults
+// filled in for every key the user has not set.
+func (p *Profile) EffectivePreferences() map[string]any {
+	return Preferences.WithDefaults(p.Preferences)
+}
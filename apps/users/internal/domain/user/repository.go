@@ -13,6 +13,10 @@ type UserRepository interface {
 	// FindByID retrieves a User aggregate by its ID.
 	FindByID(ctx context.Context, id ids.UserID) (*User, error)
 
+	// FindByIDs retrieves many User aggregates in a constant number of queries.
+	// Unknown IDs are skipped; the result order is unspecified.
+	FindByIDs(ctx context.Context, userIDs []ids.UserID) ([]User, error)
+
 	// FindByLineUserID retrieves a User aggregate by their LINE User ID.
 	FindByLineUserID(ctx context.Context, lineUserID string) (*User, error)
 
@@ -28,4 +32,19 @@ type UserRepository interface {
 
 	// GetRoleByID retrieves a Role by its ID.
 	GetRoleByID(ctx context.Context, roleID uint) (*role.Role, error)
+
+	// Search returns users matching the criteria in keyset order, at most criteria.Limit of them.
+	Search(ctx context.Context, criteria SearchCriteria) ([]User, error)
+
+	// FindIdentityVerifications retrieves every identity verification submitted by a user, newest first.
+	FindIdentityVerifications(ctx context.Context, userID ids.UserID) ([]IdentityVerification, error)
+
+	// FindAcquisition retrieves how a user found the service, or nil when
+	// nothing was recorded at registration.
+	FindAcquisition(ctx context.Context, userID ids.UserID) (*Acquisition, error)
+
+	// SaveVerificationDecision records the decision on a pending identity
+	// verification. It fails with ErrInvalidVerificationStatusTransition when
+	// the verification was decided in the meantime.
+	SaveVerificationDecision(ctx context.Context, iv *IdentityVerification) error
 }
@@ -0,0 +1,23 @@
+package user
+
+import (
+	"context"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+)
+
+// Reputation is the review aggregate the tasks service maintains for a user.
+// Users only surface it; ratings are never written from this service.
+type Reputation struct {
+	ReviewCount     int
+	AverageRating   float64
+	BayesianAverage float64
+	RecentAverage   float64
+	Trend           string
+}
+
+// ReputationReader looks up a user's reputation. A nil Reputation with a nil
+// error means the user has not been reviewed yet.
+type ReputationReader interface {
+	ReputationOf(ctx context.Context, userID ids.UserID) (*Reputation, error)
+}
@@ -0,0 +1,46 @@
+package user
+
+import (
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/role"
+)
+
+type SearchSortField string
+
+const (
+	SearchSortCreatedAt   SearchSortField = "created_at"
+	SearchSortDisplayName SearchSortField = "display_name"
+)
+
+// SearchCursor is the keyset position of the last row of a page: the value
+// of the sort column plus the user ID as a tie-breaker.
+type SearchCursor struct {
+	SortValue string
+	UserID    string
+}
+
+// SearchCriteria describes an admin user search. Nil filters are ignored.
+type SearchCriteria struct {
+	Status             *UserStatus
+	RoleName           *role.RoleName
+	VerificationStatus *VerificationStatus
+	CreatedFrom        *time.Time
+	CreatedTo          *time.Time
+	EmailPrefix        *string
+	DisplayNamePrefix  *string
+	SortField          SearchSortField
+	SortDesc           bool
+	After              *SearchCursor
+	Limit              int
+}
+
+// CursorFor returns the keyset position of u under the given sort field.
+func CursorFor(u *User, field SearchSortField) SearchCursor {
+	switch field {
+	case SearchSortDisplayName:
+		return SearchCursor{SortValue: u.Profile.DisplayName, UserID: string(u.ID)}
+	default:
+		return SearchCursor{SortValue: u.CreatedAt.UTC().Format(time.RFC3339Nano), UserID: string(u.ID)}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"slices"
 	"time"
 
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/geo"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/role"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
 )
@@ -30,6 +31,9 @@ type User struct {
 	Profile               Profile
 	Roles                 []role.Role
 	identityVerifications []IdentityVerification
+	// Acquisition is only set on newly registered users; it is written once and
+	// not loaded back with the aggregate.
+	Acquisition *Acquisition
 }
 
 func NewUser(userID ids.UserID, lineUserID string, email, password *string) (*User, error) {
@@ -82,15 +86,29 @@ func NewUserFromRepository(
 
 func (u *User) IsVerified() bool {
 	return slices.ContainsFunc(u.identityVerifications, func(idv IdentityVerification) bool {
-		return idv.VerifiedAt != nil
+		return idv.Status == VerificationStatusApproved
 	})
 }
 
+// RecordAcquisition attaches registration attribution to a new user.
+func (u *User) RecordAcquisition(a *Acquisition) {
+	if a == nil || a.IsEmpty() {
+		return
+	}
+	u.Acquisition = a
+}
+
+// RestoreIdentityVerifications attaches verifications loaded separately from
+// the aggregate, e.g. to compute the verification badge.
+func (u *User) RestoreIdentityVerifications(ivs []IdentityVerification) {
+	u.identityVerifications = ivs
+}
+
 func (u *User) SubmitVerify(idv IdentityVerification) {
 	u.identityVerifications = append(u.identityVerifications, idv)
 }
 
-func (u *User) UpdateProfile(displayName, firstName, lastName, bio, avatarURL, phoneNumber, address *string, preferences map[string]any) {
+func (u *User) UpdateProfile(displayName, firstName, lastName, bio, avatarURL, phoneNumber, address *string) {
 	if displayName != nil {
 		u.Profile.DisplayName = *displayName
 	}
@@ -100,11 +118,29 @@ func (u *User) UpdateProfile(displayName, firstName, lastName, bio, avatarURL, p
 	u.Profile.AvatarURL = avatarURL
 	u.Profile.PhoneNumber = phoneNumber
 	u.Profile.Address = address
-	u.Profile.Preferences = preferences
 	u.UpdatedAt = time.Now()
 	// u.RecordEvent(ProfileUpdated{UserID: u.ID}) // Mark event for publication
 }
 
+// SetStructuredAddress replaces the structured address; it should already be
+// geocoded so the user can be matched by proximity.
+func (u *User) SetStructuredAddress(a *geo.Address) {
+	u.Profile.StructuredAddress = a
+	u.UpdatedAt = time.Now()
+}
+
+// UpdatePreferences merges a validated patch into the stored preferences; see
+// PreferenceSchema.Apply for the merge rules.
+func (u *User) UpdatePreferences(patch map[string]any) error {
+	merged, err := Preferences.Apply(u.Profile.Preferences, patch)
+	if err != nil {
+		return err
+	}
+	u.Profile.Preferences = merged
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
 func (u *User) SetStatus(newStatus UserStatus) error {
 	u.Status = newStatus
 	u.UpdatedAt = time.Now()
@@ -0,0 +1,145 @@
+package user
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/role"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+)
+
+// Audience is who may see an optional profile field.
+type Audience string
+
+const (
+	AudiencePublic       Audience = "PUBLIC"
+	AudienceCounterparts Audience = "COUNTERPARTS"
+	AudiencePrivate      Audience = "PRIVATE"
+)
+
+func (a Audience) IsValid() bool {
+	switch a {
+	case AudiencePublic, AudienceCounterparts, AudiencePrivate:
+		return true
+	}
+	return false
+}
+
+// ProfileField names the profile fields a user can choose to share. The values
+// are the keys used under the "visibility" preference.
+type ProfileField string
+
+const (
+	ProfileFieldFirstName ProfileField = "firstName"
+	ProfileFieldLastName  ProfileField = "lastName"
+	ProfileFieldBio       ProfileField = "bio"
+	ProfileFieldAvatarURL ProfileField = "avatarUrl"
+)
+
+// VisibilityPreferenceKey is the preferences entry holding per-field audiences,
+// e.g. {"visibility": {"firstName": "COUNTERPARTS"}}.
+const VisibilityPreferenceKey = "visibility"
+
+var defaultFieldAudience = map[ProfileField]Audience{
+	ProfileFieldFirstName: AudienceCounterparts,
+	ProfileFieldLastName:  AudiencePrivate,
+	ProfileFieldBio:       AudiencePublic,
+	ProfileFieldAvatarURL: AudiencePublic,
+}
+
+// Viewer is the user looking at a profile. The zero value is an anonymous viewer.
+type Viewer struct {
+	UserID        ids.UserID
+	Roles         []role.RoleName
+	IsCounterpart bool
+}
+
+func (v Viewer) IsAdmin() bool {
+	return slices.Contains(v.Roles, role.RoleNameAdmin)
+}
+
+// IsGateway reports whether the call comes from the API gateway itself rather
+// than on behalf of a user.
+func (v Viewer) IsGateway() bool {
+	return slices.Contains(v.Roles, role.RoleNameGateway)
+}
+
+// CanViewPrivateProfile reports whether the viewer may see the full profile of
+// the given user, including contact details and preferences.
+func (v Viewer) CanViewPrivateProfile(userID ids.UserID) bool {
+	return (v.UserID != "" && strings.EqualFold(string(v.UserID), string(userID))) || v.IsAdmin()
+}
+
+// CounterpartChecker tells whether two users are matched on a task, which
+// unlocks COUNTERPARTS fields between them.
+type CounterpartChecker interface {
+	AreCounterparts(ctx context.Context, a, b ids.UserID) (bool, error)
+}
+
+// PublicProfile is the projection of a user that other marketplace users see.
+type PublicProfile struct {
+	UserID      ids.UserID
+	DisplayName string
+	FirstName   *string
+	LastName    *string
+	Bio         *string
+	AvatarURL   *string
+	IsVerified  bool
+	Roles       []role.RoleName
+	MemberSince time.Time
+}
+
+// FieldAudience returns the audience the user chose for a field, falling back
+// to the default when the preference is missing or unrecognised.
+func (p *Profile) FieldAudience(field ProfileField) Audience {
+	if visibility, ok := p.Preferences[VisibilityPreferenceKey].(map[string]any); ok {
+		if raw, ok := visibility[string(field)].(string); ok && Audience(raw).IsValid() {
+			return Audience(raw)
+		}
+	}
+	return defaultFieldAudience[field]
+}
+
+// CanViewField applies the user's visibility preference for one field.
+func (u *User) CanViewField(field ProfileField, v Viewer) bool {
+	if v.CanViewPrivateProfile(u.ID) {
+		return true
+	}
+	switch u.Profile.FieldAudience(field) {
+	case AudiencePublic:
+		return true
+	case AudienceCounterparts:
+		return v.IsCounterpart
+	default:
+		return false
+	}
+}
+
+// PublicProfile projects the user as seen by the viewer.
+func (u *User) PublicProfile(v Viewer) PublicProfile {
+	pp := PublicProfile{
+		UserID:      u.ID,
+		DisplayName: u.Profile.DisplayName,
+		IsVerified:  u.IsVerified(),
+		Roles:       make([]role.RoleName, 0, len(u.Roles)),
+		MemberSince: u.CreatedAt,
+	}
+	for _, r := range u.Roles {
+		pp.Roles = append(pp.Roles, r.Name)
+	}
+	if u.CanViewField(ProfileFieldFirstName, v) {
+		pp.FirstName = u.Profile.FirstName
+	}
+	if u.CanViewField(ProfileFieldLastName, v) {
+		pp.LastName = u.Profile.LastName
+	}
+	if u.CanViewField(ProfileFieldBio, v) {
+		pp.Bio = u.Profile.Bio
+	}
+	if u.CanViewField(ProfileFieldAvatarURL, v) {
+		pp.AvatarURL = u.Profile.AvatarURL
+	}
+	return pp
+}
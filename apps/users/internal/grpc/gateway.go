@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/api/openapi"
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/users/api/proto/user"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+)
+
+// ArchiveDownloads serves data export archives from the signed URLs
+// GetDataExport hands out.
+type ArchiveDownloads interface {
+	ServeDownload(w http.ResponseWriter, r *http.Request, key string)
+}
+
+// NewGatewayServer serves UserService as HTTP/JSON when gateway.enabled is
+// set, and is nil otherwise. Requests are forwarded to the gRPC server of
+// this process, so they are authorized, validated and rate limited the same
+// way as gRPC calls. Export downloads are the exception: their signed URLs
+// are checked here, and export.download_base_url points at them.
+func NewGatewayServer(ctx context.Context, cfg *config.Config, lgr *slog.Logger, downloads ArchiveDownloads) (*lg.GatewayServer, error) {
+	if !cfg.Gateway.Enabled {
+		return nil, nil
+	}
+
+	var tls *lg.ConfigTLS
+	if cfg.Security.EnableTLS {
+		// The gRPC server asks for a client certificate under mutual TLS;
+		// the gateway presents the server's own.
+		tls = &lg.ConfigTLS{
+			CertFile:   cfg.Security.TLSCertFile,
+			KeyFile:    cfg.Security.TLSKeyFile,
+			CAFile:     cfg.Security.TLSClientCAFile,
+			ServerName: cfg.Gateway.TLSServerName,
+		}
+	}
+	var origins []string
+	if cfg.Security.EnableCORS {
+		origins = cfg.Security.AllowedOrigins
+	}
+
+	return lg.NewGatewayServer(ctx, lg.ConfigGateway{
+		Address:         cfg.Gateway.Address,
+		Endpoint:        gatewayEndpoint(cfg.Server.Address),
+		TLS:             tls,
+		AllowedOrigins:  origins,
+		OpenAPI:         openapi.Spec,
+		MaxRequestSize:  cfg.Security.MaxRequestSize,
+		ReadTimeout:     cfg.Server.ReadTimeout,
+		ShutdownTimeout: cfg.Server.ShutdownTimeout,
+	}, lgr, pb.RegisterUserServiceHandler, exportDownloadHandler(downloads))
+}
+
+// exportDownloadHandler serves archives under /exports, where their keys,
+// "<user id>/<file>", are appended to export.download_base_url.
+func exportDownloadHandler(downloads ArchiveDownloads) lg.GatewayHandler {
+	return func(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+		return mux.HandlePath(http.MethodGet, "/exports/{user_id}/{file}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			downloads.ServeDownload(w, r, params["user_id"]+"/"+params["file"])
+		})
+	}
+}
+
+// gatewayEndpoint is where the gateway reaches the gRPC server listening on
+// address, which usually leaves the host out to listen on all of them.
+func gatewayEndpoint(address string) string {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "127.0.0.1"
+	}
+	return net.JoinHostPort(host, port)
+}
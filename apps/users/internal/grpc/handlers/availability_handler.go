@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/users/api/proto/user"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/grpc/views"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *UserGRPCHandler) SetTaskerAvailability(ctx context.Context, req *pb.SetTaskerAvailabilityRequest) (*emptypb.Empty, error) {
+	cmd := command.SetTaskerAvailabilityCommand{
+		UserID:        ids.UserID(req.GetUserId()),
+		Timezone:      lg.StringValueToPtr(req.GetTimezone()),
+		Slots:         views.AvailabilitySlotInputs(req.GetSlots()),
+		Exceptions:    views.AvailabilityExceptionInputs(req.GetExceptions()),
+		BlackoutDates: req.GetBlackoutDates(),
+		Viewer:        viewerFromCtx(ctx),
+	}
+	if _, err := h.Command.Dispatch(ctx, cmd); err != nil {
+		h.Logger.Error("Failed to dispatch SetTaskerAvailabilityCommand", "error", err)
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserGRPCHandler) GetTaskerAvailability(ctx context.Context, req *pb.GetTaskerAvailabilityRequest) (*pb.TaskerAvailability, error) {
+	qry := query.GetTaskerAvailabilityQuery{
+		UserID: ids.UserID(req.GetUserId()),
+		Viewer: viewerFromCtx(ctx),
+	}
+	if req.GetFrom() != nil {
+		from := req.GetFrom().AsTime()
+		qry.From = &from
+	}
+	if req.GetTo() != nil {
+		to := req.GetTo().AsTime()
+		qry.To = &to
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.TaskerAvailabilityDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from GetTaskerAvailabilityQuery handler")
+	}
+	return views.TaskerAvailability(dto), nil
+}
+
+func (h *UserGRPCHandler) FindAvailableTaskers(ctx context.Context, req *pb.FindAvailableTaskersRequest) (*pb.FindAvailableTaskersResponse, error) {
+	qry := query.FindAvailableTaskersQuery{
+		SkillCode:       req.GetSkillCode(),
+		WindowStart:     req.GetWindowStart().AsTime(),
+		WindowEnd:       req.GetWindowEnd().AsTime(),
+		DurationMinutes: int(req.GetDurationMinutes()),
+		PageSize:        int(req.GetPageSize()),
+		PageToken:       req.GetPageToken(),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.FindAvailableTaskersDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from FindAvailableTaskersQuery handler")
+	}
+	return views.FindAvailableTaskersResponse(dto), nil
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/users/api/proto/user"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/grpc/views"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *UserGRPCHandler) CreateTaskerProfile(ctx context.Context, req *pb.CreateTaskerProfileRequest) (*emptypb.Empty, error) {
+	cmd := command.CreateTaskerProfileCommand{
+		UserID:  ids.UserID(req.GetUserId()),
+		Profile: views.TaskerProfileInput(req.GetProfile()),
+		Viewer:  viewerFromCtx(ctx),
+	}
+	if _, err := h.Command.Dispatch(ctx, cmd); err != nil {
+		h.Logger.Error("Failed to dispatch CreateTaskerProfileCommand", "error", err)
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserGRPCHandler) UpdateTaskerProfile(ctx context.Context, req *pb.UpdateTaskerProfileRequest) (*emptypb.Empty, error) {
+	cmd := command.UpdateTaskerProfileCommand{
+		UserID:  ids.UserID(req.GetUserId()),
+		Profile: views.TaskerProfileInput(req.GetProfile()),
+		Viewer:  viewerFromCtx(ctx),
+	}
+	if _, err := h.Command.Dispatch(ctx, cmd); err != nil {
+		h.Logger.Error("Failed to dispatch UpdateTaskerProfileCommand", "error", err)
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserGRPCHandler) GetTaskerProfile(ctx context.Context, req *pb.GetTaskerProfileRequest) (*pb.TaskerProfile, error) {
+	qry := query.GetTaskerProfileQuery{
+		UserID: ids.UserID(req.GetUserId()),
+		Viewer: viewerFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.TaskerProfileDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from GetTaskerProfileQuery handler")
+	}
+	return views.TaskerProfile(dto), nil
+}
+
+func (h *UserGRPCHandler) ListSkillCategories(ctx context.Context, req *pb.ListSkillCategoriesRequest) (*pb.ListSkillCategoriesResponse, error) {
+	qry := query.ListSkillCategoriesQuery{
+		IncludeInactive: req.GetIncludeInactive(),
+		Viewer:          viewerFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.ListSkillCategoriesDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from ListSkillCategoriesQuery handler")
+	}
+	return views.ListSkillCategoriesResponse(dto), nil
+}
+
+func (h *UserGRPCHandler) UpsertSkillCategory(ctx context.Context, req *pb.UpsertSkillCategoryRequest) (*emptypb.Empty, error) {
+	cmd := command.UpsertSkillCategoryCommand{
+		Code:        req.GetCode(),
+		Name:        req.GetName(),
+		Description: lg.StringValueToPtr(req.GetDescription()),
+		IsActive:    req.GetIsActive(),
+		Viewer:      viewerFromCtx(ctx),
+	}
+	if _, err := h.Command.Dispatch(ctx, cmd); err != nil {
+		h.Logger.Error("Failed to dispatch UpsertSkillCategoryCommand", "error", err)
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserGRPCHandler) FindTaskersNear(ctx context.Context, req *pb.FindTaskersNearRequest) (*pb.FindTaskersNearResponse, error) {
+	qry := query.FindTaskersNearQuery{
+		RadiusMeters: int(req.GetRadiusMeters()),
+		SkillCode:    lg.StringValueToPtr(req.GetSkillCode()),
+		Limit:        int(req.GetLimit()),
+	}
+	qry.Latitude, qry.Longitude = views.PointToPtrs(req.GetLocation())
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.FindTaskersNearDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from FindTaskersNearQuery handler")
+	}
+	return views.FindTaskersNearResponse(dto), nil
+}
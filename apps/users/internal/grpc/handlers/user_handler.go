@@ -2,48 +2,71 @@ package handlers
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	pb "github.com/pratchaya-maneechot/service-exchange/apps/users/api/proto/user"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/command"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/role"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/grpc/views"
 	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"github.com/pratchaya-maneechot/service-exchange/libs/utils"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type UserGRPCHandler struct {
 	pb.UnimplementedUserServiceServer
 	lg.GrpcHandlerOption
+	watch ProfileWatchOptions
+}
+
+// ProfileWatchOptions are what WatchUserProfile streams are fed by and how
+// long they may run.
+type ProfileWatchOptions struct {
+	Watchers *query.UserProfileWatchers
+	// MaxDuration ends a stream after this long; the client reconnects.
+	MaxDuration time.Duration
+	// SendTimeout ends a stream whose client has not taken a change for this
+	// long.
+	SendTimeout time.Duration
 }
 
 func RegisUserGRPCHandler(
 	gs *grpc.Server,
 	opt lg.GrpcHandlerOption,
+	watch ProfileWatchOptions,
 ) {
 	pb.RegisterUserServiceServer(gs, &UserGRPCHandler{
 		GrpcHandlerOption: opt,
+		watch:             watch,
 	})
 }
 
 func (h *UserGRPCHandler) LineRegister(ctx context.Context, req *pb.LineRegisterRequest) (*pb.LineRegisterResponse, error) {
 	cmd := command.RegisterUserCommand{
-		LineUserID:  req.GetLineUserId(),
-		Email:       lg.StringValueToPtr(req.GetEmail()),
-		Password:    lg.StringValueToPtr(req.GetPassword()),
-		DisplayName: req.GetDisplayName(),
-		AvatarURL:   lg.StringValueToPtr(req.GetAvatarUrl()),
-	}
-	if err := h.Validator.Struct(cmd); err != nil {
-		return nil, h.ValidationErrors(err)
+		LineUserID:   req.GetLineUserId(),
+		Email:        lg.StringValueToPtr(req.GetEmail()),
+		Password:     lg.StringValueToPtr(req.GetPassword()),
+		DisplayName:  req.GetDisplayName(),
+		AvatarURL:    lg.StringValueToPtr(req.GetAvatarUrl()),
+		Locale:       lg.StringValueToPtr(req.GetLocale()),
+		Timezone:     lg.StringValueToPtr(req.GetTimezone()),
+		ReferralCode: lg.StringValueToPtr(req.GetReferralCode()),
+		Channel:      lg.StringValueToPtr(req.GetChannel()),
+		Campaign:     lg.StringValueToPtr(req.GetCampaign()),
+		Metadata:     lg.StructToMap(req.GetMetadata()),
+		Viewer:       viewerFromCtx(ctx),
 	}
-
 	result, err := h.Command.Dispatch(ctx, cmd)
 	if err != nil {
-		return nil, lg.NewGRPCErrCode(err)
+		return nil, lg.NewGRPCErrCode(ctx, err)
 	}
 
 	usr, ok := result.(*command.RegisterUserDto)
@@ -59,35 +82,269 @@ func (h *UserGRPCHandler) LineRegister(ctx context.Context, req *pb.LineRegister
 func (h *UserGRPCHandler) UpdateUserProfile(ctx context.Context, req *pb.UpdateUserProfileRequest) (*emptypb.Empty, error) {
 	userID := ids.UserID(req.GetUserId())
 	cmd := command.UpdateUserProfileCommand{
-		UserID:      userID,
-		DisplayName: lg.StringValueToPtr(req.GetDisplayName()),
-		FirstName:   lg.StringValueToPtr(req.GetFirstName()),
-		LastName:    lg.StringValueToPtr(req.GetLastName()),
-		Bio:         lg.StringValueToPtr(req.GetBio()),
-		AvatarURL:   lg.StringValueToPtr(req.GetAvatarUrl()),
-		PhoneNumber: lg.StringValueToPtr(req.GetPhoneNumber()),
-		Address:     lg.StringValueToPtr(req.GetAddress()),
-		Preferences: lg.StringMapToAnyMap(req.GetPreferences()),
+		UserID:            userID,
+		DisplayName:       lg.StringValueToPtr(req.GetDisplayName()),
+		FirstName:         lg.StringValueToPtr(req.GetFirstName()),
+		LastName:          lg.StringValueToPtr(req.GetLastName()),
+		Bio:               lg.StringValueToPtr(req.GetBio()),
+		AvatarURL:         lg.StringValueToPtr(req.GetAvatarUrl()),
+		PhoneNumber:       lg.StringValueToPtr(req.GetPhoneNumber()),
+		Address:           lg.StringValueToPtr(req.GetAddress()),
+		StructuredAddress: views.AddressInput(req.GetStructuredAddress()),
+		Preferences:       lg.StructToMap(req.GetPreferences()),
+		Viewer:            viewerFromCtx(ctx),
 	}
 	if _, err := h.Command.Dispatch(ctx, cmd); err != nil {
 		h.Logger.Error("Failed to dispatch UpdateUserProfileCommand", "error", err)
-		return nil, lg.NewGRPCErrCode(err)
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserGRPCHandler) ReviewIdentityVerification(ctx context.Context, req *pb.ReviewIdentityVerificationRequest) (*emptypb.Empty, error) {
+	cmd := command.ReviewIdentityVerificationCommand{
+		UserID:          ids.UserID(req.GetUserId()),
+		VerificationID:  req.GetVerificationId(),
+		Approve:         req.GetApprove(),
+		RejectionReason: req.GetRejectionReason().GetValue(),
+		Viewer:          viewerFromCtx(ctx),
+	}
+	if _, err := h.Command.Dispatch(ctx, cmd); err != nil {
+		h.Logger.Error("Failed to dispatch ReviewIdentityVerificationCommand", "error", err)
+		return nil, lg.NewGRPCErrCode(ctx, err)
 	}
 	return &emptypb.Empty{}, nil
 }
 
+func (h *UserGRPCHandler) ResolveLineUser(ctx context.Context, req *pb.ResolveLineUserRequest) (*pb.ResolvedUser, error) {
+	qry := query.ResolveLineUserQuery{
+		LineUserID: req.GetLineUserId(),
+		Viewer:     viewerFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.ResolvedUserDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from ResolveLineUserQuery handler")
+	}
+	return views.ResolvedUser(dto), nil
+}
+
 func (h *UserGRPCHandler) GetUserProfile(ctx context.Context, req *pb.GetUserProfileRequest) (*pb.UserProfile, error) {
-	userID := ids.UserID(req.UserId)
+	return h.userProfile(ctx, ids.UserID(req.UserId))
+}
+
+// WatchUserProfile sends the profile as it is, then again after every
+// change, until the stream's time is up or the client goes away.
+func (h *UserGRPCHandler) WatchUserProfile(req *pb.WatchUserProfileRequest, stream pb.UserService_WatchUserProfileServer) error {
+	userID := ids.UserID(req.GetUserId())
+	ctx, cancel := context.WithTimeout(stream.Context(), h.watch.MaxDuration)
+	defer cancel()
+
+	// Subscribed before the first read so a change in between is not missed.
+	watch := h.watch.Watchers.Watch(userID)
+	defer watch.Close()
+
+	profile, err := h.userProfile(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := h.sendProfileChange(stream, pb.UserProfileChangeType_USER_PROFILE_CHANGE_TYPE_SNAPSHOT, profile); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			if stream.Context().Err() == nil {
+				// Our own time limit rather than the client's.
+				return nil
+			}
+			return status.FromContextError(ctx.Err()).Err()
+		case <-watch.Changes():
+			next, err := h.userProfile(ctx, userID)
+			if err != nil {
+				return err
+			}
+			if proto.Equal(next, profile) {
+				// A signal raised while the last change was being read.
+				continue
+			}
+			change := pb.UserProfileChangeType_USER_PROFILE_CHANGE_TYPE_PROFILE_UPDATED
+			if next.GetStatus() != profile.GetStatus() {
+				change = pb.UserProfileChangeType_USER_PROFILE_CHANGE_TYPE_STATUS_CHANGED
+			}
+			if err := h.sendProfileChange(stream, change, next); err != nil {
+				return err
+			}
+			profile = next
+		}
+	}
+}
+
+// sendProfileChange sends a change, giving up when the client has not taken
+// it within the send timeout: a client that stopped reading would otherwise
+// hold the stream open for good.
+func (h *UserGRPCHandler) sendProfileChange(stream pb.UserService_WatchUserProfileServer, change pb.UserProfileChangeType, profile *pb.UserProfile) error {
+	sent := make(chan error, 1)
+	go func() {
+		sent <- stream.Send(&pb.UserProfileChange{Type: change, Profile: profile})
+	}()
+
+	timer := time.NewTimer(h.watch.SendTimeout)
+	defer timer.Stop()
+	select {
+	case err := <-sent:
+		return err
+	case <-timer.C:
+		return status.Errorf(codes.DeadlineExceeded, "client did not receive the profile change within %s", h.watch.SendTimeout)
+	}
+}
+
+// userProfile reads the full profile the way the caller may see it.
+func (h *UserGRPCHandler) userProfile(ctx context.Context, userID ids.UserID) (*pb.UserProfile, error) {
 	qry := query.GetUserProfileQuery{
 		UserID: userID,
+		Viewer: viewerFromCtx(ctx),
 	}
 	result, err := h.Query.Dispatch(ctx, qry)
 	if err != nil {
-		return nil, lg.NewGRPCErrCode(err)
+		return nil, lg.NewGRPCErrCode(ctx, err)
 	}
 	internalDTO, ok := result.(*query.UserProfileDTO)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from GetUserProfileQuery handler")
 	}
-	return views.UserProfile(internalDTO), nil
+	resp, err := views.UserProfile(internalDTO)
+	if err != nil {
+		h.Logger.Error("Failed to build GetUserProfile response", "error", err)
+		return nil, status.Errorf(codes.Internal, "internal server error: failed to encode user profile")
+	}
+	return resp, nil
+}
+
+func (h *UserGRPCHandler) GetPublicProfile(ctx context.Context, req *pb.GetPublicProfileRequest) (*pb.PublicProfile, error) {
+	qry := query.GetPublicProfileQuery{
+		UserID: ids.UserID(req.GetUserId()),
+		Viewer: viewerFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.PublicProfileDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from GetPublicProfileQuery handler")
+	}
+	return views.PublicProfile(dto), nil
+}
+
+func (h *UserGRPCHandler) BatchGetUserProfiles(ctx context.Context, req *pb.BatchGetUserProfilesRequest) (*pb.BatchGetUserProfilesResponse, error) {
+	qry := query.BatchGetUserProfilesQuery{
+		UserIDs: utils.ArrayMap(req.GetUserIds(), func(id string) ids.UserID { return ids.UserID(id) }),
+		Viewer:  viewerFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.BatchGetUserProfilesDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from BatchGetUserProfilesQuery handler")
+	}
+	resp, err := views.BatchGetUserProfilesResponse(dto)
+	if err != nil {
+		h.Logger.Error("Failed to build BatchGetUserProfiles response", "error", err)
+		return nil, status.Errorf(codes.Internal, "internal server error: failed to encode user profile")
+	}
+	return resp, nil
+}
+
+func (h *UserGRPCHandler) ExportUserData(ctx context.Context, req *pb.ExportUserDataRequest) (*pb.ExportUserDataResponse, error) {
+	cmd := command.ExportUserDataCommand{
+		UserID: ids.UserID(req.GetUserId()),
+		Format: views.ProtoExportFormatToDomain(req.GetFormat()),
+		Viewer: viewerFromCtx(ctx),
+	}
+	result, err := h.Command.Dispatch(ctx, cmd)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*command.ExportUserDataDto)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from ExportUserDataCommand handler")
+	}
+	return views.ExportUserDataResponse(dto), nil
+}
+
+func (h *UserGRPCHandler) GetDataExportStatus(ctx context.Context, req *pb.GetDataExportStatusRequest) (*pb.DataExport, error) {
+	qry := query.GetDataExportStatusQuery{
+		ExportID: ids.DataExportID(req.GetExportId()),
+		UserID:   ids.UserID(req.GetUserId()),
+		Viewer:   viewerFromCtx(ctx),
+	}
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.DataExportStatusDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from GetDataExportStatusQuery handler")
+	}
+	return views.DataExport(dto), nil
+}
+
+func (h *UserGRPCHandler) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	qry := query.SearchUsersQuery{
+		Status:             views.ProtoUserStatusToDomain(req.GetStatus()),
+		VerificationStatus: views.ProtoVerificationStatusToDomain(req.GetVerificationStatus()),
+		EmailPrefix:        lg.StringValueToPtr(req.GetEmailPrefix()),
+		DisplayNamePrefix:  lg.StringValueToPtr(req.GetDisplayNamePrefix()),
+		OrderBy:            req.GetOrderBy(),
+		PageSize:           int(req.GetPageSize()),
+		PageToken:          req.GetPageToken(),
+		Viewer:             viewerFromCtx(ctx),
+	}
+	if roleName := lg.StringValueToPtr(req.GetRole()); roleName != nil {
+		rn := role.RoleName(strings.ToUpper(*roleName))
+		qry.RoleName = &rn
+	}
+	if req.GetCreatedAfter() != nil {
+		t := req.GetCreatedAfter().AsTime()
+		qry.CreatedFrom = &t
+	}
+	if req.GetCreatedBefore() != nil {
+		t := req.GetCreatedBefore().AsTime()
+		qry.CreatedTo = &t
+	}
+
+	result, err := h.Query.Dispatch(ctx, qry)
+	if err != nil {
+		return nil, lg.NewGRPCErrCode(ctx, err)
+	}
+	dto, ok := result.(*query.SearchUsersResultDTO)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal server error: unexpected response from SearchUsersQuery handler")
+	}
+	resp, err := views.ListUsersResponse(dto)
+	if err != nil {
+		h.Logger.Error("Failed to build ListUsers response", "error", err)
+		return nil, status.Errorf(codes.Internal, "internal server error: failed to encode user profile")
+	}
+	return resp, nil
+}
+
+// viewerFromCtx turns the gateway-authenticated caller into a domain viewer;
+// requests without caller metadata are anonymous.
+func viewerFromCtx(ctx context.Context) user.Viewer {
+	caller, ok := lg.CallerFromCtx(ctx)
+	if !ok {
+		return user.Viewer{}
+	}
+	return user.Viewer{
+		UserID: ids.UserID(caller.UserID),
+		Roles:  utils.ArrayMap(caller.Roles, func(r string) role.RoleName { return role.RoleName(strings.ToUpper(r)) }),
+	}
 }
@@ -0,0 +1,80 @@
+package grpc
+
+import lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+
+// thaiMessages are the Thai texts of the service's error reasons. English
+// needs none: it is the language of the errors themselves.
+var thaiMessages = lg.Messages{
+	"NO_USER_IDS":        "กรุณาระบุรหัสผู้ใช้อย่างน้อยหนึ่งรายการ",
+	"TOO_MANY_USER_IDS":  "ขอข้อมูลผู้ใช้ในครั้งเดียวมากเกินไป",
+	"EMPTY_USER_ID_ITEM": "รหัสผู้ใช้ต้องไม่เป็นค่าว่าง",
+	"INVALID_PAGE_SIZE":  "page_size ต้องไม่ติดลบ",
+	"INVALID_PAGE_TOKEN": "page_token ไม่ถูกต้องหรือไม่ตรงกับคำขอ",
+	"INVALID_ORDER_BY":   "order_by ต้องเป็น created_at หรือ display_name และอาจตามด้วย asc หรือ desc",
+
+	"USER_NOT_FOUND":                              "ไม่พบผู้ใช้",
+	"USER_LINE_USER_ID_EXISTS":                    "รหัสผู้ใช้ LINE นี้ถูกใช้งานแล้ว",
+	"USER_EMAIL_EXISTS":                           "อีเมลนี้ถูกใช้งานแล้ว",
+	"USER_LINE_ID_EXISTS":                         "บัญชี LINE นี้ลงทะเบียนแล้ว",
+	"USER_MISSING_LINE_ID_OR_EMAIL":               "กรุณาระบุรหัสผู้ใช้ LINE หรืออีเมล",
+	"USER_INVALID_CREDENTIALS":                    "ข้อมูลเข้าสู่ระบบไม่ถูกต้อง",
+	"USER_ROLE_ALREADY_ASSIGNED":                  "ผู้ใช้มีบทบาทนี้อยู่แล้ว",
+	"USER_INVALID_VERIFICATION_STATUS_TRANSITION": "ไม่สามารถเปลี่ยนสถานะการยืนยันตัวตนเป็นสถานะนี้ได้",
+	"USER_MISSING_DOCUMENT_URLS":                  "กรุณาแนบเอกสารเพื่อยืนยันตัวตน",
+	"USER_MISSING_DOCUMENT_TYPE":                  "กรุณาระบุประเภทเอกสารเพื่อยืนยันตัวตน",
+	"USER_INVALID_SEARCH_CURSOR":                  "ตำแหน่งการค้นหาไม่ถูกต้อง",
+	"USER_PROFILE_ACCESS_DENIED":                  "เฉพาะเจ้าของโปรไฟล์หรือผู้ดูแลระบบเท่านั้นที่ดูโปรไฟล์ฉบับเต็มได้",
+	"USER_ADMIN_REQUIRED":                         "ต้องเป็นผู้ดูแลระบบ",
+	"USER_GATEWAY_REQUIRED":                       "เฉพาะ API gateway เท่านั้นที่ค้นหาบัญชี LINE ได้",
+	"USER_REGISTRATION_GATEWAY_REQUIRED":          "เฉพาะ API gateway เท่านั้นที่ลงทะเบียนบัญชี LINE ได้",
+	"USER_IDENTITY_VERIFICATION_NOT_FOUND":        "ไม่พบคำขอยืนยันตัวตน",
+	"USER_MISSING_REJECTION_REASON":               "ต้องระบุเหตุผลเมื่อปฏิเสธการยืนยันตัวตน",
+	"USER_PROFILE_UPDATE_DENIED":                  "เฉพาะเจ้าของโปรไฟล์หรือผู้ดูแลระบบเท่านั้นที่แก้ไขโปรไฟล์ได้",
+	"USER_INVALID_PREFERENCE":                     "ค่าการตั้งค่าไม่ถูกต้อง",
+
+	"TASKER_PROFILE_NOT_FOUND":           "ไม่พบโปรไฟล์ผู้รับงาน",
+	"TASKER_PROFILE_EXISTS":              "มีโปรไฟล์ผู้รับงานอยู่แล้ว",
+	"TASKER_ROLE_REQUIRED":               "ต้องมีบทบาทผู้รับงานจึงจะจัดการโปรไฟล์ผู้รับงานได้",
+	"TASKER_VERIFICATION_REQUIRED":       "ต้องยืนยันตัวตนให้ผ่านก่อนจึงจะจัดการโปรไฟล์ผู้รับงานได้",
+	"TASKER_NO_SKILLS":                   "กรุณาระบุทักษะอย่างน้อยหนึ่งรายการ",
+	"TASKER_TOO_MANY_SKILLS":             "มีทักษะมากเกินไป",
+	"TASKER_UNKNOWN_SKILL_CATEGORY":      "ไม่พบหมวดทักษะหรือหมวดทักษะถูกปิดใช้งาน",
+	"TASKER_INVALID_HOURLY_RATE":         "อัตราค่าจ้างรายชั่วโมงต้องเป็นช่วงที่ไม่ติดลบพร้อมรหัสสกุลเงินสามตัวอักษร",
+	"TASKER_INVALID_SERVICE_AREA":        "พื้นที่ให้บริการต้องระบุจังหวัด",
+	"TASKER_TOO_MANY_SERVICE_AREAS":      "มีพื้นที่ให้บริการมากเกินไป",
+	"TASKER_INVALID_PORTFOLIO_ITEM":      "ผลงานต้องมีชื่อยาวไม่เกิน 150 ตัวอักษร",
+	"TASKER_TOO_MANY_PORTFOLIO_ITEMS":    "มีผลงานมากเกินไป",
+	"TASKER_INVALID_YEARS_OF_EXPERIENCE": "จำนวนปีประสบการณ์ต้องอยู่ระหว่าง 0 ถึง 80 ปี",
+	"TASKER_INVALID_HEADLINE":            "คำโปรยต้องยาวไม่เกิน 150 ตัวอักษร",
+	"TASKER_SKILL_CATEGORY_NOT_FOUND":    "ไม่พบหมวดทักษะ",
+	"TASKER_INVALID_SKILL_CODE":          "รหัสทักษะต้องมี 2-64 ตัว ประกอบด้วยตัวพิมพ์เล็ก ตัวเลข หรือขีดล่าง และขึ้นต้นด้วยตัวอักษร",
+	"TASKER_INVALID_SKILL_NAME":          "กรุณาระบุชื่อทักษะ ยาวไม่เกิน 100 ตัวอักษร",
+
+	"DATA_EXPORT_NOT_FOUND":                        "ไม่พบคำขอส่งออกข้อมูล",
+	"DATA_EXPORT_ACCESS_DENIED":                    "เฉพาะเจ้าของข้อมูลหรือผู้ดูแลระบบเท่านั้นที่ส่งออกข้อมูลได้",
+	"DATA_EXPORT_UNSUPPORTED_EXPORT_FORMAT":        "ไม่รองรับรูปแบบการส่งออกข้อมูลนี้",
+	"DATA_EXPORT_INVALID_EXPORT_STATUS_TRANSITION": "ไม่สามารถเปลี่ยนสถานะการส่งออกข้อมูลเป็นสถานะนี้ได้",
+
+	"GEO_INVALID_COORDINATES": "ละติจูดต้องอยู่ระหว่าง -90 ถึง 90 และลองจิจูดต้องอยู่ระหว่าง -180 ถึง 180",
+	"GEO_INVALID_ADDRESS":     "ที่อยู่ต้องระบุจังหวัดและรหัสประเทศสองตัวอักษร",
+	"GEO_INVALID_POSTAL_CODE": "รหัสไปรษณีย์ต้องมี 3-10 ตัว ประกอบด้วยตัวอักษร ตัวเลข ช่องว่าง หรือขีด",
+	"GEO_INVALID_RADIUS":      "รัศมีการค้นหาต้องอยู่ระหว่าง 1 เมตรถึง 100 กิโลเมตร",
+	"GEO_ADDRESS_NOT_FOUND":   "ไม่พบตำแหน่งของที่อยู่ กรุณาระบุพิกัด",
+	"GEO_LOCATION_REQUIRED":   "กรุณาระบุตำแหน่งที่ต้องการค้นหา",
+
+	"ROLE_NOT_FOUND":        "ไม่พบบทบาท",
+	"ROLE_CACHE_NOT_LOADED": "ระบบยังไม่พร้อมให้บริการ กรุณาลองใหม่อีกครั้ง",
+
+	"AVAILABILITY_SCHEDULE_NOT_FOUND":      "ไม่พบตารางเวลาว่าง",
+	"AVAILABILITY_INVALID_TIMEZONE":        "เขตเวลาต้องเป็นเขตเวลา IANA เช่น Asia/Bangkok",
+	"AVAILABILITY_INVALID_DATE":            "วันที่ต้องอยู่ในรูปแบบ YYYY-MM-DD",
+	"AVAILABILITY_INVALID_START_TIME":      "เวลาเริ่มต้องอยู่ในรูปแบบ HH:MM",
+	"AVAILABILITY_INVALID_DURATION":        "ช่วงเวลาต้องยาวระหว่าง 15 นาทีถึง 24 ชั่วโมง",
+	"AVAILABILITY_TOO_MANY_SLOTS":          "มีช่วงเวลาประจำมากเกินไป",
+	"AVAILABILITY_TOO_MANY_EXCEPTIONS":     "มีข้อยกเว้นของเวลาว่างมากเกินไป",
+	"AVAILABILITY_INVALID_EXCEPTION":       "ข้อยกเว้นต้องระบุประเภทที่ถูกต้อง และเวลาสิ้นสุดต้องอยู่หลังเวลาเริ่มไม่เกิน 31 วัน",
+	"AVAILABILITY_INVALID_WINDOW":          "ช่วงเวลาต้องสิ้นสุดหลังเวลาเริ่ม และยาวไม่เกิน 31 วัน",
+	"AVAILABILITY_BLACKOUT_CONFLICT":       "ช่วงที่ปิดรับงานทับกับงานที่มีการจองไว้แล้ว",
+	"AVAILABILITY_SKILL_REQUIRED":          "กรุณาระบุรหัสทักษะ",
+	"AVAILABILITY_INVALID_RECURRENCE_RULE": "กฎการทำซ้ำไม่ถูกต้อง",
+}
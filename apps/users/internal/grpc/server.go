@@ -1,14 +1,19 @@
 package grpc
 
 import (
+	"context"
 	"log/slog"
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/query"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/grpc/handlers"
 	"github.com/pratchaya-maneechot/service-exchange/libs/bus"
 	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	lh "github.com/pratchaya-maneechot/service-exchange/libs/health"
 	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
@@ -20,6 +25,9 @@ func NewGRPCServer(
 	lgr *slog.Logger,
 	vd *validator.Validate,
 	mr observability.MetricsRecorder,
+	users user.UserRepository,
+	watchers *query.UserProfileWatchers,
+	health *lh.Registry,
 ) (*lg.GRPCServer, error) {
 	opts := []grpc.ServerOption{
 		grpc.KeepaliveParams(keepalive.ServerParameters{
@@ -40,19 +48,42 @@ func NewGRPCServer(
 
 	server, err := lg.NewServer(lg.ConfigGRPCServer{
 		Address:           cfg.Server.Address,
-		EnableHealthCheck: cfg.Server.EnableReflection,
+		EnableHealthCheck: cfg.Server.EnableHealthCheck,
 		EnableReflection:  cfg.Server.EnableReflection,
 		ShutdownTimeout:   cfg.Server.ShutdownTimeout,
 		Options:           opts,
 		MetricsRecorder:   &mr,
+		RateLimit:         cfg.Security.RateLimit(),
+		TLS:               cfg.Security.ServerTLS(),
+		Identity:          cfg.Security.Identity(),
+		Messages:          lg.NewCatalogue().Register(lg.LocaleThai, thaiMessages),
+		LocaleResolver:    profileLocale(users),
+		Health:            health,
 	}, lgr)
 	if err != nil {
 		return nil, err
 	}
 
 	server.RegisHandler(func(gs *grpc.Server) {
-		handlers.RegisUserGRPCHandler(gs, lg.NewGrpcHandlerOption(bus.CommandBus, bus.QueryBus, lgr, vd))
+		handlers.RegisUserGRPCHandler(gs, lg.NewGrpcHandlerOption(bus.CommandBus, bus.QueryBus, lgr, vd), handlers.ProfileWatchOptions{
+			Watchers:    watchers,
+			MaxDuration: cfg.Watch.MaxDuration,
+			SendTimeout: cfg.Watch.SendTimeout,
+		})
 	})
 
 	return server, nil
 }
+
+// profileLocale answers signed-in users in the language they chose in
+// their preferences. Users who never chose one fall back to accept-language.
+func profileLocale(users user.UserRepository) lg.LocaleResolver {
+	return func(ctx context.Context, c lg.Caller) (string, bool) {
+		u, err := users.FindByID(ctx, ids.UserID(c.UserID))
+		if err != nil {
+			return "", false
+		}
+		locale, ok := u.Profile.Preferences[user.PreferenceLanguage].(string)
+		return locale, ok
+	}
+}
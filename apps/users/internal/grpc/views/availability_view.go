@@ -0,0 +1,121 @@
+package views
+
+import (
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/users/api/proto/user"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/availability"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func domainExceptionKindToProto(kind string) pb.AvailabilityExceptionKind {
+	switch availability.ExceptionKind(kind) {
+	case availability.ExceptionKindAvailable:
+		return pb.AvailabilityExceptionKind_AVAILABILITY_EXCEPTION_KIND_AVAILABLE
+	case availability.ExceptionKindBlackout:
+		return pb.AvailabilityExceptionKind_AVAILABILITY_EXCEPTION_KIND_BLACKOUT
+	default:
+		return pb.AvailabilityExceptionKind_AVAILABILITY_EXCEPTION_KIND_UNSPECIFIED
+	}
+}
+
+// protoExceptionKindToDomain leaves UNSPECIFIED empty so validation rejects it.
+func protoExceptionKindToDomain(kind pb.AvailabilityExceptionKind) availability.ExceptionKind {
+	switch kind {
+	case pb.AvailabilityExceptionKind_AVAILABILITY_EXCEPTION_KIND_AVAILABLE:
+		return availability.ExceptionKindAvailable
+	case pb.AvailabilityExceptionKind_AVAILABILITY_EXCEPTION_KIND_BLACKOUT:
+		return availability.ExceptionKindBlackout
+	default:
+		return ""
+	}
+}
+
+func AvailabilitySlotInputs(in []*pb.AvailabilitySlot) []command.AvailabilitySlotInput {
+	out := make([]command.AvailabilitySlotInput, 0, len(in))
+	for _, s := range in {
+		out = append(out, command.AvailabilitySlotInput{
+			RRule:           s.GetRrule(),
+			StartDate:       s.GetStartDate(),
+			StartTime:       s.GetStartTime(),
+			DurationMinutes: int(s.GetDurationMinutes()),
+		})
+	}
+	return out
+}
+
+func AvailabilityExceptionInputs(in []*pb.AvailabilityException) []command.AvailabilityExceptionInput {
+	out := make([]command.AvailabilityExceptionInput, 0, len(in))
+	for _, e := range in {
+		out = append(out, command.AvailabilityExceptionInput{
+			Kind:     protoExceptionKindToDomain(e.GetKind()),
+			StartsAt: e.GetStartsAt().AsTime(),
+			EndsAt:   e.GetEndsAt().AsTime(),
+			Reason:   lg.StringValueToPtr(e.GetReason()),
+		})
+	}
+	return out
+}
+
+func timeRanges(intervals []query.IntervalDTO) []*pb.TimeRange {
+	out := make([]*pb.TimeRange, 0, len(intervals))
+	for _, iv := range intervals {
+		out = append(out, &pb.TimeRange{
+			Start: timestamppb.New(iv.Start),
+			End:   timestamppb.New(iv.End),
+		})
+	}
+	return out
+}
+
+func TaskerAvailability(payload *query.TaskerAvailabilityDTO) *pb.TaskerAvailability {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.TaskerAvailability{
+		UserId:        payload.UserID,
+		Timezone:      payload.Timezone,
+		Slots:         make([]*pb.AvailabilitySlot, 0, len(payload.Slots)),
+		Exceptions:    make([]*pb.AvailabilityException, 0, len(payload.Exceptions)),
+		FreeIntervals: timeRanges(payload.FreeIntervals),
+		UpdatedAt:     timestamppb.New(payload.UpdatedAt),
+	}
+	for _, s := range payload.Slots {
+		resp.Slots = append(resp.Slots, &pb.AvailabilitySlot{
+			Id:              s.ID,
+			Rrule:           s.RRule,
+			StartDate:       s.StartDate,
+			StartTime:       s.StartTime,
+			DurationMinutes: int32(s.DurationMinutes),
+		})
+	}
+	for _, e := range payload.Exceptions {
+		resp.Exceptions = append(resp.Exceptions, &pb.AvailabilityException{
+			Id:       e.ID,
+			Kind:     domainExceptionKindToProto(e.Kind),
+			StartsAt: timestamppb.New(e.StartsAt),
+			EndsAt:   timestamppb.New(e.EndsAt),
+			Reason:   lg.PtrToStringValue(e.Reason),
+		})
+	}
+	return resp
+}
+
+func FindAvailableTaskersResponse(payload *query.FindAvailableTaskersDTO) *pb.FindAvailableTaskersResponse {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.FindAvailableTaskersResponse{
+		Taskers:       make([]*pb.AvailableTasker, 0, len(payload.Taskers)),
+		NextPageToken: payload.NextPageToken,
+	}
+	for _, t := range payload.Taskers {
+		resp.Taskers = append(resp.Taskers, &pb.AvailableTasker{
+			UserId:        t.UserID,
+			Timezone:      t.Timezone,
+			FreeIntervals: timeRanges(t.FreeIntervals),
+		})
+	}
+	return resp
+}
@@ -0,0 +1,83 @@
+package views
+
+import (
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/users/api/proto/user"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/query"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/dataexport"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func domainExportStatusToProto(status string) pb.DataExportStatus {
+	switch dataexport.ExportStatus(status) {
+	case dataexport.ExportStatusPending:
+		return pb.DataExportStatus_DATA_EXPORT_STATUS_PENDING
+	case dataexport.ExportStatusRunning:
+		return pb.DataExportStatus_DATA_EXPORT_STATUS_RUNNING
+	case dataexport.ExportStatusCompleted:
+		return pb.DataExportStatus_DATA_EXPORT_STATUS_COMPLETED
+	case dataexport.ExportStatusFailed:
+		return pb.DataExportStatus_DATA_EXPORT_STATUS_FAILED
+	default:
+		return pb.DataExportStatus_DATA_EXPORT_STATUS_UNSPECIFIED
+	}
+}
+
+func domainExportFormatToProto(format string) pb.DataExportFormat {
+	switch dataexport.ExportFormat(format) {
+	case dataexport.ExportFormatJSON:
+		return pb.DataExportFormat_DATA_EXPORT_FORMAT_JSON
+	case dataexport.ExportFormatZIP:
+		return pb.DataExportFormat_DATA_EXPORT_FORMAT_ZIP
+	default:
+		return pb.DataExportFormat_DATA_EXPORT_FORMAT_UNSPECIFIED
+	}
+}
+
+// ProtoExportFormatToDomain maps the requested format; UNSPECIFIED falls back to JSON.
+func ProtoExportFormatToDomain(format pb.DataExportFormat) string {
+	switch format {
+	case pb.DataExportFormat_DATA_EXPORT_FORMAT_ZIP:
+		return string(dataexport.ExportFormatZIP)
+	default:
+		return string(dataexport.ExportFormatJSON)
+	}
+}
+
+func ExportUserDataResponse(payload *command.ExportUserDataDto) *pb.ExportUserDataResponse {
+	if payload == nil {
+		return nil
+	}
+	return &pb.ExportUserDataResponse{
+		ExportId: payload.ExportID,
+		Status:   domainExportStatusToProto(payload.Status),
+	}
+}
+
+func DataExport(payload *query.DataExportStatusDTO) *pb.DataExport {
+	if payload == nil {
+		return nil
+	}
+	protoDTO := &pb.DataExport{
+		ExportId:      payload.ExportID,
+		UserId:        payload.UserID,
+		Format:        domainExportFormatToProto(payload.Format),
+		Status:        domainExportStatusToProto(payload.Status),
+		SchemaVersion: int32(payload.SchemaVersion),
+		SizeBytes:     payload.SizeBytes,
+		FailureReason: lg.PtrToStringValue(payload.FailureReason),
+		RequestedAt:   timestamppb.New(payload.RequestedAt),
+		DownloadUrl:   lg.PtrToStringValue(payload.DownloadURL),
+	}
+	if payload.CompletedAt != nil {
+		protoDTO.CompletedAt = timestamppb.New(*payload.CompletedAt)
+	}
+	if payload.ExpiresAt != nil {
+		protoDTO.ExpiresAt = timestamppb.New(*payload.ExpiresAt)
+	}
+	if payload.DownloadExpiresAt != nil {
+		protoDTO.DownloadExpiresAt = timestamppb.New(*payload.DownloadExpiresAt)
+	}
+	return protoDTO
+}
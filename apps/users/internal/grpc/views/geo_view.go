@@ -0,0 +1,81 @@
+package views
+
+import (
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/users/api/proto/user"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/query"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+)
+
+// PointToPtrs splits an optional GeoPoint into optional coordinates; commands
+// geocode addresses that come without them.
+func PointToPtrs(p *pb.GeoPoint) (latitude, longitude *float64) {
+	if p == nil {
+		return nil, nil
+	}
+	lat, lng := p.GetLatitude(), p.GetLongitude()
+	return &lat, &lng
+}
+
+func geoPoint(p *query.GeoPointDTO) *pb.GeoPoint {
+	if p == nil {
+		return nil
+	}
+	return &pb.GeoPoint{Latitude: p.Latitude, Longitude: p.Longitude}
+}
+
+// AddressInput maps an optional address; nil means "leave unchanged".
+func AddressInput(in *pb.Address) *command.AddressInput {
+	if in == nil {
+		return nil
+	}
+	latitude, longitude := PointToPtrs(in.GetLocation())
+	return &command.AddressInput{
+		SubDistrict: lg.StringValueToPtr(in.GetSubDistrict()),
+		District:    lg.StringValueToPtr(in.GetDistrict()),
+		Province:    in.GetProvince(),
+		PostalCode:  lg.StringValueToPtr(in.GetPostalCode()),
+		CountryCode: in.GetCountryCode(),
+		Latitude:    latitude,
+		Longitude:   longitude,
+	}
+}
+
+func Address(payload *query.AddressDTO) *pb.Address {
+	if payload == nil {
+		return nil
+	}
+	return &pb.Address{
+		SubDistrict: lg.PtrToStringValue(payload.SubDistrict),
+		District:    lg.PtrToStringValue(payload.District),
+		Province:    payload.Province,
+		PostalCode:  lg.PtrToStringValue(payload.PostalCode),
+		CountryCode: payload.CountryCode,
+		Location:    geoPoint(payload.Location),
+	}
+}
+
+func ServiceArea(payload query.ServiceAreaDTO) *pb.ServiceArea {
+	return &pb.ServiceArea{
+		Province:   payload.Province,
+		District:   lg.PtrToStringValue(payload.District),
+		PostalCode: lg.PtrToStringValue(payload.PostalCode),
+		Location:   geoPoint(payload.Location),
+	}
+}
+
+func FindTaskersNearResponse(payload *query.FindTaskersNearDTO) *pb.FindTaskersNearResponse {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.FindTaskersNearResponse{Taskers: make([]*pb.NearbyTasker, 0, len(payload.Taskers))}
+	for _, t := range payload.Taskers {
+		resp.Taskers = append(resp.Taskers, &pb.NearbyTasker{
+			UserId:         t.UserID,
+			Headline:       lg.PtrToStringValue(t.Headline),
+			NearestArea:    ServiceArea(t.NearestArea),
+			DistanceMeters: t.DistanceMeters,
+		})
+	}
+	return resp
+}
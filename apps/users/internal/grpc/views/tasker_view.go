@@ -0,0 +1,114 @@
+package views
+
+import (
+	pb "github.com/pratchaya-maneechot/service-exchange/apps/users/api/proto/user"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/query"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TaskerProfileInput maps the editable tasker fields of a create or update request.
+func TaskerProfileInput(in *pb.TaskerProfileInput) command.TaskerProfileInput {
+	out := command.TaskerProfileInput{
+		Headline:   lg.StringValueToPtr(in.GetHeadline()),
+		SkillCodes: in.GetSkillCodes(),
+		HourlyRate: command.HourlyRateInput{
+			MinAmount: in.GetHourlyRate().GetMinAmount(),
+			MaxAmount: in.GetHourlyRate().GetMaxAmount(),
+			Currency:  in.GetHourlyRate().GetCurrency(),
+		},
+		ServiceAreas:      make([]command.ServiceAreaInput, 0, len(in.GetServiceAreas())),
+		Portfolio:         make([]command.PortfolioItemInput, 0, len(in.GetPortfolio())),
+		YearsOfExperience: int(in.GetYearsOfExperience()),
+		IsVisible:         in.GetIsVisible(),
+	}
+	for _, a := range in.GetServiceAreas() {
+		latitude, longitude := PointToPtrs(a.GetLocation())
+		out.ServiceAreas = append(out.ServiceAreas, command.ServiceAreaInput{
+			Province:   a.GetProvince(),
+			District:   lg.StringValueToPtr(a.GetDistrict()),
+			PostalCode: lg.StringValueToPtr(a.GetPostalCode()),
+			Latitude:   latitude,
+			Longitude:  longitude,
+		})
+	}
+	for _, p := range in.GetPortfolio() {
+		item := command.PortfolioItemInput{
+			ID:          p.GetId(),
+			Title:       p.GetTitle(),
+			Description: lg.StringValueToPtr(p.GetDescription()),
+			ImageURLs:   p.GetImageUrls(),
+		}
+		if p.GetCompletedAt() != nil {
+			completedAt := p.GetCompletedAt().AsTime()
+			item.CompletedAt = &completedAt
+		}
+		out.Portfolio = append(out.Portfolio, item)
+	}
+	return out
+}
+
+func TaskerProfile(payload *query.TaskerProfileDTO) *pb.TaskerProfile {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.TaskerProfile{
+		UserId:   payload.UserID,
+		Headline: lg.PtrToStringValue(payload.Headline),
+		Skills:   make([]*pb.SkillCategory, 0, len(payload.Skills)),
+		HourlyRate: &pb.HourlyRateRange{
+			MinAmount: payload.HourlyRate.MinAmount,
+			MaxAmount: payload.HourlyRate.MaxAmount,
+			Currency:  payload.HourlyRate.Currency,
+		},
+		ServiceAreas:      make([]*pb.ServiceArea, 0, len(payload.ServiceAreas)),
+		Portfolio:         make([]*pb.PortfolioItem, 0, len(payload.Portfolio)),
+		YearsOfExperience: int32(payload.YearsOfExperience),
+		IsVisible:         payload.IsVisible,
+		CreatedAt:         timestamppb.New(payload.CreatedAt),
+		UpdatedAt:         timestamppb.New(payload.UpdatedAt),
+	}
+	for _, s := range payload.Skills {
+		resp.Skills = append(resp.Skills, SkillCategory(s))
+	}
+	for _, a := range payload.ServiceAreas {
+		resp.ServiceAreas = append(resp.ServiceAreas, ServiceArea(a))
+	}
+	for _, p := range payload.Portfolio {
+		var completedAt *timestamppb.Timestamp
+		if p.CompletedAt != nil {
+			completedAt = timestamppb.New(*p.CompletedAt)
+		}
+		resp.Portfolio = append(resp.Portfolio, &pb.PortfolioItem{
+			Id:          p.ID,
+			Title:       p.Title,
+			Description: lg.PtrToStringValue(p.Description),
+			ImageUrls:   p.ImageURLs,
+			CompletedAt: completedAt,
+		})
+	}
+	return resp
+}
+
+func SkillCategory(payload query.SkillCategoryDTO) *pb.SkillCategory {
+	return &pb.SkillCategory{
+		Code:        payload.Code,
+		Name:        payload.Name,
+		Description: lg.PtrToStringValue(payload.Description),
+		IsActive:    payload.IsActive,
+	}
+}
+
+func ListSkillCategoriesResponse(payload *query.ListSkillCategoriesDTO) *pb.ListSkillCategoriesResponse {
+	if payload == nil {
+		return nil
+	}
+	resp := &pb.ListSkillCategoriesResponse{
+		Categories: make([]*pb.SkillCategory, 0, len(payload.Categories)),
+	}
+	for _, c := range payload.Categories {
+		resp.Categories = append(resp.Categories, SkillCategory(c))
+	}
+	return resp
+}
@@ -1,6 +1,8 @@
 package views
 
 import (
+	"fmt"
+
 	pb "github.com/pratchaya-maneechot/service-exchange/apps/users/api/proto/user"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/query"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
@@ -23,31 +25,150 @@ func domainUserStatusToProto(domainStatus user.UserStatus) pb.UserStatus {
 	}
 }
 
-func UserProfile(payload *query.UserProfileDTO) *pb.UserProfile {
+func UserProfile(payload *query.UserProfileDTO) (*pb.UserProfile, error) {
 	if payload == nil {
-		return nil
+		return nil, nil
+	}
+	preferences, err := lg.MapToStruct(payload.Preferences)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert preferences to struct: %w", err)
 	}
 	var lastLoginAt *timestamppb.Timestamp
 	if payload.LastLoginAt != nil {
 		lastLoginAt = timestamppb.New(*payload.LastLoginAt)
 	}
 	protoDTO := &pb.UserProfile{
+		UserId:            payload.UserID,
+		LineUserId:        payload.LineUserID,
+		Email:             lg.PtrToStringValue(payload.Email),
+		DisplayName:       payload.DisplayName,
+		Status:            domainUserStatusToProto(payload.Status),
+		IsVerified:        payload.IsVerified,
+		CreatedAt:         timestamppb.New(payload.CreatedAt),
+		LastLoginAt:       lastLoginAt,
+		FirstName:         lg.PtrToStringValue(payload.FirstName),
+		LastName:          lg.PtrToStringValue(payload.LastName),
+		Bio:               lg.PtrToStringValue(payload.Bio),
+		AvatarUrl:         lg.PtrToStringValue(payload.AvatarURL),
+		PhoneNumber:       lg.PtrToStringValue(payload.PhoneNumber),
+		Address:           lg.PtrToStringValue(payload.Address),
+		StructuredAddress: Address(payload.StructuredAddress),
+		Preferences:       preferences,
+		Locale:            payload.Locale,
+		Timezone:          payload.Timezone,
+		Roles:             payload.Roles,
+		Reputation:        Reputation(payload.Reputation),
+	}
+	return protoDTO, nil
+}
+
+func PublicProfile(payload *query.PublicProfileDTO) *pb.PublicProfile {
+	if payload == nil {
+		return nil
+	}
+	return &pb.PublicProfile{
 		UserId:      payload.UserID,
-		LineUserId:  payload.LineUserID,
-		Email:       lg.PtrToStringValue(payload.Email),
 		DisplayName: payload.DisplayName,
-		Status:      domainUserStatusToProto(payload.Status),
-		IsVerified:  payload.IsVerified,
-		CreatedAt:   timestamppb.New(payload.CreatedAt),
-		LastLoginAt: lastLoginAt,
 		FirstName:   lg.PtrToStringValue(payload.FirstName),
 		LastName:    lg.PtrToStringValue(payload.LastName),
 		Bio:         lg.PtrToStringValue(payload.Bio),
 		AvatarUrl:   lg.PtrToStringValue(payload.AvatarURL),
-		PhoneNumber: lg.PtrToStringValue(payload.PhoneNumber),
-		Address:     lg.PtrToStringValue(payload.Address),
-		Preferences: lg.AnyMapToStringMap(payload.Preferences),
+		IsVerified:  payload.IsVerified,
 		Roles:       payload.Roles,
+		MemberSince: timestamppb.New(payload.MemberSince),
+		Reputation:  Reputation(payload.Reputation),
+	}
+}
+
+func ResolvedUser(payload *query.ResolvedUserDTO) *pb.ResolvedUser {
+	if payload == nil {
+		return nil
+	}
+	return &pb.ResolvedUser{
+		UserId: payload.UserID,
+		Roles:  payload.Roles,
+	}
+}
+
+func Reputation(payload *query.ReputationDTO) *pb.Reputation {
+	if payload == nil {
+		return nil
+	}
+	return &pb.Reputation{
+		ReviewCount:     int32(payload.ReviewCount),
+		AverageRating:   payload.AverageRating,
+		BayesianAverage: payload.BayesianAverage,
+		RecentAverage:   payload.RecentAverage,
+		Trend:           payload.Trend,
+	}
+}
+
+// ProtoUserStatusToDomain maps a status filter; UNSPECIFIED means "no filter".
+func ProtoUserStatusToDomain(status pb.UserStatus) *user.UserStatus {
+	var s user.UserStatus
+	switch status {
+	case pb.UserStatus_USER_STATUS_ACTIVE:
+		s = user.UserStatusActive
+	case pb.UserStatus_USER_STATUS_INACTIVE:
+		s = user.UserStatusInactive
+	case pb.UserStatus_USER_STATUS_SUSPENDED:
+		s = user.UserStatusSuspended
+	case pb.UserStatus_USER_STATUS_PENDING_VERIFICATION:
+		s = user.UserStatusPendingVerification
+	default:
+		return nil
+	}
+	return &s
+}
+
+// ProtoVerificationStatusToDomain maps a verification filter; UNSPECIFIED means "no filter".
+func ProtoVerificationStatusToDomain(status pb.VerificationStatus) *user.VerificationStatus {
+	var s user.VerificationStatus
+	switch status {
+	case pb.VerificationStatus_VERIFICATION_STATUS_PENDING:
+		s = user.VerificationStatusPending
+	case pb.VerificationStatus_VERIFICATION_STATUS_APPROVED:
+		s = user.VerificationStatusApproved
+	case pb.VerificationStatus_VERIFICATION_STATUS_REJECTED:
+		s = user.VerificationStatusRejected
+	default:
+		return nil
+	}
+	return &s
+}
+
+func ListUsersResponse(payload *query.SearchUsersResultDTO) (*pb.ListUsersResponse, error) {
+	if payload == nil {
+		return nil, nil
+	}
+	users := make([]*pb.UserProfile, 0, len(payload.Users))
+	for i := range payload.Users {
+		profile, err := UserProfile(&payload.Users[i])
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, profile)
+	}
+	return &pb.ListUsersResponse{
+		Users:         users,
+		NextPageToken: payload.NextPageToken,
+	}, nil
+}
+
+func BatchGetUserProfilesResponse(payload *query.BatchGetUserProfilesDTO) (*pb.BatchGetUserProfilesResponse, error) {
+	if payload == nil {
+		return nil, nil
+	}
+	profiles := make([]*pb.UserProfile, 0, len(payload.Profiles))
+	for i := range payload.Profiles {
+		profile, err := UserProfile(&payload.Profiles[i])
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, profile)
 	}
-	return protoDTO
+	return &pb.BatchGetUserProfilesResponse{
+		Profiles:        profiles,
+		NotFoundUserIds: payload.NotFoundUserIDs,
+	}, nil
 }
@@ -0,0 +1,115 @@
+package geocoding
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/geo"
+)
+
+//go:embed fixtures/th_places.json
+var embeddedPlaces []byte
+
+// place is one fixture entry. Entries without districts are province
+// centroids; names list every spelling that should match.
+type place struct {
+	CountryCode string   `json:"countryCode"`
+	Province    []string `json:"province"`
+	District    []string `json:"district"`
+	PostalCodes []string `json:"postalCodes"`
+	Latitude    float64  `json:"latitude"`
+	Longitude   float64  `json:"longitude"`
+}
+
+// fixtureGeocoder resolves addresses against a small table of administrative
+// centroids. It needs no network access, which makes it suitable for local
+// development and tests; its precision is district level at best.
+type fixtureGeocoder struct {
+	byDistrict map[string]geo.Point
+	byPostal   map[string]geo.Point
+	byProvince map[string]geo.Point
+}
+
+func NewFixtureGeocoder(cfg *config.Config) (geo.Geocoder, error) {
+	data := embeddedPlaces
+	if cfg.Geocoding.FixtureFile != "" {
+		var err error
+		if data, err = os.ReadFile(cfg.Geocoding.FixtureFile); err != nil {
+			return nil, fmt.Errorf("failed to read geocoding fixture %s: %w", cfg.Geocoding.FixtureFile, err)
+		}
+	}
+
+	var places []place
+	if err := json.Unmarshal(data, &places); err != nil {
+		return nil, fmt.Errorf("failed to parse geocoding fixture: %w", err)
+	}
+
+	g := &fixtureGeocoder{
+		byDistrict: make(map[string]geo.Point),
+		byPostal:   make(map[string]geo.Point),
+		byProvince: make(map[string]geo.Point),
+	}
+	for _, p := range places {
+		point, err := geo.NewPoint(p.Latitude, p.Longitude)
+		if err != nil {
+			return nil, fmt.Errorf("invalid geocoding fixture entry %v: %w", p.Province, err)
+		}
+		country := strings.ToUpper(p.CountryCode)
+		for _, code := range p.PostalCodes {
+			// The first entry listing a postal code wins; several districts can share one.
+			if _, ok := g.byPostal[country+"|"+code]; !ok {
+				g.byPostal[country+"|"+code] = point
+			}
+		}
+		for _, province := range p.Province {
+			if len(p.District) == 0 {
+				g.byProvince[country+"|"+normalize(province)] = point
+				continue
+			}
+			for _, district := range p.District {
+				g.byDistrict[country+"|"+normalize(province)+"|"+normalize(district)] = point
+			}
+		}
+	}
+	return g, nil
+}
+
+// Geocode tries the district, then the postal code, then the province
+// centroid, so an unknown district still resolves to its province.
+func (g *fixtureGeocoder) Geocode(ctx context.Context, a geo.Address) (geo.Point, error) {
+	country := strings.ToUpper(a.CountryCode)
+	if country == "" {
+		country = geo.DefaultCountryCode
+	}
+	province := normalize(a.Province)
+
+	if a.District != nil {
+		if p, ok := g.byDistrict[country+"|"+province+"|"+normalize(*a.District)]; ok {
+			return p, nil
+		}
+	}
+	if a.PostalCode != nil {
+		if p, ok := g.byPostal[country+"|"+strings.TrimSpace(*a.PostalCode)]; ok {
+			return p, nil
+		}
+	}
+	if p, ok := g.byProvince[country+"|"+province]; ok {
+		return p, nil
+	}
+	return geo.Point{}, geo.ErrAddressNotFound
+}
+
+// normalize folds case and drops the administrative prefixes people commonly
+// type, e.g. "Khet Bang Rak" or "อำเภอหาดใหญ่".
+func normalize(s string) string {
+	s = strings.ToLower(strings.Join(strings.Fields(s), " "))
+	for _, prefix := range []string{"changwat ", "amphoe ", "khet ", "จังหวัด", "อำเภอ", "เขต"} {
+		s = strings.TrimPrefix(s, prefix)
+	}
+	return strings.TrimSpace(s)
+}
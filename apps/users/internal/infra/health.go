@@ -0,0 +1,24 @@
+package infra
+
+import (
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/role"
+	lh "github.com/pratchaya-maneechot/service-exchange/libs/health"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+)
+
+// ProvideHealthRegistry checks the dependencies the service cannot serve
+// without. It runs once started from main.
+func ProvideHealthRegistry(cfg *config.Config, logger *slog.Logger, dbPool *lp.DBPool, roles *role.RoleCacheService) *lh.Registry {
+	registry := lh.NewRegistry(lh.Config{
+		Interval: cfg.Health.Interval,
+		Timeout:  cfg.Health.Timeout,
+	}, logger)
+	registry.Register(
+		lh.Check{Name: "postgres", Run: dbPool.Ping, Critical: true},
+		lh.Check{Name: "role_cache", Run: roles.CheckLoaded, Critical: true},
+	)
+	return registry
+}
@@ -8,27 +8,42 @@ import (
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
 	"github.com/google/wire"
+	tasksclient "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/client"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/dataexport"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/infra/geocoding"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/infra/notifications"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/infra/persistence/postgres"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/infra/persistence/readers"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/infra/persistence/repositories"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/infra/relationships"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/infra/storage"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	lh "github.com/pratchaya-maneechot/service-exchange/libs/health"
 	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
 	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+	"google.golang.org/grpc"
 )
 
 type Infra struct {
-	dbPool *lp.DBPool
-	logger *slog.Logger
-	tracer *sdktrace.TracerProvider
+	dbPool        *lp.DBPool
+	tasks         *tasksclient.Client
+	notifications *grpc.ClientConn
+	logger        *slog.Logger
+	tracer        *sdktrace.TracerProvider
 }
 
 func NewInfra(
 	dbPool *lp.DBPool,
+	tasks *tasksclient.Client,
+	notifications *grpc.ClientConn,
 	logger *slog.Logger,
 	tracer *sdktrace.TracerProvider,
 ) *Infra {
 	return &Infra{
 		dbPool,
+		tasks,
+		notifications,
 		logger,
 		tracer,
 	}
@@ -42,6 +57,18 @@ func (i *Infra) Close(ctx context.Context) error {
 			errs = append(errs, fmt.Errorf("tracer shutdown failed: %w", err))
 		}
 	}
+	if i.tasks != nil {
+		if err := i.tasks.Close(); err != nil {
+			i.logger.Error("Failed to close tasks client", "error", err)
+			errs = append(errs, fmt.Errorf("tasks client close failed: %w", err))
+		}
+	}
+	if i.notifications != nil {
+		if err := i.notifications.Close(); err != nil {
+			i.logger.Error("Failed to close notifications connection", "error", err)
+			errs = append(errs, fmt.Errorf("notifications connection close failed: %w", err))
+		}
+	}
 	if i.dbPool != nil {
 		i.dbPool.Close()
 		i.logger.Info("PostgreSQL database pool closed.")
@@ -54,11 +81,12 @@ func (i *Infra) Close(ctx context.Context) error {
 	return nil
 }
 
-func ProvideMetricServer(cfg *config.Config) *observability.MetricServer {
+func ProvideMetricServer(cfg *config.Config, health *lh.Registry) *observability.MetricServer {
 	return observability.NewMetricServer(observability.MetricConfig{
 		Path:    cfg.Metrics.Path,
 		Addr:    cfg.Metrics.Address,
 		Enabled: cfg.Metrics.Enabled,
+		Health:  health,
 	})
 }
 
@@ -82,12 +110,41 @@ func ProvideMetricRecorder() observability.MetricsRecorder {
 	return observability.NewPrometheusMetricsRecorder()
 }
 
+func ProvideClientFactory(cfg *config.Config, logger *slog.Logger, recorder observability.MetricsRecorder) *lg.ClientFactory {
+	return lg.NewClientFactory(logger, recorder, cfg.Security.Identity())
+}
+
+func ProvideTasksClient(ctx context.Context, cfg *config.Config, factory *lg.ClientFactory) (*tasksclient.Client, error) {
+	return tasksclient.New(ctx, factory, cfg.Clients.Tasks.Client(cfg.Security))
+}
+
+// ProvideNotificationsConn connects to the notifications service, which
+// serves the UserEventSink of userevent.proto.
+func ProvideNotificationsConn(ctx context.Context, cfg *config.Config, factory *lg.ClientFactory) (*grpc.ClientConn, error) {
+	return factory.NewClient(ctx, cfg.Clients.Notifications.Client(cfg.Security))
+}
+
 var InfraModuleSet = wire.NewSet(
 	postgres.NewDBConn,
 	repositories.NewPostgresUserRepository,
+	repositories.NewPostgresDataExportRepository,
+	repositories.NewPostgresTaskerProfileRepository,
+	repositories.NewPostgresSkillCatalogueRepository,
+	repositories.NewPostgresAvailabilityRepository,
+	storage.NewLocalArchiveStorage,
+	wire.Bind(new(dataexport.ArchiveStorage), new(*storage.LocalArchiveStorage)),
+	geocoding.NewFixtureGeocoder,
+	relationships.NewTasksCounterpartChecker,
+	relationships.NewTasksBookingCalendar,
+	relationships.NewTasksReputationReader,
 	readers.NewPostgresRoleReader,
+	ProvideClientFactory,
+	ProvideTasksClient,
+	ProvideNotificationsConn,
+	notifications.NewUserEventNotifier,
 	ProvideMetricServer,
 	ProvideMetricRecorder,
+	ProvideHealthRegistry,
 	ProvideLogger,
 	ProvideTracer,
 	NewInfra,
@@ -0,0 +1,55 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	usereventpb "github.com/pratchaya-maneechot/service-exchange/apps/users/api/proto/userevent"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// userEventNotifier delivers notices to the notifications service, which
+// serves the UserEventSink of userevent.proto, on behalf of the user they
+// are about. The notifications service reads the user's preferences from
+// this one to pick the channels.
+type userEventNotifier struct {
+	client usereventpb.UserEventSinkClient
+}
+
+// NewUserEventNotifier delivers notices over conn, a connection to the
+// notifications service made by a grpc.ClientFactory.
+func NewUserEventNotifier(conn *grpc.ClientConn) user.Notifier {
+	return userEventNotifier{client: usereventpb.NewUserEventSinkClient(conn)}
+}
+
+func (n userEventNotifier) Notify(ctx context.Context, u *user.User, notice user.Notice) error {
+	data, err := lg.MapToStruct(notice.Data)
+	if err != nil {
+		return fmt.Errorf("invalid data of notice %s: %w", notice.ID, err)
+	}
+	evt := &usereventpb.UserEvent{
+		EventId:     notice.ID,
+		Type:        notice.Type,
+		UserId:      string(u.ID),
+		Email:       lg.PtrToStringValue(u.Email),
+		PhoneNumber: lg.PtrToStringValue(u.Profile.PhoneNumber),
+		Data:        data,
+		OccurredAt:  timestamppb.New(time.Now()),
+	}
+	if u.LineUserID != "" {
+		evt.LineUserId = wrapperspb.String(u.LineUserID)
+	}
+
+	// Events are delivered from subscribers, where the caller may be an
+	// admin or the gateway; the user is who the notice is for.
+	ctx = lg.ContextWithCaller(ctx, lg.Caller{UserID: string(u.ID)})
+	if _, err := n.client.DeliverUserEvent(ctx, evt); err != nil {
+		return fmt.Errorf("failed to deliver %s: %w", notice.ID, lg.ErrorFromGRPC(err))
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+-- Create DataExports table to track data portability jobs
+CREATE TABLE data_exports (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    format VARCHAR(10) NOT NULL, -- Enum-like string (e.g., 'JSON', 'ZIP')
+    status VARCHAR(20) NOT NULL, -- Enum-like string (e.g., 'PENDING', 'RUNNING', 'COMPLETED', 'FAILED')
+    schema_version INTEGER NOT NULL,
+    storage_key TEXT,            -- Can be NULL until the archive is written
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    failure_reason TEXT,         -- Can be NULL
+    requested_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    started_at TIMESTAMP WITH TIME ZONE,   -- Can be NULL
+    completed_at TIMESTAMP WITH TIME ZONE, -- Can be NULL
+    expires_at TIMESTAMP WITH TIME ZONE    -- Can be NULL, download reference is refused after this point
+);
+
+CREATE INDEX idx_data_exports_user_id ON data_exports (user_id);
+CREATE INDEX idx_data_exports_status ON data_exports (status);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_users_status;
+DROP INDEX IF EXISTS idx_profiles_display_name_user_id;
+DROP INDEX IF EXISTS idx_users_created_at_id;
+DROP INDEX IF EXISTS idx_profiles_display_name_trgm;
+DROP INDEX IF EXISTS idx_users_email_trgm;
@@ -0,0 +1,10 @@
+-- Trigram indexes back the case-insensitive email / display name prefix search
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_users_email_trgm ON users USING GIN (LOWER(email) gin_trgm_ops);
+CREATE INDEX idx_profiles_display_name_trgm ON profiles USING GIN (LOWER(display_name) gin_trgm_ops);
+
+-- Keyset pagination indexes (sort column + id tie-breaker)
+CREATE INDEX idx_users_created_at_id ON users (created_at, id);
+CREATE INDEX idx_profiles_display_name_user_id ON profiles (display_name, user_id);
+CREATE INDEX idx_users_status ON users (status);
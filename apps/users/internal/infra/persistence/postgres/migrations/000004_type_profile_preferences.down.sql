@@ -0,0 +1,6 @@
+UPDATE profiles p
+SET preferences = b.preferences
+FROM profile_preferences_backup b
+WHERE b.user_id = p.user_id;
+
+DROP TABLE IF EXISTS profile_preferences_backup;
@@ -0,0 +1,57 @@
+-- Preferences used to arrive as map<string, string>, so every stored value is a
+-- string. Convert the keys the preference schema knows about into their typed
+-- form, drop anything the schema would reject, and keep the original rows so the
+-- migration can be reversed.
+CREATE TABLE profile_preferences_backup (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    preferences JSONB NOT NULL,
+    backed_up_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO profile_preferences_backup (user_id, preferences)
+SELECT user_id, preferences
+FROM profiles
+WHERE preferences <> '{}'::jsonb;
+
+UPDATE profiles p
+SET preferences = COALESCE((
+    SELECT jsonb_object_agg(t.key, t.value)
+    FROM (
+        SELECT 'language' AS key, to_jsonb(LOWER(p.preferences->>'language')) AS value
+        WHERE LOWER(p.preferences->>'language') IN ('th', 'en')
+
+        UNION ALL
+        SELECT 'distanceUnit', to_jsonb(LOWER(p.preferences->>'distanceUnit'))
+        WHERE LOWER(p.preferences->>'distanceUnit') IN ('km', 'mi')
+
+        UNION ALL
+        SELECT 'marketingOptIn', to_jsonb(LOWER(p.preferences->>'marketingOptIn') = 'true')
+        WHERE LOWER(p.preferences->>'marketingOptIn') IN ('true', 'false')
+
+        -- "line,email" strings become ["line", "email"]; unknown channels are dropped
+        UNION ALL
+        SELECT 'notificationChannels', (
+            SELECT jsonb_agg(DISTINCT c.channel)
+            FROM (
+                SELECT LOWER(TRIM(raw)) AS channel
+                FROM unnest(string_to_array(p.preferences->>'notificationChannels', ',')) AS raw
+                WHERE jsonb_typeof(p.preferences->'notificationChannels') = 'string'
+                UNION ALL
+                SELECT LOWER(raw)
+                FROM jsonb_array_elements_text(
+                    CASE WHEN jsonb_typeof(p.preferences->'notificationChannels') = 'array'
+                         THEN p.preferences->'notificationChannels'
+                         ELSE '[]'::jsonb
+                    END) AS raw
+            ) c
+            WHERE c.channel IN ('line', 'email', 'sms')
+        )
+        WHERE p.preferences ? 'notificationChannels'
+
+        UNION ALL
+        SELECT 'visibility', p.preferences->'visibility'
+        WHERE jsonb_typeof(p.preferences->'visibility') = 'object'
+    ) t
+    WHERE t.value IS NOT NULL
+), '{}'::jsonb)
+WHERE p.preferences <> '{}'::jsonb;
@@ -0,0 +1,13 @@
+-- Registration attribution, written once per user and read by analytics
+CREATE TABLE user_acquisitions (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    referral_code VARCHAR(64),
+    channel VARCHAR(64),
+    campaign VARCHAR(128),
+    metadata JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_user_acquisitions_channel_campaign ON user_acquisitions (channel, campaign, created_at);
+CREATE INDEX idx_user_acquisitions_referral_code ON user_acquisitions (referral_code) WHERE referral_code IS NOT NULL;
+CREATE INDEX idx_user_acquisitions_created_at ON user_acquisitions (created_at);
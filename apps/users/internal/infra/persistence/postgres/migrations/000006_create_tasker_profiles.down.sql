@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS tasker_portfolio_items;
+DROP TABLE IF EXISTS tasker_service_areas;
+DROP TABLE IF EXISTS tasker_skills;
+DROP TABLE IF EXISTS tasker_profiles;
+DROP TABLE IF EXISTS skill_categories;
@@ -0,0 +1,62 @@
+-- Admin-managed catalogue of skills a tasker can offer
+CREATE TABLE skill_categories (
+    code VARCHAR(64) PRIMARY KEY, -- Stable identifier, e.g. 'cleaning'
+    name VARCHAR(100) NOT NULL,
+    description TEXT, -- Can be NULL
+    is_active BOOLEAN NOT NULL DEFAULT TRUE, -- Inactive categories stay on existing profiles but cannot be newly chosen
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO skill_categories (code, name, description) VALUES
+    ('cleaning', 'Cleaning', 'Home and office cleaning'),
+    ('plumbing', 'Plumbing', 'Leaks, pipes and fixtures'),
+    ('electrical', 'Electrical', 'Wiring, lighting and appliances'),
+    ('moving', 'Moving', 'Packing, lifting and transport'),
+    ('handyman', 'Handyman', 'Assembly, mounting and small repairs'),
+    ('gardening', 'Gardening', 'Lawn care, planting and trimming'),
+    ('tutoring', 'Tutoring', 'Lessons and homework help'),
+    ('delivery', 'Delivery', 'Errands and parcel delivery');
+
+-- One tasker profile per user holding the TASKER role
+CREATE TABLE tasker_profiles (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    headline VARCHAR(150), -- Can be NULL
+    hourly_rate_min BIGINT NOT NULL CHECK (hourly_rate_min >= 0), -- Minor currency units
+    hourly_rate_max BIGINT NOT NULL,
+    currency CHAR(3) NOT NULL DEFAULT 'THB',
+    years_of_experience INTEGER NOT NULL DEFAULT 0 CHECK (years_of_experience BETWEEN 0 AND 80),
+    is_visible BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK (hourly_rate_max >= hourly_rate_min)
+);
+
+CREATE TABLE tasker_skills (
+    user_id UUID NOT NULL REFERENCES tasker_profiles(user_id) ON DELETE CASCADE,
+    category_code VARCHAR(64) NOT NULL REFERENCES skill_categories(code),
+    PRIMARY KEY (user_id, category_code)
+);
+
+CREATE TABLE tasker_service_areas (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES tasker_profiles(user_id) ON DELETE CASCADE,
+    province VARCHAR(100) NOT NULL,
+    district VARCHAR(100), -- Can be NULL, meaning the whole province
+    position INTEGER NOT NULL -- Display order chosen by the tasker
+);
+
+CREATE TABLE tasker_portfolio_items (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES tasker_profiles(user_id) ON DELETE CASCADE,
+    title VARCHAR(150) NOT NULL,
+    description TEXT, -- Can be NULL
+    image_urls TEXT[] NOT NULL DEFAULT '{}',
+    completed_at TIMESTAMP WITH TIME ZONE, -- Can be NULL
+    position INTEGER NOT NULL
+);
+
+CREATE INDEX idx_tasker_skills_category_code ON tasker_skills (category_code);
+CREATE INDEX idx_tasker_service_areas_user_id ON tasker_service_areas (user_id);
+CREATE INDEX idx_tasker_service_areas_province ON tasker_service_areas (province, district);
+CREATE INDEX idx_tasker_portfolio_items_user_id ON tasker_portfolio_items (user_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS availability_exceptions;
+DROP TABLE IF EXISTS availability_slots;
+DROP TABLE IF EXISTS availability_schedules;
@@ -0,0 +1,31 @@
+-- Weekly availability of a tasker, expressed in their own time zone
+CREATE TABLE availability_schedules (
+    user_id UUID PRIMARY KEY REFERENCES tasker_profiles(user_id) ON DELETE CASCADE,
+    timezone VARCHAR(64) NOT NULL, -- IANA name, e.g. 'Asia/Bangkok'
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Recurring wall-clock slots; expanded in the application with the schedule's time zone
+CREATE TABLE availability_slots (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES availability_schedules(user_id) ON DELETE CASCADE,
+    rrule TEXT NOT NULL, -- RFC 5545 subset, e.g. 'FREQ=WEEKLY;BYDAY=MO,WE'
+    start_date DATE NOT NULL, -- Local date of the first occurrence (DTSTART)
+    start_minute INTEGER NOT NULL CHECK (start_minute BETWEEN 0 AND 1439), -- Local minutes after midnight
+    duration_minutes INTEGER NOT NULL CHECK (duration_minutes BETWEEN 15 AND 1440),
+    position INTEGER NOT NULL
+);
+
+-- One-off additions (AVAILABLE) and removals (BLACKOUT), stored as absolute time
+CREATE TABLE availability_exceptions (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES availability_schedules(user_id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL, -- 'AVAILABLE' or 'BLACKOUT'
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reason TEXT, -- Can be NULL
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX idx_availability_slots_user_id ON availability_slots (user_id);
+CREATE INDEX idx_availability_exceptions_user_id_period ON availability_exceptions (user_id, starts_at, ends_at);
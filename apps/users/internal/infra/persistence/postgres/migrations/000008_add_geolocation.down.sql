@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idx_tasker_service_areas_location;
+
+ALTER TABLE tasker_service_areas
+    DROP CONSTRAINT IF EXISTS tasker_service_areas_coordinates_check,
+    DROP COLUMN IF EXISTS location,
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude,
+    DROP COLUMN IF EXISTS postal_code;
+
+DROP TABLE IF EXISTS profile_addresses;
+
+-- The postgis extension is left installed; other databases on the server may use it
@@ -0,0 +1,33 @@
+CREATE EXTENSION IF NOT EXISTS postgis;
+
+-- Structured part of a profile address; street-level detail stays in profiles.address
+CREATE TABLE profile_addresses (
+    user_id UUID PRIMARY KEY REFERENCES profiles(user_id) ON DELETE CASCADE,
+    sub_district VARCHAR(100), -- Can be NULL
+    district VARCHAR(100),     -- Can be NULL
+    province VARCHAR(100) NOT NULL,
+    postal_code VARCHAR(10),   -- Can be NULL
+    country_code CHAR(2) NOT NULL DEFAULT 'TH',
+    latitude DOUBLE PRECISION,  -- Can be NULL until geocoded
+    longitude DOUBLE PRECISION, -- Can be NULL until geocoded
+    -- Derived from latitude/longitude so the application never handles geography values
+    location GEOGRAPHY(POINT, 4326) GENERATED ALWAYS AS (ST_SetSRID(ST_MakePoint(longitude, latitude), 4326)::geography) STORED,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT profile_addresses_coordinates_check CHECK (
+        (latitude IS NULL AND longitude IS NULL)
+        OR (latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180)
+    )
+);
+
+ALTER TABLE tasker_service_areas
+    ADD COLUMN postal_code VARCHAR(10), -- Can be NULL
+    ADD COLUMN latitude DOUBLE PRECISION, -- Can be NULL for areas saved before geocoding
+    ADD COLUMN longitude DOUBLE PRECISION,
+    ADD COLUMN location GEOGRAPHY(POINT, 4326) GENERATED ALWAYS AS (ST_SetSRID(ST_MakePoint(longitude, latitude), 4326)::geography) STORED,
+    ADD CONSTRAINT tasker_service_areas_coordinates_check CHECK (
+        (latitude IS NULL AND longitude IS NULL)
+        OR (latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180)
+    );
+
+CREATE INDEX idx_profile_addresses_location ON profile_addresses USING GIST (location);
+CREATE INDEX idx_tasker_service_areas_location ON tasker_service_areas USING GIST (location);
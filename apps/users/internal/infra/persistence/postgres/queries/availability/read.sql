@@ -0,0 +1,30 @@
+-- name: FindAvailabilityScheduleByUserID :one
+SELECT user_id, timezone, updated_at FROM availability_schedules WHERE user_id = $1;
+
+-- name: FindAvailabilitySchedulesBySkill :many
+SELECT s.user_id, s.timezone, s.updated_at
+FROM availability_schedules s
+JOIN tasker_profiles tp ON tp.user_id = s.user_id
+JOIN tasker_skills ts ON ts.user_id = s.user_id
+WHERE ts.category_code = sqlc.arg('skill_code')
+  AND tp.is_visible
+  AND (sqlc.narg('after_user_id')::uuid IS NULL OR s.user_id > sqlc.narg('after_user_id')::uuid)
+ORDER BY s.user_id
+LIMIT sqlc.arg('row_limit');
+
+-- name: FindAvailabilitySlotsByUserIDs :many
+SELECT
+    id, user_id, rrule, start_date, start_minute, duration_minutes, position
+FROM availability_slots
+WHERE user_id = ANY(sqlc.arg('user_ids')::uuid[])
+ORDER BY user_id, position;
+
+-- name: FindAvailabilityExceptionsByUserIDs :many
+-- A NULL bound leaves that side of the window open.
+SELECT
+    id, user_id, kind, starts_at, ends_at, reason
+FROM availability_exceptions
+WHERE user_id = ANY(sqlc.arg('user_ids')::uuid[])
+  AND (sqlc.narg('window_start')::timestamptz IS NULL OR ends_at > sqlc.narg('window_start')::timestamptz)
+  AND (sqlc.narg('window_end')::timestamptz IS NULL OR starts_at < sqlc.narg('window_end')::timestamptz)
+ORDER BY user_id, starts_at;
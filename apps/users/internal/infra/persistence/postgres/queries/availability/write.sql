@@ -0,0 +1,27 @@
+-- name: UpsertAvailabilitySchedule :exec
+INSERT INTO availability_schedules (user_id, timezone, updated_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id)
+DO UPDATE SET
+    timezone = EXCLUDED.timezone,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: DeleteAvailabilitySlots :exec
+DELETE FROM availability_slots WHERE user_id = $1;
+
+-- name: InsertAvailabilitySlot :exec
+INSERT INTO availability_slots (
+    id, user_id, rrule, start_date, start_minute, duration_minutes, position
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: DeleteAvailabilityExceptions :exec
+DELETE FROM availability_exceptions WHERE user_id = $1;
+
+-- name: InsertAvailabilityException :exec
+INSERT INTO availability_exceptions (
+    id, user_id, kind, starts_at, ends_at, reason
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
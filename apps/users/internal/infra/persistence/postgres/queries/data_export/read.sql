@@ -0,0 +1,24 @@
+-- name: FindDataExportByID :one
+SELECT
+    id, user_id, format, status, schema_version, storage_key, size_bytes, failure_reason,
+    requested_at, started_at, completed_at, expires_at
+FROM data_exports
+WHERE id = $1;
+
+-- name: ListUnfinishedDataExports :many
+SELECT
+    id, user_id, format, status, schema_version, storage_key, size_bytes, failure_reason,
+    requested_at, started_at, completed_at, expires_at
+FROM data_exports
+WHERE status IN ('PENDING', 'RUNNING') AND requested_at < sqlc.arg('requested_before')::timestamptz
+ORDER BY requested_at
+LIMIT sqlc.arg('row_limit');
+
+-- name: ListExpiredDataExports :many
+SELECT
+    id, user_id, format, status, schema_version, storage_key, size_bytes, failure_reason,
+    requested_at, started_at, completed_at, expires_at
+FROM data_exports
+WHERE status = 'COMPLETED' AND storage_key IS NOT NULL AND expires_at < sqlc.arg('expired_before')::timestamptz
+ORDER BY expires_at
+LIMIT sqlc.arg('row_limit');
@@ -0,0 +1,17 @@
+-- name: UpsertDataExport :one
+INSERT INTO data_exports (
+    id, user_id, format, status, schema_version, storage_key, size_bytes, failure_reason,
+    requested_at, started_at, completed_at, expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+)
+ON CONFLICT (id)
+DO UPDATE SET
+    status = EXCLUDED.status,
+    storage_key = EXCLUDED.storage_key,
+    size_bytes = EXCLUDED.size_bytes,
+    failure_reason = EXCLUDED.failure_reason,
+    started_at = EXCLUDED.started_at,
+    completed_at = EXCLUDED.completed_at,
+    expires_at = EXCLUDED.expires_at
+RETURNING id;
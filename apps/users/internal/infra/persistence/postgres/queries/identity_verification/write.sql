@@ -5,12 +5,12 @@ INSERT INTO identity_verifications (
     $1, $2, $3, $4, $5, $6, NOW(), $7, $8, $9
 ) RETURNING *;
 
--- name: UpdateIdentityVerificationStatus :one
+-- name: UpdateIdentityVerificationStatus :execrows
+-- Only pending verifications are decided, and only once.
 UPDATE identity_verifications
 SET
     status = $2,
     verified_at = $3,
     reviewer_id = $4,
     rejection_reason = $5
-WHERE id = $1
-RETURNING *;
\ No newline at end of file
+WHERE id = $1 AND status = 'PENDING';
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- name: FindProfileAddressesByUserIDs :many
+SELECT user_id, sub_district, district, province, postal_code, country_code, latitude, longitude
+FROM profile_addresses
+WHERE user_id = ANY(sqlc.arg('user_ids')::uuid[]);
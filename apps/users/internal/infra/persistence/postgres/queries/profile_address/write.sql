@@ -0,0 +1,16 @@
+-- name: UpsertProfileAddress :exec
+INSERT INTO profile_addresses (
+    user_id, sub_district, district, province, postal_code, country_code, latitude, longitude, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, NOW()
+)
+ON CONFLICT (user_id)
+DO UPDATE SET
+    sub_district = EXCLUDED.sub_district,
+    district = EXCLUDED.district,
+    province = EXCLUDED.province,
+    postal_code = EXCLUDED.postal_code,
+    country_code = EXCLUDED.country_code,
+    latitude = EXCLUDED.latitude,
+    longitude = EXCLUDED.longitude,
+    updated_at = EXCLUDED.updated_at;
@@ -0,0 +1,12 @@
+-- name: ListSkillCategories :many
+SELECT
+    code, name, description, is_active, created_at, updated_at
+FROM skill_categories
+WHERE is_active OR sqlc.arg('include_inactive')::boolean
+ORDER BY name;
+
+-- name: FindSkillCategoriesByCodes :many
+SELECT
+    code, name, description, is_active, created_at, updated_at
+FROM skill_categories
+WHERE code = ANY(sqlc.arg('codes')::text[]);
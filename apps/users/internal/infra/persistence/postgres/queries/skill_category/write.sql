@@ -0,0 +1,12 @@
+-- name: UpsertSkillCategory :exec
+INSERT INTO skill_categories (
+    code, name, description, is_active, created_at, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (code)
+DO UPDATE SET
+    name = EXCLUDED.name,
+    description = EXCLUDED.description,
+    is_active = EXCLUDED.is_active,
+    updated_at = EXCLUDED.updated_at;
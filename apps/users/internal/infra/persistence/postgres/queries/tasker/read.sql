@@ -0,0 +1,39 @@
+-- name: FindTaskerProfileByUserID :one
+SELECT
+    user_id, headline, hourly_rate_min, hourly_rate_max, currency, years_of_experience, is_visible, created_at, updated_at
+FROM tasker_profiles
+WHERE user_id = $1;
+
+-- name: FindTaskerSkillCodes :many
+SELECT category_code FROM tasker_skills WHERE user_id = $1 ORDER BY category_code;
+
+-- name: FindTaskerServiceAreas :many
+SELECT province, district, postal_code, latitude, longitude FROM tasker_service_areas WHERE user_id = $1 ORDER BY position;
+
+-- name: FindTaskerPortfolioItems :many
+SELECT id, title, description, image_urls, completed_at FROM tasker_portfolio_items WHERE user_id = $1 ORDER BY position;
+
+-- name: FindTaskersNear :many
+-- ST_DWithin uses the GiST index on location; DISTINCT ON keeps each tasker's
+-- nearest service area before the results are ordered by distance.
+SELECT nearest.user_id, nearest.headline, nearest.province, nearest.district, nearest.postal_code,
+    nearest.latitude, nearest.longitude, nearest.distance_meters
+FROM (
+    SELECT DISTINCT ON (sa.user_id)
+        sa.user_id, tp.headline, sa.province, sa.district, sa.postal_code, sa.latitude, sa.longitude,
+        ST_Distance(sa.location, ST_SetSRID(ST_MakePoint(sqlc.arg('longitude')::float8, sqlc.arg('latitude')::float8), 4326)::geography)::float8 AS distance_meters
+    FROM tasker_service_areas sa
+    JOIN tasker_profiles tp ON tp.user_id = sa.user_id
+    WHERE tp.is_visible
+      AND ST_DWithin(
+          sa.location,
+          ST_SetSRID(ST_MakePoint(sqlc.arg('longitude')::float8, sqlc.arg('latitude')::float8), 4326)::geography,
+          sqlc.arg('radius_meters')::float8
+      )
+      AND (sqlc.narg('skill_code')::text IS NULL OR EXISTS (
+          SELECT 1 FROM tasker_skills ts WHERE ts.user_id = sa.user_id AND ts.category_code = sqlc.narg('skill_code')::text
+      ))
+    ORDER BY sa.user_id, distance_meters
+) nearest
+ORDER BY nearest.distance_meters, nearest.user_id
+LIMIT sqlc.arg('row_limit');
@@ -0,0 +1,41 @@
+-- name: UpsertTaskerProfile :exec
+INSERT INTO tasker_profiles (
+    user_id, headline, hourly_rate_min, hourly_rate_max, currency, years_of_experience, is_visible, created_at, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+)
+ON CONFLICT (user_id)
+DO UPDATE SET
+    headline = EXCLUDED.headline,
+    hourly_rate_min = EXCLUDED.hourly_rate_min,
+    hourly_rate_max = EXCLUDED.hourly_rate_max,
+    currency = EXCLUDED.currency,
+    years_of_experience = EXCLUDED.years_of_experience,
+    is_visible = EXCLUDED.is_visible,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: DeleteTaskerSkills :exec
+DELETE FROM tasker_skills WHERE user_id = $1;
+
+-- name: InsertTaskerSkill :exec
+INSERT INTO tasker_skills (user_id, category_code) VALUES ($1, $2);
+
+-- name: DeleteTaskerServiceAreas :exec
+DELETE FROM tasker_service_areas WHERE user_id = $1;
+
+-- name: InsertTaskerServiceArea :exec
+INSERT INTO tasker_service_areas (
+    id, user_id, province, district, postal_code, latitude, longitude, position
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+);
+
+-- name: DeleteTaskerPortfolioItems :exec
+DELETE FROM tasker_portfolio_items WHERE user_id = $1;
+
+-- name: InsertTaskerPortfolioItem :exec
+INSERT INTO tasker_portfolio_items (
+    id, user_id, title, description, image_urls, completed_at, position
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
@@ -14,6 +14,14 @@ FROM users u
 JOIN profiles p ON u.id = p.user_id
 WHERE u.line_user_id = $1;
 
+-- name: FindUsersByIDs :many
+SELECT
+    u.id, u.line_user_id, u.email, u.password_hash, u.status, u.created_at, u.updated_at, u.last_login_at,
+    p.display_name, p.first_name, p.last_name, p.bio, p.avatar_url, p.phone_number, p.address, p.preferences
+FROM users u
+JOIN profiles p ON u.id = p.user_id
+WHERE u.id = ANY(sqlc.arg('user_ids')::uuid[]);
+
 -- name: UserExistsByLineUserID :one
 SELECT EXISTS(SELECT 1 FROM users WHERE line_user_id = $1);
 
@@ -30,4 +38,106 @@ SELECT EXISTS(SELECT 1 FROM user_roles ur WHERE ur.user_id = $1 AND ur.role_id =
 SELECT r.id, r.name, r.description
 FROM user_roles ur
 JOIN roles r ON r.id = ur.role_id
-WHERE ur.user_id = $1;
\ No newline at end of file
+WHERE ur.user_id = $1;
+
+-- name: SearchUsersByCreatedAtDesc :many
+-- The SearchUsersBy queries differ only in their keyset order. Each sort
+-- direction has its own query with a plain ORDER BY, so the planner can walk
+-- the keyset indexes of 000003_add_user_search_indexes instead of sorting.
+SELECT
+    u.id, u.line_user_id, u.email, u.password_hash, u.status, u.created_at, u.updated_at, u.last_login_at,
+    p.display_name, p.first_name, p.last_name, p.bio, p.avatar_url, p.phone_number, p.address, p.preferences
+FROM users u
+JOIN profiles p ON u.id = p.user_id
+WHERE (sqlc.narg('status')::text IS NULL OR u.status = sqlc.narg('status')::text)
+  AND (sqlc.narg('role_name')::text IS NULL OR EXISTS (
+        SELECT 1 FROM user_roles ur JOIN roles r ON r.id = ur.role_id
+        WHERE ur.user_id = u.id AND r.name = sqlc.narg('role_name')::text))
+  AND (sqlc.narg('verification_status')::text IS NULL OR EXISTS (
+        SELECT 1 FROM identity_verifications iv
+        WHERE iv.user_id = u.id AND iv.status = sqlc.narg('verification_status')::text))
+  AND (sqlc.narg('created_from')::timestamptz IS NULL OR u.created_at >= sqlc.narg('created_from')::timestamptz)
+  AND (sqlc.narg('created_to')::timestamptz IS NULL OR u.created_at < sqlc.narg('created_to')::timestamptz)
+  AND (sqlc.narg('email_prefix')::text IS NULL OR LOWER(u.email) LIKE sqlc.narg('email_prefix')::text || '%')
+  AND (sqlc.narg('display_name_prefix')::text IS NULL OR LOWER(p.display_name) LIKE sqlc.narg('display_name_prefix')::text || '%')
+  AND (sqlc.narg('after_created_at')::timestamptz IS NULL
+       OR (u.created_at, u.id) < (sqlc.narg('after_created_at')::timestamptz, sqlc.narg('after_id')::uuid))
+ORDER BY u.created_at DESC, u.id DESC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: SearchUsersByCreatedAtAsc :many
+SELECT
+    u.id, u.line_user_id, u.email, u.password_hash, u.status, u.created_at, u.updated_at, u.last_login_at,
+    p.display_name, p.first_name, p.last_name, p.bio, p.avatar_url, p.phone_number, p.address, p.preferences
+FROM users u
+JOIN profiles p ON u.id = p.user_id
+WHERE (sqlc.narg('status')::text IS NULL OR u.status = sqlc.narg('status')::text)
+  AND (sqlc.narg('role_name')::text IS NULL OR EXISTS (
+        SELECT 1 FROM user_roles ur JOIN roles r ON r.id = ur.role_id
+        WHERE ur.user_id = u.id AND r.name = sqlc.narg('role_name')::text))
+  AND (sqlc.narg('verification_status')::text IS NULL OR EXISTS (
+        SELECT 1 FROM identity_verifications iv
+        WHERE iv.user_id = u.id AND iv.status = sqlc.narg('verification_status')::text))
+  AND (sqlc.narg('created_from')::timestamptz IS NULL OR u.created_at >= sqlc.narg('created_from')::timestamptz)
+  AND (sqlc.narg('created_to')::timestamptz IS NULL OR u.created_at < sqlc.narg('created_to')::timestamptz)
+  AND (sqlc.narg('email_prefix')::text IS NULL OR LOWER(u.email) LIKE sqlc.narg('email_prefix')::text || '%')
+  AND (sqlc.narg('display_name_prefix')::text IS NULL OR LOWER(p.display_name) LIKE sqlc.narg('display_name_prefix')::text || '%')
+  AND (sqlc.narg('after_created_at')::timestamptz IS NULL
+       OR (u.created_at, u.id) > (sqlc.narg('after_created_at')::timestamptz, sqlc.narg('after_id')::uuid))
+ORDER BY u.created_at ASC, u.id ASC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: SearchUsersByDisplayNameDesc :many
+SELECT
+    u.id, u.line_user_id, u.email, u.password_hash, u.status, u.created_at, u.updated_at, u.last_login_at,
+    p.display_name, p.first_name, p.last_name, p.bio, p.avatar_url, p.phone_number, p.address, p.preferences
+FROM users u
+JOIN profiles p ON u.id = p.user_id
+WHERE (sqlc.narg('status')::text IS NULL OR u.status = sqlc.narg('status')::text)
+  AND (sqlc.narg('role_name')::text IS NULL OR EXISTS (
+        SELECT 1 FROM user_roles ur JOIN roles r ON r.id = ur.role_id
+        WHERE ur.user_id = u.id AND r.name = sqlc.narg('role_name')::text))
+  AND (sqlc.narg('verification_status')::text IS NULL OR EXISTS (
+        SELECT 1 FROM identity_verifications iv
+        WHERE iv.user_id = u.id AND iv.status = sqlc.narg('verification_status')::text))
+  AND (sqlc.narg('created_from')::timestamptz IS NULL OR u.created_at >= sqlc.narg('created_from')::timestamptz)
+  AND (sqlc.narg('created_to')::timestamptz IS NULL OR u.created_at < sqlc.narg('created_to')::timestamptz)
+  AND (sqlc.narg('email_prefix')::text IS NULL OR LOWER(u.email) LIKE sqlc.narg('email_prefix')::text || '%')
+  AND (sqlc.narg('display_name_prefix')::text IS NULL OR LOWER(p.display_name) LIKE sqlc.narg('display_name_prefix')::text || '%')
+  AND (sqlc.narg('after_display_name')::text IS NULL
+       OR (p.display_name, p.user_id) < (sqlc.narg('after_display_name')::text, sqlc.narg('after_id')::uuid))
+ORDER BY p.display_name DESC, p.user_id DESC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: SearchUsersByDisplayNameAsc :many
+SELECT
+    u.id, u.line_user_id, u.email, u.password_hash, u.status, u.created_at, u.updated_at, u.last_login_at,
+    p.display_name, p.first_name, p.last_name, p.bio, p.avatar_url, p.phone_number, p.address, p.preferences
+FROM users u
+JOIN profiles p ON u.id = p.user_id
+WHERE (sqlc.narg('status')::text IS NULL OR u.status = sqlc.narg('status')::text)
+  AND (sqlc.narg('role_name')::text IS NULL OR EXISTS (
+        SELECT 1 FROM user_roles ur JOIN roles r ON r.id = ur.role_id
+        WHERE ur.user_id = u.id AND r.name = sqlc.narg('role_name')::text))
+  AND (sqlc.narg('verification_status')::text IS NULL OR EXISTS (
+        SELECT 1 FROM identity_verifications iv
+        WHERE iv.user_id = u.id AND iv.status = sqlc.narg('verification_status')::text))
+  AND (sqlc.narg('created_from')::timestamptz IS NULL OR u.created_at >= sqlc.narg('created_from')::timestamptz)
+  AND (sqlc.narg('created_to')::timestamptz IS NULL OR u.created_at < sqlc.narg('created_to')::timestamptz)
+  AND (sqlc.narg('email_prefix')::text IS NULL OR LOWER(u.email) LIKE sqlc.narg('email_prefix')::text || '%')
+  AND (sqlc.narg('display_name_prefix')::text IS NULL OR LOWER(p.display_name) LIKE sqlc.narg('display_name_prefix')::text || '%')
+  AND (sqlc.narg('after_display_name')::text IS NULL
+       OR (p.display_name, p.user_id) > (sqlc.narg('after_display_name')::text, sqlc.narg('after_id')::uuid))
+ORDER BY p.display_name ASC, p.user_id ASC
+LIMIT sqlc.arg('page_limit')::int;
+
+-- name: GetRolesByUserIDs :many
+SELECT ur.user_id, r.id, r.name, r.description
+FROM user_roles ur
+JOIN roles r ON r.id = ur.role_id
+WHERE ur.user_id = ANY(sqlc.arg('user_ids')::uuid[]);
+
+-- name: FindUserAcquisition :one
+SELECT user_id, referral_code, channel, campaign, metadata, created_at
+FROM user_acquisitions
+WHERE user_id = $1;
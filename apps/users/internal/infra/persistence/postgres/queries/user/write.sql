@@ -38,4 +38,11 @@ RETURNING id, line_user_id, email, password_hash, status, created_at, updated_at
 -- name: UpdateUserLastLoginAt :execrows
 UPDATE users
 SET last_login_at = $2, updated_at = NOW()
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+-- name: InsertUserAcquisition :exec
+INSERT INTO user_acquisitions (
+    user_id, referral_code, channel, campaign, metadata, created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (user_id) DO NOTHING;
@@ -0,0 +1,328 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/availability"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	db "github.com/pratchaya-maneechot/service-exchange/apps/users/internal/infra/persistence/postgres/generated"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type availabilityRepository struct {
+	db     *db.Queries
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+	config *config.Config
+	tracer trace.Tracer
+}
+
+func NewPostgresAvailabilityRepository(cfg *config.Config, dbPool *lp.DBPool, logger *slog.Logger) availability.ScheduleRepository {
+	repoLogger := logger.With(slog.String("component", "availabilityRepository"))
+	return &availabilityRepository{
+		db:     db.New(dbPool.Pool),
+		pool:   dbPool.Pool,
+		logger: repoLogger,
+		config: cfg,
+		tracer: otel.Tracer(fmt.Sprintf("%s.repository", cfg.Name)),
+	}
+}
+
+// scheduleHeader is the schedule row common to the single and by-skill lookups.
+type scheduleHeader struct {
+	userID    pgtype.UUID
+	timezone  string
+	updatedAt pgtype.Timestamptz
+}
+
+func (r *availabilityRepository) FindByUserID(ctx context.Context, userID ids.UserID) (*availability.Schedule, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(userID)))
+
+	ctx, span := r.tracer.Start(ctx, "AvailabilityRepository.FindByUserID", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "read_by_user_id"),
+		attribute.String("db.user_id", string(userID)),
+	)
+
+	raw, err := r.db.FindAvailabilityScheduleByUserID(ctx, lp.ToUUID(string(userID)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.SetStatus(codes.Ok, "Availability schedule not found in DB")
+			span.SetAttributes(attribute.Bool("availability.found", false))
+			logger.Debug("Availability schedule not found in DB.")
+			return nil, availability.ErrScheduleNotFound
+		}
+		span.SetStatus(codes.Error, "Failed to query availability schedule from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query availability schedule from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query availability schedule: %w", lp.TranslateError(err))
+	}
+
+	schedules, err := r.hydrateSchedules(ctx, []scheduleHeader{{raw.UserID, raw.Timezone, raw.UpdatedAt}}, nil)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to load availability schedule details")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to load availability schedule details from DB", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Availability schedule found")
+	span.SetAttributes(attribute.Bool("availability.found", true))
+	return &schedules[0], nil
+}
+
+func (r *availabilityRepository) FindBySkill(
+	ctx context.Context,
+	skillCode string,
+	window availability.Interval,
+	afterUserID *ids.UserID,
+	limit int,
+) ([]availability.Schedule, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("method", "FindBySkill"))
+
+	ctx, span := r.tracer.Start(ctx, "AvailabilityRepository.FindBySkill", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.skill_code", skillCode),
+		attribute.Int("db.limit", limit),
+	)
+
+	params := db.FindAvailabilitySchedulesBySkillParams{
+		SkillCode: skillCode,
+		RowLimit:  int32(limit),
+	}
+	if afterUserID != nil {
+		params.AfterUserID = lp.ToUUID(string(*afterUserID))
+	}
+	rows, err := r.db.FindAvailabilitySchedulesBySkill(ctx, params)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query availability schedules by skill")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query availability schedules by skill from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query availability schedules: %w", lp.TranslateError(err))
+	}
+
+	headers := make([]scheduleHeader, 0, len(rows))
+	for _, row := range rows {
+		headers = append(headers, scheduleHeader{row.UserID, row.Timezone, row.UpdatedAt})
+	}
+	schedules, err := r.hydrateSchedules(ctx, headers, &window)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to load availability schedule details")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to load availability schedule details from DB", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Availability schedules loaded")
+	span.SetAttributes(attribute.Int("availability.count", len(schedules)))
+	return schedules, nil
+}
+
+// hydrateSchedules loads slots and exceptions for the given schedules in two
+// queries. With a window, only exceptions overlapping it are loaded.
+func (r *availabilityRepository) hydrateSchedules(ctx context.Context, headers []scheduleHeader, window *availability.Interval) ([]availability.Schedule, error) {
+	if len(headers) == 0 {
+		return []availability.Schedule{}, nil
+	}
+
+	userIDs := make([]pgtype.UUID, 0, len(headers))
+	for _, h := range headers {
+		userIDs = append(userIDs, h.userID)
+	}
+
+	slotRows, err := r.db.FindAvailabilitySlotsByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query availability slots: %w", lp.TranslateError(err))
+	}
+	slotsByUser := make(map[string][]availability.RecurringSlot, len(headers))
+	for _, row := range slotRows {
+		rule, err := availability.ParseRecurrenceRule(row.Rrule)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse stored recurrence rule %q: %w", row.Rrule, err)
+		}
+		key := lp.FromUUID(row.UserID)
+		slotsByUser[key] = append(slotsByUser[key], availability.RecurringSlot{
+			ID:              uuid.UUID(row.ID.Bytes),
+			Rule:            rule,
+			StartDate:       time.Date(row.StartDate.Time.Year(), row.StartDate.Time.Month(), row.StartDate.Time.Day(), 0, 0, 0, 0, time.UTC),
+			StartMinute:     int(row.StartMinute),
+			DurationMinutes: int(row.DurationMinutes),
+		})
+	}
+
+	params := db.FindAvailabilityExceptionsByUserIDsParams{UserIds: userIDs}
+	if window != nil {
+		params.WindowStart = lp.ToTimestamp(&window.Start)
+		params.WindowEnd = lp.ToTimestamp(&window.End)
+	}
+	exceptionRows, err := r.db.FindAvailabilityExceptionsByUserIDs(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query availability exceptions: %w", lp.TranslateError(err))
+	}
+	exceptionsByUser := make(map[string][]availability.Exception, len(headers))
+	for _, row := range exceptionRows {
+		key := lp.FromUUID(row.UserID)
+		exceptionsByUser[key] = append(exceptionsByUser[key], availability.Exception{
+			ID:   uuid.UUID(row.ID.Bytes),
+			Kind: availability.ExceptionKind(row.Kind),
+			Period: availability.Interval{
+				Start: *lp.ToTime(row.StartsAt),
+				End:   *lp.ToTime(row.EndsAt),
+			},
+			Reason: row.Reason,
+		})
+	}
+
+	schedules := make([]availability.Schedule, 0, len(headers))
+	for _, h := range headers {
+		key := lp.FromUUID(h.userID)
+		schedules = append(schedules, *availability.NewScheduleFromRepository(
+			key,
+			h.timezone,
+			slotsByUser[key],
+			exceptionsByUser[key],
+			*lp.ToTime(h.updatedAt),
+		))
+	}
+	return schedules, nil
+}
+
+func (r *availabilityRepository) Save(ctx context.Context, s *availability.Schedule) (err error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(s.UserID)))
+
+	ctx, span := r.tracer.Start(ctx, "AvailabilityRepository.Save", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.user_id", string(s.UserID)),
+		attribute.Int("availability.slot_count", len(s.Slots)),
+		attribute.Int("availability.exception_count", len(s.Exceptions)),
+	)
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to begin transaction")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+		logger.Error("Failed to begin DB transaction", slog.Any("error", err))
+		return fmt.Errorf("failed to begin transaction: %w", lp.TranslateError(err))
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		} else if err != nil {
+			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+				logger.Error("Failed to rollback DB transaction", slog.Any("error", rollbackErr))
+			}
+		} else {
+			if commitErr := tx.Commit(ctx); commitErr != nil {
+				span.SetStatus(codes.Error, "Failed to commit transaction")
+				span.RecordError(commitErr)
+				span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+				logger.Error("Failed to commit DB transaction", slog.Any("error", commitErr))
+				err = fmt.Errorf("failed to commit transaction: %w", lp.TranslateError(commitErr))
+			}
+		}
+	}()
+
+	qtx := r.db.WithTx(tx)
+	userID := lp.ToUUID(string(s.UserID))
+
+	err = qtx.UpsertAvailabilitySchedule(ctx, db.UpsertAvailabilityScheduleParams{
+		UserID:    userID,
+		Timezone:  s.Timezone,
+		UpdatedAt: lp.ToTimestamp(&s.UpdatedAt),
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to upsert availability schedule in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to upsert availability schedule in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to upsert availability schedule: %w", lp.TranslateError(err))
+	}
+
+	logger.Debug("Replacing availability slots in DB.")
+	if err = qtx.DeleteAvailabilitySlots(ctx, userID); err != nil {
+		span.SetStatus(codes.Error, "Failed to delete old availability slots in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to delete old availability slots in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to delete old availability slots: %w", lp.TranslateError(err))
+	}
+	for i, slot := range s.Slots {
+		err = qtx.InsertAvailabilitySlot(ctx, db.InsertAvailabilitySlotParams{
+			ID:              lp.ToUUID(slot.ID.String()),
+			UserID:          userID,
+			Rrule:           slot.Rule.String(),
+			StartDate:       pgtype.Date{Time: slot.StartDate, Valid: true},
+			StartMinute:     int32(slot.StartMinute),
+			DurationMinutes: int32(slot.DurationMinutes),
+			Position:        int32(i),
+		})
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to add availability slot in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to add availability slot in DB", slog.Any("error", err))
+			return fmt.Errorf("failed to add availability slot: %w", lp.TranslateError(err))
+		}
+	}
+
+	logger.Debug("Replacing availability exceptions in DB.")
+	if err = qtx.DeleteAvailabilityExceptions(ctx, userID); err != nil {
+		span.SetStatus(codes.Error, "Failed to delete old availability exceptions in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to delete old availability exceptions in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to delete old availability exceptions: %w", lp.TranslateError(err))
+	}
+	for _, e := range s.Exceptions {
+		err = qtx.InsertAvailabilityException(ctx, db.InsertAvailabilityExceptionParams{
+			ID:       lp.ToUUID(e.ID.String()),
+			UserID:   userID,
+			Kind:     string(e.Kind),
+			StartsAt: lp.ToTimestamp(&e.Period.Start),
+			EndsAt:   lp.ToTimestamp(&e.Period.End),
+			Reason:   e.Reason,
+		})
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to add availability exception in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to add availability exception in DB", slog.Any("error", err))
+			return fmt.Errorf("failed to add availability exception: %w", lp.TranslateError(err))
+		}
+	}
+
+	span.SetStatus(codes.Ok, "Availability schedule saved to DB")
+	logger.Info("Availability schedule saved successfully to DB.")
+	return nil
+}
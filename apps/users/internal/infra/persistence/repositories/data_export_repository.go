@@ -0,0 +1,180 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/dataexport"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	db "github.com/pratchaya-maneechot/service-exchange/apps/users/internal/infra/persistence/postgres/generated"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+	"github.com/pratchaya-maneechot/service-exchange/libs/utils"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type dataExportRepository struct {
+	db     *db.Queries
+	logger *slog.Logger
+	tracer trace.Tracer
+}
+
+func NewPostgresDataExportRepository(cfg *config.Config, dbPool *lp.DBPool, logger *slog.Logger) dataexport.DataExportRepository {
+	return &dataExportRepository{
+		db:     db.New(dbPool.Pool),
+		logger: logger.With(slog.String("component", "dataExportRepository")),
+		tracer: otel.Tracer(fmt.Sprintf("%s.repository", cfg.Name)),
+	}
+}
+
+func (r *dataExportRepository) FindByID(ctx context.Context, id ids.DataExportID) (*dataexport.DataExport, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("export_id", string(id)))
+
+	ctx, span := r.tracer.Start(ctx, "DataExportRepository.FindByID", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "read_by_id"),
+		attribute.String("db.export_id", string(id)),
+	)
+
+	raw, err := r.db.FindDataExportByID(ctx, lp.ToUUID(string(id)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.SetStatus(codes.Ok, "Data export not found in DB")
+			logger.Debug("Data export not found in DB.")
+			return nil, dataexport.ErrDataExportNotFound
+		}
+		span.SetStatus(codes.Error, "Failed to query data export by ID from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query data export by ID from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query data export by ID: %w", lp.TranslateError(err))
+	}
+
+	span.SetStatus(codes.Ok, "Data export loaded from DB")
+	return toDomainDataExport(raw), nil
+}
+
+func (r *dataExportRepository) ListUnfinished(ctx context.Context, requestedBefore time.Time, limit int) ([]*dataexport.DataExport, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "DataExportRepository.ListUnfinished", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "list_unfinished"),
+	)
+
+	rows, err := r.db.ListUnfinishedDataExports(ctx, db.ListUnfinishedDataExportsParams{
+		RequestedBefore: lp.ToTimestamp(&requestedBefore),
+		RowLimit:        int32(limit),
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list unfinished data exports from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to list unfinished data exports from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to list unfinished data exports: %w", lp.TranslateError(err))
+	}
+
+	span.SetStatus(codes.Ok, "Unfinished data exports loaded from DB")
+	span.SetAttributes(attribute.Int("db.rows", len(rows)))
+	return utils.ArrayMap(rows, toDomainDataExport), nil
+}
+
+func (r *dataExportRepository) ListExpired(ctx context.Context, expiredBefore time.Time, limit int) ([]*dataexport.DataExport, error) {
+	logger := observability.LoggerFromCtx(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "DataExportRepository.ListExpired", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "list_expired"),
+	)
+
+	rows, err := r.db.ListExpiredDataExports(ctx, db.ListExpiredDataExportsParams{
+		ExpiredBefore: lp.ToTimestamp(&expiredBefore),
+		RowLimit:      int32(limit),
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list expired data exports from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to list expired data exports from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to list expired data exports: %w", lp.TranslateError(err))
+	}
+
+	span.SetStatus(codes.Ok, "Expired data exports loaded from DB")
+	span.SetAttributes(attribute.Int("db.rows", len(rows)))
+	return utils.ArrayMap(rows, toDomainDataExport), nil
+}
+
+func (r *dataExportRepository) Save(ctx context.Context, e *dataexport.DataExport) error {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("export_id", string(e.ID)))
+
+	ctx, span := r.tracer.Start(ctx, "DataExportRepository.Save", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.export_id", string(e.ID)),
+		attribute.String("export.status", string(e.Status)),
+	)
+
+	_, err := r.db.UpsertDataExport(ctx, db.UpsertDataExportParams{
+		ID:            lp.ToUUID(string(e.ID)),
+		UserID:        lp.ToUUID(string(e.UserID)),
+		Format:        string(e.Format),
+		Status:        string(e.Status),
+		SchemaVersion: int32(e.SchemaVersion),
+		StorageKey:    e.StorageKey,
+		SizeBytes:     e.SizeBytes,
+		FailureReason: e.FailureReason,
+		RequestedAt:   lp.ToTimestamp(&e.RequestedAt),
+		StartedAt:     lp.ToTimestamp(e.StartedAt),
+		CompletedAt:   lp.ToTimestamp(e.CompletedAt),
+		ExpiresAt:     lp.ToTimestamp(e.ExpiresAt),
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to upsert data export in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to upsert data export in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to upsert data export: %w", lp.TranslateError(err))
+	}
+
+	span.SetStatus(codes.Ok, "Data export saved to DB")
+	logger.Debug("Data export saved successfully to DB.", "status", e.Status)
+	return nil
+}
+
+func toDomainDataExport(raw db.DataExport) *dataexport.DataExport {
+	return dataexport.NewDataExportFromRepository(
+		lp.FromUUID(raw.ID),
+		lp.FromUUID(raw.UserID),
+		raw.Format,
+		raw.Status,
+		int(raw.SchemaVersion),
+		raw.StorageKey,
+		raw.SizeBytes,
+		raw.FailureReason,
+		*lp.ToTime(raw.RequestedAt),
+		lp.ToTime(raw.StartedAt),
+		lp.ToTime(raw.CompletedAt),
+		lp.ToTime(raw.ExpiresAt),
+	)
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import "github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/geo"
+
+// toPoint maps nullable latitude/longitude columns; the geography column is
+// generated from them, so they are the only coordinates the app reads or writes.
+func toPoint(latitude, longitude *float64) *geo.Point {
+	if latitude == nil || longitude == nil {
+		return nil
+	}
+	return &geo.Point{Latitude: *latitude, Longitude: *longitude}
+}
+
+func fromPoint(p *geo.Point) (latitude, longitude *float64) {
+	if p == nil {
+		return nil, nil
+	}
+	return &p.Latitude, &p.Longitude
+}
@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/tasker"
+	db "github.com/pratchaya-maneechot/service-exchange/apps/users/internal/infra/persistence/postgres/generated"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type skillCatalogueRepository struct {
+	db     *db.Queries
+	logger *slog.Logger
+	config *config.Config
+	tracer trace.Tracer
+}
+
+func NewPostgresSkillCatalogueRepository(cfg *config.Config, dbPool *lp.DBPool, logger *slog.Logger) tasker.SkillCatalogueRepository {
+	repoLogger := logger.With(slog.String("component", "skillCatalogueRepository"))
+	return &skillCatalogueRepository{
+		db:     db.New(dbPool.Pool),
+		logger: repoLogger,
+		config: cfg,
+		tracer: otel.Tracer(fmt.Sprintf("%s.repository", cfg.Name)),
+	}
+}
+
+func (r *skillCatalogueRepository) List(ctx context.Context, includeInactive bool) ([]tasker.SkillCategory, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("method", "List"))
+
+	ctx, span := r.tracer.Start(ctx, "SkillCatalogueRepository.List", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.Bool("db.include_inactive", includeInactive),
+	)
+
+	rows, err := r.db.ListSkillCategories(ctx, includeInactive)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to list skill categories")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to list skill categories from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to list skill categories: %w", lp.TranslateError(err))
+	}
+
+	span.SetStatus(codes.Ok, "Skill categories listed")
+	span.SetAttributes(attribute.Int("skill_category.count", len(rows)))
+	return toSkillCategories(rows), nil
+}
+
+func (r *skillCatalogueRepository) FindByCodes(ctx context.Context, skillCodes []string) ([]tasker.SkillCategory, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("method", "FindByCodes"))
+
+	ctx, span := r.tracer.Start(ctx, "SkillCatalogueRepository.FindByCodes", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.Int("db.code_count", len(skillCodes)),
+	)
+
+	if len(skillCodes) == 0 {
+		span.SetStatus(codes.Ok, "No codes requested")
+		return []tasker.SkillCategory{}, nil
+	}
+
+	rows, err := r.db.FindSkillCategoriesByCodes(ctx, skillCodes)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query skill categories by code")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query skill categories by code from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query skill categories: %w", lp.TranslateError(err))
+	}
+
+	span.SetStatus(codes.Ok, "Skill categories loaded")
+	span.SetAttributes(attribute.Int("skill_category.count", len(rows)))
+	return toSkillCategories(rows), nil
+}
+
+func (r *skillCatalogueRepository) Save(ctx context.Context, c *tasker.SkillCategory) error {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("skill_code", c.Code))
+
+	ctx, span := r.tracer.Start(ctx, "SkillCatalogueRepository.Save", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.skill_code", c.Code),
+	)
+
+	err := r.db.UpsertSkillCategory(ctx, db.UpsertSkillCategoryParams{
+		Code:        c.Code,
+		Name:        c.Name,
+		Description: c.Description,
+		IsActive:    c.IsActive,
+		CreatedAt:   lp.ToTimestamp(&c.CreatedAt),
+		UpdatedAt:   lp.ToTimestamp(&c.UpdatedAt),
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to upsert skill category in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to upsert skill category in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to upsert skill category: %w", lp.TranslateError(err))
+	}
+
+	span.SetStatus(codes.Ok, "Skill category saved to DB")
+	logger.Info("Skill category saved successfully to DB.", "is_active", c.IsActive)
+	return nil
+}
+
+func toSkillCategories(rows []db.SkillCategory) []tasker.SkillCategory {
+	categories := make([]tasker.SkillCategory, 0, len(rows))
+	for _, row := range rows {
+		categories = append(categories, *tasker.NewSkillCategoryFromRepository(
+			row.Code,
+			row.Name,
+			row.Description,
+			row.IsActive,
+			*lp.ToTime(row.CreatedAt),
+			*lp.ToTime(row.UpdatedAt),
+		))
+	}
+	return categories
+}
@@ -0,0 +1,331 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/tasker"
+	db "github.com/pratchaya-maneechot/service-exchange/apps/users/internal/infra/persistence/postgres/generated"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	lp "github.com/pratchaya-maneechot/service-exchange/libs/infra/postgres"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type taskerProfileRepository struct {
+	db     *db.Queries
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+	config *config.Config
+	tracer trace.Tracer
+}
+
+func NewPostgresTaskerProfileRepository(cfg *config.Config, dbPool *lp.DBPool, logger *slog.Logger) tasker.TaskerProfileRepository {
+	repoLogger := logger.With(slog.String("component", "taskerProfileRepository"))
+	return &taskerProfileRepository{
+		db:     db.New(dbPool.Pool),
+		pool:   dbPool.Pool,
+		logger: repoLogger,
+		config: cfg,
+		tracer: otel.Tracer(fmt.Sprintf("%s.repository", cfg.Name)),
+	}
+}
+
+func (r *taskerProfileRepository) FindByUserID(ctx context.Context, userID ids.UserID) (*tasker.TaskerProfile, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(userID)))
+
+	ctx, span := r.tracer.Start(ctx, "TaskerProfileRepository.FindByUserID", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "read_by_user_id"),
+		attribute.String("db.user_id", string(userID)),
+	)
+
+	id := lp.ToUUID(string(userID))
+	raw, err := r.db.FindTaskerProfileByUserID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.SetStatus(codes.Ok, "Tasker profile not found in DB")
+			span.SetAttributes(attribute.Bool("tasker_profile.found", false))
+			logger.Debug("Tasker profile not found in DB.")
+			return nil, tasker.ErrTaskerProfileNotFound
+		}
+		span.SetStatus(codes.Error, "Failed to query tasker profile from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query tasker profile from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query tasker profile: %w", lp.TranslateError(err))
+	}
+
+	skillCodes, err := r.db.FindTaskerSkillCodes(ctx, id)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query tasker skills from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query tasker skills from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query tasker skills: %w", lp.TranslateError(err))
+	}
+
+	areaRows, err := r.db.FindTaskerServiceAreas(ctx, id)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query tasker service areas from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query tasker service areas from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query tasker service areas: %w", lp.TranslateError(err))
+	}
+	areas := make([]tasker.ServiceArea, 0, len(areaRows))
+	for _, row := range areaRows {
+		areas = append(areas, tasker.ServiceArea{
+			Province:   row.Province,
+			District:   row.District,
+			PostalCode: row.PostalCode,
+			Location:   toPoint(row.Latitude, row.Longitude),
+		})
+	}
+
+	portfolioRows, err := r.db.FindTaskerPortfolioItems(ctx, id)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query tasker portfolio from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query tasker portfolio from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query tasker portfolio: %w", lp.TranslateError(err))
+	}
+	portfolio := make([]tasker.PortfolioItem, 0, len(portfolioRows))
+	for _, row := range portfolioRows {
+		portfolio = append(portfolio, tasker.PortfolioItem{
+			ID:          uuid.UUID(row.ID.Bytes),
+			Title:       row.Title,
+			Description: row.Description,
+			ImageURLs:   row.ImageUrls,
+			CompletedAt: lp.ToTime(row.CompletedAt),
+		})
+	}
+
+	span.SetStatus(codes.Ok, "Tasker profile found")
+	span.SetAttributes(attribute.Bool("tasker_profile.found", true))
+
+	return tasker.NewTaskerProfileFromRepository(
+		lp.FromUUID(raw.UserID),
+		raw.Headline,
+		skillCodes,
+		tasker.HourlyRateRange{
+			MinAmount: raw.HourlyRateMin,
+			MaxAmount: raw.HourlyRateMax,
+			Currency:  raw.Currency,
+		},
+		areas,
+		portfolio,
+		int(raw.YearsOfExperience),
+		raw.IsVisible,
+		*lp.ToTime(raw.CreatedAt),
+		*lp.ToTime(raw.UpdatedAt),
+	), nil
+}
+
+func (r *taskerProfileRepository) Save(ctx context.Context, p *tasker.TaskerProfile) (err error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("user_id", string(p.UserID)))
+
+	ctx, span := r.tracer.Start(ctx, "TaskerProfileRepository.Save", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.user_id", string(p.UserID)),
+	)
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to begin transaction")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+		logger.Error("Failed to begin DB transaction", slog.Any("error", err))
+		return fmt.Errorf("failed to begin transaction: %w", lp.TranslateError(err))
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		} else if err != nil {
+			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+				logger.Error("Failed to rollback DB transaction", slog.Any("error", rollbackErr))
+			}
+		} else {
+			if commitErr := tx.Commit(ctx); commitErr != nil {
+				span.SetStatus(codes.Error, "Failed to commit transaction")
+				span.RecordError(commitErr)
+				span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
+				logger.Error("Failed to commit DB transaction", slog.Any("error", commitErr))
+				err = fmt.Errorf("failed to commit transaction: %w", lp.TranslateError(commitErr))
+			}
+		}
+	}()
+
+	qtx := r.db.WithTx(tx)
+	userID := lp.ToUUID(string(p.UserID))
+
+	err = qtx.UpsertTaskerProfile(ctx, db.UpsertTaskerProfileParams{
+		UserID:            userID,
+		Headline:          p.Headline,
+		HourlyRateMin:     p.HourlyRate.MinAmount,
+		HourlyRateMax:     p.HourlyRate.MaxAmount,
+		Currency:          p.HourlyRate.Currency,
+		YearsOfExperience: int32(p.YearsOfExperience),
+		IsVisible:         p.IsVisible,
+		CreatedAt:         lp.ToTimestamp(&p.CreatedAt),
+		UpdatedAt:         lp.ToTimestamp(&p.UpdatedAt),
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to upsert tasker profile in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to upsert tasker profile in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to upsert tasker profile: %w", lp.TranslateError(err))
+	}
+
+	logger.Debug("Replacing tasker skills in DB.")
+	if err = qtx.DeleteTaskerSkills(ctx, userID); err != nil {
+		span.SetStatus(codes.Error, "Failed to delete old tasker skills in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to delete old tasker skills in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to delete old tasker skills: %w", lp.TranslateError(err))
+	}
+	for _, code := range p.SkillCodes {
+		if err = qtx.InsertTaskerSkill(ctx, db.InsertTaskerSkillParams{UserID: userID, CategoryCode: code}); err != nil {
+			err = lp.TranslateError(err)
+			if errors.Is(err, lp.ErrForeignKeyViolation) {
+				span.SetStatus(codes.Error, "Unknown skill category")
+				span.SetAttributes(attribute.String("error.type", "db_foreign_key_violation"))
+				logger.Warn("Tasker skill references an unknown category.", "category_code", code)
+				return tasker.ErrUnknownSkillCategory
+			}
+			span.SetStatus(codes.Error, "Failed to add tasker skill in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to add tasker skill in DB", slog.Any("error", err), "category_code", code)
+			return fmt.Errorf("failed to add skill %s to tasker %s: %w", code, p.UserID, err)
+		}
+	}
+
+	logger.Debug("Replacing tasker service areas in DB.")
+	if err = qtx.DeleteTaskerServiceAreas(ctx, userID); err != nil {
+		span.SetStatus(codes.Error, "Failed to delete old tasker service areas in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to delete old tasker service areas in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to delete old tasker service areas: %w", lp.TranslateError(err))
+	}
+	for i, area := range p.ServiceAreas {
+		latitude, longitude := fromPoint(area.Location)
+		err = qtx.InsertTaskerServiceArea(ctx, db.InsertTaskerServiceAreaParams{
+			ID:         lp.ToUUID(uuid.NewString()),
+			UserID:     userID,
+			Province:   area.Province,
+			District:   area.District,
+			PostalCode: area.PostalCode,
+			Latitude:   latitude,
+			Longitude:  longitude,
+			Position:   int32(i),
+		})
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to add tasker service area in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to add tasker service area in DB", slog.Any("error", err))
+			return fmt.Errorf("failed to add tasker service area: %w", lp.TranslateError(err))
+		}
+	}
+
+	logger.Debug("Replacing tasker portfolio in DB.")
+	if err = qtx.DeleteTaskerPortfolioItems(ctx, userID); err != nil {
+		span.SetStatus(codes.Error, "Failed to delete old tasker portfolio in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to delete old tasker portfolio in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to delete old tasker portfolio: %w", lp.TranslateError(err))
+	}
+	for i, item := range p.Portfolio {
+		err = qtx.InsertTaskerPortfolioItem(ctx, db.InsertTaskerPortfolioItemParams{
+			ID:          lp.ToUUID(item.ID.String()),
+			UserID:      userID,
+			Title:       item.Title,
+			Description: item.Description,
+			ImageUrls:   item.ImageURLs,
+			CompletedAt: lp.ToTimestamp(item.CompletedAt),
+			Position:    int32(i),
+		})
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to add tasker portfolio item in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to add tasker portfolio item in DB", slog.Any("error", err))
+			return fmt.Errorf("failed to add tasker portfolio item: %w", lp.TranslateError(err))
+		}
+	}
+
+	span.SetStatus(codes.Ok, "Tasker profile saved to DB")
+	logger.Info("Tasker profile saved successfully to DB.")
+	return nil
+}
+
+func (r *taskerProfileRepository) FindNear(ctx context.Context, search tasker.ProximitySearch) ([]tasker.NearbyTasker, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("method", "FindNear"))
+
+	ctx, span := r.tracer.Start(ctx, "TaskerProfileRepository.FindNear", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.Int("db.radius_meters", search.RadiusMeters),
+		attribute.Int("db.limit", search.Limit),
+	)
+
+	rows, err := r.db.FindTaskersNear(ctx, db.FindTaskersNearParams{
+		Latitude:     search.Point.Latitude,
+		Longitude:    search.Point.Longitude,
+		RadiusMeters: float64(search.RadiusMeters),
+		SkillCode:    search.SkillCode,
+		RowLimit:     int32(search.Limit),
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query taskers near point")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query taskers near point from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query taskers near point: %w", lp.TranslateError(err))
+	}
+
+	nearby := make([]tasker.NearbyTasker, 0, len(rows))
+	for _, row := range rows {
+		nearby = append(nearby, tasker.NearbyTasker{
+			UserID:   ids.UserID(row.UserID.String()),
+			Headline: row.Headline,
+			NearestArea: tasker.ServiceArea{
+				Province:   row.Province,
+				District:   row.District,
+				PostalCode: row.PostalCode,
+				Location:   toPoint(row.Latitude, row.Longitude),
+			},
+			DistanceMeters: row.DistanceMeters,
+		})
+	}
+
+	span.SetStatus(codes.Ok, "Taskers near point loaded")
+	span.SetAttributes(attribute.Int("tasker.count", len(nearby)))
+	return nearby, nil
+}
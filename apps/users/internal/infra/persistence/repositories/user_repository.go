@@ -5,8 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/geo"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/role"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
@@ -21,7 +24,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -69,7 +72,7 @@ func (r *userRepository) FindByID(ctx context.Context, id ids.UserID) (*user.Use
 		span.RecordError(err)
 		span.SetAttributes(attribute.String("error.type", "db_read_error"))
 		logger.Error("Failed to query user by ID from DB", "user_id", string(id), slog.Any("error", err))
-		return nil, fmt.Errorf("failed to query user by ID: %w", err)
+		return nil, fmt.Errorf("failed to query user by ID: %w", lp.TranslateError(err))
 	}
 
 	uRoles, err := r.db.GetUserRoles(ctx, raw.ID)
@@ -78,7 +81,7 @@ func (r *userRepository) FindByID(ctx context.Context, id ids.UserID) (*user.Use
 		span.RecordError(err)
 		span.SetAttributes(attribute.String("error.type", "db_read_error"))
 		logger.Error("Failed to query user roles from DB", "user_id", string(id), slog.Any("error", err))
-		return nil, fmt.Errorf("failed to query user roles: %w", err)
+		return nil, fmt.Errorf("failed to query user roles: %w", lp.TranslateError(err))
 	}
 	var roles = utils.ArrayMap(uRoles, func(ur db.Role) role.Role { return *role.NewRoleFromRepository(uint(ur.ID), ur.Name, ur.Description) })
 
@@ -101,7 +104,17 @@ func (r *userRepository) FindByID(ctx context.Context, id ids.UserID) (*user.Use
 		*lp.ToTime(raw.CreatedAt),
 		*lp.ToTime(raw.UpdatedAt),
 		lp.ToTime(raw.LastLoginAt),
-		user.NewProfileFromRepository(raw.ID.String(), raw.DisplayName, *preferencesJSON),
+		user.NewProfileFromRepository(
+			raw.ID.String(),
+			raw.DisplayName,
+			raw.FirstName,
+			raw.LastName,
+			raw.Bio,
+			raw.AvatarUrl,
+			raw.PhoneNumber,
+			raw.Address,
+			*preferencesJSON,
+		),
 		roles,
 	)
 	if err != nil {
@@ -109,6 +122,16 @@ func (r *userRepository) FindByID(ctx context.Context, id ids.UserID) (*user.Use
 		return nil, fmt.Errorf("failed to transform json to user model: %w", err)
 	}
 
+	addresses, err := r.addressesByUserIDs(ctx, []pgtype.UUID{raw.ID})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query profile address from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query profile address from DB", "user_id", raw.ID, slog.Any("error", err))
+		return nil, err
+	}
+	resp.Profile.StructuredAddress = addresses[lp.FromUUID(raw.ID)]
+
 	return resp, nil
 }
 
@@ -130,7 +153,7 @@ func (r *userRepository) FindByLineUserID(ctx context.Context, lineUserID string
 		span.SetStatus(codes.Error, "Failed to query user by Line User ID")
 		span.RecordError(err)
 		logger.Error("Failed to query user by Line User ID", "line_user_id", lineUserID, slog.Any("error", err))
-		return nil, fmt.Errorf("failed to query user full aggregate by LINE User ID: %w", err)
+		return nil, fmt.Errorf("failed to query user full aggregate by LINE User ID: %w", lp.TranslateError(err))
 	}
 
 	uRoles, err := r.db.GetUserRoles(ctx, raw.ID)
@@ -139,7 +162,7 @@ func (r *userRepository) FindByLineUserID(ctx context.Context, lineUserID string
 		span.RecordError(err)
 		span.SetAttributes(attribute.String("error.type", "db_read_error"))
 		logger.Error("Failed to query user roles from DB", "user_id", raw.ID, slog.Any("error", err))
-		return nil, fmt.Errorf("failed to query user roles: %w", err)
+		return nil, fmt.Errorf("failed to query user roles: %w", lp.TranslateError(err))
 	}
 	var roles = utils.ArrayMap(uRoles, func(ur db.Role) role.Role { return *role.NewRoleFromRepository(uint(ur.ID), ur.Name, ur.Description) })
 
@@ -160,7 +183,17 @@ func (r *userRepository) FindByLineUserID(ctx context.Context, lineUserID string
 		*lp.ToTime(raw.CreatedAt),
 		*lp.ToTime(raw.UpdatedAt),
 		lp.ToTime(raw.LastLoginAt),
-		user.NewProfileFromRepository(raw.ID.String(), raw.DisplayName, *preferencesJSON),
+		user.NewProfileFromRepository(
+			raw.ID.String(),
+			raw.DisplayName,
+			raw.FirstName,
+			raw.LastName,
+			raw.Bio,
+			raw.AvatarUrl,
+			raw.PhoneNumber,
+			raw.Address,
+			*preferencesJSON,
+		),
 		roles,
 	)
 	if err != nil {
@@ -168,6 +201,16 @@ func (r *userRepository) FindByLineUserID(ctx context.Context, lineUserID string
 		return nil, fmt.Errorf("failed to transform json to user model: %w", err)
 	}
 
+	addresses, err := r.addressesByUserIDs(ctx, []pgtype.UUID{raw.ID})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query profile address from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query profile address from DB", "user_id", raw.ID, slog.Any("error", err))
+		return nil, err
+	}
+	resp.Profile.StructuredAddress = addresses[lp.FromUUID(raw.ID)]
+
 	return resp, nil
 }
 
@@ -188,7 +231,7 @@ func (r *userRepository) Save(ctx context.Context, u *user.User) (err error) {
 		span.RecordError(err)
 		span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
 		logger.Error("Failed to begin DB transaction", slog.Any("error", err))
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return fmt.Errorf("failed to begin transaction: %w", lp.TranslateError(err))
 	}
 	defer func() {
 		if r := recover(); r != nil {
@@ -204,7 +247,7 @@ func (r *userRepository) Save(ctx context.Context, u *user.User) (err error) {
 				span.RecordError(commitErr)
 				span.SetAttributes(attribute.String("error.type", "db_transaction_error"))
 				logger.Error("Failed to commit DB transaction", slog.Any("error", commitErr))
-				err = fmt.Errorf("failed to commit transaction: %w", commitErr)
+				err = fmt.Errorf("failed to commit transaction: %w", lp.TranslateError(commitErr))
 			}
 		}
 	}()
@@ -218,7 +261,7 @@ func (r *userRepository) Save(ctx context.Context, u *user.User) (err error) {
 		span.RecordError(err)
 		span.SetAttributes(attribute.String("error.type", "db_read_error"))
 		logger.Error("Failed to check user existence in DB", slog.Any("error", err))
-		return fmt.Errorf("failed to check user existence: %w", err)
+		return fmt.Errorf("failed to check user existence: %w", lp.TranslateError(err))
 	}
 
 	if userExists {
@@ -236,7 +279,7 @@ func (r *userRepository) Save(ctx context.Context, u *user.User) (err error) {
 			span.RecordError(err)
 			span.SetAttributes(attribute.String("error.type", "db_write_error"))
 			logger.Error("Failed to update user in DB", slog.Any("error", err))
-			return fmt.Errorf("failed to update user: %w", err)
+			return fmt.Errorf("failed to update user: %w", lp.TranslateError(err))
 		}
 	} else {
 		logger.Debug("Creating new user in DB.")
@@ -248,20 +291,18 @@ func (r *userRepository) Save(ctx context.Context, u *user.User) (err error) {
 			Status:       string(u.Status),
 		}
 		if _, err = qtx.CreateUser(ctx, input); err != nil {
-			var pgErr *pgconn.PgError
-			if errors.As(err, &pgErr) {
-				if pgErr.Code == "23505" {
-					logger.Warn("Duplicate entry during user creation in DB.", slog.Any("error", err))
-					span.SetStatus(codes.Error, "Duplicate user entry in DB")
-					span.SetAttributes(attribute.String("error.type", "db_unique_violation"))
-					return user.ErrLineUserAlreadyExists
-				}
+			err = lp.TranslateError(err)
+			if errors.Is(err, lp.ErrUniqueViolation) {
+				logger.Warn("Duplicate entry during user creation in DB.", slog.Any("error", err))
+				span.SetStatus(codes.Error, "Duplicate user entry in DB")
+				span.SetAttributes(attribute.String("error.type", "db_unique_violation"))
+				return user.ErrLineUserAlreadyExists
 			}
 			span.SetStatus(codes.Error, "Failed to create user in DB")
 			span.RecordError(err)
 			span.SetAttributes(attribute.String("error.type", "db_write_error"))
 			logger.Error("Failed to insert user in DB", slog.Any("error", err))
-			return fmt.Errorf("failed to insert user: %w", err)
+			return fmt.Errorf("failed to insert user: %w", lp.TranslateError(err))
 		}
 	}
 
@@ -290,7 +331,54 @@ func (r *userRepository) Save(ctx context.Context, u *user.User) (err error) {
 		span.RecordError(err)
 		span.SetAttributes(attribute.String("error.type", "db_write_error"))
 		logger.Error("Failed to upsert user profile in DB", slog.Any("error", err))
-		return fmt.Errorf("failed to upsert user profile: %w", err)
+		return fmt.Errorf("failed to upsert user profile: %w", lp.TranslateError(err))
+	}
+
+	if a := u.Profile.StructuredAddress; a != nil {
+		latitude, longitude := fromPoint(a.Location)
+		addressInput := db.UpsertProfileAddressParams{
+			UserID:      userID,
+			SubDistrict: a.SubDistrict,
+			District:    a.District,
+			Province:    a.Province,
+			PostalCode:  a.PostalCode,
+			CountryCode: a.CountryCode,
+			Latitude:    latitude,
+			Longitude:   longitude,
+		}
+		if err = qtx.UpsertProfileAddress(ctx, addressInput); err != nil {
+			span.SetStatus(codes.Error, "Failed to upsert profile address in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to upsert profile address in DB", slog.Any("error", err))
+			return fmt.Errorf("failed to upsert profile address: %w", lp.TranslateError(err))
+		}
+	}
+
+	if u.Acquisition != nil {
+		metadataByte, marshalErr := utils.MapToByte(u.Acquisition.Metadata)
+		if marshalErr != nil {
+			span.SetStatus(codes.Error, "Failed to marshal acquisition metadata")
+			span.RecordError(marshalErr)
+			span.SetAttributes(attribute.String("error.type", "data_serialization_error"))
+			logger.Error("Failed to marshal acquisition metadata for DB", slog.Any("error", marshalErr))
+			return marshalErr
+		}
+		acquisitionInput := db.InsertUserAcquisitionParams{
+			UserID:       userID,
+			ReferralCode: u.Acquisition.ReferralCode,
+			Channel:      u.Acquisition.Channel,
+			Campaign:     u.Acquisition.Campaign,
+			Metadata:     metadataByte,
+			CreatedAt:    lp.ToTimestamp(&u.Acquisition.CreatedAt),
+		}
+		if err = qtx.InsertUserAcquisition(ctx, acquisitionInput); err != nil {
+			span.SetStatus(codes.Error, "Failed to insert user acquisition in DB")
+			span.RecordError(err)
+			span.SetAttributes(attribute.String("error.type", "db_write_error"))
+			logger.Error("Failed to insert user acquisition in DB", slog.Any("error", err))
+			return fmt.Errorf("failed to insert user acquisition: %w", lp.TranslateError(err))
+		}
 	}
 
 	logger.Debug("Updating user roles in DB.")
@@ -299,7 +387,7 @@ func (r *userRepository) Save(ctx context.Context, u *user.User) (err error) {
 		span.RecordError(err)
 		span.SetAttributes(attribute.String("error.type", "db_write_error"))
 		logger.Error("Failed to delete old user roles in DB", slog.Any("error", err))
-		return fmt.Errorf("failed to delete old user roles: %w", err)
+		return fmt.Errorf("failed to delete old user roles: %w", lp.TranslateError(err))
 	}
 	for _, r := range u.Roles {
 		roleInput := db.CreateUserRoleParams{
@@ -338,7 +426,7 @@ func (r *userRepository) ExistsByLineUserID(ctx context.Context, lineUserID stri
 		span.RecordError(err)
 		span.SetAttributes(attribute.String("error.type", "db_read_error"))
 		logger.Error("Failed to check Line User ID existence in DB", slog.Any("error", err))
-		return false, fmt.Errorf("failed to check line user ID existence: %w", err)
+		return false, fmt.Errorf("failed to check line user ID existence: %w", lp.TranslateError(err))
 	}
 	span.SetStatus(codes.Ok, "Existence checked in DB")
 	span.SetAttributes(attribute.Bool("user.exists", exists))
@@ -365,7 +453,7 @@ func (r *userRepository) CreateUserRole(ctx context.Context, usrId ids.UserID, r
 		span.SetStatus(codes.Error, "Failed to check role existence")
 		span.RecordError(err)
 		logger.Error("Failed to check role existence", slog.Any("error", err))
-		return fmt.Errorf("failed to check role existence: %w", err)
+		return fmt.Errorf("failed to check role existence: %w", lp.TranslateError(err))
 	}
 	if !roleExists {
 		span.SetStatus(codes.Error, "Role not found")
@@ -381,7 +469,7 @@ func (r *userRepository) CreateUserRole(ctx context.Context, usrId ids.UserID, r
 		span.SetStatus(codes.Error, "Failed to check if user already has role")
 		span.RecordError(err)
 		logger.Error("Failed to check if user already has role", slog.Any("error", err))
-		return fmt.Errorf("failed to check if user already has role: %w", err)
+		return fmt.Errorf("failed to check if user already has role: %w", lp.TranslateError(err))
 	}
 	if alreadyHasRole {
 		span.SetStatus(codes.Error, "User already has role")
@@ -397,7 +485,7 @@ func (r *userRepository) CreateUserRole(ctx context.Context, usrId ids.UserID, r
 		span.SetStatus(codes.Error, "Failed to add role to user")
 		span.RecordError(err)
 		logger.Error("Failed to add role to user", slog.Any("error", err))
-		return fmt.Errorf("failed to add role to user: %w", err)
+		return fmt.Errorf("failed to add role to user: %w", lp.TranslateError(err))
 	}
 
 	span.SetStatus(codes.Ok, "User role created successfully")
@@ -426,7 +514,7 @@ func (r *userRepository) GetRoleByID(ctx context.Context, roleID uint) (*role.Ro
 		span.SetStatus(codes.Error, "Failed to get role by ID")
 		span.RecordError(err)
 		logger.Error("Failed to get role by ID", slog.Any("error", err))
-		return nil, fmt.Errorf("failed to get role by ID: %w", err)
+		return nil, fmt.Errorf("failed to get role by ID: %w", lp.TranslateError(err))
 	}
 
 	span.SetStatus(codes.Ok, "Role found successfully")
@@ -434,3 +522,406 @@ func (r *userRepository) GetRoleByID(ctx context.Context, roleID uint) (*role.Ro
 
 	return role.NewRoleFromRepository(roleID, raw.Name, raw.Description), nil
 }
+
+func (r *userRepository) FindIdentityVerifications(ctx context.Context, usrID ids.UserID) ([]user.IdentityVerification, error) {
+	logger := observability.LoggerFromCtx(ctx).With(
+		slog.String("method", "FindIdentityVerifications"),
+		slog.String("user_id", string(usrID)),
+	)
+	ctx, span := r.tracer.Start(ctx, "UserRepository.FindIdentityVerifications", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("db.user_id", string(usrID)))
+
+	rows, err := r.db.FindIdentityVerificationsByUserID(ctx, lp.ToUUID(string(usrID)))
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query identity verifications")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query identity verifications from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query identity verifications: %w", lp.TranslateError(err))
+	}
+
+	verifications := make([]user.IdentityVerification, 0, len(rows))
+	for _, row := range rows {
+		var reviewerID *string
+		if row.ReviewerID.Valid {
+			rid := lp.FromUUID(row.ReviewerID)
+			reviewerID = &rid
+		}
+		var rejectionReason string
+		if row.RejectionReason != nil {
+			rejectionReason = *row.RejectionReason
+		}
+		iv, err := user.NewIdentityVerificationFromRepository(
+			lp.FromUUID(row.ID),
+			lp.FromUUID(row.UserID),
+			row.DocumentType,
+			row.DocumentNumber,
+			row.DocumentUrls,
+			row.Status,
+			*lp.ToTime(row.SubmittedAt),
+			lp.ToTime(row.VerifiedAt),
+			reviewerID,
+			rejectionReason,
+		)
+		if err != nil {
+			span.SetStatus(codes.Error, "Failed to map identity verification")
+			span.RecordError(err)
+			logger.Error("Failed to map identity verification", slog.Any("error", err))
+			return nil, fmt.Errorf("failed to map identity verification: %w", err)
+		}
+		verifications = append(verifications, *iv)
+	}
+
+	span.SetStatus(codes.Ok, "Identity verifications loaded")
+	span.SetAttributes(attribute.Int("identity_verification.count", len(verifications)))
+	return verifications, nil
+}
+
+func (r *userRepository) FindAcquisition(ctx context.Context, usrID ids.UserID) (*user.Acquisition, error) {
+	logger := observability.LoggerFromCtx(ctx).With(
+		slog.String("method", "FindAcquisition"),
+		slog.String("user_id", string(usrID)),
+	)
+	ctx, span := r.tracer.Start(ctx, "UserRepository.FindAcquisition", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("db.user_id", string(usrID)))
+
+	raw, err := r.db.FindUserAcquisition(ctx, lp.ToUUID(string(usrID)))
+	if err != nil {
+		// Nothing is stored for users who registered without attribution.
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.SetStatus(codes.Ok, "No acquisition recorded")
+			return nil, nil
+		}
+		span.SetStatus(codes.Error, "Failed to query acquisition")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query acquisition from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query acquisition: %w", lp.TranslateError(err))
+	}
+
+	metadata, err := utils.ByteToMap(raw.Metadata)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to unmarshal acquisition metadata")
+		span.RecordError(err)
+		logger.Error("Failed to unmarshal acquisition metadata", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to unmarshal acquisition metadata: %w", err)
+	}
+
+	span.SetStatus(codes.Ok, "Acquisition loaded")
+	return &user.Acquisition{
+		UserID:       usrID,
+		ReferralCode: raw.ReferralCode,
+		Channel:      raw.Channel,
+		Campaign:     raw.Campaign,
+		Metadata:     metadata,
+		CreatedAt:    *lp.ToTime(raw.CreatedAt),
+	}, nil
+}
+
+func (r *userRepository) SaveVerificationDecision(ctx context.Context, iv *user.IdentityVerification) error {
+	logger := observability.LoggerFromCtx(ctx).With(
+		slog.String("method", "SaveVerificationDecision"),
+		slog.String("verification_id", iv.ID.String()),
+	)
+	ctx, span := r.tracer.Start(ctx, "UserRepository.SaveVerificationDecision", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.user_id", string(iv.UserID)),
+		attribute.String("identity_verification.status", string(iv.Status)),
+	)
+
+	var reviewerID pgtype.UUID
+	if iv.ReviewerID != nil {
+		reviewerID = lp.ToUUID(string(*iv.ReviewerID))
+	}
+	var rejectionReason *string
+	if iv.RejectionReason != "" {
+		rejectionReason = &iv.RejectionReason
+	}
+	rows, err := r.db.UpdateIdentityVerificationStatus(ctx, db.UpdateIdentityVerificationStatusParams{
+		ID:              lp.ToUUID(iv.ID.String()),
+		Status:          string(iv.Status),
+		VerifiedAt:      lp.ToTimestamp(iv.VerifiedAt),
+		ReviewerID:      reviewerID,
+		RejectionReason: rejectionReason,
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to update identity verification")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_write_error"))
+		logger.Error("Failed to update identity verification in DB", slog.Any("error", err))
+		return fmt.Errorf("failed to update identity verification: %w", lp.TranslateError(err))
+	}
+	if rows == 0 {
+		span.SetStatus(codes.Error, "Identity verification already decided")
+		span.SetAttributes(attribute.String("error.type", string(user.ErrInvalidVerificationStatusTransition.Code)))
+		return user.ErrInvalidVerificationStatusTransition
+	}
+
+	span.SetStatus(codes.Ok, "Identity verification decision saved")
+	return nil
+}
+
+func (r *userRepository) Search(ctx context.Context, criteria user.SearchCriteria) ([]user.User, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("method", "Search"))
+	ctx, span := r.tracer.Start(ctx, "UserRepository.Search", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "search"),
+		attribute.String("search.sort_field", string(criteria.SortField)),
+		attribute.Bool("search.sort_desc", criteria.SortDesc),
+		attribute.Int("search.limit", criteria.Limit),
+	)
+
+	var status, roleName, verificationStatus *string
+	if criteria.Status != nil {
+		s := string(*criteria.Status)
+		status = &s
+	}
+	if criteria.RoleName != nil {
+		s := string(*criteria.RoleName)
+		roleName = &s
+	}
+	if criteria.VerificationStatus != nil {
+		s := string(*criteria.VerificationStatus)
+		verificationStatus = &s
+	}
+
+	// Every sort has its own query so each can use its keyset index; the
+	// queries share their parameters and rows.
+	var (
+		rows []db.SearchUsersByCreatedAtDescRow
+		err  error
+	)
+	switch criteria.SortField {
+	case user.SearchSortDisplayName:
+		params := db.SearchUsersByDisplayNameDescParams{
+			Status:             status,
+			RoleName:           roleName,
+			VerificationStatus: verificationStatus,
+			CreatedFrom:        lp.ToTimestamp(criteria.CreatedFrom),
+			CreatedTo:          lp.ToTimestamp(criteria.CreatedTo),
+			EmailPrefix:        likePrefix(criteria.EmailPrefix),
+			DisplayNamePrefix:  likePrefix(criteria.DisplayNamePrefix),
+			PageLimit:          int32(criteria.Limit),
+		}
+		if criteria.After != nil {
+			params.AfterDisplayName = &criteria.After.SortValue
+			params.AfterID = lp.ToUUID(criteria.After.UserID)
+		}
+		if criteria.SortDesc {
+			var byName []db.SearchUsersByDisplayNameDescRow
+			byName, err = r.db.SearchUsersByDisplayNameDesc(ctx, params)
+			rows = utils.ArrayMap(byName, func(row db.SearchUsersByDisplayNameDescRow) db.SearchUsersByCreatedAtDescRow {
+				return db.SearchUsersByCreatedAtDescRow(row)
+			})
+		} else {
+			var byName []db.SearchUsersByDisplayNameAscRow
+			byName, err = r.db.SearchUsersByDisplayNameAsc(ctx, db.SearchUsersByDisplayNameAscParams(params))
+			rows = utils.ArrayMap(byName, func(row db.SearchUsersByDisplayNameAscRow) db.SearchUsersByCreatedAtDescRow {
+				return db.SearchUsersByCreatedAtDescRow(row)
+			})
+		}
+	default:
+		params := db.SearchUsersByCreatedAtDescParams{
+			Status:             status,
+			RoleName:           roleName,
+			VerificationStatus: verificationStatus,
+			CreatedFrom:        lp.ToTimestamp(criteria.CreatedFrom),
+			CreatedTo:          lp.ToTimestamp(criteria.CreatedTo),
+			EmailPrefix:        likePrefix(criteria.EmailPrefix),
+			DisplayNamePrefix:  likePrefix(criteria.DisplayNamePrefix),
+			PageLimit:          int32(criteria.Limit),
+		}
+		if criteria.After != nil {
+			afterCreatedAt, parseErr := time.Parse(time.RFC3339Nano, criteria.After.SortValue)
+			if parseErr != nil {
+				span.SetStatus(codes.Error, "Invalid search cursor")
+				logger.Warn("Invalid created_at value in search cursor", slog.Any("error", parseErr))
+				return nil, user.ErrInvalidSearchCursor
+			}
+			params.AfterCreatedAt = lp.ToTimestamp(&afterCreatedAt)
+			params.AfterID = lp.ToUUID(criteria.After.UserID)
+		}
+		if criteria.SortDesc {
+			rows, err = r.db.SearchUsersByCreatedAtDesc(ctx, params)
+		} else {
+			var asc []db.SearchUsersByCreatedAtAscRow
+			asc, err = r.db.SearchUsersByCreatedAtAsc(ctx, db.SearchUsersByCreatedAtAscParams(params))
+			rows = utils.ArrayMap(asc, func(row db.SearchUsersByCreatedAtAscRow) db.SearchUsersByCreatedAtDescRow {
+				return db.SearchUsersByCreatedAtDescRow(row)
+			})
+		}
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to search users in DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to search users in DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to search users: %w", lp.TranslateError(err))
+	}
+
+	users, err := r.hydrateUsers(ctx, rows)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to load searched users")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to load roles or profiles for searched users", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Users searched")
+	span.SetAttributes(attribute.Int("search.result_count", len(users)))
+	return users, nil
+}
+
+func (r *userRepository) FindByIDs(ctx context.Context, userIDs []ids.UserID) ([]user.User, error) {
+	logger := observability.LoggerFromCtx(ctx).With(slog.String("method", "FindByIDs"))
+	ctx, span := r.tracer.Start(ctx, "UserRepository.FindByIDs", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "read_by_ids"),
+		attribute.Int("db.user_id_count", len(userIDs)),
+	)
+
+	if len(userIDs) == 0 {
+		span.SetStatus(codes.Ok, "No user IDs requested")
+		return []user.User{}, nil
+	}
+
+	raw, err := r.db.FindUsersByIDs(ctx, utils.ArrayMap(userIDs, func(id ids.UserID) pgtype.UUID { return lp.ToUUID(string(id)) }))
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to query users by IDs from DB")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to query users by IDs from DB", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to query users by IDs: %w", lp.TranslateError(err))
+	}
+
+	users, err := r.hydrateUsers(ctx, utils.ArrayMap(raw, func(row db.FindUsersByIDsRow) db.SearchUsersByCreatedAtDescRow {
+		return db.SearchUsersByCreatedAtDescRow(row)
+	}))
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to load users by IDs")
+		span.RecordError(err)
+		span.SetAttributes(attribute.String("error.type", "db_read_error"))
+		logger.Error("Failed to load roles or profiles for users", slog.Any("error", err))
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Users loaded from DB")
+	span.SetAttributes(attribute.Int("user.found_count", len(users)))
+	logger.Debug("Users loaded from DB.", "requested", len(userIDs), "found", len(users))
+	return users, nil
+}
+
+// hydrateUsers turns user+profile rows into aggregates, loading every user's
+// roles and structured address with one extra query each regardless of how
+// many rows there are.
+func (r *userRepository) hydrateUsers(ctx context.Context, rows []db.SearchUsersByCreatedAtDescRow) ([]user.User, error) {
+	userIDs := utils.ArrayMap(rows, func(row db.SearchUsersByCreatedAtDescRow) pgtype.UUID { return row.ID })
+	rolesByUser, err := r.rolesByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	addressesByUser, err := r.addressesByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]user.User, 0, len(rows))
+	for _, raw := range rows {
+		preferencesJSON, err := utils.ByteToMap(raw.Preferences)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal preferences to JSON for user %s: %w", raw.ID.String(), err)
+		}
+		u, err := user.NewUserFromRepository(
+			raw.ID.String(),
+			raw.LineUserID,
+			raw.Email,
+			raw.PasswordHash,
+			raw.Status,
+			*lp.ToTime(raw.CreatedAt),
+			*lp.ToTime(raw.UpdatedAt),
+			lp.ToTime(raw.LastLoginAt),
+			user.NewProfileFromRepository(
+				raw.ID.String(),
+				raw.DisplayName,
+				raw.FirstName,
+				raw.LastName,
+				raw.Bio,
+				raw.AvatarUrl,
+				raw.PhoneNumber,
+				raw.Address,
+				*preferencesJSON,
+			),
+			rolesByUser[lp.FromUUID(raw.ID)],
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to transform row to user model: %w", err)
+		}
+		u.Profile.StructuredAddress = addressesByUser[lp.FromUUID(raw.ID)]
+		users = append(users, *u)
+	}
+	return users, nil
+}
+
+// rolesByUserIDs loads the roles of many users in a single round-trip, keyed by user ID.
+func (r *userRepository) rolesByUserIDs(ctx context.Context, userIDs []pgtype.UUID) (map[string][]role.Role, error) {
+	result := make(map[string][]role.Role, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+	rows, err := r.db.GetRolesByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user roles: %w", lp.TranslateError(err))
+	}
+	for _, row := range rows {
+		key := lp.FromUUID(row.UserID)
+		result[key] = append(result[key], *role.NewRoleFromRepository(uint(row.ID), row.Name, row.Description))
+	}
+	return result, nil
+}
+
+// addressesByUserIDs loads the structured addresses of many users in a single
+// round-trip, keyed by user ID; users without one are absent.
+func (r *userRepository) addressesByUserIDs(ctx context.Context, userIDs []pgtype.UUID) (map[string]*geo.Address, error) {
+	result := make(map[string]*geo.Address, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+	rows, err := r.db.FindProfileAddressesByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query profile addresses: %w", lp.TranslateError(err))
+	}
+	for _, row := range rows {
+		result[lp.FromUUID(row.UserID)] = &geo.Address{
+			SubDistrict: row.SubDistrict,
+			District:    row.District,
+			Province:    row.Province,
+			PostalCode:  row.PostalCode,
+			CountryCode: row.CountryCode,
+			Location:    toPoint(row.Latitude, row.Longitude),
+		}
+	}
+	return result, nil
+}
+
+// likePrefix lower-cases a prefix filter and escapes LIKE wildcards so user
+// input is matched literally.
+func likePrefix(prefix *string) *string {
+	if prefix == nil || *prefix == "" {
+		return nil
+	}
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(strings.ToLower(*prefix))
+	return &escaped
+}
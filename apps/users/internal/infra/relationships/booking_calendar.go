@@ -0,0 +1,53 @@
+package relationships
+
+import (
+	"context"
+	"fmt"
+
+	tasksclient "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/client"
+	bidpb "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/proto/bid"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/availability"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxBookingTaskers is how many taskers BidService.ListBookings takes at once.
+const maxBookingTaskers = 100
+
+// tasksBookingCalendar reads bookings from the tasks service: the schedules
+// of the bids taskers won on tasks that are not yet done.
+type tasksBookingCalendar struct {
+	client *tasksclient.Client
+}
+
+func NewTasksBookingCalendar(c *tasksclient.Client) availability.BookingCalendar {
+	return tasksBookingCalendar{client: c}
+}
+
+func (c tasksBookingCalendar) BookedIntervals(ctx context.Context, userIDs []ids.UserID, window availability.Interval) (map[ids.UserID][]availability.Interval, error) {
+	booked := make(map[ids.UserID][]availability.Interval, len(userIDs))
+	for start := 0; start < len(userIDs); start += maxBookingTaskers {
+		batch := userIDs[start:min(start+maxBookingTaskers, len(userIDs))]
+		taskerIDs := make([]string, 0, len(batch))
+		for _, id := range batch {
+			taskerIDs = append(taskerIDs, string(id))
+		}
+		resp, err := c.client.Bids.ListBookings(ctx, &bidpb.ListBookingsRequest{
+			TaskerIds:   taskerIDs,
+			WindowStart: timestamppb.New(window.Start),
+			WindowEnd:   timestamppb.New(window.End),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list bookings: %w", lg.ErrorFromGRPC(err))
+		}
+		for _, b := range resp.GetBookings() {
+			id := ids.UserID(b.GetTaskerId())
+			booked[id] = append(booked[id], availability.Interval{
+				Start: b.GetStartAt().AsTime(),
+				End:   b.GetEndAt().AsTime(),
+			})
+		}
+	}
+	return booked, nil
+}
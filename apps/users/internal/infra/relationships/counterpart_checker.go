@@ -0,0 +1,44 @@
+package relationships
+
+import (
+	"context"
+	"fmt"
+
+	tasksclient "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/client"
+	taskpb "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/proto/task"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// tasksCounterpartChecker asks the tasks service whether either user was
+// assigned a task the other posted. Accepting a bid assigns its task to the
+// tasker, so this covers matches made through bids as well.
+type tasksCounterpartChecker struct {
+	client *tasksclient.Client
+}
+
+func NewTasksCounterpartChecker(c *tasksclient.Client) user.CounterpartChecker {
+	return tasksCounterpartChecker{client: c}
+}
+
+func (c tasksCounterpartChecker) AreCounterparts(ctx context.Context, a, b ids.UserID) (bool, error) {
+	if a == b {
+		return false, nil
+	}
+	for _, pair := range [][2]ids.UserID{{a, b}, {b, a}} {
+		resp, err := c.client.Tasks.ListTasks(ctx, &taskpb.ListTasksRequest{
+			PageSize:   1,
+			PosterId:   wrapperspb.String(string(pair[0])),
+			AssigneeId: wrapperspb.String(string(pair[1])),
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to list tasks between users: %w", lg.ErrorFromGRPC(err))
+		}
+		if len(resp.GetTasks()) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,51 @@
+package relationships
+
+import (
+	"context"
+	"fmt"
+
+	tasksclient "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/client"
+	reviewpb "github.com/pratchaya-maneechot/service-exchange/apps/tasks/api/proto/review"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/shared/ids"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+)
+
+var trends = map[reviewpb.Trend]string{
+	reviewpb.Trend_TREND_STABLE: "STABLE",
+	reviewpb.Trend_TREND_UP:     "UP",
+	reviewpb.Trend_TREND_DOWN:   "DOWN",
+}
+
+// tasksReputationReader reads reputations from the tasks ReviewService, which
+// keeps them up to date as reviews are published and moderated.
+type tasksReputationReader struct {
+	client *tasksclient.Client
+}
+
+func NewTasksReputationReader(c *tasksclient.Client) user.ReputationReader {
+	return tasksReputationReader{client: c}
+}
+
+func (r tasksReputationReader) ReputationOf(ctx context.Context, userID ids.UserID) (*user.Reputation, error) {
+	rep, err := r.client.Reviews.GetReputation(ctx, &reviewpb.GetReputationRequest{UserId: string(userID)})
+	if err != nil {
+		err = lg.ErrorFromGRPC(err)
+		if errs.HasReason(err, tasksclient.ReasonReputationNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get reputation: %w", err)
+	}
+	// Users never reviewed get a reputation with no reviews.
+	if rep.GetReviewCount() == 0 {
+		return nil, nil
+	}
+	return &user.Reputation{
+		ReviewCount:     int(rep.GetReviewCount()),
+		AverageRating:   rep.GetAverageRating(),
+		BayesianAverage: rep.GetBayesianAverage(),
+		RecentAverage:   rep.GetRecentAverage(),
+		Trend:           trends[rep.GetTrend()],
+	}, nil
+}
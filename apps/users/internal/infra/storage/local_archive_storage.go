@@ -0,0 +1,143 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+)
+
+// LocalArchiveStorage writes archives to a directory on disk and hands out
+// HMAC-signed download URLs, which ServeDownload checks. It is meant for
+// local development and single-replica deployments.
+type LocalArchiveStorage struct {
+	baseDir    string
+	baseURL    string
+	signingKey []byte
+}
+
+func NewLocalArchiveStorage(cfg *config.Config) (*LocalArchiveStorage, error) {
+	if err := os.MkdirAll(cfg.Export.StorageDir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create export storage dir %s: %w", cfg.Export.StorageDir, err)
+	}
+	return &LocalArchiveStorage{
+		baseDir:    cfg.Export.StorageDir,
+		baseURL:    strings.TrimRight(cfg.Export.DownloadBaseURL, "/"),
+		signingKey: []byte(cfg.Export.SigningKey),
+	}, nil
+}
+
+func (s *LocalArchiveStorage) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	path, err := s.pathFor(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create archive directory: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o640); err != nil {
+		return fmt.Errorf("failed to write archive %s: %w", key, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to finalize archive %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *LocalArchiveStorage) DownloadURL(ctx context.Context, key string, ttl time.Duration) (string, time.Time, error) {
+	if _, err := s.pathFor(key); err != nil {
+		return "", time.Time{}, err
+	}
+	expiresAt := time.Now().Add(ttl).UTC().Truncate(time.Second)
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+
+	q := url.Values{}
+	q.Set("expires", expires)
+	q.Set("signature", s.sign(key, expires))
+
+	return fmt.Sprintf("%s/%s?%s", s.baseURL, key, q.Encode()), expiresAt, nil
+}
+
+func (s *LocalArchiveStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.pathFor(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete archive %s: %w", key, err)
+	}
+	return nil
+}
+
+// ServeDownload serves the archive at key to whoever holds a URL from
+// DownloadURL that has not expired. The signature is all that is checked:
+// it is handed out only to the owner of the export.
+func (s *LocalArchiveStorage) ServeDownload(w http.ResponseWriter, r *http.Request, key string) {
+	expires := r.URL.Query().Get("expires")
+	signature, err := hex.DecodeString(r.URL.Query().Get("signature"))
+	if err != nil || !hmac.Equal(signature, s.signature(key, expires)) {
+		http.Error(w, "invalid download link", http.StatusForbidden)
+		return
+	}
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().After(time.Unix(unix, 0)) {
+		http.Error(w, "download link expired", http.StatusGone)
+		return
+	}
+
+	path, err := s.pathFor(key)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		// Archives past their retention period are deleted.
+		http.NotFound(w, r)
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		http.Error(w, "failed to read archive", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filepath.Base(path)))
+	w.Header().Set("Cache-Control", "private, no-store")
+	http.ServeContent(w, r, filepath.Base(path), info.ModTime(), f)
+}
+
+func (s *LocalArchiveStorage) sign(key, expires string) string {
+	return hex.EncodeToString(s.signature(key, expires))
+}
+
+func (s *LocalArchiveStorage) signature(key, expires string) []byte {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(key))
+	mac.Write([]byte{'\n'})
+	mac.Write([]byte(expires))
+	return mac.Sum(nil)
+}
+
+func (s *LocalArchiveStorage) pathFor(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if clean == "/" {
+		return "", fmt.Errorf("invalid archive key %q", key)
+	}
+	return filepath.Join(s.baseDir, clean), nil
+}
@@ -11,15 +11,20 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/google/wire"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/command"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/query"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/domain/user"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/grpc"
 	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/infra"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/infra/storage"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/worker"
 	"github.com/pratchaya-maneechot/service-exchange/libs/bus"
 	lg "github.com/pratchaya-maneechot/service-exchange/libs/grpc"
+	lh "github.com/pratchaya-maneechot/service-exchange/libs/health"
 	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
 )
 
@@ -34,7 +39,15 @@ type Internal struct {
 	App          *app.App
 	Bus          *bus.Bus
 	MetricServer *observability.MetricServer
-	Cleanup      func()
+	// GatewayServer serves Server as HTTP/JSON; nil when gateway.enabled is
+	// off.
+	GatewayServer *lg.GatewayServer
+	// Health runs the dependency checks behind /readyz and the gRPC health
+	// service.
+	Health *lh.Registry
+	// ExportRecovery fails the data exports a restart interrupted.
+	ExportRecovery *worker.ExportRecovery
+	Cleanup        func()
 }
 
 func NewInternal(
@@ -42,23 +55,54 @@ func NewInternal(
 	gs *lg.GRPCServer,
 	appModule *app.App,
 	bBus *bus.Bus,
+	vd *validator.Validate,
 	logger *slog.Logger,
 	metricServer *observability.MetricServer,
+	gatewayServer *lg.GatewayServer,
+	health *lh.Registry,
+	exportRecovery *worker.ExportRecovery,
 	cleanup func(),
 ) *Internal {
 
+	bBus.CommandBus.Use(lg.ValidateCommands(vd))
+	bBus.QueryBus.Use(lg.ValidateQueries(vd))
+
 	bBus.CommandBus.RegisterHandler(command.RegisterUserCommand{}, appModule.RegisterUserCommandHandler)
 	bBus.CommandBus.RegisterHandler(command.UpdateUserProfileCommand{}, appModule.UpdateUserProfileCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.ExportUserDataCommand{}, appModule.ExportUserDataCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.FailInterruptedExportsCommand{}, appModule.FailInterruptedExportsCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.PurgeExpiredExportsCommand{}, appModule.PurgeExpiredExportsCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.CreateTaskerProfileCommand{}, appModule.CreateTaskerProfileCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.UpdateTaskerProfileCommand{}, appModule.UpdateTaskerProfileCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.UpsertSkillCategoryCommand{}, appModule.UpsertSkillCategoryCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.SetTaskerAvailabilityCommand{}, appModule.SetTaskerAvailabilityCommandHandler)
+	bBus.CommandBus.RegisterHandler(command.ReviewIdentityVerificationCommand{}, appModule.ReviewIdentityVerificationCommandHandler)
 	bBus.QueryBus.RegisterHandler(query.GetUserProfileQuery{}, appModule.GetUserProfileQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.GetPublicProfileQuery{}, appModule.GetPublicProfileQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.GetDataExportStatusQuery{}, appModule.GetDataExportStatusQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.SearchUsersQuery{}, appModule.SearchUsersQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.BatchGetUserProfilesQuery{}, appModule.BatchGetUserProfilesQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.GetTaskerProfileQuery{}, appModule.GetTaskerProfileQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.ListSkillCategoriesQuery{}, appModule.ListSkillCategoriesQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.GetTaskerAvailabilityQuery{}, appModule.GetTaskerAvailabilityQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.FindAvailableTaskersQuery{}, appModule.FindAvailableTaskersQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.FindTaskersNearQuery{}, appModule.FindTaskersNearQueryHandler)
+	bBus.QueryBus.RegisterHandler(query.ResolveLineUserQuery{}, appModule.ResolveLineUserQueryHandler)
+	bBus.EventBus.Subscribe(user.UserChanged{}, appModule.UserProfileWatchers)
+	bBus.EventBus.Subscribe(user.UserCreated{}, appModule.NotifyUserCreatedHandler)
+	bBus.EventBus.Subscribe(user.IdentityVerificationDecided{}, appModule.NotifyVerificationDecidedHandler)
 
 	return &Internal{
-		Config:       cfg,
-		Server:       gs,
-		App:          appModule,
-		Bus:          bBus,
-		Logger:       logger,
-		MetricServer: metricServer,
-		Cleanup:      cleanup,
+		Config:         cfg,
+		Server:         gs,
+		App:            appModule,
+		Bus:            bBus,
+		Logger:         logger,
+		MetricServer:   metricServer,
+		GatewayServer:  gatewayServer,
+		Health:         health,
+		ExportRecovery: exportRecovery,
+		Cleanup:        cleanup,
 	}
 }
 
@@ -68,6 +112,9 @@ func InitializeApp(parentCtx context.Context) (*Internal, error) {
 		bus.BusModuleSet,
 		infra.InfraModuleSet,
 		grpc.NewGRPCServer,
+		grpc.NewGatewayServer,
+		wire.Bind(new(grpc.ArchiveDownloads), new(*storage.LocalArchiveStorage)),
+		worker.NewExportRecovery,
 		NewInternal,
 		lg.ProvideValidator,
 		ProvideAppCleanup,
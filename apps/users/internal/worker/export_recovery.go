@@ -0,0 +1,53 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/app/command"
+	"github.com/pratchaya-maneechot/service-exchange/apps/users/internal/config"
+	"github.com/pratchaya-maneechot/service-exchange/libs/bus"
+)
+
+// ExportRecovery sends a FailInterruptedExportsCommand and a
+// PurgeExpiredExportsCommand at startup and then once every job timeout, so
+// exports left pending or running by a restart do not stay that way and
+// archives are deleted once their retention period is over. Running it on
+// several replicas is safe: it only touches jobs too old to be running
+// anywhere, and deleting an archive twice is harmless.
+type ExportRecovery struct {
+	bus      bus.Bus
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+func NewExportRecovery(cfg *config.Config, b bus.Bus, logger *slog.Logger) *ExportRecovery {
+	return &ExportRecovery{
+		bus:      b,
+		interval: cfg.Export.JobTimeout,
+		logger:   logger.With(slog.String("component", "ExportRecovery")),
+	}
+}
+
+// Run blocks until ctx is cancelled.
+func (r *ExportRecovery) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	r.logger.Info("Export recovery started.", "interval", r.interval)
+	for {
+		if _, err := r.bus.CommandBus.Dispatch(ctx, command.FailInterruptedExportsCommand{}); err != nil {
+			r.logger.Error("Failed to fail interrupted data exports", "error", err)
+		}
+		if _, err := r.bus.CommandBus.Dispatch(ctx, command.PurgeExpiredExportsCommand{}); err != nil {
+			r.logger.Error("Failed to purge expired data exports", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Export recovery stopped.")
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -3,6 +3,7 @@ package bus
 import (
 	"github.com/google/wire"
 	"github.com/pratchaya-maneechot/service-exchange/libs/bus/command"
+	"github.com/pratchaya-maneechot/service-exchange/libs/bus/event"
 	"github.com/pratchaya-maneechot/service-exchange/libs/bus/handler"
 	"github.com/pratchaya-maneechot/service-exchange/libs/bus/query"
 )
@@ -10,6 +11,7 @@ import (
 type Bus struct {
 	CommandBus command.CommandBus
 	QueryBus   query.QueryBus
+	EventBus   event.EventBus
 }
 
 var BusModuleSet = wire.NewSet(
@@ -17,5 +19,6 @@ var BusModuleSet = wire.NewSet(
 	query.NewQueryBus,
 	handler.NewInMemoryCommandBusHandler,
 	command.NewCommandBus,
+	event.NewEventBus,
 	wire.Struct(new(Bus), "*"),
 )
@@ -16,8 +16,18 @@ type CommandHandler[C Command, R Result] interface {
 type CommandBus interface {
 	Dispatch(ctx context.Context, cmd Command) (Result, error)
 	RegisterHandler(cmdType Command, handler any) error
+	// Use adds middlewares around every dispatch; they run in the order
+	// they were added. It is meant for startup, before any dispatch.
+	Use(mws ...Middleware)
 }
 
+// DispatchFunc runs a command through the bus to its handler.
+type DispatchFunc func(ctx context.Context, cmd Command) (Result, error)
+
+// Middleware wraps the dispatch of every command, for concerns no handler
+// should have to remember, such as validation.
+type Middleware func(next DispatchFunc) DispatchFunc
+
 type ErrNoCommandHandlerFound struct {
 	CommandType reflect.Type
 }
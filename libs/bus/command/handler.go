@@ -13,13 +13,26 @@ type CommandBusHandler interface {
 }
 
 type commandBus struct {
-	handlers CommandBusHandler
+	handlers    CommandBusHandler
+	middlewares []Middleware
+	// dispatch is the handler call wrapped in the middlewares.
+	dispatch DispatchFunc
 }
 
 func NewCommandBus(h CommandBusHandler) CommandBus {
-	return &commandBus{
+	b := &commandBus{
 		handlers: h,
 	}
+	b.dispatch = b.handle
+	return b
+}
+
+func (b *commandBus) Use(mws ...Middleware) {
+	b.middlewares = append(b.middlewares, mws...)
+	b.dispatch = b.handle
+	for i := len(b.middlewares) - 1; i >= 0; i-- {
+		b.dispatch = b.middlewares[i](b.dispatch)
+	}
 }
 
 func (b *commandBus) RegisterHandler(cmdType Command, handler any) error {
@@ -95,6 +108,10 @@ func (b *commandBus) RegisterHandler(cmdType Command, handler any) error {
 }
 
 func (b *commandBus) Dispatch(ctx context.Context, cmd Command) (Result, error) {
+	return b.dispatch(ctx, cmd)
+}
+
+func (b *commandBus) handle(ctx context.Context, cmd Command) (Result, error) {
 	cmdType := reflect.TypeOf(cmd)
 
 	handlerUntyped, ok := b.handlers.Load(cmdType)
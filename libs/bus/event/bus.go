@@ -0,0 +1,31 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+type Event any
+
+type EventHandler[E Event] interface {
+	Handle(ctx context.Context, evt E) error
+}
+
+// EventBus fans a published event out to every handler subscribed to its
+// type. Unlike commands and queries, an event may have any number of
+// subscribers, including none.
+type EventBus interface {
+	Publish(ctx context.Context, evt Event) error
+	Subscribe(evtType Event, handler any) error
+}
+
+type ErrInvalidEventHandler struct {
+	HandlerType reflect.Type
+	EventType   reflect.Type
+	Reason      string
+}
+
+func (e ErrInvalidEventHandler) Error() string {
+	return fmt.Sprintf("invalid event handler type %s for event type %s: %s", e.HandlerType.String(), e.EventType.String(), e.Reason)
+}
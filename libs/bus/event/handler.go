@@ -0,0 +1,85 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+type eventBus struct {
+	mu       sync.RWMutex
+	handlers map[reflect.Type][]reflect.Value
+}
+
+func NewEventBus() EventBus {
+	return &eventBus{
+		handlers: make(map[reflect.Type][]reflect.Value),
+	}
+}
+
+func (b *eventBus) Subscribe(evtType Event, handler any) error {
+	evtReflectType := reflect.TypeOf(evtType)
+	handlerReflectType := reflect.TypeOf(handler)
+
+	if handler == nil {
+		panic(fmt.Errorf("event handler for type %s cannot be nil", evtReflectType.String()))
+	}
+
+	handleMethod, found := handlerReflectType.MethodByName("Handle")
+	if !found {
+		panic(ErrInvalidEventHandler{
+			HandlerType: handlerReflectType,
+			EventType:   evtReflectType,
+			Reason:      "handler does not have a 'Handle' method",
+		})
+	}
+
+	ctxType := reflect.TypeOf((*context.Context)(nil)).Elem()
+	errType := reflect.TypeOf((*error)(nil)).Elem()
+	if handleMethod.Type.NumIn() != 3 ||
+		handleMethod.Type.In(1) != ctxType ||
+		handleMethod.Type.In(2) != evtReflectType {
+		panic(ErrInvalidEventHandler{
+			HandlerType: handlerReflectType,
+			EventType:   evtReflectType,
+			Reason:      fmt.Sprintf("Handle method must accept (%s, %s)", ctxType.String(), evtReflectType.String()),
+		})
+	}
+	if handleMethod.Type.NumOut() != 1 || handleMethod.Type.Out(0) != errType {
+		panic(ErrInvalidEventHandler{
+			HandlerType: handlerReflectType,
+			EventType:   evtReflectType,
+			Reason:      "Handle method must return only an error",
+		})
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[evtReflectType] = append(b.handlers[evtReflectType], reflect.ValueOf(handler).MethodByName("Handle"))
+	return nil
+}
+
+// Publish runs the subscribers synchronously in the order they subscribed.
+// Every subscriber runs even when an earlier one fails; their errors are
+// joined.
+func (b *eventBus) Publish(ctx context.Context, evt Event) error {
+	b.mu.RLock()
+	handlers := b.handlers[reflect.TypeOf(evt)]
+	b.mu.RUnlock()
+
+	args := []reflect.Value{
+		reflect.ValueOf(ctx),
+		reflect.ValueOf(evt),
+	}
+
+	var errs []error
+	for _, handle := range handlers {
+		results := handle.Call(args)
+		if !results[0].IsNil() {
+			errs = append(errs, results[0].Interface().(error))
+		}
+	}
+	return errors.Join(errs...)
+}
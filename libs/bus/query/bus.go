@@ -22,8 +22,18 @@ type QueryHandler[Q Query, R any] interface {
 type QueryBus interface {
 	Dispatch(ctx context.Context, query Query) (Result, error)
 	RegisterHandler(queryType Query, handler any) error
+	// Use adds middlewares around every dispatch; they run in the order
+	// they were added. It is meant for startup, before any dispatch.
+	Use(mws ...Middleware)
 }
 
+// DispatchFunc runs a query through the bus to its handler.
+type DispatchFunc func(ctx context.Context, query Query) (Result, error)
+
+// Middleware wraps the dispatch of every query, for concerns no handler
+// should have to remember, such as validation.
+type Middleware func(next DispatchFunc) DispatchFunc
+
 type ErrNoQueryHandlerFound struct {
 	QueryType reflect.Type
 }
@@ -0,0 +1,132 @@
+package query
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// BatchFunc loads many keys at once. Keys absent from the returned map are
+// reported to their callers as not found.
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// Coalescer merges concurrent single-key lookups that arrive within a short
+// window into one BatchFunc call, DataLoader style. A batch is flushed when the
+// window elapses or when it reaches the maximum size, whichever comes first.
+//
+// The batch belongs to no single caller, so it runs on a context of its own,
+// bounded by the timeout, that carries none of the callers' values; its span
+// starts a new trace linked to the trace of every caller. One caller going
+// away does not fail the others, and each caller still stops waiting when its
+// own context is done.
+type Coalescer[K comparable, V any] struct {
+	fetch    BatchFunc[K, V]
+	window   time.Duration
+	maxBatch int
+	timeout  time.Duration
+	tracer   trace.Tracer
+
+	mu      sync.Mutex
+	pending *batch[K, V]
+}
+
+type batch[K comparable, V any] struct {
+	keys    []K
+	seen    map[K]struct{}
+	links   []trace.Link
+	timer   *time.Timer
+	done    chan struct{}
+	results map[K]V
+	err     error
+}
+
+// NewCoalescer creates a Coalescer whose batches are cancelled after timeout.
+// A non-positive maxBatch means unbounded.
+func NewCoalescer[K comparable, V any](fetch BatchFunc[K, V], window time.Duration, maxBatch int, timeout time.Duration) *Coalescer[K, V] {
+	return &Coalescer[K, V]{
+		fetch:    fetch,
+		window:   window,
+		maxBatch: maxBatch,
+		timeout:  timeout,
+		tracer:   otel.Tracer("bus.query-coalescer"),
+	}
+}
+
+// Load returns the value for key, sharing the round-trip with any other keys
+// requested during the same window. found is false when the batch did not
+// return the key.
+func (c *Coalescer[K, V]) Load(ctx context.Context, key K) (value V, found bool, err error) {
+	c.mu.Lock()
+	b := c.pending
+	if b == nil {
+		b = &batch[K, V]{
+			seen: make(map[K]struct{}),
+			done: make(chan struct{}),
+		}
+		c.pending = b
+		b.timer = time.AfterFunc(c.window, func() { c.flush(b) })
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		b.links = append(b.links, trace.Link{SpanContext: sc})
+	}
+	if _, ok := b.seen[key]; !ok {
+		b.seen[key] = struct{}{}
+		b.keys = append(b.keys, key)
+	}
+	if c.maxBatch > 0 && len(b.keys) >= c.maxBatch && b.timer.Stop() {
+		c.pending = nil
+		c.mu.Unlock()
+		go c.run(b)
+	} else {
+		c.mu.Unlock()
+	}
+
+	select {
+	case <-b.done:
+		if b.err != nil {
+			return value, false, b.err
+		}
+		value, found = b.results[key]
+		return value, found, nil
+	case <-ctx.Done():
+		return value, false, ctx.Err()
+	}
+}
+
+func (c *Coalescer[K, V]) flush(b *batch[K, V]) {
+	c.mu.Lock()
+	if c.pending == b {
+		c.pending = nil
+	}
+	c.mu.Unlock()
+	c.run(b)
+}
+
+// run is only called once b is no longer pending, so its keys and links are
+// final.
+func (c *Coalescer[K, V]) run(b *batch[K, V]) {
+	defer close(b.done)
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	ctx, span := c.tracer.Start(ctx, "Coalescer.run",
+		trace.WithNewRoot(),
+		trace.WithLinks(b.links...),
+		trace.WithSpanKind(trace.SpanKindInternal),
+	)
+	defer span.End()
+	span.SetAttributes(attribute.Int("batch.size", len(b.keys)))
+
+	b.results, b.err = c.fetch(ctx, b.keys)
+	if b.err != nil {
+		span.SetStatus(codes.Error, "Batch failed")
+		span.RecordError(b.err)
+		return
+	}
+	span.SetStatus(codes.Ok, "Batch loaded")
+}
@@ -7,13 +7,26 @@ import (
 )
 
 type queryBus struct {
-	handlers QueryBusHandler
+	handlers    QueryBusHandler
+	middlewares []Middleware
+	// dispatch is the handler call wrapped in the middlewares.
+	dispatch DispatchFunc
 }
 
 func NewQueryBus(h QueryBusHandler) QueryBus {
-	return &queryBus{
+	b := &queryBus{
 		handlers: h,
 	}
+	b.dispatch = b.handle
+	return b
+}
+
+func (b *queryBus) Use(mws ...Middleware) {
+	b.middlewares = append(b.middlewares, mws...)
+	b.dispatch = b.handle
+	for i := len(b.middlewares) - 1; i >= 0; i-- {
+		b.dispatch = b.middlewares[i](b.dispatch)
+	}
 }
 
 func (b *queryBus) RegisterHandler(queryType Query, handler any) error {
@@ -88,6 +101,10 @@ func (b *queryBus) RegisterHandler(queryType Query, handler any) error {
 }
 
 func (b *queryBus) Dispatch(ctx context.Context, query Query) (Result, error) {
+	return b.dispatch(ctx, query)
+}
+
+func (b *queryBus) handle(ctx context.Context, query Query) (Result, error) {
 	queryType := reflect.TypeOf(query)
 
 	handlerUntyped, ok := b.handlers.Load(queryType)
@@ -9,4 +9,73 @@ const (
 	CodeInvalidArgument Code = "INVALID_ARGUMENT"
 	CodeUnauthorized    Code = "UNAUTHORIZED"
 	CodeForbidden       Code = "FORBIDDEN"
+	// CodeConflict is a request that clashes with the current state of
+	// another resource, such as a blackout over a booked task.
+	CodeConflict Code = "CONFLICT"
+	// CodeFailedPrecondition is a request the resource is not in a state to
+	// accept, such as replying to a closed ticket.
+	CodeFailedPrecondition Code = "FAILED_PRECONDITION"
+	// CodeResourceExhausted is a request over a quota or rate limit.
+	CodeResourceExhausted Code = "RESOURCE_EXHAUSTED"
+	// CodeUnavailable is a dependency that cannot be reached right now.
+	CodeUnavailable Code = "UNAVAILABLE"
+	// CodeDeadlineExceeded is work that did not finish in time.
+	CodeDeadlineExceeded Code = "DEADLINE_EXCEEDED"
+	// CodeAborted is a request that lost a race with a concurrent change,
+	// such as an optimistic version check.
+	CodeAborted Code = "ABORTED"
+	// CodeUnimplemented is an operation the service does not support.
+	CodeUnimplemented Code = "UNIMPLEMENTED"
 )
+
+// IsRetryable reports whether sending the same request again may succeed
+// without the caller changing anything.
+func (c Code) IsRetryable() bool {
+	switch c {
+	case CodeUnavailable, CodeDeadlineExceeded, CodeAborted, CodeResourceExhausted:
+		return true
+	}
+	return false
+}
+
+// IsClientError reports whether the request itself was at fault, as
+// opposed to the service or one of its dependencies.
+func (c Code) IsClientError() bool {
+	switch c {
+	case CodeNotFound, CodeAlreadyExists, CodeInvalidArgument, CodeUnauthorized, CodeForbidden,
+		CodeConflict, CodeFailedPrecondition, CodeResourceExhausted, CodeAborted:
+		return true
+	}
+	return false
+}
+
+// IsRetryable reports whether err is an application error whose code is
+// retryable. Other errors are not.
+func IsRetryable(err error) bool {
+	if code, ok := GetErrorInternalCode(err); ok {
+		return code.IsRetryable()
+	}
+	return false
+}
+
+// IsClientError reports whether err is an application error caused by the
+// request. Other errors are not.
+func IsClientError(err error) bool {
+	if code, ok := GetErrorInternalCode(err); ok {
+		return code.IsClientError()
+	}
+	return false
+}
+
+// HasCode reports whether err is an application error with the given code.
+func HasCode(err error, code Code) bool {
+	c, ok := GetErrorInternalCode(err)
+	return ok && c == code
+}
+
+// HasReason reports whether err is an application error with the given
+// reason, such as USER_NOT_FOUND.
+func HasReason(err error, reason string) bool {
+	de, ok := AsErrorInternal(err)
+	return ok && de.Reason == reason
+}
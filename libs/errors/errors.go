@@ -2,26 +2,132 @@ package errs
 
 import (
 	"errors"
+	"maps"
+	"time"
 )
 
 var (
-	ErrNotFound        = New(CodeNotFound, "not found")
-	ErrAlreadyExists   = New(CodeAlreadyExists, "already exists")
-	ErrInternal        = New(CodeInternal, "internal server error")
-	ErrUnauthorized    = New(CodeUnauthorized, "unauthorized")
-	ErrForbidden       = New(CodeForbidden, "forbidden")
-	ErrInvalidArgument = New(CodeInvalidArgument, "invalid argument")
+	ErrNotFound           = New(CodeNotFound, "not found")
+	ErrAlreadyExists      = New(CodeAlreadyExists, "already exists")
+	ErrInternal           = New(CodeInternal, "internal server error")
+	ErrUnauthorized       = New(CodeUnauthorized, "unauthorized")
+	ErrForbidden          = New(CodeForbidden, "forbidden")
+	ErrInvalidArgument    = New(CodeInvalidArgument, "invalid argument")
+	ErrConflict           = New(CodeConflict, "conflict")
+	ErrFailedPrecondition = New(CodeFailedPrecondition, "failed precondition")
+	ErrResourceExhausted  = New(CodeResourceExhausted, "resource exhausted")
+	ErrUnavailable        = New(CodeUnavailable, "service unavailable")
+	ErrDeadlineExceeded   = New(CodeDeadlineExceeded, "deadline exceeded")
+	ErrAborted            = New(CodeAborted, "aborted")
+	ErrUnimplemented      = New(CodeUnimplemented, "not implemented")
 )
 
+// Resource identifies what an error is about, such as the task that was not
+// found.
+type Resource struct {
+	Type string
+	ID   string
+}
+
+// ErrorInternal is an error the application reports on purpose. Code and
+// Message are safe to show to clients; the cause is only for logs.
+//
+// Errors are usually declared once as package variables and returned as
+// they are. The With methods return a copy carrying more detail, which
+// still matches the original with errors.Is.
 type ErrorInternal struct {
-	Code    Code
+	Code Code
+	// Reason tells clients which error this is, such as USER_NOT_FOUND. It
+	// never changes once released, unlike Message; it is empty for errors
+	// only told apart by their code.
+	Reason  string
 	Message string
+	// Field is the request field the error is about, if any.
+	Field string
+	// Resource is the resource the error is about, if any.
+	Resource *Resource
+	// RetryAfter is how long the client should wait before retrying; zero
+	// when retrying the same request will not help.
+	RetryAfter time.Duration
+	// Metadata holds further facts about the error for clients, such as the
+	// limit that was exceeded.
+	Metadata map[string]string
+
+	cause  error
+	origin *ErrorInternal
 }
 
 func (e *ErrorInternal) Error() string {
+	if e.cause != nil {
+		return e.Message + ": " + e.cause.Error()
+	}
 	return e.Message
 }
 
+func (e *ErrorInternal) Unwrap() error {
+	return e.cause
+}
+
+// Is reports whether target is e or the error e was derived from with one
+// of the With methods.
+func (e *ErrorInternal) Is(target error) bool {
+	t, ok := target.(*ErrorInternal)
+	return ok && t.root() == e.root()
+}
+
+func (e *ErrorInternal) root() *ErrorInternal {
+	if e.origin != nil {
+		return e.origin
+	}
+	return e
+}
+
+func (e *ErrorInternal) clone() *ErrorInternal {
+	c := *e
+	c.origin = e.root()
+	c.Metadata = maps.Clone(e.Metadata)
+	return &c
+}
+
+// WithCause returns a copy of e wrapping cause.
+func (e *ErrorInternal) WithCause(cause error) *ErrorInternal {
+	c := e.clone()
+	c.cause = cause
+	return c
+}
+
+// WithField returns a copy of e about the request field.
+func (e *ErrorInternal) WithField(field string) *ErrorInternal {
+	c := e.clone()
+	c.Field = field
+	return c
+}
+
+// WithResource returns a copy of e about the resource of the given type
+// and ID.
+func (e *ErrorInternal) WithResource(resourceType, id string) *ErrorInternal {
+	c := e.clone()
+	c.Resource = &Resource{Type: resourceType, ID: id}
+	return c
+}
+
+// WithRetryAfter returns a copy of e telling the client to retry after d.
+func (e *ErrorInternal) WithRetryAfter(d time.Duration) *ErrorInternal {
+	c := e.clone()
+	c.RetryAfter = d
+	return c
+}
+
+// WithMetadata returns a copy of e with key set to value in its metadata.
+func (e *ErrorInternal) WithMetadata(key, value string) *ErrorInternal {
+	c := e.clone()
+	if c.Metadata == nil {
+		c.Metadata = make(map[string]string, 1)
+	}
+	c.Metadata[key] = value
+	return c
+}
+
 func New(code Code, msg string) *ErrorInternal {
 	return &ErrorInternal{
 		Code:    code,
@@ -29,14 +135,42 @@ func New(code Code, msg string) *ErrorInternal {
 	}
 }
 
+// NewWithReason is New for domain errors clients need to tell apart from
+// others with the same code. The reason is the package followed by the
+// error, in upper snake case, such as USER_LINE_ID_EXISTS.
+func NewWithReason(code Code, reason, msg string) *ErrorInternal {
+	return &ErrorInternal{
+		Code:    code,
+		Reason:  reason,
+		Message: msg,
+	}
+}
+
+// Wrap reports cause to clients as code and msg.
+func Wrap(cause error, code Code, msg string) *ErrorInternal {
+	return &ErrorInternal{
+		Code:    code,
+		Message: msg,
+		cause:   cause,
+	}
+}
+
 func IsErrorInternal(err error) bool {
 	var de *ErrorInternal
 	return errors.As(err, &de)
 }
 
-func GetErrorInternalCode(err error) (Code, bool) {
+// AsErrorInternal finds the first ErrorInternal in err's chain.
+func AsErrorInternal(err error) (*ErrorInternal, bool) {
 	var de *ErrorInternal
 	if errors.As(err, &de) {
+		return de, true
+	}
+	return nil, false
+}
+
+func GetErrorInternalCode(err error) (Code, bool) {
+	if de, ok := AsErrorInternal(err); ok {
 		return de.Code, true
 	}
 	return "", false
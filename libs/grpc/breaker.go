@@ -0,0 +1,177 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReasonCircuitOpen is the ErrorInfo reason of calls failed fast because
+// the service called kept failing.
+const ReasonCircuitOpen = "CIRCUIT_OPEN"
+
+// ConfigBreaker configures the circuit breaker of a target.
+type ConfigBreaker struct {
+	// FailureThreshold is how many calls in a row must fail for the breaker
+	// to open; zero turns it off.
+	FailureThreshold int
+	// OpenTimeout is how long an open breaker fails calls fast before it
+	// lets one through to find out whether the service is back.
+	OpenTimeout time.Duration
+}
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	// breakerHalfOpen lets a single call through; how it ends closes or
+	// opens the breaker again.
+	breakerHalfOpen
+)
+
+// breaker counts the unary calls to a target that fail in a way that says
+// the service is down or overloaded, and fails calls fast while it is open,
+// rather than piling more of them onto the service.
+type breaker struct {
+	target   string
+	cfg      ConfigBreaker
+	recorder observability.MetricsRecorder
+	logger   *slog.Logger
+
+	mu        sync.Mutex
+	state     breakerState
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func newBreaker(target string, cfg ConfigBreaker, recorder observability.MetricsRecorder, logger *slog.Logger) *breaker {
+	return &breaker{target: target, cfg: cfg, recorder: recorder, logger: logger}
+}
+
+// allow reports whether a call may go ahead, and whether it is the probe of
+// a half-open breaker. When it may not, it returns how long the breaker
+// stays open.
+func (b *breaker) allow() (probe bool, wait time.Duration, ok bool) {
+	if b.cfg.FailureThreshold <= 0 {
+		return false, 0, true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case breakerOpen:
+		if wait := time.Until(b.openUntil); wait > 0 {
+			return false, wait, false
+		}
+		b.state = breakerHalfOpen
+		b.probing = true
+		return true, 0, true
+	case breakerHalfOpen:
+		if b.probing {
+			return false, 0, false
+		}
+		b.probing = true
+		return true, 0, true
+	default:
+		return false, 0, true
+	}
+}
+
+// done records how an allowed call ended.
+func (b *breaker) done(probe bool, err error) {
+	if b.cfg.FailureThreshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if probe {
+		b.probing = false
+	} else if b.state != breakerClosed {
+		// A call let through before the breaker opened says nothing about
+		// the service now.
+		return
+	}
+
+	switch code := status.Code(err); {
+	case code == codes.Canceled:
+		// The caller gave up; the service may be fine.
+	case breakerFailure(code):
+		b.failures++
+		if b.state == breakerHalfOpen || b.failures >= b.cfg.FailureThreshold {
+			b.openLocked()
+		}
+	default:
+		if b.state != breakerClosed {
+			b.logger.Info("circuit breaker closed", "target", b.target)
+		}
+		b.state = breakerClosed
+		b.failures = 0
+	}
+}
+
+func (b *breaker) openLocked() {
+	b.state = breakerOpen
+	b.openUntil = time.Now().Add(b.cfg.OpenTimeout)
+	b.logger.Warn("circuit breaker opened", "target", b.target, "failures", b.failures, "open_for", b.cfg.OpenTimeout)
+	if b.recorder != nil {
+		b.recorder.RecordGrpcClientCircuitOpen(b.target)
+	}
+}
+
+// breakerFailure reports whether a call failing with code says the service
+// is unwell; errors about the request itself, such as NOT_FOUND, do not.
+func breakerFailure(code codes.Code) bool {
+	switch code {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown:
+		return true
+	}
+	return false
+}
+
+func (b *breaker) openError(ctx context.Context, wait time.Duration) error {
+	return NewGRPCErrCode(ctx, errs.NewWithReason(errs.CodeUnavailable, ReasonCircuitOpen,
+		fmt.Sprintf("%s is unavailable after too many failed calls, retry later", b.target)).WithRetryAfter(wait))
+}
+
+func (b *breaker) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		probe, wait, ok := b.allow()
+		if !ok {
+			return b.openError(ctx, wait)
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.done(probe, err)
+		return err
+	}
+}
+
+// streamInterceptor fails streams fast while the breaker is open. Streams
+// do not count towards it: opening one says little about the service, and
+// they end in their own time.
+func (b *breaker) streamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if wait, open := b.isOpen(); open {
+			return nil, b.openError(ctx, wait)
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+func (b *breaker) isOpen() (time.Duration, bool) {
+	if b.cfg.FailureThreshold <= 0 {
+		return 0, false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	wait := time.Until(b.openUntil)
+	return wait, b.state == breakerOpen && wait > 0
+}
@@ -0,0 +1,265 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MetadataAuthorization is the metadata key of the caller's bearer token,
+// which calls to other services pass on.
+const MetadataAuthorization = "authorization"
+
+// propagatedMetadata are the incoming metadata keys calls to other services
+// pass on as they are, so they are made in the same language with the same
+// bearer token. The caller's identity is passed on too; see
+// UnaryClientPropagationInterceptor.
+var propagatedMetadata = []string{
+	MetadataUserLocale,
+	MetadataAcceptLanguage,
+	MetadataAuthorization,
+}
+
+// ConfigClient configures a connection to another service.
+type ConfigClient struct {
+	// Target is the service to dial, such as dns:///users:50051.
+	Target string
+	// TLS dials over TLS, presenting a client certificate for mutual TLS
+	// when it has one; nil dials plaintext.
+	TLS *ConfigTLS
+	// Timeout is the deadline of unary calls made without one; zero leaves
+	// them without. Streams never get one, as they may stay open for long.
+	Timeout time.Duration
+	Retry   RetryPolicy
+	Breaker ConfigBreaker
+	// Options are added after the factory's own, such as
+	// grpc.WithContextDialer in tests.
+	Options []grpc.DialOption
+}
+
+// DefaultConfigClient is the configuration calls between services use
+// unless they have a reason not to.
+func DefaultConfigClient(target string) ConfigClient {
+	return ConfigClient{
+		Target:  target,
+		Timeout: 5 * time.Second,
+		Retry: RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: 100 * time.Millisecond,
+			MaxBackoff:     2 * time.Second,
+		},
+		Breaker: ConfigBreaker{
+			FailureThreshold: 5,
+			OpenTimeout:      10 * time.Second,
+		},
+	}
+}
+
+// ConfigTarget is the section of a service's configuration naming another
+// service it calls.
+type ConfigTarget struct {
+	// Target is the service to dial, such as dns:///tasks-service:50052.
+	Target string `mapstructure:"target" validate:"required"`
+	// Timeout replaces the deadline of DefaultConfigClient when set.
+	Timeout time.Duration `mapstructure:"timeout" validate:"gte=0"`
+}
+
+// Client returns DefaultConfigClient of the target. When security serves
+// over TLS, calls are made over it too: the service presents its own
+// certificate, and the other service's must chain to the client CA file.
+func (c ConfigTarget) Client(security ConfigSecurity) ConfigClient {
+	cfg := DefaultConfigClient(c.Target)
+	if c.Timeout > 0 {
+		cfg.Timeout = c.Timeout
+	}
+	if security.EnableTLS {
+		cfg.TLS = &ConfigTLS{
+			CertFile: security.TLSCertFile,
+			KeyFile:  security.TLSKeyFile,
+			CAFile:   security.TLSClientCAFile,
+		}
+	}
+	return cfg
+}
+
+// ClientFactory dials other services. Connections to the same target share
+// a circuit breaker, so once a service keeps failing none of them call it
+// until it has had time to recover.
+type ClientFactory struct {
+	logger   *slog.Logger
+	recorder observability.MetricsRecorder
+	identity IdentityKeys
+
+	mu       sync.Mutex
+	breakers map[string]*breaker
+}
+
+// NewClientFactory returns a factory recording call metrics to recorder,
+// which may be nil, and signing the identity of callers with identity.
+func NewClientFactory(logger *slog.Logger, recorder observability.MetricsRecorder, identity IdentityKeys) *ClientFactory {
+	return &ClientFactory{
+		logger:   logger,
+		recorder: recorder,
+		identity: identity,
+		breakers: make(map[string]*breaker),
+	}
+}
+
+// NewClient returns a connection to cfg.Target. It connects on the first
+// call rather than right away, so a service can start before the ones it
+// calls. When cfg.TLS is set, the certificate files are watched until ctx
+// is done.
+//
+// Unary calls get cfg.Timeout as their deadline when they have none, pass
+// on the caller's identity, and are retried under cfg.Retry and failed fast
+// while the target's breaker is open. Every attempt is traced and recorded.
+func (f *ClientFactory) NewClient(ctx context.Context, cfg ConfigClient) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if cfg.TLS != nil {
+		var err error
+		if creds, err = NewClientCredentials(ctx, *cfg.TLS, f.logger); err != nil {
+			return nil, fmt.Errorf("failed to configure client TLS for %s: %w", cfg.Target, err)
+		}
+	}
+
+	b := f.breaker(cfg.Target, cfg.Breaker)
+	opts := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(
+			UnaryClientTimeoutInterceptor(cfg.Timeout),
+			UnaryClientPropagationInterceptor(f.identity),
+			unaryClientRetryInterceptor(cfg.Target, cfg.Retry, f.recorder),
+			unaryClientMetricsInterceptor(cfg.Target, f.recorder),
+			b.unaryInterceptor(),
+		),
+		grpc.WithChainStreamInterceptor(
+			StreamClientPropagationInterceptor(f.identity),
+			streamClientMetricsInterceptor(cfg.Target, f.recorder),
+			b.streamInterceptor(),
+		),
+	}, cfg.Options...)
+
+	conn, err := grpc.NewClient(cfg.Target, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client for %s: %w", cfg.Target, err)
+	}
+	return conn, nil
+}
+
+// breaker returns the breaker of target, made with cfg by the first
+// connection to it.
+func (f *ClientFactory) breaker(target string, cfg ConfigBreaker) *breaker {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	b, ok := f.breakers[target]
+	if !ok {
+		b = newBreaker(target, cfg, f.recorder, f.logger)
+		f.breakers[target] = b
+	}
+	return b
+}
+
+// UnaryClientTimeoutInterceptor gives calls made without a deadline one of
+// timeout; zero leaves them without.
+func UnaryClientTimeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok && timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// UnaryClientPropagationInterceptor passes the caller's identity, language
+// and bearer token on to the service called, unless the call sets them
+// itself. A caller on the context gets a fresh identity token signed with
+// keys, so background jobs can call on behalf of a user with
+// ContextWithCaller; otherwise the incoming token is passed on as it is.
+func UnaryClientPropagationInterceptor(keys IdentityKeys) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(propagateMetadata(ctx, keys), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientPropagationInterceptor is UnaryClientPropagationInterceptor
+// for streams.
+func StreamClientPropagationInterceptor(keys IdentityKeys) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(propagateMetadata(ctx, keys), desc, cc, method, opts...)
+	}
+}
+
+func propagateMetadata(ctx context.Context, keys IdentityKeys) context.Context {
+	out, _ := metadata.FromOutgoingContext(ctx)
+	in, _ := metadata.FromIncomingContext(ctx)
+
+	var pairs []string
+	if len(out.Get(MetadataUserIdentity)) == 0 {
+		token := ""
+		if caller, ok := CallerFromCtx(ctx); ok && len(keys.Current) > 0 {
+			token, _ = keys.Sign(caller)
+		}
+		if token == "" {
+			if tokens := in.Get(MetadataUserIdentity); len(tokens) > 0 {
+				token = tokens[0]
+			}
+		}
+		if token != "" {
+			pairs = append(pairs, MetadataUserIdentity, token)
+		}
+	}
+	for _, key := range propagatedMetadata {
+		if len(out.Get(key)) > 0 {
+			continue
+		}
+		for _, v := range in.Get(key) {
+			pairs = append(pairs, key, v)
+		}
+	}
+	if len(pairs) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
+
+// unaryClientMetricsInterceptor records every attempt of a call, so the
+// breaker's fast failures show up as UNAVAILABLE ones.
+func unaryClientMetricsInterceptor(target string, recorder observability.MetricsRecorder) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if recorder == nil {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		recorder.RecordGrpcClientRequestTotal(target, method, status.Code(err).String())
+		recorder.RecordGrpcClientRequestDuration(target, method, time.Since(start).Seconds())
+		return err
+	}
+}
+
+// streamClientMetricsInterceptor records whether streams could be opened;
+// how they end is up to the code reading them.
+func streamClientMetricsInterceptor(target string, recorder observability.MetricsRecorder) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if recorder == nil {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+		start := time.Now()
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		recorder.RecordGrpcClientRequestTotal(target, method, status.Code(err).String())
+		recorder.RecordGrpcClientRequestDuration(target, method, time.Since(start).Seconds())
+		return cs, err
+	}
+}
@@ -4,17 +4,95 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 
 	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
-func NewGRPCErrCode(err error) error {
+// ErrorDomain is the ErrorInfo domain of every error the services return.
+const ErrorDomain = "service-exchange"
+
+// codeMetadataKey is the ErrorInfo metadata entry carrying the application
+// error code, since the reason is the more specific domain reason when the
+// error has one.
+const codeMetadataKey = "code"
+
+var codeToGRPC = map[errs.Code]codes.Code{
+	errs.CodeInternal:        codes.Internal,
+	errs.CodeNotFound:        codes.NotFound,
+	errs.CodeAlreadyExists:   codes.AlreadyExists,
+	errs.CodeInvalidArgument: codes.InvalidArgument,
+	errs.CodeUnauthorized:    codes.Unauthenticated,
+	errs.CodeForbidden:       codes.PermissionDenied,
+	// gRPC has no conflict code; Aborted is what HTTP gateways send as 409.
+	errs.CodeConflict:           codes.Aborted,
+	errs.CodeFailedPrecondition: codes.FailedPrecondition,
+	errs.CodeResourceExhausted:  codes.ResourceExhausted,
+	errs.CodeUnavailable:        codes.Unavailable,
+	errs.CodeDeadlineExceeded:   codes.DeadlineExceeded,
+	errs.CodeAborted:            codes.Aborted,
+	errs.CodeUnimplemented:      codes.Unimplemented,
+}
+
+var grpcToCode = map[codes.Code]errs.Code{
+	codes.Internal:           errs.CodeInternal,
+	codes.Unknown:            errs.CodeInternal,
+	codes.DataLoss:           errs.CodeInternal,
+	codes.Unimplemented:      errs.CodeUnimplemented,
+	codes.NotFound:           errs.CodeNotFound,
+	codes.AlreadyExists:      errs.CodeAlreadyExists,
+	codes.InvalidArgument:    errs.CodeInvalidArgument,
+	codes.OutOfRange:         errs.CodeInvalidArgument,
+	codes.FailedPrecondition: errs.CodeFailedPrecondition,
+	codes.Aborted:            errs.CodeAborted,
+	codes.ResourceExhausted:  errs.CodeResourceExhausted,
+	codes.Unavailable:        errs.CodeUnavailable,
+	codes.DeadlineExceeded:   errs.CodeDeadlineExceeded,
+	codes.Unauthenticated:    errs.CodeUnauthorized,
+	codes.PermissionDenied:   errs.CodeForbidden,
+}
+
+// GRPCCode is the gRPC status code an application error code is sent as.
+func GRPCCode(code errs.Code) codes.Code {
+	if c, ok := codeToGRPC[code]; ok {
+		return c
+	}
+	return codes.Internal
+}
+
+// CodeFromGRPC is the application error code a gRPC status code received
+// from another service stands for.
+func CodeFromGRPC(code codes.Code) errs.Code {
+	if c, ok := grpcToCode[code]; ok {
+		return c
+	}
+	return errs.CodeInternal
+}
+
+// NewGRPCErrCode turns an error returned by a command or query handler into
+// a gRPC status. Validation errors keep their field violations. Application
+// errors keep their message and carry their details as ErrorInfo,
+// ResourceInfo, RetryInfo and BadRequest; their cause and any other error
+// are not shown to the client, but logged with the logger of ctx, so they
+// can be found by the trace of the call.
+func NewGRPCErrCode(ctx context.Context, err error) error {
 	if err == nil {
 		return nil
 	}
 
+	// Rejected by the bus validation middleware; the locale interceptor
+	// renders it.
+	var ve *validationError
+	if errors.As(err, &ve) {
+		return ve
+	}
+
 	if errors.Is(err, context.Canceled) {
 		return status.Errorf(codes.Canceled, "request was cancelled: %s", err.Error())
 	}
@@ -22,24 +100,102 @@ func NewGRPCErrCode(err error) error {
 		return status.Errorf(codes.DeadlineExceeded, "request timed out: %s", err.Error())
 	}
 
-	if domainErrorCode, ok := errs.GetErrorInternalCode(err); ok {
-		switch domainErrorCode {
-		case errs.CodeAlreadyExists:
-			return status.Errorf(codes.AlreadyExists, "%s", err.Error())
-
-		case errs.CodeNotFound:
-			return status.Errorf(codes.NotFound, "%s", err.Error())
-
-		// INVALID_ARGUMENT
-		case errs.CodeInvalidArgument:
-			return status.Errorf(codes.InvalidArgument, "%s", err.Error())
-
-		default:
-			fmt.Printf("Unhandled domain error code: %s - %s\n", domainErrorCode, err.Error())
+	if de, ok := errs.AsErrorInternal(err); ok {
+		code, known := codeToGRPC[de.Code]
+		if !known {
+			observability.LoggerFromCtx(ctx).Error("unmapped application error code", "code", de.Code, "error", err)
 			return status.Errorf(codes.Internal, "internal server error: an unmapped domain error occurred")
 		}
+		if code == codes.Internal && errors.Unwrap(de) != nil {
+			observability.LoggerFromCtx(ctx).Error("internal error", "error", err)
+		}
+		return withDetails(status.New(code, de.Message), de).Err()
 	}
 
-	fmt.Printf("Unhandled error type: %T - %v\n", err, err)
+	observability.LoggerFromCtx(ctx).Error("unexpected error", "type", fmt.Sprintf("%T", err), "error", err)
 	return status.Errorf(codes.Internal, "internal server error: an unexpected error occurred")
 }
+
+func withDetails(st *status.Status, de *errs.ErrorInternal) *status.Status {
+	reason := de.Reason
+	if reason == "" {
+		reason = string(de.Code)
+	}
+	metadata := maps.Clone(de.Metadata)
+	if metadata == nil {
+		metadata = make(map[string]string, 1)
+	}
+	metadata[codeMetadataKey] = string(de.Code)
+	details := []protoadapt.MessageV1{
+		&epb.ErrorInfo{
+			Reason:   reason,
+			Domain:   ErrorDomain,
+			Metadata: metadata,
+		},
+	}
+	if de.Resource != nil {
+		details = append(details, &epb.ResourceInfo{
+			ResourceType: de.Resource.Type,
+			ResourceName: de.Resource.ID,
+			Description:  de.Message,
+		})
+	}
+	if de.RetryAfter > 0 {
+		details = append(details, &epb.RetryInfo{RetryDelay: durationpb.New(de.RetryAfter)})
+	}
+	if de.Field != "" {
+		details = append(details, &epb.BadRequest{
+			FieldViolations: []*epb.BadRequest_FieldViolation{{Field: de.Field, Description: de.Message}},
+		})
+	}
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
+// ErrorFromGRPC turns an error returned by a call to another service back
+// into an application error, restoring the details NewGRPCErrCode attached.
+// Errors that are not gRPC statuses are returned as they are.
+func ErrorFromGRPC(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() == codes.OK {
+		return err
+	}
+	if st.Code() == codes.Canceled {
+		return fmt.Errorf("%w: %s", context.Canceled, st.Message())
+	}
+
+	de := errs.Wrap(err, CodeFromGRPC(st.Code()), st.Message())
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *epb.ErrorInfo:
+			de.Metadata = maps.Clone(d.GetMetadata())
+			if d.GetDomain() != ErrorDomain {
+				break
+			}
+			if code, ok := de.Metadata[codeMetadataKey]; ok {
+				de.Code = errs.Code(code)
+				delete(de.Metadata, codeMetadataKey)
+				if d.GetReason() != code {
+					de.Reason = d.GetReason()
+				}
+			} else if d.GetReason() != "" {
+				de.Code = errs.Code(d.GetReason())
+			}
+			if len(de.Metadata) == 0 {
+				de.Metadata = nil
+			}
+		case *epb.ResourceInfo:
+			de.Resource = &errs.Resource{Type: d.GetResourceType(), ID: d.GetResourceName()}
+		case *epb.RetryInfo:
+			de.RetryAfter = d.GetRetryDelay().AsDuration()
+		case *epb.BadRequest:
+			if v := d.GetFieldViolations(); len(v) == 1 {
+				de.Field = v[0].GetField()
+			}
+		}
+	}
+	return de
+}
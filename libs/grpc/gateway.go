@@ -0,0 +1,379 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// GatewayOpenAPIPath is where a gateway serves its OpenAPI document.
+const GatewayOpenAPIPath = "/openapi.yaml"
+
+// statusClientClosedRequest is the status nginx made common for requests
+// the client gave up on; net/http has no name for it.
+const statusClientClosedRequest = 499
+
+// ConfigGateway configures a GatewayServer, which serves gRPC services as
+// HTTP/JSON by forwarding every request to a gRPC server.
+type ConfigGateway struct {
+	// Address is where the gateway listens, such as :8081.
+	Address string
+	// Endpoint is the gRPC server requests are forwarded to, normally the
+	// one in the same process, so they go through all of its interceptors.
+	Endpoint string
+	// TLS dials Endpoint over TLS; nil dials plaintext.
+	TLS *ConfigTLS
+	// AllowedOrigins are the origins browsers may call the gateway from;
+	// "*" allows any. Empty turns CORS off.
+	AllowedOrigins []string
+	// OpenAPI is the document served at GatewayOpenAPIPath; nil serves none.
+	OpenAPI []byte
+	// MaxRequestSize caps request bodies in bytes; zero leaves them
+	// uncapped.
+	MaxRequestSize int64
+	// ReadTimeout bounds reading a request. There is no write timeout, as
+	// server streams stay open for as long as the gRPC server allows.
+	ReadTimeout     time.Duration
+	ShutdownTimeout time.Duration
+}
+
+// GatewayHandler registers the routes of a service on the gateway, such as
+// the generated RegisterUserServiceHandler.
+type GatewayHandler func(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error
+
+type GatewayServer struct {
+	server          *http.Server
+	conn            *grpc.ClientConn
+	logger          *slog.Logger
+	shutdownTimeout time.Duration
+}
+
+// NewGatewayServer dials the gRPC server and registers the handlers' routes.
+// When cfg.TLS is set, the certificate files are watched until ctx is done.
+func NewGatewayServer(ctx context.Context, cfg ConfigGateway, logger *slog.Logger, handlers ...GatewayHandler) (*GatewayServer, error) {
+	creds := insecure.NewCredentials()
+	if cfg.TLS != nil {
+		var err error
+		if creds, err = NewClientCredentials(ctx, *cfg.TLS, logger); err != nil {
+			return nil, fmt.Errorf("failed to configure gateway TLS: %w", err)
+		}
+	}
+	conn, err := grpc.NewClient(cfg.Endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gateway client for %s: %w", cfg.Endpoint, err)
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher()),
+		// Response metadata is for gRPC clients; none of it is sent as
+		// headers.
+		runtime.WithOutgoingHeaderMatcher(func(string) (string, bool) { return "", false }),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, gatewayMarshaler{Marshaler: &runtime.HTTPBodyMarshaler{
+			Marshaler: &runtime.JSONPb{
+				MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+				UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+			},
+		}}),
+		runtime.WithErrorHandler(gatewayErrorHandler),
+		runtime.WithRoutingErrorHandler(gatewayRoutingErrorHandler),
+	)
+	for _, register := range handlers {
+		if err := register(ctx, mux, conn); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to register gateway handler: %w", err)
+		}
+	}
+	if cfg.OpenAPI != nil {
+		err := mux.HandlePath(http.MethodGet, GatewayOpenAPIPath, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			w.Header().Set("Content-Type", "application/yaml")
+			w.Write(cfg.OpenAPI)
+		})
+		if err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to serve the OpenAPI document: %w", err)
+		}
+	}
+
+	var handler http.Handler = mux
+	if cfg.MaxRequestSize > 0 {
+		handler = maxRequestSize(handler, cfg.MaxRequestSize)
+	}
+	handler = cors(handler, cfg.AllowedOrigins)
+
+	logger.Info("HTTP gateway configured", "endpoint", cfg.Endpoint, "cors", len(cfg.AllowedOrigins) > 0)
+	return &GatewayServer{
+		server: &http.Server{
+			Addr:              cfg.Address,
+			Handler:           handler,
+			ReadHeaderTimeout: cfg.ReadTimeout,
+			ReadTimeout:       cfg.ReadTimeout,
+		},
+		conn:            conn,
+		logger:          logger,
+		shutdownTimeout: cfg.ShutdownTimeout,
+	}, nil
+}
+
+// Start serves until ctx is done, then shuts down gracefully.
+func (s *GatewayServer) Start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.server.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.server.Addr, err)
+	}
+
+	go s.handleShutdown(ctx)
+
+	s.logger.Info("HTTP gateway starting", "address", s.server.Addr)
+	if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve HTTP gateway: %w", err)
+	}
+	return nil
+}
+
+func (s *GatewayServer) handleShutdown(ctx context.Context) {
+	<-ctx.Done()
+	defer s.conn.Close()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+	if err := s.server.Shutdown(shutdownCtx); err != nil {
+		// Streams still open; they end when their connections close.
+		s.logger.Warn("HTTP gateway shutdown timeout exceeded, closing connections", "error", err)
+		s.server.Close()
+		return
+	}
+	s.logger.Info("HTTP gateway stopped gracefully")
+}
+
+// gatewayHeaderMatcher picks the request headers forwarded as metadata:
+// the language, and the identity token set by the proxy in front of the
+// gateway, which the gRPC server checks the signature of. Nothing else is
+// forwarded unless it is known to be safe.
+func gatewayHeaderMatcher() runtime.HeaderMatcherFunc {
+	return func(key string) (string, bool) {
+		switch key = strings.ToLower(key); key {
+		case MetadataAcceptLanguage, MetadataUserLocale, MetadataUserIdentity:
+			return key, true
+		}
+		return "", false
+	}
+}
+
+// gatewayError is the JSON error body of the gateway. It is built from the
+// application error the gRPC status carries, so HTTP clients see the same
+// codes and reasons as gRPC clients.
+type gatewayError struct {
+	Code   errs.Code `json:"code"`
+	Reason string    `json:"reason,omitempty"`
+	// Message is in the request's language when the service has it in
+	// that language.
+	Message           string                  `json:"message"`
+	Locale            string                  `json:"locale,omitempty"`
+	Resource          *gatewayErrorResource   `json:"resource,omitempty"`
+	Violations        []gatewayFieldViolation `json:"violations,omitempty"`
+	RetryAfterSeconds int                     `json:"retryAfterSeconds,omitempty"`
+	Metadata          map[string]string       `json:"metadata,omitempty"`
+}
+
+type gatewayErrorResource struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+type gatewayFieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+type gatewayErrorBody struct {
+	Error gatewayError `json:"error"`
+}
+
+var codeToHTTP = map[errs.Code]int{
+	errs.CodeInternal:           http.StatusInternalServerError,
+	errs.CodeNotFound:           http.StatusNotFound,
+	errs.CodeAlreadyExists:      http.StatusConflict,
+	errs.CodeInvalidArgument:    http.StatusBadRequest,
+	errs.CodeUnauthorized:       http.StatusUnauthorized,
+	errs.CodeForbidden:          http.StatusForbidden,
+	errs.CodeConflict:           http.StatusConflict,
+	errs.CodeFailedPrecondition: http.StatusBadRequest,
+	errs.CodeResourceExhausted:  http.StatusTooManyRequests,
+	errs.CodeUnavailable:        http.StatusServiceUnavailable,
+	errs.CodeDeadlineExceeded:   http.StatusGatewayTimeout,
+	errs.CodeAborted:            http.StatusConflict,
+	errs.CodeUnimplemented:      http.StatusNotImplemented,
+}
+
+// HTTPStatus is the HTTP status an application error code is sent as.
+func HTTPStatus(code errs.Code) int {
+	if s, ok := codeToHTTP[code]; ok {
+		return s
+	}
+	return http.StatusInternalServerError
+}
+
+// newGatewayError builds the error body of a gRPC status, and the HTTP
+// status it is sent with.
+func newGatewayError(st *status.Status) (gatewayError, int) {
+	if st.Code() == codes.Canceled {
+		return gatewayError{Code: "CANCELLED", Message: st.Message()}, statusClientClosedRequest
+	}
+
+	body := gatewayError{Code: errs.CodeInternal, Message: st.Message()}
+	if de, ok := errs.AsErrorInternal(ErrorFromGRPC(st.Err())); ok {
+		body.Code = de.Code
+		body.Reason = de.Reason
+		body.Message = de.Message
+		body.Metadata = de.Metadata
+		if de.Resource != nil {
+			body.Resource = &gatewayErrorResource{Type: de.Resource.Type, ID: de.Resource.ID}
+		}
+		if de.RetryAfter > 0 {
+			body.RetryAfterSeconds = int(math.Ceil(de.RetryAfter.Seconds()))
+		}
+	}
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *epb.LocalizedMessage:
+			body.Message = d.GetMessage()
+			body.Locale = d.GetLocale()
+		case *epb.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				body.Violations = append(body.Violations, gatewayFieldViolation{Field: v.GetField(), Description: v.GetDescription()})
+			}
+		}
+	}
+	return body, HTTPStatus(body.Code)
+}
+
+// gatewayErrorHandler writes a failed call as a gatewayError.
+func gatewayErrorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	var statusErr *runtime.HTTPStatusError
+	if errors.As(err, &statusErr) {
+		err = statusErr.Err
+	}
+	body, httpStatus := newGatewayError(status.Convert(err))
+	if statusErr != nil {
+		httpStatus = statusErr.HTTPStatus
+	}
+
+	w.Header().Del("Trailer")
+	w.Header().Del("Transfer-Encoding")
+	w.Header().Set("Content-Type", "application/json")
+	if body.RetryAfterSeconds > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(body.RetryAfterSeconds))
+	}
+	w.WriteHeader(httpStatus)
+	json.NewEncoder(w).Encode(gatewayErrorBody{Error: body})
+}
+
+// gatewayRoutingErrorHandler answers requests that match no route with the
+// routing status, such as 405 for a known path with the wrong method.
+func gatewayRoutingErrorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, httpStatus int) {
+	code := codes.InvalidArgument
+	switch httpStatus {
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusMethodNotAllowed:
+		code = codes.Unimplemented
+	}
+	gatewayErrorHandler(ctx, mux, m, w, r, &runtime.HTTPStatusError{
+		HTTPStatus: httpStatus,
+		Err:        status.Error(code, http.StatusText(httpStatus)),
+	})
+}
+
+// gatewayMarshaler writes the error that ends a server stream as a
+// gatewayError too; the gateway hands it over as a google.rpc.Status.
+type gatewayMarshaler struct {
+	runtime.Marshaler
+}
+
+func (m gatewayMarshaler) Marshal(v any) ([]byte, error) {
+	if chunk, ok := v.(map[string]proto.Message); ok && len(chunk) == 1 {
+		if st, ok := chunk["error"].(*spb.Status); ok {
+			body, _ := newGatewayError(status.FromProto(st))
+			return json.Marshal(gatewayErrorBody{Error: body})
+		}
+	}
+	return m.Marshaler.Marshal(v)
+}
+
+func (m gatewayMarshaler) Delimiter() []byte {
+	return []byte("\n")
+}
+
+// cors lets browsers on the allowed origins call the gateway, and answers
+// their preflight requests.
+func cors(next http.Handler, allowedOrigins []string) http.Handler {
+	if len(allowedOrigins) == 0 {
+		return next
+	}
+	anyOrigin := slices.Contains(allowedOrigins, "*")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		h := w.Header()
+		h.Add("Vary", "Origin")
+		if !anyOrigin && !slices.ContainsFunc(allowedOrigins, func(o string) bool { return strings.EqualFold(o, origin) }) {
+			// Without the CORS headers the browser keeps the response from
+			// the page.
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		h.Set("Access-Control-Allow-Origin", origin)
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			h.Add("Vary", "Access-Control-Request-Method")
+			h.Add("Vary", "Access-Control-Request-Headers")
+			h.Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE")
+			// Headers the gateway does not forward are dropped anyway.
+			h.Set("Access-Control-Allow-Headers", r.Header.Get("Access-Control-Request-Headers"))
+			h.Set("Access-Control-Max-Age", "600")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		h.Set("Access-Control-Expose-Headers", "Retry-After")
+		next.ServeHTTP(w, r)
+	})
+}
+
+// maxRequestSize rejects bodies over limit bytes: up front when the request
+// says how long it is, or once limit bytes have been read when it does not.
+func maxRequestSize(next http.Handler, limit int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > limit {
+			gatewayErrorHandler(r.Context(), nil, nil, w, r, &runtime.HTTPStatusError{
+				HTTPStatus: http.StatusRequestEntityTooLarge,
+				Err:        status.Errorf(codes.InvalidArgument, "request body is larger than %d bytes", limit),
+			})
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+		next.ServeHTTP(w, r)
+	})
+}
@@ -1,17 +1,22 @@
 package grpc
 
 import (
-	"fmt"
+	"context"
+	"errors"
 	"log/slog"
+	"reflect"
+	"strings"
 	"time"
 	"unicode"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/pratchaya-maneechot/service-exchange/libs/bus/command"
 	"github.com/pratchaya-maneechot/service-exchange/libs/bus/query"
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
 	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
 )
 
 type GrpcHandlerOption struct {
@@ -32,6 +37,7 @@ func NewGrpcHandlerOption(cb command.CommandBus, qb query.QueryBus, log *slog.Lo
 
 func ProvideValidator() *validator.Validate {
 	v := validator.New()
+	v.RegisterTagNameFunc(jsonFieldName)
 
 	v.RegisterValidation("password_strength", validatePasswordStrength)
 	v.RegisterValidation("timezone", validateTimezone)
@@ -39,82 +45,177 @@ func ProvideValidator() *validator.Validate {
 	return v
 }
 
+// ValidationErrors turns the errors of Validator.Struct into an
+// InvalidArgument status with a field violation per failed field. The
+// violations are in English until the locale interceptor renders them in
+// the caller's language.
 func (h GrpcHandlerOption) ValidationErrors(err error) error {
+	return structValidationError(err)
+}
+
+// ValidateCommands is a command bus middleware that checks every command
+// against its validate tags before it reaches the handler, so no handler
+// can forget to.
+func ValidateCommands(v *validator.Validate) command.Middleware {
+	return func(next command.DispatchFunc) command.DispatchFunc {
+		return func(ctx context.Context, cmd command.Command) (command.Result, error) {
+			if err := validateStruct(v, cmd); err != nil {
+				return nil, err
+			}
+			return next(ctx, cmd)
+		}
+	}
+}
+
+// ValidateQueries is the query bus counterpart of ValidateCommands.
+func ValidateQueries(v *validator.Validate) query.Middleware {
+	return func(next query.DispatchFunc) query.DispatchFunc {
+		return func(ctx context.Context, qry query.Query) (query.Result, error) {
+			if err := validateStruct(v, qry); err != nil {
+				return nil, err
+			}
+			return next(ctx, qry)
+		}
+	}
+}
+
+// validateStruct validates s when it is a struct; commands and queries of
+// other kinds carry no validate tags.
+func validateStruct(v *validator.Validate, s any) error {
+	err := v.Struct(s)
+	var invalid *validator.InvalidValidationError
+	if err == nil || errors.As(err, &invalid) {
+		return nil
+	}
+	return structValidationError(err)
+}
+
+func structValidationError(err error) error {
 	validationErrors, ok := err.(validator.ValidationErrors)
 	if !ok {
 		return err
 	}
+	violations := make([]fieldViolation, 0, len(validationErrors))
+	for _, fieldErr := range validationErrors {
+		violations = append(violations, fieldViolation{
+			field: getFieldName(fieldErr),
+			key:   validationMessageKey(fieldErr),
+			tag:   fieldErr.Tag(),
+			param: fieldErr.Param(),
+		})
+	}
+	return &validationError{violations: violations}
+}
 
-	st := status.New(codes.InvalidArgument, "Request validation failed")
+// validationError is a request that broke validation rules, whether the
+// validate tags of a command or the buf.validate rules of a proto message.
+type validationError struct {
+	violations []fieldViolation
+}
 
-	fieldViolations := make([]*epb.BadRequest_FieldViolation, 0, len(validationErrors))
+// fieldViolation is a field that broke a rule. key names the rule's message
+// in the catalogue; tag and param fill in its {tag} and {param}.
+type fieldViolation struct {
+	field string
+	key   string
+	tag   string
+	param string
+}
 
-	for _, fieldErr := range validationErrors {
+func (e *validationError) Error() string {
+	parts := make([]string, len(e.violations))
+	for i, v := range e.violations {
+		parts[i] = v.field + ": " + v.tag
+	}
+	return "validation failed: " + strings.Join(parts, ", ")
+}
+
+func (e *validationError) GRPCStatus() *status.Status {
+	return e.status(defaultCatalogue, messageLocale)
+}
+
+func (e *validationError) status(c *Catalogue, locale string) *status.Status {
+	st := status.New(codes.InvalidArgument, "Request validation failed")
+
+	fieldViolations := make([]*epb.BadRequest_FieldViolation, 0, len(e.violations))
+	for _, v := range e.violations {
 		fieldViolations = append(fieldViolations, &epb.BadRequest_FieldViolation{
-			Field:       getFieldName(fieldErr),
-			Description: getValidationMessage(fieldErr),
+			Field:       v.field,
+			Description: v.message(c, locale),
 		})
 	}
 
-	withDetails, detailErr := st.WithDetails(&epb.BadRequest{
-		FieldViolations: fieldViolations,
-	})
+	details := []protoadapt.MessageV1{
+		&epb.ErrorInfo{
+			Reason: string(errs.CodeInvalidArgument),
+			Domain: ErrorDomain,
+		},
+		&epb.BadRequest{
+			FieldViolations: fieldViolations,
+		},
+	}
+	if msg, ok := c.Message(locale, "validation.failed"); ok {
+		details = append(details, &epb.LocalizedMessage{Locale: locale, Message: msg})
+	}
+	withDetails, detailErr := st.WithDetails(details...)
 	if detailErr != nil {
-		return st.Err()
+		return st
 	}
+	return withDetails
+}
 
-	return withDetails.Err()
+func (v fieldViolation) message(c *Catalogue, locale string) string {
+	msg, ok := c.Message(locale, v.key)
+	if !ok {
+		msg, _ = c.Message(locale, "validation.default")
+	}
+	return strings.NewReplacer(
+		"{field}", v.field,
+		"{param}", v.param,
+		"{tag}", v.tag,
+	).Replace(msg)
 }
 
+// jsonFieldName names struct fields by their JSON tag in validation errors,
+// so field paths match what clients send.
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	}
+	return name
+}
+
+// getFieldName is the path of the failed field from the validated struct,
+// such as profile.displayName.
 func getFieldName(fieldErr validator.FieldError) string {
-	// Try to get JSON tag name first
-	if jsonTag := fieldErr.Tag(); jsonTag != "" {
-		// You might want to parse the actual JSON tag from the struct field
-		// For now, return the field name as is
+	if _, path, ok := strings.Cut(fieldErr.Namespace(), "."); ok {
+		return path
 	}
 	return fieldErr.Field()
 }
 
-func getValidationMessage(fieldErr validator.FieldError) string {
-	field := fieldErr.Field()
+// validationMessageKey is the catalogue key of a failed validator tag. Tags
+// whose meaning depends on the kind of field, such as min, have a key per
+// kind.
+func validationMessageKey(fieldErr validator.FieldError) string {
 	tag := fieldErr.Tag()
-	value := fieldErr.Value()
-	param := fieldErr.Param()
-
+	key := "validation." + tag
 	switch tag {
-	case "required":
-		return fmt.Sprintf("Field '%s' is required", field)
-	case "email":
-		return fmt.Sprintf("Field '%s' must be a valid email address", field)
-	case "min":
-		return fmt.Sprintf("Field '%s' must be at least %s characters long", field, param)
-	case "max":
-		return fmt.Sprintf("Field '%s' must be at most %s characters long", field, param)
-	case "len":
-		return fmt.Sprintf("Field '%s' must be exactly %s characters long", field, param)
-	case "gt":
-		return fmt.Sprintf("Field '%s' must be greater than %s", field, param)
-	case "gte":
-		return fmt.Sprintf("Field '%s' must be greater than or equal to %s", field, param)
-	case "lt":
-		return fmt.Sprintf("Field '%s' must be less than %s", field, param)
-	case "lte":
-		return fmt.Sprintf("Field '%s' must be less than or equal to %s", field, param)
-	case "oneof":
-		return fmt.Sprintf("Field '%s' must be one of: %s", field, param)
-	case "uuid":
-		return fmt.Sprintf("Field '%s' must be a valid UUID", field)
-	case "url":
-		return fmt.Sprintf("Field '%s' must be a valid URL", field)
-	case "alpha":
-		return fmt.Sprintf("Field '%s' must contain only alphabetic characters", field)
-	case "alphanum":
-		return fmt.Sprintf("Field '%s' must contain only alphanumeric characters", field)
-	case "numeric":
-		return fmt.Sprintf("Field '%s' must be numeric", field)
-	default:
-		return fmt.Sprintf("Field '%s' failed validation '%s' with value '%v'", field, tag, value)
+	case "min", "max", "len":
+		switch fieldErr.Kind() {
+		case reflect.String:
+			key += ".string"
+		case reflect.Slice, reflect.Array, reflect.Map:
+			key += ".items"
+		default:
+			key += ".number"
+		}
 	}
+	return key
 }
 
 func validatePasswordStrength(fl validator.FieldLevel) bool {
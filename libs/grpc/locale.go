@@ -0,0 +1,195 @@
+package grpc
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Locales the services have messages in. Most users are in Thailand, so
+// Thai is the default.
+const (
+	LocaleEnglish = "en"
+	LocaleThai    = "th"
+	DefaultLocale = LocaleThai
+)
+
+// messageLocale is the language ErrorInternal messages are written in.
+const messageLocale = LocaleEnglish
+
+// Metadata keys the locale of a request is read from. The gateway sets
+// x-user-locale when it knows the signed-in user's language; clients may
+// send accept-language.
+const (
+	MetadataUserLocale     = "x-user-locale"
+	MetadataAcceptLanguage = "accept-language"
+)
+
+// LocaleResolver looks up the language a signed-in caller chose, such as
+// the language preference on their profile. It returns false when the
+// caller has none.
+type LocaleResolver func(ctx context.Context, c Caller) (string, bool)
+
+// UnaryLocaleInterceptor adds an errdetails.LocalizedMessage in the caller's
+// language to the errors handlers return, and renders validation errors in
+// it. The locale is only negotiated when a call fails.
+func UnaryLocaleInterceptor(catalogue *Catalogue, resolve LocaleResolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			err = catalogue.Localize(err, catalogue.Negotiate(ctx, resolve))
+		}
+		return resp, err
+	}
+}
+
+// StreamLocaleInterceptor is UnaryLocaleInterceptor for streams: the error
+// a stream ends with is localized.
+func StreamLocaleInterceptor(catalogue *Catalogue, resolve LocaleResolver) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		if err != nil {
+			err = catalogue.Localize(err, catalogue.Negotiate(ss.Context(), resolve))
+		}
+		return err
+	}
+}
+
+// Negotiate picks the locale to answer a request in: the x-user-locale
+// metadata, then the caller's own preference, then accept-language, then
+// DefaultLocale. Only locales the catalogue supports are picked.
+func (c *Catalogue) Negotiate(ctx context.Context, resolve LocaleResolver) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get(MetadataUserLocale) {
+		if l, ok := c.match(v); ok {
+			return l
+		}
+	}
+	if resolve != nil {
+		if caller, ok := CallerFromCtx(ctx); ok {
+			if v, ok := resolve(ctx, caller); ok {
+				if l, ok := c.match(v); ok {
+					return l
+				}
+			}
+		}
+	}
+	for _, v := range md.Get(MetadataAcceptLanguage) {
+		for _, tag := range parseAcceptLanguage(v) {
+			if l, ok := c.match(tag); ok {
+				return l
+			}
+		}
+	}
+	return DefaultLocale
+}
+
+// match finds the supported locale for a language tag such as th-TH.
+func (c *Catalogue) match(tag string) (string, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if c.Supports(tag) {
+		return tag, true
+	}
+	base, _, _ := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+	if c.Supports(base) {
+		return base, true
+	}
+	return "", false
+}
+
+// parseAcceptLanguage returns the language tags of an Accept-Language
+// header, most preferred first.
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil || parsed <= 0 {
+				continue
+			}
+			q = parsed
+		}
+		tags = append(tags, weighted{tag: tag, q: q})
+	}
+	slices.SortStableFunc(tags, func(a, b weighted) int { return cmp.Compare(b.q, a.q) })
+
+	out := make([]string, len(tags))
+	for i, t := range tags {
+		out[i] = t.tag
+	}
+	return out
+}
+
+// Localize renders err in the locale. Validation errors get their field
+// violations in the locale; any other gRPC status gets a LocalizedMessage
+// for its reason or, failing that, its code.
+func (c *Catalogue) Localize(err error, locale string) error {
+	var ve *validationError
+	if errors.As(err, &ve) {
+		return ve.status(c, locale).Err()
+	}
+
+	st, ok := status.FromError(err)
+	if !ok || st.Code() == codes.OK {
+		return err
+	}
+	for _, d := range st.Details() {
+		if _, ok := d.(*epb.LocalizedMessage); ok {
+			return err
+		}
+	}
+	msg, ok := c.statusMessage(st, locale)
+	if !ok {
+		return err
+	}
+	localized, detailErr := st.WithDetails(&epb.LocalizedMessage{Locale: locale, Message: msg})
+	if detailErr != nil {
+		return err
+	}
+	return localized.Err()
+}
+
+func (c *Catalogue) statusMessage(st *status.Status, locale string) (string, bool) {
+	code := string(CodeFromGRPC(st.Code()))
+	var reason string
+	for _, d := range st.Details() {
+		if info, ok := d.(*epb.ErrorInfo); ok && info.GetDomain() == ErrorDomain {
+			reason = info.GetReason()
+			if v, ok := info.GetMetadata()[codeMetadataKey]; ok {
+				code = v
+			}
+		}
+	}
+
+	// Internal errors all read the same to clients.
+	if reason != "" && reason != code && errs.Code(code) != errs.CodeInternal {
+		if msg, ok := c.Message(locale, reason); ok {
+			return msg, true
+		}
+		// Domain errors carry their English text as the status message.
+		if locale == messageLocale {
+			return st.Message(), true
+		}
+	}
+	return c.Message(locale, code)
+}
@@ -32,6 +32,25 @@ func UnaryTraceInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// StreamTraceInterceptor is UnaryTraceInterceptor for streams; the span
+// lasts as long as the stream.
+func StreamTraceInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		tracer := otel.Tracer("grpc-server")
+		ctx, span := tracer.Start(ss.Context(), info.FullMethod, trace.WithSpanKind(trace.SpanKindServer))
+		defer span.End()
+
+		err := handler(srv, StreamWithContext(ss, ctx))
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			span.RecordError(err)
+		} else {
+			span.SetStatus(codes.Ok, "success")
+		}
+		return err
+	}
+}
+
 func UnaryLoggerInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		logger.Info("request starting", "method", info.FullMethod, "req", req)
@@ -45,6 +64,22 @@ func UnaryLoggerInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
 	}
 }
 
+// StreamLoggerInterceptor logs when a stream opens and how it ended; the
+// messages themselves are not logged.
+func StreamLoggerInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		logger.Info("stream starting", "method", info.FullMethod)
+		start := time.Now()
+		err := handler(srv, ss)
+		if err != nil {
+			logger.Error("stream failed", "method", info.FullMethod, "duration", time.Since(start), "error", err)
+			return err
+		}
+		logger.Info("stream completed", "method", info.FullMethod, "duration", time.Since(start))
+		return nil
+	}
+}
+
 // UnaryMetricsInterceptor records gRPC request metrics.
 func UnaryMetricsInterceptor(metricsRecorder observability.MetricsRecorder) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
@@ -60,6 +95,21 @@ func UnaryMetricsInterceptor(metricsRecorder observability.MetricsRecorder) grpc
 	}
 }
 
+// StreamMetricsInterceptor records gRPC stream metrics; a stream counts as
+// one request lasting until it ends.
+func StreamMetricsInterceptor(metricsRecorder observability.MetricsRecorder) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		duration := time.Since(start).Seconds()
+
+		metricsRecorder.RecordGrpcRequestTotal(info.FullMethod, status.Code(err).String())
+		metricsRecorder.RecordGrpcRequestDuration(info.FullMethod, duration)
+
+		return err
+	}
+}
+
 // UnaryRecoveryInterceptor recovers from panics and logs them.
 func UnaryRecoveryInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
@@ -72,3 +122,17 @@ func UnaryRecoveryInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
 		return handler(ctx, req)
 	}
 }
+
+// StreamRecoveryInterceptor recovers from panics in stream handlers and logs
+// them.
+func StreamRecoveryInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("Panic in gRPC stream handler", "panic", r, "method", info.FullMethod)
+				err = status.Errorf(grpcCodes.Internal, "panic: %v", r)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
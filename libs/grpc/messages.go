@@ -0,0 +1,197 @@
+package grpc
+
+// Messages maps message keys, such as error reasons, to their text in one
+// locale.
+type Messages map[string]string
+
+// Catalogue holds the text of messages in every locale the service speaks.
+// Messages are registered at startup and only read afterwards.
+type Catalogue struct {
+	locales map[string]Messages
+}
+
+// NewCatalogue returns a catalogue holding the validation messages and the
+// messages for error codes and database errors every service shares.
+// Services register the messages for their own error reasons on top.
+func NewCatalogue() *Catalogue {
+	c := &Catalogue{locales: make(map[string]Messages)}
+	c.Register(LocaleEnglish, englishMessages)
+	c.Register(LocaleThai, thaiMessages)
+	return c
+}
+
+// Register adds msgs to the locale, replacing messages with the same key.
+func (c *Catalogue) Register(locale string, msgs Messages) *Catalogue {
+	m, ok := c.locales[locale]
+	if !ok {
+		m = make(Messages, len(msgs))
+		c.locales[locale] = m
+	}
+	for k, v := range msgs {
+		m[k] = v
+	}
+	return c
+}
+
+// Supports reports whether the catalogue has messages in the locale.
+func (c *Catalogue) Supports(locale string) bool {
+	_, ok := c.locales[locale]
+	return ok
+}
+
+// Message is the text of key in the locale.
+func (c *Catalogue) Message(locale, key string) (string, bool) {
+	msg, ok := c.locales[locale][key]
+	return msg, ok
+}
+
+// defaultCatalogue renders errors that never reach the locale interceptor.
+var defaultCatalogue = NewCatalogue()
+
+// Validation messages are keyed by validator tag; buf.validate rules reuse
+// the key of the matching tag. Tags whose meaning depends on the kind of
+// field, such as min, have a key per kind. {field} and {param} stand for the
+// field path and the tag parameter.
+var englishMessages = Messages{
+	"validation.failed":            "The request is invalid.",
+	"validation.required":          "Field '{field}' is required",
+	"validation.required_with":     "Field '{field}' is required when {param} is set",
+	"validation.email":             "Field '{field}' must be a valid email address",
+	"validation.e164":              "Field '{field}' must be a phone number in international format, such as +66812345678",
+	"validation.min.string":        "Field '{field}' must be at least {param} characters long",
+	"validation.min.items":         "Field '{field}' must contain at least {param} items",
+	"validation.min.number":        "Field '{field}' must be at least {param}",
+	"validation.max.string":        "Field '{field}' must be at most {param} characters long",
+	"validation.max.items":         "Field '{field}' must contain at most {param} items",
+	"validation.max.number":        "Field '{field}' must be at most {param}",
+	"validation.len.string":        "Field '{field}' must be exactly {param} characters long",
+	"validation.len.items":         "Field '{field}' must contain exactly {param} items",
+	"validation.len.number":        "Field '{field}' must be exactly {param}",
+	"validation.gt":                "Field '{field}' must be greater than {param}",
+	"validation.gte":               "Field '{field}' must be greater than or equal to {param}",
+	"validation.lt":                "Field '{field}' must be less than {param}",
+	"validation.lte":               "Field '{field}' must be less than or equal to {param}",
+	"validation.gtfield":           "Field '{field}' must be after {param}",
+	"validation.gtefield":          "Field '{field}' must not be before {param}",
+	"validation.oneof":             "Field '{field}' must be one of: {param}",
+	"validation.uuid":              "Field '{field}' must be a valid UUID",
+	"validation.url":               "Field '{field}' must be a valid URL",
+	"validation.alpha":             "Field '{field}' must contain only alphabetic characters",
+	"validation.alphanum":          "Field '{field}' must contain only alphanumeric characters",
+	"validation.numeric":           "Field '{field}' must be numeric",
+	"validation.printascii":        "Field '{field}' must contain only printable ASCII characters",
+	"validation.password_strength": "Field '{field}' must be at least 8 characters and mix upper case, lower case, digits or symbols",
+	"validation.timezone":          "Field '{field}' must be an IANA time zone such as Asia/Bangkok",
+	"validation.eq":                "Field '{field}' must be {param}",
+	"validation.not_in":            "Field '{field}' must not be one of: {param}",
+	"validation.pattern":           "Field '{field}' has an invalid format",
+	"validation.startswith":        "Field '{field}' must start with '{param}'",
+	"validation.endswith":          "Field '{field}' must end with '{param}'",
+	"validation.contains":          "Field '{field}' must contain '{param}'",
+	"validation.excludes":          "Field '{field}' must not contain '{param}'",
+	"validation.hostname":          "Field '{field}' must be a valid host name",
+	"validation.ip":                "Field '{field}' must be a valid IP address",
+	"validation.ipv4":              "Field '{field}' must be a valid IPv4 address",
+	"validation.ipv6":              "Field '{field}' must be a valid IPv6 address",
+	"validation.enum":              "Field '{field}' must be one of the defined values",
+	"validation.unique":            "Field '{field}' must not contain duplicates",
+	"validation.finite":            "Field '{field}' must be a finite number",
+	"validation.default":           "Field '{field}' failed validation '{tag}'",
+
+	"INTERNAL_ERROR":      "Something went wrong on our side. Please try again later.",
+	"NOT_FOUND":           "The requested item was not found.",
+	"ALREADY_EXISTS":      "The item already exists.",
+	"INVALID_ARGUMENT":    "The request is invalid.",
+	"UNAUTHORIZED":        "Please sign in to continue.",
+	"FORBIDDEN":           "You do not have permission to do this.",
+	"CONFLICT":            "The request conflicts with the current state of the item.",
+	"FAILED_PRECONDITION": "This cannot be done in the item's current state.",
+	"RESOURCE_EXHAUSTED":  "Too many requests. Please wait a moment and try again.",
+	"UNAVAILABLE":         "The service is temporarily unavailable. Please try again shortly.",
+	"DEADLINE_EXCEEDED":   "The request took too long. Please try again.",
+	"ABORTED":             "The item was changed by someone else. Please try again.",
+	"UNIMPLEMENTED":       "This operation is not supported.",
+
+	"RATE_LIMITED": "You are sending requests too quickly. Please wait a moment and try again.",
+	"CIRCUIT_OPEN": "The service is temporarily unavailable. Please try again shortly.",
+
+	"DB_NO_ROWS":               "The requested item was not found.",
+	"DB_UNIQUE_VIOLATION":      "The item already exists.",
+	"DB_FOREIGN_KEY_VIOLATION": "The request refers to an item that does not exist.",
+	"DB_CHECK_VIOLATION":       "The request contains an invalid value.",
+	"DB_SERIALIZATION_FAILURE": "The item was changed by someone else. Please try again.",
+	"DB_QUERY_CANCELED":        "The request took too long. Please try again.",
+	"DB_UNAVAILABLE":           "The service is temporarily unavailable. Please try again shortly.",
+}
+
+var thaiMessages = Messages{
+	"validation.failed":            "คำขอไม่ถูกต้อง",
+	"validation.required":          "กรุณาระบุ '{field}'",
+	"validation.required_with":     "กรุณาระบุ '{field}' เมื่อระบุ {param}",
+	"validation.email":             "'{field}' ต้องเป็นอีเมลที่ถูกต้อง",
+	"validation.e164":              "'{field}' ต้องเป็นหมายเลขโทรศัพท์รูปแบบสากล เช่น +66812345678",
+	"validation.min.string":        "'{field}' ต้องมีความยาวอย่างน้อย {param} ตัวอักษร",
+	"validation.min.items":         "'{field}' ต้องมีอย่างน้อย {param} รายการ",
+	"validation.min.number":        "'{field}' ต้องมีค่าอย่างน้อย {param}",
+	"validation.max.string":        "'{field}' ต้องมีความยาวไม่เกิน {param} ตัวอักษร",
+	"validation.max.items":         "'{field}' ต้องมีไม่เกิน {param} รายการ",
+	"validation.max.number":        "'{field}' ต้องมีค่าไม่เกิน {param}",
+	"validation.len.string":        "'{field}' ต้องมีความยาว {param} ตัวอักษรพอดี",
+	"validation.len.items":         "'{field}' ต้องมี {param} รายการพอดี",
+	"validation.len.number":        "'{field}' ต้องมีค่าเท่ากับ {param}",
+	"validation.gt":                "'{field}' ต้องมากกว่า {param}",
+	"validation.gte":               "'{field}' ต้องมากกว่าหรือเท่ากับ {param}",
+	"validation.lt":                "'{field}' ต้องน้อยกว่า {param}",
+	"validation.lte":               "'{field}' ต้องน้อยกว่าหรือเท่ากับ {param}",
+	"validation.gtfield":           "'{field}' ต้องอยู่หลัง {param}",
+	"validation.gtefield":          "'{field}' ต้องไม่อยู่ก่อน {param}",
+	"validation.oneof":             "'{field}' ต้องเป็นค่าใดค่าหนึ่งต่อไปนี้: {param}",
+	"validation.uuid":              "'{field}' ต้องเป็น UUID ที่ถูกต้อง",
+	"validation.url":               "'{field}' ต้องเป็น URL ที่ถูกต้อง",
+	"validation.alpha":             "'{field}' ต้องประกอบด้วยตัวอักษรเท่านั้น",
+	"validation.alphanum":          "'{field}' ต้องประกอบด้วยตัวอักษรหรือตัวเลขเท่านั้น",
+	"validation.numeric":           "'{field}' ต้องเป็นตัวเลข",
+	"validation.printascii":        "'{field}' ต้องประกอบด้วยอักขระ ASCII ที่พิมพ์ได้เท่านั้น",
+	"validation.password_strength": "'{field}' ต้องยาวอย่างน้อย 8 ตัวอักษร และผสมตัวพิมพ์ใหญ่ ตัวพิมพ์เล็ก ตัวเลข หรือสัญลักษณ์",
+	"validation.timezone":          "'{field}' ต้องเป็นเขตเวลา IANA เช่น Asia/Bangkok",
+	"validation.eq":                "'{field}' ต้องเป็น {param}",
+	"validation.not_in":            "'{field}' ต้องไม่เป็นค่าใดค่าหนึ่งต่อไปนี้: {param}",
+	"validation.pattern":           "'{field}' มีรูปแบบไม่ถูกต้อง",
+	"validation.startswith":        "'{field}' ต้องขึ้นต้นด้วย '{param}'",
+	"validation.endswith":          "'{field}' ต้องลงท้ายด้วย '{param}'",
+	"validation.contains":          "'{field}' ต้องมี '{param}'",
+	"validation.excludes":          "'{field}' ต้องไม่มี '{param}'",
+	"validation.hostname":          "'{field}' ต้องเป็นชื่อโฮสต์ที่ถูกต้อง",
+	"validation.ip":                "'{field}' ต้องเป็นที่อยู่ IP ที่ถูกต้อง",
+	"validation.ipv4":              "'{field}' ต้องเป็นที่อยู่ IPv4 ที่ถูกต้อง",
+	"validation.ipv6":              "'{field}' ต้องเป็นที่อยู่ IPv6 ที่ถูกต้อง",
+	"validation.enum":              "'{field}' ต้องเป็นค่าที่กำหนดไว้",
+	"validation.unique":            "'{field}' ต้องไม่มีค่าซ้ำกัน",
+	"validation.finite":            "'{field}' ต้องเป็นตัวเลขที่มีค่าจำกัด",
+	"validation.default":           "'{field}' ไม่ผ่านการตรวจสอบ '{tag}'",
+
+	"INTERNAL_ERROR":      "เกิดข้อผิดพลาดในระบบ กรุณาลองใหม่อีกครั้งในภายหลัง",
+	"NOT_FOUND":           "ไม่พบข้อมูลที่ต้องการ",
+	"ALREADY_EXISTS":      "มีข้อมูลนี้อยู่แล้ว",
+	"INVALID_ARGUMENT":    "คำขอไม่ถูกต้อง",
+	"UNAUTHORIZED":        "กรุณาเข้าสู่ระบบเพื่อดำเนินการต่อ",
+	"FORBIDDEN":           "คุณไม่มีสิทธิ์ดำเนินการนี้",
+	"CONFLICT":            "คำขอขัดแย้งกับสถานะปัจจุบันของข้อมูล",
+	"FAILED_PRECONDITION": "ไม่สามารถดำเนินการได้ในสถานะปัจจุบัน",
+	"RESOURCE_EXHAUSTED":  "มีคำขอมากเกินไป กรุณารอสักครู่แล้วลองใหม่",
+	"UNAVAILABLE":         "บริการไม่พร้อมใช้งานชั่วคราว กรุณาลองใหม่อีกครั้ง",
+	"DEADLINE_EXCEEDED":   "คำขอใช้เวลานานเกินไป กรุณาลองใหม่อีกครั้ง",
+	"ABORTED":             "ข้อมูลถูกแก้ไขโดยผู้อื่น กรุณาลองใหม่อีกครั้ง",
+	"UNIMPLEMENTED":       "ไม่รองรับการดำเนินการนี้",
+
+	"RATE_LIMITED": "คุณส่งคำขอถี่เกินไป กรุณารอสักครู่แล้วลองใหม่",
+	"CIRCUIT_OPEN": "บริการไม่พร้อมใช้งานชั่วคราว กรุณาลองใหม่อีกครั้ง",
+
+	"DB_NO_ROWS":               "ไม่พบข้อมูลที่ต้องการ",
+	"DB_UNIQUE_VIOLATION":      "มีข้อมูลนี้อยู่แล้ว",
+	"DB_FOREIGN_KEY_VIOLATION": "คำขออ้างถึงข้อมูลที่ไม่มีอยู่",
+	"DB_CHECK_VIOLATION":       "คำขอมีค่าที่ไม่ถูกต้อง",
+	"DB_SERIALIZATION_FAILURE": "ข้อมูลถูกแก้ไขโดยผู้อื่น กรุณาลองใหม่อีกครั้ง",
+	"DB_QUERY_CANCELED":        "คำขอใช้เวลานานเกินไป กรุณาลองใหม่อีกครั้ง",
+	"DB_UNAVAILABLE":           "บริการไม่พร้อมใช้งานชั่วคราว กรุณาลองใหม่อีกครั้ง",
+}
@@ -0,0 +1,366 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// MetadataForwardedFor is the metadata key proxies append the address of the
+// client they forward for to.
+const MetadataForwardedFor = "x-forwarded-for"
+
+// ReasonRateLimited is the ErrorInfo reason of calls rejected by the rate
+// limiter.
+const ReasonRateLimited = "RATE_LIMITED"
+
+// RateLimit is a token bucket: a client may make Burst calls at once, and the
+// bucket refills at RPS calls per second.
+type RateLimit struct {
+	RPS float64
+	// Burst below one is rounded up from RPS.
+	Burst int
+}
+
+func (l RateLimit) enabled() bool {
+	return l.RPS > 0
+}
+
+func (l RateLimit) burst() float64 {
+	if l.Burst >= 1 {
+		return float64(l.Burst)
+	}
+	return math.Max(1, math.Ceil(l.RPS))
+}
+
+// ConfigRateLimit configures the rate limiting interceptor. Every client gets
+// a bucket per method: signed-in callers are told apart by user ID, anonymous
+// ones by IP address.
+type ConfigRateLimit struct {
+	// Default is the limit of methods without an override; a zero RPS leaves
+	// them unlimited.
+	Default RateLimit
+	// Methods overrides the limit of full method names, such as
+	// /user.v1.UserService/LineRegister. A zero RPS leaves the method unlimited.
+	Methods map[string]RateLimit
+	// TrustedProxies are the IP addresses or CIDR ranges of the proxies in
+	// front of the service. Calls they forward are keyed by the last address
+	// in x-forwarded-for that is not a trusted proxy.
+	TrustedProxies []string
+	// Store keeps the buckets; nil keeps them in memory, which limits each
+	// replica on its own.
+	Store RateLimitStore
+}
+
+// Enabled reports whether any method is rate limited.
+func (c ConfigRateLimit) Enabled() bool {
+	if c.Default.enabled() {
+		return true
+	}
+	for _, l := range c.Methods {
+		if l.enabled() {
+			return true
+		}
+	}
+	return false
+}
+
+// RateLimitStore keeps token buckets.
+type RateLimitStore interface {
+	// Take removes a token from the bucket under key, creating a full bucket
+	// if there is none. When the bucket is empty it reports how long until
+	// the next token.
+	Take(ctx context.Context, key string, limit RateLimit) (ok bool, retryAfter time.Duration, err error)
+}
+
+// UnaryRateLimitInterceptor rejects calls over their token bucket with
+// RESOURCE_EXHAUSTED and a RetryInfo saying when to retry. Health checks are
+// never limited. Calls are let through when the store fails, so an outage
+// of a shared store does not take the service down with it.
+func UnaryRateLimitInterceptor(cfg ConfigRateLimit, recorder observability.MetricsRecorder, logger *slog.Logger) (grpc.UnaryServerInterceptor, error) {
+	l, err := newRateLimiter(cfg, recorder, logger)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := l.take(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}, nil
+}
+
+// StreamRateLimitInterceptor is UnaryRateLimitInterceptor for streams:
+// opening a stream takes a token, the messages on it do not.
+func StreamRateLimitInterceptor(cfg ConfigRateLimit, recorder observability.MetricsRecorder, logger *slog.Logger) (grpc.StreamServerInterceptor, error) {
+	l, err := newRateLimiter(cfg, recorder, logger)
+	if err != nil {
+		return nil, err
+	}
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.take(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}, nil
+}
+
+type rateLimiter struct {
+	cfg      ConfigRateLimit
+	proxies  []netip.Prefix
+	store    RateLimitStore
+	recorder observability.MetricsRecorder
+	logger   *slog.Logger
+}
+
+func newRateLimiter(cfg ConfigRateLimit, recorder observability.MetricsRecorder, logger *slog.Logger) (*rateLimiter, error) {
+	proxies, err := parseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	store := cfg.Store
+	if store == nil {
+		store = NewMemoryRateLimitStore()
+	}
+	return &rateLimiter{cfg: cfg, proxies: proxies, store: store, recorder: recorder, logger: logger}, nil
+}
+
+// take takes a token for a call of the method, returning the error to fail
+// the call with when there is none.
+func (l *rateLimiter) take(ctx context.Context, method string) error {
+	limit, ok := l.cfg.Methods[method]
+	if !ok {
+		limit = l.cfg.Default
+	}
+	if !limit.enabled() || strings.HasPrefix(method, "/grpc.health.v1.Health/") {
+		return nil
+	}
+
+	kind, client := rateLimitClient(ctx, l.proxies)
+	allowed, retryAfter, err := l.store.Take(ctx, "ratelimit:"+method+":"+kind+":"+client, limit)
+	if err != nil {
+		l.logger.Warn("rate limit store failed, letting the call through", "method", method, "error", err)
+		return nil
+	}
+	if allowed {
+		return nil
+	}
+	if l.recorder != nil {
+		l.recorder.RecordGrpcRequestThrottled(method, kind)
+	}
+	return NewGRPCErrCode(ctx, errs.NewWithReason(errs.CodeResourceExhausted, ReasonRateLimited,
+		"too many requests, retry later").WithRetryAfter(retryAfter))
+}
+
+// rateLimitClient tells who a call counts against: the signed-in user, or
+// the client's IP address.
+func rateLimitClient(ctx context.Context, proxies []netip.Prefix) (kind, client string) {
+	if c, ok := CallerFromCtx(ctx); ok {
+		return "user", c.UserID
+	}
+	ip := clientIP(ctx, proxies)
+	if !ip.IsValid() {
+		return "ip", "unknown"
+	}
+	return "ip", ip.String()
+}
+
+// clientIP is the peer address or, when the peer is a trusted proxy, the
+// last address in x-forwarded-for that is not one. Addresses before it are
+// set by the client and cannot be trusted.
+func clientIP(ctx context.Context, proxies []netip.Prefix) netip.Addr {
+	var ip netip.Addr
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if ap, err := netip.ParseAddrPort(p.Addr.String()); err == nil {
+			ip = ap.Addr().Unmap()
+		}
+	}
+	if !trusted(ip, proxies) {
+		return ip
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	var hops []string
+	for _, v := range md.Get(MetadataForwardedFor) {
+		hops = append(hops, strings.Split(v, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+		ip = hop.Unmap()
+		if !trusted(ip, proxies) {
+			break
+		}
+	}
+	return ip
+}
+
+func trusted(ip netip.Addr, proxies []netip.Prefix) bool {
+	if !ip.IsValid() {
+		return false
+	}
+	for _, p := range proxies {
+		if p.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseTrustedProxies(proxies []string) ([]netip.Prefix, error) {
+	out := make([]netip.Prefix, 0, len(proxies))
+	for _, s := range proxies {
+		s = strings.TrimSpace(s)
+		if p, err := netip.ParsePrefix(s); err == nil {
+			out = append(out, p.Masked())
+			continue
+		}
+		ip, err := netip.ParseAddr(s)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q is neither an IP address nor a CIDR range", s)
+		}
+		ip = ip.Unmap()
+		out = append(out, netip.PrefixFrom(ip, ip.BitLen()))
+	}
+	return out, nil
+}
+
+// memoryRateLimitStore keeps buckets in the process. Buckets that have
+// refilled are dropped once a minute.
+type memoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+	// full is when the bucket will have refilled.
+	full time.Time
+}
+
+const rateLimitSweepInterval = time.Minute
+
+// NewMemoryRateLimitStore returns a store keeping buckets in memory.
+func NewMemoryRateLimitStore() RateLimitStore {
+	return &memoryRateLimitStore{buckets: make(map[string]*tokenBucket), now: time.Now}
+}
+
+func (s *memoryRateLimitStore) Take(_ context.Context, key string, limit RateLimit) (bool, time.Duration, error) {
+	now := s.now()
+	burst := limit.burst()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastSweep) >= rateLimitSweepInterval {
+		for k, b := range s.buckets {
+			if !now.Before(b.full) {
+				delete(s.buckets, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: burst, last: now}
+		s.buckets[key] = b
+	}
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(burst, b.tokens+elapsed.Seconds()*limit.RPS)
+		b.last = now
+	}
+	if b.tokens < 1 {
+		return false, secondsToDuration((1 - b.tokens) / limit.RPS), nil
+	}
+	b.tokens--
+	b.full = now.Add(secondsToDuration((burst - b.tokens) / limit.RPS))
+	return true, 0, nil
+}
+
+// RedisEvalFunc runs a Lua script on a Redis-compatible server and returns
+// its reply. With go-redis it is
+//
+//	func(ctx context.Context, script string, keys []string, args ...any) (any, error) {
+//		return client.Eval(ctx, script, keys, args...).Result()
+//	}
+type RedisEvalFunc func(ctx context.Context, script string, keys []string, args ...any) (any, error)
+
+// redisTokenBucket refills and takes from the bucket in one step on the
+// server, using the server's clock so every replica agrees on the time. The
+// wait is returned as a string because Lua numbers are truncated to integers
+// in replies.
+const redisTokenBucket = `
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) + tonumber(t[2]) / 1000000
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1]) or burst
+local ts = tonumber(state[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate)
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  wait = (1 - tokens) / rate
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil((burst - tokens) / rate * 1000) + 1000)
+return {allowed, tostring(wait)}
+`
+
+type redisRateLimitStore struct {
+	eval RedisEvalFunc
+}
+
+// NewRedisRateLimitStore returns a store keeping buckets on a
+// Redis-compatible server, so that replicas share their limits.
+func NewRedisRateLimitStore(eval RedisEvalFunc) RateLimitStore {
+	return &redisRateLimitStore{eval: eval}
+}
+
+func (s *redisRateLimitStore) Take(ctx context.Context, key string, limit RateLimit) (bool, time.Duration, error) {
+	reply, err := s.eval(ctx, redisTokenBucket, []string{key}, limit.RPS, limit.burst())
+	if err != nil {
+		return false, 0, fmt.Errorf("run token bucket script: %w", err)
+	}
+	values, ok := reply.([]any)
+	if !ok || len(values) != 2 {
+		return false, 0, fmt.Errorf("unexpected token bucket reply %v", reply)
+	}
+	allowed, ok := values[0].(int64)
+	if !ok {
+		return false, 0, fmt.Errorf("unexpected token bucket reply %v", reply)
+	}
+	if allowed == 1 {
+		return true, 0, nil
+	}
+	var wait float64
+	if _, err := fmt.Sscan(fmt.Sprint(values[1]), &wait); err != nil {
+		return false, 0, fmt.Errorf("unexpected token bucket wait %v", values[1])
+	}
+	return false, secondsToDuration(wait), nil
+}
+
+func secondsToDuration(s float64) time.Duration {
+	return time.Duration(math.Ceil(s * float64(time.Second)))
+}
@@ -0,0 +1,116 @@
+package grpc
+
+import (
+	"context"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// RetryPolicy says which failed calls are sent again, and how long to wait
+// in between.
+type RetryPolicy struct {
+	// MaxAttempts counts the first call too; below two turns retries off.
+	MaxAttempts int
+	// The wait before retry n is random, up to InitialBackoff doubled n-1
+	// times and capped at MaxBackoff, so clients that failed together do
+	// not retry together.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Idempotent reports whether a method, such as
+	// /user.UserService/GetUserProfile, may safely be sent twice. Nil uses
+	// the idempotency_level option of the method in its proto: only
+	// methods marked NO_SIDE_EFFECTS or IDEMPOTENT are retried.
+	Idempotent func(fullMethod string) bool
+}
+
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < retry && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	d = min(d, p.MaxBackoff)
+	if d <= 0 {
+		return 0
+	}
+	return rand.N(d + 1)
+}
+
+// unaryClientRetryInterceptor sends an idempotent call again when it failed
+// with an error the service says is worth retrying: UNAVAILABLE,
+// DEADLINE_EXCEEDED, ABORTED or RESOURCE_EXHAUSTED. A retry waits at least
+// as long as the error's RetryInfo asks. It gives up early rather than wait
+// past the call's deadline or MaxBackoff.
+func unaryClientRetryInterceptor(target string, p RetryPolicy, recorder observability.MetricsRecorder) grpc.UnaryClientInterceptor {
+	idempotent := p.Idempotent
+	if idempotent == nil {
+		idempotent = methodIdempotent
+	}
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if p.MaxAttempts < 2 || !idempotent(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt >= p.MaxAttempts {
+				return err
+			}
+			appErr := ErrorFromGRPC(err)
+			if !errs.IsRetryable(appErr) {
+				return err
+			}
+
+			wait := p.backoff(attempt)
+			if de, ok := errs.AsErrorInternal(appErr); ok && de.RetryAfter > wait {
+				if de.RetryAfter > p.MaxBackoff {
+					return err
+				}
+				wait = de.RetryAfter
+			}
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= wait {
+				return err
+			}
+
+			if recorder != nil {
+				recorder.RecordGrpcClientRetry(target, method)
+			}
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// idempotentMethods caches methodIdempotent by full method name.
+var idempotentMethods sync.Map
+
+// methodIdempotent looks the method up in the registered proto files, which
+// the generated code of every service a process calls registers.
+func methodIdempotent(fullMethod string) bool {
+	if v, ok := idempotentMethods.Load(fullMethod); ok {
+		return v.(bool)
+	}
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+	idempotent := false
+	if d, err := protoregistry.GlobalFiles.FindDescriptorByName(name); err == nil {
+		if md, ok := d.(protoreflect.MethodDescriptor); ok {
+			if opts, ok := md.Options().(*descriptorpb.MethodOptions); ok {
+				idempotent = opts.GetIdempotencyLevel() != descriptorpb.MethodOptions_IDEMPOTENCY_UNKNOWN
+			}
+		}
+	}
+	idempotentMethods.Store(fullMethod, idempotent)
+	return idempotent
+}
@@ -0,0 +1,75 @@
+package grpc
+
+// ConfigSecurity is the security section every service's configuration
+// shares, under the key security.
+type ConfigSecurity struct {
+	EnableTLS   bool   `mapstructure:"enable_tls"`
+	TLSCertFile string `mapstructure:"tls_cert_file" validate:"required_if=EnableTLS true"`
+	TLSKeyFile  string `mapstructure:"tls_key_file" validate:"required_if=EnableTLS true"`
+	// TLSClientCAFile turns on mutual TLS: callers must present a
+	// certificate issued by one of its CAs.
+	TLSClientCAFile string `mapstructure:"tls_client_ca_file"`
+	// IdentityKey checks the signed caller identity of incoming calls and
+	// signs it on calls to other services. The API gateway and every
+	// service share it; set it with SECURITY_IDENTITY_KEY.
+	IdentityKey string `mapstructure:"identity_key" validate:"required,min=32"`
+	// IdentityPreviousKey still checks identities while IdentityKey is
+	// rotated.
+	IdentityPreviousKey string `mapstructure:"identity_previous_key" validate:"omitempty,min=32"`
+	// TrustedProxies are the proxies in front of the service whose
+	// x-forwarded-for is believed, such as the HTTP gateway of the same
+	// process on 127.0.0.1. Nothing is trusted unless it is listed here.
+	TrustedProxies []string `mapstructure:"trusted_proxies" validate:"omitempty,dive,ip|cidr"`
+	RateLimitRPS   int      `mapstructure:"rate_limit_rps" validate:"gte=0"`
+	RateLimitBurst int      `mapstructure:"rate_limit_burst" validate:"gte=0"`
+	// RateLimitMethods overrides rate_limit_rps and rate_limit_burst for
+	// single methods.
+	RateLimitMethods []ConfigRateLimitMethod `mapstructure:"rate_limit_methods" validate:"dive"`
+	MaxRequestSize   int64                   `mapstructure:"max_request_size" validate:"gte=0"`
+	EnableCORS       bool                    `mapstructure:"enable_cors"`
+	AllowedOrigins   []string                `mapstructure:"allowed_origins" validate:"required_if=EnableCORS true"`
+}
+
+// ConfigRateLimitMethod is the rate limit of one gRPC method, named in full
+// such as /user.v1.UserService/LineRegister. A zero rps leaves it unlimited.
+type ConfigRateLimitMethod struct {
+	Method string `mapstructure:"method" validate:"required,startswith=/"`
+	RPS    int    `mapstructure:"rps" validate:"gte=0"`
+	Burst  int    `mapstructure:"burst" validate:"gte=0"`
+}
+
+// ServerTLS serves over TLS when enable_tls is set, and over mutual TLS when
+// there is also a client CA file.
+func (c ConfigSecurity) ServerTLS() *ConfigTLS {
+	if !c.EnableTLS {
+		return nil
+	}
+	return &ConfigTLS{
+		CertFile: c.TLSCertFile,
+		KeyFile:  c.TLSKeyFile,
+		CAFile:   c.TLSClientCAFile,
+	}
+}
+
+// RateLimit limits every client to rate_limit_rps calls per second on each
+// method, apart from the methods given their own limit.
+func (c ConfigSecurity) RateLimit() ConfigRateLimit {
+	methods := make(map[string]RateLimit, len(c.RateLimitMethods))
+	for _, m := range c.RateLimitMethods {
+		methods[m.Method] = RateLimit{RPS: float64(m.RPS), Burst: m.Burst}
+	}
+	return ConfigRateLimit{
+		Default:        RateLimit{RPS: float64(c.RateLimitRPS), Burst: c.RateLimitBurst},
+		Methods:        methods,
+		TrustedProxies: c.TrustedProxies,
+	}
+}
+
+// Identity returns the keys of identity_key and identity_previous_key.
+func (c ConfigSecurity) Identity() IdentityKeys {
+	keys := IdentityKeys{Current: []byte(c.IdentityKey)}
+	if c.IdentityPreviousKey != "" {
+		keys.Previous = [][]byte{[]byte(c.IdentityPreviousKey)}
+	}
+	return keys
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"log/slog"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+	lh "github.com/pratchaya-maneechot/service-exchange/libs/health"
 	"github.com/pratchaya-maneechot/service-exchange/libs/infra/observability"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
@@ -19,6 +21,8 @@ type GRPCServer struct {
 	server          *grpc.Server
 	logger          *slog.Logger
 	healthServer    *health.Server
+	health          *lh.Registry
+	certs           *certReloader
 	address         string
 	shutdownTimeout time.Duration
 }
@@ -30,29 +34,98 @@ type ConfigGRPCServer struct {
 	ShutdownTimeout   time.Duration
 	MetricsRecorder   *observability.MetricsRecorder
 	Options           []grpc.ServerOption
+	// Messages renders errors in the caller's language; nil uses the shared
+	// messages only.
+	Messages *Catalogue
+	// LocaleResolver looks up a signed-in caller's language preference.
+	LocaleResolver LocaleResolver
+	// RateLimit throttles clients; the zero value leaves calls unlimited.
+	RateLimit ConfigRateLimit
+	// TLS serves over TLS, and mutual TLS when it has a CA file; nil serves
+	// plaintext.
+	TLS *ConfigTLS
+	// Identity checks the identity tokens callers are taken from. Without
+	// a key every call carrying one is rejected, and the rest are anonymous.
+	Identity IdentityKeys
+	// Health drives the health service: each service is serving while the
+	// critical checks it needs pass. Nil reports every service as serving.
+	Health *lh.Registry
 }
 
 func NewServer(cfg ConfigGRPCServer, logger *slog.Logger) (*GRPCServer, error) {
+	messages := cfg.Messages
+	if messages == nil {
+		messages = NewCatalogue()
+	}
 	interceptors := []grpc.UnaryServerInterceptor{
 		UnaryRecoveryInterceptor(logger),
 		UnaryTraceInterceptor(),
+		UnaryCallerInterceptor(cfg.Identity),
+		UnaryPeerIdentityInterceptor(),
+		UnaryLocaleInterceptor(messages, cfg.LocaleResolver),
 		UnaryLoggerInterceptor(logger),
 	}
+	// Every unary interceptor has a stream counterpart in the same place of
+	// the chain, so streams are not a way around any of them.
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		StreamRecoveryInterceptor(logger),
+		StreamTraceInterceptor(),
+		StreamCallerInterceptor(cfg.Identity),
+		StreamPeerIdentityInterceptor(),
+		StreamLocaleInterceptor(messages, cfg.LocaleResolver),
+		StreamLoggerInterceptor(logger),
+	}
 
 	if cfg.MetricsRecorder != nil {
 		interceptors = append(interceptors, UnaryMetricsInterceptor(*cfg.MetricsRecorder))
+		streamInterceptors = append(streamInterceptors, StreamMetricsInterceptor(*cfg.MetricsRecorder))
+	}
+	if cfg.RateLimit.Enabled() {
+		var recorder observability.MetricsRecorder
+		if cfg.MetricsRecorder != nil {
+			recorder = *cfg.MetricsRecorder
+		}
+		rateLimitCfg := cfg.RateLimit
+		if rateLimitCfg.Store == nil {
+			// One store for both interceptors rather than one each.
+			rateLimitCfg.Store = NewMemoryRateLimitStore()
+		}
+		rateLimit, err := UnaryRateLimitInterceptor(rateLimitCfg, recorder, logger)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to configure rate limiting")
+		}
+		streamRateLimit, err := StreamRateLimitInterceptor(rateLimitCfg, recorder, logger)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to configure rate limiting")
+		}
+		interceptors = append(interceptors, rateLimit)
+		streamInterceptors = append(streamInterceptors, streamRateLimit)
+	}
+	interceptors = append(interceptors, UnaryValidationInterceptor())
+	streamInterceptors = append(streamInterceptors, StreamValidationInterceptor())
+
+	opts := append(cfg.Options,
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+	)
+	var certs *certReloader
+	if cfg.TLS != nil {
+		creds, reloader, err := newServerCredentials(*cfg.TLS, logger)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to configure TLS")
+		}
+		opts = append(opts, grpc.Creds(creds))
+		certs = reloader
+		logger.Info("TLS enabled", "mutual", cfg.TLS.CAFile != "")
 	}
-
-	opts := append(cfg.Options, grpc.ChainUnaryInterceptor(interceptors...), grpc.StatsHandler(otelgrpc.NewServerHandler()))
 	grpcServer := grpc.NewServer(opts...)
 
 	var healthServer *health.Server
 	if cfg.EnableHealthCheck {
 		healthServer = health.NewServer()
 		grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
-		healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
-		healthServer.SetServingStatus("user.UserService", grpc_health_v1.HealthCheckResponse_SERVING)
-		logger.Info("health check service enabled")
+		logger.Info("health check service enabled", "checks", cfg.Health != nil)
 	}
 
 	if cfg.EnableReflection {
@@ -60,13 +133,19 @@ func NewServer(cfg ConfigGRPCServer, logger *slog.Logger) (*GRPCServer, error) {
 		logger.Info("gRPC reflection enabled")
 	}
 
-	return &GRPCServer{
+	server := &GRPCServer{
 		server:          grpcServer,
 		logger:          logger,
 		healthServer:    healthServer,
+		health:          cfg.Health,
+		certs:           certs,
 		shutdownTimeout: cfg.ShutdownTimeout,
 		address:         cfg.Address,
-	}, nil
+	}
+	if healthServer != nil && cfg.Health != nil {
+		cfg.Health.OnChange(server.updateHealth)
+	}
+	return server, nil
 }
 
 func (s *GRPCServer) RegisHandler(fn func(*grpc.Server)) error {
@@ -81,6 +160,11 @@ func (s *GRPCServer) Start(ctx context.Context) error {
 	}
 
 	go s.handleShutdown(ctx)
+	// Services are registered by now.
+	s.updateHealth()
+	if s.certs != nil {
+		go s.certs.watch(ctx)
+	}
 
 	s.logger.Info("gRPC server starting", "address", s.address)
 	if err := s.server.Serve(listener); err != nil {
@@ -95,8 +179,8 @@ func (s *GRPCServer) handleShutdown(ctx context.Context) {
 	s.logger.Info("initiating graceful shutdown...")
 
 	if s.healthServer != nil {
-		s.healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
-		s.healthServer.SetServingStatus("user.UserService", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+		// Every service stops serving, and stays so whatever the checks say.
+		s.healthServer.Shutdown()
 	}
 
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
@@ -116,3 +200,25 @@ func (s *GRPCServer) handleShutdown(ctx context.Context) {
 		s.server.Stop()
 	}
 }
+
+// updateHealth sets the status of the server under the empty name, and of
+// every service registered on it under its own name.
+func (s *GRPCServer) updateHealth() {
+	if s.healthServer == nil {
+		return
+	}
+	status := func(service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+		if s.health == nil || s.health.Ready(service) {
+			return grpc_health_v1.HealthCheckResponse_SERVING
+		}
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	s.healthServer.SetServingStatus("", status(""))
+	for service := range s.server.GetServiceInfo() {
+		// The health and reflection services themselves.
+		if strings.HasPrefix(service, "grpc.") {
+			continue
+		}
+		s.healthServer.SetServingStatus(service, status(service))
+	}
+}
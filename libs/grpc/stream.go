@@ -0,0 +1,23 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// serverStream replaces the context of a stream, so that stream interceptors
+// can hand values down to the handler the way unary interceptors do.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamWithContext returns ss with its context replaced by ctx.
+func StreamWithContext(ss grpc.ServerStream, ctx context.Context) grpc.ServerStream {
+	return &serverStream{ServerStream: ss, ctx: ctx}
+}
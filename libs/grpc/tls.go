@@ -0,0 +1,304 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// ConfigTLS holds the certificate files of a server or client. The files are
+// watched and reloaded when they change, so rotated certificates are picked
+// up without a restart.
+type ConfigTLS struct {
+	// CertFile and KeyFile are the PEM certificate and key presented to the
+	// other side. Servers need them; clients only for mutual TLS.
+	CertFile string
+	KeyFile  string
+	// CAFile holds the PEM CAs the other side's certificate must chain to.
+	// On a server it turns on mutual TLS: clients must present a certificate
+	// from one of them. On a client it replaces the system roots.
+	CAFile string
+	// ServerName is the name a client checks the server certificate for;
+	// empty uses the host of the target.
+	ServerName string
+	// ServerSPIFFEID makes a client check the server certificate for this
+	// spiffe:// URI instead of a host name. It needs CAFile.
+	ServerSPIFFEID string
+}
+
+// PeerIdentity is who a mutual TLS peer proved to be with its certificate.
+type PeerIdentity struct {
+	// SPIFFEID is the spiffe:// URI of the certificate, such as
+	// spiffe://service-exchange/ns/prod/sa/api-gateway; empty when it has
+	// none.
+	SPIFFEID    string
+	TrustDomain string
+	Path        string
+	CommonName  string
+}
+
+type peerIdentityCtxKey struct{}
+
+// PeerIdentityFromCtx returns the identity stored by
+// UnaryPeerIdentityInterceptor, if the peer presented a verified certificate.
+func PeerIdentityFromCtx(ctx context.Context) (PeerIdentity, bool) {
+	id, ok := ctx.Value(peerIdentityCtxKey{}).(PeerIdentity)
+	return id, ok
+}
+
+// UnaryPeerIdentityInterceptor stores the identity of the peer's verified
+// client certificate on the context.
+func UnaryPeerIdentityInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if id, ok := peerIdentity(ctx); ok {
+			ctx = context.WithValue(ctx, peerIdentityCtxKey{}, id)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamPeerIdentityInterceptor is UnaryPeerIdentityInterceptor for streams.
+func StreamPeerIdentityInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if id, ok := peerIdentity(ss.Context()); ok {
+			ss = StreamWithContext(ss, context.WithValue(ss.Context(), peerIdentityCtxKey{}, id))
+		}
+		return handler(srv, ss)
+	}
+}
+
+func peerIdentity(ctx context.Context) (PeerIdentity, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return PeerIdentity{}, false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return PeerIdentity{}, false
+	}
+	leaf := info.State.VerifiedChains[0][0]
+	id := PeerIdentity{CommonName: leaf.Subject.CommonName}
+	for _, u := range leaf.URIs {
+		if u.Scheme == "spiffe" {
+			id.SPIFFEID = u.String()
+			id.TrustDomain = u.Host
+			id.Path = u.Path
+			break
+		}
+	}
+	return id, true
+}
+
+// NewClientCredentials returns the transport credentials for calls to other
+// services. The certificate files are watched until ctx is done.
+func NewClientCredentials(ctx context.Context, cfg ConfigTLS, logger *slog.Logger) (credentials.TransportCredentials, error) {
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return nil, errors.New("tls: a client certificate needs both a certificate and a key file")
+	}
+	if cfg.ServerSPIFFEID != "" && cfg.CAFile == "" {
+		return nil, errors.New("tls: checking the server SPIFFE ID needs a CA file")
+	}
+	certs, err := newCertReloader(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+	go certs.watch(ctx)
+	return credentials.NewTLS(certs.clientConfig()), nil
+}
+
+// newServerCredentials returns the transport credentials of a server and
+// the reloader keeping its certificates current.
+func newServerCredentials(cfg ConfigTLS, logger *slog.Logger) (credentials.TransportCredentials, *certReloader, error) {
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, nil, errors.New("tls: a server needs a certificate and a key file")
+	}
+	certs, err := newCertReloader(cfg, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	return credentials.NewTLS(certs.serverConfig()), certs, nil
+}
+
+// certReloader holds the current certificate and CAs, and reloads them when
+// their files change.
+type certReloader struct {
+	cfg    ConfigTLS
+	logger *slog.Logger
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+	cas  *x509.CertPool
+}
+
+func newCertReloader(cfg ConfigTLS, logger *slog.Logger) (*certReloader, error) {
+	r := &certReloader{cfg: cfg, logger: logger}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *certReloader) load() error {
+	var cert *tls.Certificate
+	if r.cfg.CertFile != "" {
+		c, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+		if err != nil {
+			return fmt.Errorf("tls: load certificate %s: %w", r.cfg.CertFile, err)
+		}
+		cert = &c
+	}
+	var cas *x509.CertPool
+	if r.cfg.CAFile != "" {
+		pem, err := os.ReadFile(r.cfg.CAFile)
+		if err != nil {
+			return fmt.Errorf("tls: read CA file: %w", err)
+		}
+		cas = x509.NewCertPool()
+		if !cas.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("tls: no certificates in CA file %s", r.cfg.CAFile)
+		}
+	}
+
+	r.mu.Lock()
+	r.cert, r.cas = cert, cas
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *certReloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, r.cas
+}
+
+// watch reloads the files whenever their directories change, until ctx is
+// done. Directories are watched rather than the files, since certificates
+// are usually replaced by renaming, such as Kubernetes swapping the
+// symlink of a mounted secret. A failed reload keeps the previous files.
+func (r *certReloader) watch(ctx context.Context) {
+	dirs := make(map[string]bool)
+	for _, f := range []string{r.cfg.CertFile, r.cfg.KeyFile, r.cfg.CAFile} {
+		if f != "" {
+			dirs[filepath.Dir(f)] = true
+		}
+	}
+	if len(dirs) == 0 {
+		return
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		r.logger.Error("cannot watch TLS certificates, they will not be reloaded", "error", err)
+		return
+	}
+	defer watcher.Close()
+	for dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			r.logger.Error("cannot watch TLS certificates, they will not be reloaded", "dir", dir, "error", err)
+			return
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Has(fsnotify.Chmod) {
+				continue
+			}
+			if err := r.load(); err != nil {
+				r.logger.Warn("TLS certificate reload failed, keeping the previous one", "error", err)
+				continue
+			}
+			r.logger.Info("TLS certificates reloaded", "file", event.Name)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			r.logger.Warn("TLS certificate watcher error", "error", err)
+		}
+	}
+}
+
+// serverConfig hands every handshake the current certificate and, for
+// mutual TLS, the current client CAs.
+func (r *certReloader) serverConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, cas := r.current()
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				// The config returned here replaces the one gRPC added h2 to.
+				NextProtos: []string{"h2"},
+			}
+			if cas != nil {
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+				cfg.ClientCAs = cas
+			}
+			return cfg, nil
+		},
+	}
+}
+
+// clientConfig presents the current client certificate and, when there is
+// a CA file, checks the server against the current CAs.
+func (r *certReloader) clientConfig() *tls.Config {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: r.cfg.ServerName}
+	if r.cfg.CertFile != "" {
+		cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := r.current()
+			return cert, nil
+		}
+	}
+	if r.cfg.CAFile != "" {
+		// RootCAs cannot change after the handshake starts, so the chain is
+		// checked here against the CAs loaded last.
+		cfg.InsecureSkipVerify = true
+		cfg.VerifyConnection = r.verifyServer
+	}
+	return cfg
+}
+
+func (r *certReloader) verifyServer(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("tls: server presented no certificate")
+	}
+	_, cas := r.current()
+	opts := x509.VerifyOptions{Roots: cas, Intermediates: x509.NewCertPool()}
+	if r.cfg.ServerSPIFFEID == "" {
+		opts.DNSName = cs.ServerName
+	}
+	for _, c := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(c)
+	}
+	leaf := cs.PeerCertificates[0]
+	if _, err := leaf.Verify(opts); err != nil {
+		return err
+	}
+	if r.cfg.ServerSPIFFEID == "" {
+		return nil
+	}
+	for _, u := range leaf.URIs {
+		if u.String() == r.cfg.ServerSPIFFEID {
+			return nil
+		}
+	}
+	return fmt.Errorf("tls: server certificate is not for %s", r.cfg.ServerSPIFFEID)
+}
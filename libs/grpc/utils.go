@@ -1,6 +1,7 @@
 package grpc
 
 import (
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -23,29 +24,20 @@ func PtrToStringValue(s *string) *wrapperspb.StringValue {
 	return wrapperspb.String(*s)
 }
 
-// AnyMapToStringMap converts a map[string]any to a map[string]string,
-// including only values that are actually strings.
-func AnyMapToStringMap(m map[string]any) map[string]string {
-	if m == nil {
+// StructToMap converts a *structpb.Struct into a map[string]any holding
+// JSON-decoded values. Returns nil if the input is nil.
+func StructToMap(s *structpb.Struct) map[string]any {
+	if s == nil {
 		return nil
 	}
-	res := make(map[string]string)
-	for k, v := range m {
-		if _v, ok := v.(string); ok {
-			res[k] = _v
-		}
-	}
-	return res
+	return s.AsMap()
 }
 
-// StringMapToAnyMap converts a map[string]string to a map[string]any.
-func StringMapToAnyMap(m map[string]string) map[string]any {
+// MapToStruct converts a map of JSON-compatible values into a *structpb.Struct.
+// Returns nil if the input map is nil.
+func MapToStruct(m map[string]any) (*structpb.Struct, error) {
 	if m == nil {
-		return nil
-	}
-	res := make(map[string]any)
-	for k, v := range m {
-		res[k] = v
+		return nil, nil
 	}
-	return res
+	return structpb.NewStruct(m)
 }
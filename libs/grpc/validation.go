@@ -0,0 +1,709 @@
+package grpc
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// UnaryValidationInterceptor checks requests against the buf.validate rules
+// declared on their fields in the .proto files before the handler runs. A
+// request that breaks any fails with InvalidArgument and a BadRequest field
+// violation per broken rule, rendered like the validation errors of the bus.
+//
+// It evaluates the standard rules the services declare: required and
+// ignore; the string, bool, enum and numeric rules, on scalars and on
+// wrapper types; the repeated and map rules; required oneofs; and the rules
+// of nested messages. A rule it cannot evaluate, such as a CEL expression,
+// fails every call of the method with Internal rather than being skipped.
+func UnaryValidationInterceptor() grpc.UnaryServerInterceptor {
+	v := &protoValidator{}
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if msg, ok := req.(proto.Message); ok {
+			if err := v.validate(msg); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamValidationInterceptor is UnaryValidationInterceptor for streams:
+// every message the client sends is checked as it is received.
+func StreamValidationInterceptor() grpc.StreamServerInterceptor {
+	v := &protoValidator{}
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss, v: v})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+	v *protoValidator
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		return s.v.validate(msg)
+	}
+	return nil
+}
+
+// protoValidator evaluates buf.validate rules. The rules of a message type
+// are read from its descriptor once and cached.
+type protoValidator struct {
+	messages sync.Map // protoreflect.FullName -> *messageRules
+	patterns sync.Map // string -> *regexp.Regexp
+}
+
+type messageRules struct {
+	fields []fieldRules
+	// oneofs are the oneofs one of whose fields must be set.
+	oneofs []protoreflect.OneofDescriptor
+	// err is a rule on the message that cannot be evaluated.
+	err error
+}
+
+type fieldRules struct {
+	fd protoreflect.FieldDescriptor
+	// rules is nil for message fields that only have rules of their own.
+	rules *validate.FieldRules
+}
+
+func (v *protoValidator) validate(msg proto.Message) error {
+	var violations []fieldViolation
+	if err := v.validateMessage(msg.ProtoReflect(), "", &violations); err != nil {
+		return status.Errorf(codes.Internal, "cannot validate request: %v", err)
+	}
+	if len(violations) > 0 {
+		return &validationError{violations: violations}
+	}
+	return nil
+}
+
+func (v *protoValidator) validateMessage(m protoreflect.Message, prefix string, out *[]fieldViolation) error {
+	mr := v.rulesFor(m.Descriptor())
+	if mr.err != nil {
+		return mr.err
+	}
+
+	for _, od := range mr.oneofs {
+		if m.WhichOneof(od) == nil {
+			*out = append(*out, fieldViolation{field: prefix + string(od.Name()), key: "validation.required", tag: "required"})
+		}
+	}
+
+	for _, f := range mr.fields {
+		fd, path := f.fd, prefix+string(f.fd.Name())
+		has := m.Has(fd)
+		if r := f.rules; r != nil {
+			if r.GetIgnore() == validate.Ignore_IGNORE_ALWAYS {
+				continue
+			}
+			if r.GetRequired() && !has {
+				*out = append(*out, fieldViolation{field: path, key: "validation.required", tag: "required"})
+				continue
+			}
+			if !has && (fd.HasPresence() || r.GetIgnore() == validate.Ignore_IGNORE_IF_ZERO_VALUE) {
+				continue
+			}
+			v.checkField(fd, m.Get(fd), r, path, out)
+		}
+		if has {
+			if err := v.validateNested(fd, m.Get(fd), path, out); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateNested validates the messages a field holds against their own
+// rules.
+func (v *protoValidator) validateNested(fd protoreflect.FieldDescriptor, val protoreflect.Value, path string, out *[]fieldViolation) error {
+	switch {
+	case fd.IsMap():
+		if !hasOwnRules(fd.MapValue()) {
+			return nil
+		}
+		m := val.Map()
+		for _, k := range sortedMapKeys(m) {
+			if err := v.validateMessage(m.Get(k).Message(), path+mapIndex(k)+".", out); err != nil {
+				return err
+			}
+		}
+		return nil
+	case !hasOwnRules(fd):
+		return nil
+	case fd.IsList():
+		list := val.List()
+		for i := 0; i < list.Len(); i++ {
+			if err := v.validateMessage(list.Get(i).Message(), path+"["+strconv.Itoa(i)+"].", out); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return v.validateMessage(val.Message(), path+".", out)
+	}
+}
+
+// hasOwnRules reports whether values of the field are messages that may
+// declare rules. The well-known types declare none.
+func hasOwnRules(fd protoreflect.FieldDescriptor) bool {
+	md := fd.Message()
+	return md != nil && md.ParentFile().Package() != "google.protobuf"
+}
+
+func (v *protoValidator) rulesFor(md protoreflect.MessageDescriptor) *messageRules {
+	if mr, ok := v.messages.Load(md.FullName()); ok {
+		return mr.(*messageRules)
+	}
+	mr, _ := v.messages.LoadOrStore(md.FullName(), v.compile(md))
+	return mr.(*messageRules)
+}
+
+func (v *protoValidator) compile(md protoreflect.MessageDescriptor) *messageRules {
+	mr := &messageRules{}
+	if proto.HasExtension(md.Options(), validate.E_Message) {
+		mr.err = fmt.Errorf("message rules on %s are not supported", md.FullName())
+		return mr
+	}
+
+	oneofs := md.Oneofs()
+	for i := 0; i < oneofs.Len(); i++ {
+		od := oneofs.Get(i)
+		if !proto.HasExtension(od.Options(), validate.E_Oneof) {
+			continue
+		}
+		if proto.GetExtension(od.Options(), validate.E_Oneof).(*validate.OneofRules).GetRequired() {
+			mr.oneofs = append(mr.oneofs, od)
+		}
+	}
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		var rules *validate.FieldRules
+		if proto.HasExtension(fd.Options(), validate.E_Field) {
+			rules = proto.GetExtension(fd.Options(), validate.E_Field).(*validate.FieldRules)
+			if err := v.checkSupported(fd, rules, false); err != nil {
+				mr.err = fmt.Errorf("field %s: %w", fd.FullName(), err)
+				return mr
+			}
+		}
+		if rules != nil || hasOwnRules(fd) || (fd.IsMap() && hasOwnRules(fd.MapValue())) {
+			mr.fields = append(mr.fields, fieldRules{fd: fd, rules: rules})
+		}
+	}
+	return mr
+}
+
+// supportedRules are the rules evaluated for each kind of type rules.
+var supportedRules = map[protoreflect.Name][]protoreflect.Name{
+	"StringRules": {"const", "len", "min_len", "max_len", "pattern", "prefix", "suffix", "contains", "not_contains",
+		"in", "not_in", "email", "hostname", "ip", "ipv4", "ipv6", "uri", "uuid", "example"},
+	"BoolRules":     {"const", "example"},
+	"EnumRules":     {"const", "defined_only", "in", "not_in", "example"},
+	"numeric":       {"const", "lt", "lte", "gt", "gte", "in", "not_in", "finite", "example"},
+	"RepeatedRules": {"min_items", "max_items", "unique", "items"},
+	"MapRules":      {"min_pairs", "max_pairs", "keys", "values"},
+}
+
+// rulesForKind names the type rules that apply to each scalar kind.
+var rulesForKind = map[protoreflect.Kind]protoreflect.Name{
+	protoreflect.StringKind:   "StringRules",
+	protoreflect.BoolKind:     "BoolRules",
+	protoreflect.EnumKind:     "EnumRules",
+	protoreflect.BytesKind:    "BytesRules",
+	protoreflect.FloatKind:    "FloatRules",
+	protoreflect.DoubleKind:   "DoubleRules",
+	protoreflect.Int32Kind:    "Int32Rules",
+	protoreflect.Int64Kind:    "Int64Rules",
+	protoreflect.Uint32Kind:   "UInt32Rules",
+	protoreflect.Uint64Kind:   "UInt64Rules",
+	protoreflect.Sint32Kind:   "SInt32Rules",
+	protoreflect.Sint64Kind:   "SInt64Rules",
+	protoreflect.Fixed32Kind:  "Fixed32Rules",
+	protoreflect.Fixed64Kind:  "Fixed64Rules",
+	protoreflect.Sfixed32Kind: "SFixed32Rules",
+	protoreflect.Sfixed64Kind: "SFixed64Rules",
+}
+
+// checkSupported fails for rules the validator cannot evaluate and for type
+// rules that do not match the field, as protovalidate would. item is set
+// for the rules of the items of a repeated field.
+func (v *protoValidator) checkSupported(fd protoreflect.FieldDescriptor, r *validate.FieldRules, item bool) error {
+	if len(r.GetCel()) > 0 {
+		return fmt.Errorf("CEL rules are not supported")
+	}
+	rules := typeRules(r)
+	if rules == nil {
+		return nil
+	}
+	name := rules.Descriptor().Name()
+	isList := fd.IsList() && !item
+
+	switch {
+	case isList && name == "RepeatedRules":
+		items := r.GetRepeated().GetItems()
+		if r.GetRepeated().GetUnique() && fd.Message() != nil {
+			return fmt.Errorf("unique is only supported on scalar items")
+		}
+		if items != nil {
+			if items.GetRequired() {
+				return fmt.Errorf("required is not supported on items")
+			}
+			if err := v.checkSupported(fd, items, true); err != nil {
+				return fmt.Errorf("items: %w", err)
+			}
+		}
+	case fd.IsMap() && name == "MapRules":
+		parts := []struct {
+			name  string
+			fd    protoreflect.FieldDescriptor
+			rules *validate.FieldRules
+		}{
+			{"keys", fd.MapKey(), r.GetMap().GetKeys()},
+			{"values", fd.MapValue(), r.GetMap().GetValues()},
+		}
+		for _, part := range parts {
+			if part.rules == nil {
+				continue
+			}
+			if part.rules.GetRequired() {
+				return fmt.Errorf("required is not supported on map %s", part.name)
+			}
+			if err := v.checkSupported(part.fd, part.rules, false); err != nil {
+				return fmt.Errorf("%s: %w", part.name, err)
+			}
+		}
+	case isList || fd.IsMap():
+		return fmt.Errorf("%s do not apply to a %s field", name, fieldShape(fd))
+	default:
+		scalar := scalarOf(fd)
+		if scalar == nil || rulesForKind[scalar.Kind()] != name {
+			return fmt.Errorf("%s do not apply to a %s field", name, fieldShape(fd))
+		}
+	}
+
+	allowed := supportedRules[name]
+	if isNumericRules(name) {
+		allowed = supportedRules["numeric"]
+	}
+	fields := rules.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		rule := fields.Get(i)
+		if rules.Has(rule) && !slices.Contains(allowed, rule.Name()) {
+			return fmt.Errorf("rule %s.%s is not supported", name, rule.Name())
+		}
+	}
+
+	if isNumericRules(name) {
+		lower, upper := rangeBound(rules, "gt", "gte"), rangeBound(rules, "lt", "lte")
+		if lower.IsValid() && upper.IsValid() && compareNumbers(lower, upper) > 0 {
+			return fmt.Errorf("exclusive ranges are not supported")
+		}
+	}
+	if sr := r.GetString(); sr.HasPattern() {
+		if _, err := v.pattern(sr.GetPattern()); err != nil {
+			return fmt.Errorf("invalid pattern: %w", err)
+		}
+	}
+	return nil
+}
+
+// checkField evaluates the type rules of a field that is set or must be
+// checked when unset.
+func (v *protoValidator) checkField(fd protoreflect.FieldDescriptor, val protoreflect.Value, r *validate.FieldRules, path string, out *[]fieldViolation) {
+	switch {
+	case fd.IsList():
+		rr := r.GetRepeated()
+		if rr == nil {
+			return
+		}
+		list := val.List()
+		n := uint64(list.Len())
+		if rr.HasMinItems() && n < rr.GetMinItems() {
+			*out = append(*out, fieldViolation{field: path, key: "validation.min.items", tag: "repeated.min_items", param: strconv.FormatUint(rr.GetMinItems(), 10)})
+		}
+		if rr.HasMaxItems() && n > rr.GetMaxItems() {
+			*out = append(*out, fieldViolation{field: path, key: "validation.max.items", tag: "repeated.max_items", param: strconv.FormatUint(rr.GetMaxItems(), 10)})
+		}
+		if rr.GetUnique() {
+			seen := make(map[any]struct{}, list.Len())
+			for i := 0; i < list.Len(); i++ {
+				item := list.Get(i).Interface()
+				if _, dup := seen[item]; dup {
+					*out = append(*out, fieldViolation{field: path, key: "validation.unique", tag: "repeated.unique"})
+					break
+				}
+				seen[item] = struct{}{}
+			}
+		}
+		if items := rr.GetItems(); items != nil && items.GetIgnore() != validate.Ignore_IGNORE_ALWAYS {
+			for i := 0; i < list.Len(); i++ {
+				v.checkValue(fd, list.Get(i), items, path+"["+strconv.Itoa(i)+"]", out)
+			}
+		}
+	case fd.IsMap():
+		mr := r.GetMap()
+		if mr == nil {
+			return
+		}
+		m := val.Map()
+		n := uint64(m.Len())
+		if mr.HasMinPairs() && n < mr.GetMinPairs() {
+			*out = append(*out, fieldViolation{field: path, key: "validation.min.items", tag: "map.min_pairs", param: strconv.FormatUint(mr.GetMinPairs(), 10)})
+		}
+		if mr.HasMaxPairs() && n > mr.GetMaxPairs() {
+			*out = append(*out, fieldViolation{field: path, key: "validation.max.items", tag: "map.max_pairs", param: strconv.FormatUint(mr.GetMaxPairs(), 10)})
+		}
+		if mr.GetKeys() == nil && mr.GetValues() == nil {
+			return
+		}
+		for _, k := range sortedMapKeys(m) {
+			entry := path + mapIndex(k)
+			if keys := mr.GetKeys(); keys != nil && keys.GetIgnore() != validate.Ignore_IGNORE_ALWAYS {
+				v.checkValue(fd.MapKey(), k.Value(), keys, entry, out)
+			}
+			if values := mr.GetValues(); values != nil && values.GetIgnore() != validate.Ignore_IGNORE_ALWAYS {
+				v.checkValue(fd.MapValue(), m.Get(k), values, entry, out)
+			}
+		}
+	default:
+		v.checkValue(fd, val, r, path, out)
+	}
+}
+
+// checkValue evaluates the type rules of one value: a singular field, a
+// list item, or a map key or value.
+func (v *protoValidator) checkValue(fd protoreflect.FieldDescriptor, val protoreflect.Value, r *validate.FieldRules, path string, out *[]fieldViolation) {
+	rules := typeRules(r)
+	if rules == nil {
+		return
+	}
+	if fd.Message() != nil {
+		inner := fd.Message().Fields().ByName("value")
+		fd, val = inner, val.Message().Get(inner)
+	}
+	if r.GetIgnore() == validate.Ignore_IGNORE_IF_ZERO_VALUE && val.Equal(fd.Default()) {
+		return
+	}
+
+	switch rules.Descriptor().Name() {
+	case "StringRules":
+		v.checkString(val.String(), r.GetString(), path, out)
+	case "BoolRules":
+		if br := r.GetBool(); br.HasConst() && val.Bool() != br.GetConst() {
+			*out = append(*out, fieldViolation{field: path, key: "validation.eq", tag: "bool.const", param: strconv.FormatBool(br.GetConst())})
+		}
+	case "EnumRules":
+		checkEnum(fd.Enum(), val.Enum(), r.GetEnum(), path, out)
+	default:
+		checkNumber(val, rules, path, out)
+	}
+}
+
+func (v *protoValidator) checkString(s string, sr *validate.StringRules, path string, out *[]fieldViolation) {
+	add := func(key, rule, param string) {
+		*out = append(*out, fieldViolation{field: path, key: key, tag: "string." + rule, param: param})
+	}
+	n := uint64(utf8.RuneCountInString(s))
+
+	if sr.HasConst() && s != sr.GetConst() {
+		add("validation.eq", "const", sr.GetConst())
+	}
+	if sr.HasLen() && n != sr.GetLen() {
+		add("validation.len.string", "len", strconv.FormatUint(sr.GetLen(), 10))
+	}
+	if sr.HasMinLen() && n < sr.GetMinLen() {
+		add("validation.min.string", "min_len", strconv.FormatUint(sr.GetMinLen(), 10))
+	}
+	if sr.HasMaxLen() && n > sr.GetMaxLen() {
+		add("validation.max.string", "max_len", strconv.FormatUint(sr.GetMaxLen(), 10))
+	}
+	if sr.HasPattern() {
+		if re, err := v.pattern(sr.GetPattern()); err == nil && !re.MatchString(s) {
+			add("validation.pattern", "pattern", sr.GetPattern())
+		}
+	}
+	if sr.HasPrefix() && !strings.HasPrefix(s, sr.GetPrefix()) {
+		add("validation.startswith", "prefix", sr.GetPrefix())
+	}
+	if sr.HasSuffix() && !strings.HasSuffix(s, sr.GetSuffix()) {
+		add("validation.endswith", "suffix", sr.GetSuffix())
+	}
+	if sr.HasContains() && !strings.Contains(s, sr.GetContains()) {
+		add("validation.contains", "contains", sr.GetContains())
+	}
+	if sr.HasNotContains() && strings.Contains(s, sr.GetNotContains()) {
+		add("validation.excludes", "not_contains", sr.GetNotContains())
+	}
+	if in := sr.GetIn(); len(in) > 0 && !slices.Contains(in, s) {
+		add("validation.oneof", "in", strings.Join(in, " "))
+	}
+	if notIn := sr.GetNotIn(); slices.Contains(notIn, s) {
+		add("validation.not_in", "not_in", strings.Join(notIn, " "))
+	}
+
+	switch {
+	case sr.GetEmail() && !isEmail(s):
+		add("validation.email", "email", "")
+	case sr.GetHostname() && !isHostname(s):
+		add("validation.hostname", "hostname", "")
+	case sr.GetIp() && net.ParseIP(s) == nil:
+		add("validation.ip", "ip", "")
+	case sr.GetIpv4() && (net.ParseIP(s) == nil || !strings.Contains(s, ".") || strings.Contains(s, ":")):
+		add("validation.ipv4", "ipv4", "")
+	case sr.GetIpv6() && (net.ParseIP(s) == nil || !strings.Contains(s, ":")):
+		add("validation.ipv6", "ipv6", "")
+	case sr.GetUri() && !isURI(s):
+		add("validation.url", "uri", "")
+	case sr.GetUuid() && !uuidPattern.MatchString(s):
+		add("validation.uuid", "uuid", "")
+	}
+}
+
+func checkEnum(ed protoreflect.EnumDescriptor, n protoreflect.EnumNumber, er *validate.EnumRules, path string, out *[]fieldViolation) {
+	add := func(key, rule, param string) {
+		*out = append(*out, fieldViolation{field: path, key: key, tag: "enum." + rule, param: param})
+	}
+	names := func(numbers []int32) string {
+		parts := make([]string, len(numbers))
+		for i, num := range numbers {
+			parts[i] = strconv.Itoa(int(num))
+			if ev := ed.Values().ByNumber(protoreflect.EnumNumber(num)); ev != nil {
+				parts[i] = string(ev.Name())
+			}
+		}
+		return strings.Join(parts, " ")
+	}
+
+	if er.HasConst() && int32(n) != er.GetConst() {
+		add("validation.eq", "const", names([]int32{er.GetConst()}))
+	}
+	if er.GetDefinedOnly() && ed.Values().ByNumber(n) == nil {
+		add("validation.enum", "defined_only", "")
+	}
+	if in := er.GetIn(); len(in) > 0 && !slices.Contains(in, int32(n)) {
+		add("validation.oneof", "in", names(in))
+	}
+	if notIn := er.GetNotIn(); slices.Contains(notIn, int32(n)) {
+		add("validation.not_in", "not_in", names(notIn))
+	}
+}
+
+// checkNumber evaluates the rules shared by every numeric type. The rules of
+// each type only differ in the type of their values.
+func checkNumber(val protoreflect.Value, rules protoreflect.Message, path string, out *[]fieldViolation) {
+	fields := rules.Descriptor().Fields()
+	prefix := strings.ToLower(strings.TrimSuffix(string(rules.Descriptor().Name()), "Rules")) + "."
+	add := func(key string, rule protoreflect.FieldDescriptor, param string) {
+		*out = append(*out, fieldViolation{field: path, key: key, tag: prefix + string(rule.Name()), param: param})
+	}
+
+	for i := 0; i < fields.Len(); i++ {
+		rule := fields.Get(i)
+		if !rules.Has(rule) {
+			continue
+		}
+		rv := rules.Get(rule)
+		switch rule.Name() {
+		case "const":
+			if compareNumbers(val, rv) != 0 {
+				add("validation.eq", rule, formatNumber(rv))
+			}
+		case "lt":
+			if compareNumbers(val, rv) >= 0 {
+				add("validation.lt", rule, formatNumber(rv))
+			}
+		case "lte":
+			if compareNumbers(val, rv) > 0 {
+				add("validation.lte", rule, formatNumber(rv))
+			}
+		case "gt":
+			if compareNumbers(val, rv) <= 0 {
+				add("validation.gt", rule, formatNumber(rv))
+			}
+		case "gte":
+			if compareNumbers(val, rv) < 0 {
+				add("validation.gte", rule, formatNumber(rv))
+			}
+		case "in", "not_in":
+			list, found := rv.List(), false
+			parts := make([]string, list.Len())
+			for j := 0; j < list.Len(); j++ {
+				parts[j] = formatNumber(list.Get(j))
+				found = found || compareNumbers(val, list.Get(j)) == 0
+			}
+			if rule.Name() == "in" && !found {
+				add("validation.oneof", rule, strings.Join(parts, " "))
+			}
+			if rule.Name() == "not_in" && found {
+				add("validation.not_in", rule, strings.Join(parts, " "))
+			}
+		case "finite":
+			if f := val.Float(); rv.Bool() && (math.IsInf(f, 0) || math.IsNaN(f)) {
+				add("validation.finite", rule, "")
+			}
+		}
+	}
+}
+
+// typeRules is the set member of the type oneof of r, such as its
+// StringRules, or nil when r has none.
+func typeRules(r *validate.FieldRules) protoreflect.Message {
+	m := r.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("type"))
+	if fd == nil {
+		return nil
+	}
+	return m.Get(fd).Message()
+}
+
+func isNumericRules(name protoreflect.Name) bool {
+	switch name {
+	case "StringRules", "BoolRules", "EnumRules", "BytesRules":
+		return false
+	}
+	for _, n := range rulesForKind {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// rangeBound is the exclusive or inclusive bound of numeric rules, if set.
+func rangeBound(rules protoreflect.Message, exclusive, inclusive protoreflect.Name) protoreflect.Value {
+	fields := rules.Descriptor().Fields()
+	for _, name := range []protoreflect.Name{exclusive, inclusive} {
+		if fd := fields.ByName(name); fd != nil && rules.Has(fd) {
+			return rules.Get(fd)
+		}
+	}
+	return protoreflect.Value{}
+}
+
+// scalarOf is the field type rules apply to: the field itself, or the value
+// of a wrapper type such as google.protobuf.StringValue.
+func scalarOf(fd protoreflect.FieldDescriptor) protoreflect.FieldDescriptor {
+	md := fd.Message()
+	if md == nil {
+		return fd
+	}
+	if md.ParentFile().Package() != "google.protobuf" || !strings.HasSuffix(string(md.Name()), "Value") {
+		return nil
+	}
+	value := md.Fields().ByName("value")
+	if value == nil || md.Fields().Len() != 1 {
+		return nil
+	}
+	return value
+}
+
+func fieldShape(fd protoreflect.FieldDescriptor) string {
+	switch {
+	case fd.IsMap():
+		return "map"
+	case fd.IsList():
+		return "repeated " + fd.Kind().String()
+	case fd.Message() != nil:
+		return string(fd.Message().FullName())
+	}
+	return fd.Kind().String()
+}
+
+func compareNumbers(a, b protoreflect.Value) int {
+	switch x := a.Interface().(type) {
+	case int32:
+		return cmp.Compare(int64(x), b.Int())
+	case int64:
+		return cmp.Compare(x, b.Int())
+	case uint32:
+		return cmp.Compare(uint64(x), b.Uint())
+	case uint64:
+		return cmp.Compare(x, b.Uint())
+	case float32, float64:
+		return cmp.Compare(a.Float(), b.Float())
+	}
+	return 0
+}
+
+func formatNumber(v protoreflect.Value) string {
+	return fmt.Sprint(v.Interface())
+}
+
+func mapIndex(k protoreflect.MapKey) string {
+	if s, ok := k.Interface().(string); ok {
+		return "[" + strconv.Quote(s) + "]"
+	}
+	return "[" + k.String() + "]"
+}
+
+func sortedMapKeys(m protoreflect.Map) []protoreflect.MapKey {
+	keys := make([]protoreflect.MapKey, 0, m.Len())
+	m.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	slices.SortFunc(keys, func(a, b protoreflect.MapKey) int {
+		if s, ok := a.Interface().(string); ok {
+			return strings.Compare(s, b.String())
+		}
+		return compareNumbers(a.Value(), b.Value())
+	})
+	return keys
+}
+
+func (v *protoValidator) pattern(expr string) (*regexp.Regexp, error) {
+	if re, ok := v.patterns.Load(expr); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	v.patterns.Store(expr, re)
+	return re, nil
+}
+
+var (
+	uuidPattern     = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	hostnamePattern = regexp.MustCompile(`^(?i:[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?)(?:\.(?i:[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?))*$`)
+)
+
+func isEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Name == "" && addr.Address == s
+}
+
+func isHostname(s string) bool {
+	return len(s) <= 253 && hostnamePattern.MatchString(strings.TrimSuffix(s, "."))
+}
+
+func isURI(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.Scheme != ""
+}
@@ -0,0 +1,3 @@
+module github.com/pratchaya-maneechot/service-exchange/libs/health
+
+go 1.24.3
@@ -0,0 +1,344 @@
+// Package health keeps track of whether the dependencies of a service, such
+// as its database, are usable, so the service only reports itself ready
+// while it can actually serve.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Status is the outcome of a check, or of all of them.
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	// StatusWarn means only checks that are not critical fail: the service
+	// is ready, but some of its features may not work.
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+)
+
+const (
+	DefaultInterval = 10 * time.Second
+	DefaultTimeout  = 2 * time.Second
+)
+
+// Check is one dependency of the service.
+type Check struct {
+	// Name identifies the check in reports, such as postgres.
+	Name string
+	// Run returns nil when the dependency is usable. It should return once
+	// ctx is done; a run that does not is reported as failing until it
+	// returns.
+	Run func(ctx context.Context) error
+	// Timeout bounds one run; zero uses the registry's timeout.
+	Timeout time.Duration
+	// Critical checks make the service not ready when they fail. Others
+	// only mark it degraded.
+	Critical bool
+	// Services are the gRPC services that need the dependency, such as
+	// user.v1.UserService; empty means all of them.
+	Services []string
+}
+
+// CheckResult is the latest run of a check.
+type CheckResult struct {
+	Name     string   `json:"name"`
+	Status   Status   `json:"status"`
+	Critical bool     `json:"critical"`
+	Services []string `json:"services,omitempty"`
+	Error    string   `json:"error,omitempty"`
+	// Duration is how long the run took, such as 1.52ms.
+	Duration      string     `json:"duration"`
+	CheckedAt     time.Time  `json:"checkedAt"`
+	LastSuccessAt *time.Time `json:"lastSuccessAt,omitempty"`
+	// ConsecutiveFailures counts the failed runs since the last success.
+	ConsecutiveFailures int `json:"consecutiveFailures,omitempty"`
+}
+
+// Report is the status of the service, and the latest result of every
+// check sorted by name.
+type Report struct {
+	Status Status `json:"status"`
+	// Message tells why the service is not ready when no check says so,
+	// such as during shutdown.
+	Message   string        `json:"message,omitempty"`
+	CheckedAt *time.Time    `json:"checkedAt,omitempty"`
+	Checks    []CheckResult `json:"checks"`
+}
+
+type Config struct {
+	// Interval is how often the checks run; zero uses DefaultInterval.
+	Interval time.Duration
+	// Timeout bounds the runs of checks without their own; zero uses
+	// DefaultTimeout.
+	Timeout time.Duration
+}
+
+type registeredCheck struct {
+	Check
+	running atomic.Bool
+}
+
+// Registry runs the checks of a service periodically and keeps their latest
+// results.
+type Registry struct {
+	interval time.Duration
+	timeout  time.Duration
+	logger   *slog.Logger
+
+	mu        sync.RWMutex
+	checks    []*registeredCheck
+	results   map[string]CheckResult
+	startedAt time.Time
+	checkedAt time.Time
+	stopping  bool
+	listeners []func()
+}
+
+func NewRegistry(cfg Config, logger *slog.Logger) *Registry {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultInterval
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	return &Registry{
+		interval: cfg.Interval,
+		timeout:  cfg.Timeout,
+		logger:   logger,
+		results:  make(map[string]CheckResult),
+	}
+}
+
+// Register adds checks; they first run on the next evaluation. It panics
+// when a name is taken, as that is a wiring mistake.
+func (r *Registry) Register(checks ...Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, c := range checks {
+		if c.Name == "" || c.Run == nil {
+			panic("health: check needs a name and a Run function")
+		}
+		if slices.ContainsFunc(r.checks, func(rc *registeredCheck) bool { return rc.Name == c.Name }) {
+			panic(fmt.Sprintf("health: check %q registered twice", c.Name))
+		}
+		r.checks = append(r.checks, &registeredCheck{Check: c})
+	}
+}
+
+// OnChange calls fn after every evaluation that changed the status of a
+// check, and when the registry stops. It is how the gRPC health service
+// follows the checks.
+func (r *Registry) OnChange(fn func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.listeners = append(r.listeners, fn)
+}
+
+// Start runs the checks right away and then every interval until ctx is
+// done. From then on the service is reported not ready, so it stops getting
+// traffic while it shuts down.
+func (r *Registry) Start(ctx context.Context) {
+	r.mu.Lock()
+	r.startedAt = time.Now()
+	r.mu.Unlock()
+
+	r.Evaluate(ctx)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			r.mu.Lock()
+			r.stopping = true
+			listeners := slices.Clone(r.listeners)
+			r.mu.Unlock()
+			for _, fn := range listeners {
+				fn()
+			}
+			return
+		case <-ticker.C:
+			r.Evaluate(ctx)
+		}
+	}
+}
+
+// Evaluate runs every check now, all at once, and returns the new report.
+func (r *Registry) Evaluate(ctx context.Context) Report {
+	r.mu.RLock()
+	checks := slices.Clone(r.checks)
+	r.mu.RUnlock()
+
+	results := make([]CheckResult, len(checks))
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = r.run(ctx, c)
+		}()
+	}
+	wg.Wait()
+
+	r.mu.Lock()
+	changed := r.checkedAt.IsZero()
+	for _, res := range results {
+		prev, seen := r.results[res.Name]
+		if res.Status == StatusPass {
+			at := res.CheckedAt
+			res.LastSuccessAt = &at
+		} else {
+			res.LastSuccessAt = prev.LastSuccessAt
+			res.ConsecutiveFailures = prev.ConsecutiveFailures + 1
+		}
+		if !seen || prev.Status != res.Status {
+			changed = true
+			r.logTransition(res)
+		}
+		r.results[res.Name] = res
+	}
+	r.checkedAt = time.Now()
+	report := r.reportLocked()
+	listeners := slices.Clone(r.listeners)
+	r.mu.Unlock()
+
+	if changed {
+		for _, fn := range listeners {
+			fn()
+		}
+	}
+	return report
+}
+
+func (r *Registry) run(ctx context.Context, c *registeredCheck) CheckResult {
+	res := CheckResult{Name: c.Name, Critical: c.Critical, Services: c.Services, CheckedAt: time.Now()}
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = r.timeout
+	}
+
+	var err error
+	if !c.running.CompareAndSwap(false, true) {
+		// Do not pile up runs behind one that ignores its context.
+		err = errors.New("the previous run has not returned yet")
+	} else {
+		runCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		done := make(chan error, 1)
+		go func() {
+			defer c.running.Store(false)
+			done <- c.Run(runCtx)
+		}()
+		select {
+		case err = <-done:
+		case <-runCtx.Done():
+			err = fmt.Errorf("timed out after %s", timeout)
+		}
+	}
+
+	res.Duration = time.Since(res.CheckedAt).Round(time.Microsecond).String()
+	res.Status = StatusPass
+	if err != nil {
+		res.Status = StatusFail
+		res.Error = err.Error()
+	}
+	return res
+}
+
+func (r *Registry) logTransition(res CheckResult) {
+	if res.Status == StatusPass {
+		r.logger.Info("health check passing", "check", res.Name)
+		return
+	}
+	level := slog.LevelWarn
+	if res.Critical {
+		level = slog.LevelError
+	}
+	r.logger.Log(context.Background(), level, "health check failing", "check", res.Name, "critical", res.Critical, "error", res.Error)
+}
+
+// Report returns the results of the latest evaluation.
+func (r *Registry) Report() Report {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.reportLocked()
+}
+
+func (r *Registry) reportLocked() Report {
+	report := Report{Status: StatusPass, Checks: make([]CheckResult, 0, len(r.results))}
+	for _, res := range r.results {
+		report.Checks = append(report.Checks, res)
+		if res.Status == StatusPass {
+			continue
+		}
+		if res.Critical {
+			report.Status = StatusFail
+		} else if report.Status == StatusPass {
+			report.Status = StatusWarn
+		}
+	}
+	slices.SortFunc(report.Checks, func(a, b CheckResult) int { return strings.Compare(a.Name, b.Name) })
+
+	switch {
+	case r.stopping:
+		report.Status = StatusFail
+		report.Message = "shutting down"
+	case r.checkedAt.IsZero():
+		report.Status = StatusFail
+		report.Message = "not checked yet"
+	default:
+		at := r.checkedAt
+		report.CheckedAt = &at
+	}
+	return report
+}
+
+// Ready reports whether every critical check that service needs passes.
+// The empty name stands for the whole service, which needs all of them.
+func (r *Registry) Ready(service string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.stopping || r.checkedAt.IsZero() {
+		return false
+	}
+	for _, res := range r.results {
+		if res.Status == StatusPass || !res.Critical {
+			continue
+		}
+		if service == "" || len(res.Services) == 0 || slices.Contains(res.Services, service) {
+			return false
+		}
+	}
+	return true
+}
+
+// Live reports whether the process is still working, which only depends on
+// the checks still being run: restarting the service does not bring its
+// database back.
+func (r *Registry) Live() Report {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	report := Report{Status: StatusPass, Checks: []CheckResult{}}
+	if r.startedAt.IsZero() {
+		return report
+	}
+	last := r.checkedAt
+	if last.IsZero() {
+		last = r.startedAt
+	}
+	report.CheckedAt = &last
+	if !r.stopping && time.Since(last) > 3*r.interval+r.timeout {
+		report.Status = StatusFail
+		report.Message = fmt.Sprintf("checks have not run since %s", last.Format(time.RFC3339))
+	}
+	return report
+}
@@ -0,0 +1,33 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// LivezHandler answers /livez with Live: 200 while the process works, 503
+// when it should be restarted.
+func (r *Registry) LivezHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeReport(w, r.Live())
+	})
+}
+
+// ReadyzHandler answers /readyz with the latest Report: 200 while every
+// critical check passes, 503 otherwise.
+func (r *Registry) ReadyzHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeReport(w, r.Report())
+	})
+}
+
+func writeReport(w http.ResponseWriter, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if report.Status == StatusFail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	json.NewEncoder(w).Encode(report)
+}
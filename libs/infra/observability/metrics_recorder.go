@@ -14,6 +14,17 @@ type MetricsRecorder interface {
 	RecordRoleCacheMiss(reason string)
 	RecordGrpcRequestTotal(fullMethod string, statusCode string)
 	RecordGrpcRequestDuration(fullMethod string, durationSeconds float64)
+	// RecordGrpcRequestThrottled counts a call the rate limiter rejected;
+	// key says whether the client was told apart by "user" or "ip".
+	RecordGrpcRequestThrottled(fullMethod string, key string)
+	// RecordGrpcClientRequestTotal counts one attempt of a call to another
+	// service; retries count as attempts of their own.
+	RecordGrpcClientRequestTotal(target string, fullMethod string, statusCode string)
+	RecordGrpcClientRequestDuration(target string, fullMethod string, durationSeconds float64)
+	RecordGrpcClientRetry(target string, fullMethod string)
+	// RecordGrpcClientCircuitOpen counts the times the circuit breaker of a
+	// target opened.
+	RecordGrpcClientCircuitOpen(target string)
 }
 
 type prometheusMetricsRecorder struct {
@@ -25,6 +36,11 @@ type prometheusMetricsRecorder struct {
 	roleCacheMissCounter           *prometheus.CounterVec
 	grpcRequestsTotal              *prometheus.CounterVec
 	grpcRequestDuration            *prometheus.HistogramVec
+	grpcRequestsThrottled          *prometheus.CounterVec
+	grpcClientRequestsTotal        *prometheus.CounterVec
+	grpcClientRequestDuration      *prometheus.HistogramVec
+	grpcClientRetries              *prometheus.CounterVec
+	grpcClientCircuitOpens         *prometheus.CounterVec
 }
 
 func NewPrometheusMetricsRecorder() MetricsRecorder {
@@ -62,6 +78,27 @@ func NewPrometheusMetricsRecorder() MetricsRecorder {
 			Help:    "Histogram of gRPC server request latencies.",
 			Buckets: prometheus.DefBuckets,
 		}, []string{"method"}),
+		grpcRequestsThrottled: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_requests_throttled_total",
+			Help: "Total number of gRPC requests rejected by the rate limiter by method and client key.",
+		}, []string{"method", "key"}),
+		grpcClientRequestsTotal: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_client_requests_total",
+			Help: "Total number of gRPC call attempts to other services by target, method and status code.",
+		}, []string{"target", "method", "code"}),
+		grpcClientRequestDuration: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_client_request_duration_seconds",
+			Help:    "Histogram of gRPC call attempt latencies to other services.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"target", "method"}),
+		grpcClientRetries: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_client_retries_total",
+			Help: "Total number of retried gRPC calls to other services by target and method.",
+		}, []string{"target", "method"}),
+		grpcClientCircuitOpens: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_client_circuit_opens_total",
+			Help: "Total number of times the circuit breaker of a target opened.",
+		}, []string{"target"}),
 	}
 }
 
@@ -89,3 +126,18 @@ func (r *prometheusMetricsRecorder) RecordGrpcRequestTotal(fullMethod string, st
 func (r *prometheusMetricsRecorder) RecordGrpcRequestDuration(fullMethod string, durationSeconds float64) {
 	r.grpcRequestDuration.WithLabelValues(fullMethod).Observe(durationSeconds)
 }
+func (r *prometheusMetricsRecorder) RecordGrpcRequestThrottled(fullMethod string, key string) {
+	r.grpcRequestsThrottled.WithLabelValues(fullMethod, key).Inc()
+}
+func (r *prometheusMetricsRecorder) RecordGrpcClientRequestTotal(target string, fullMethod string, statusCode string) {
+	r.grpcClientRequestsTotal.WithLabelValues(target, fullMethod, statusCode).Inc()
+}
+func (r *prometheusMetricsRecorder) RecordGrpcClientRequestDuration(target string, fullMethod string, durationSeconds float64) {
+	r.grpcClientRequestDuration.WithLabelValues(target, fullMethod).Observe(durationSeconds)
+}
+func (r *prometheusMetricsRecorder) RecordGrpcClientRetry(target string, fullMethod string) {
+	r.grpcClientRetries.WithLabelValues(target, fullMethod).Inc()
+}
+func (r *prometheusMetricsRecorder) RecordGrpcClientCircuitOpen(target string) {
+	r.grpcClientCircuitOpens.WithLabelValues(target).Inc()
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	lh "github.com/pratchaya-maneechot/service-exchange/libs/health"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
@@ -12,6 +13,9 @@ type MetricConfig struct {
 	Path    string
 	Addr    string
 	Enabled bool
+	// Health serves /livez and /readyz from the registry's checks; nil
+	// leaves /health answering OK regardless.
+	Health *lh.Registry
 }
 type MetricServer struct {
 	server *http.Server
@@ -21,10 +25,16 @@ type MetricServer struct {
 func NewMetricServer(cfg MetricConfig) *MetricServer {
 	mux := http.NewServeMux()
 	mux.Handle(cfg.Path, promhttp.Handler())
-	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("OK"))
-	})
+	if cfg.Health != nil {
+		mux.Handle("/livez", cfg.Health.LivezHandler())
+		mux.Handle("/readyz", cfg.Health.ReadyzHandler())
+		mux.Handle("/health", cfg.Health.ReadyzHandler())
+	} else {
+		mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("OK"))
+		})
+	}
 
 	return &MetricServer{
 		server: &http.Server{
@@ -46,6 +46,11 @@ func NewConnection(pgxConfig *pgxpool.Config, log *slog.Logger) (*DBPool, error)
 	}, nil
 }
 
+// Ping checks that a connection can be acquired and used.
+func (d *DBPool) Ping(ctx context.Context) error {
+	return d.Pool.Ping(ctx)
+}
+
 func (d *DBPool) Close() {
 	if d.Pool != nil {
 		d.Pool.Close()
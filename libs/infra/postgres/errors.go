@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	errs "github.com/pratchaya-maneechot/service-exchange/libs/errors"
+)
+
+// SQLSTATE codes the translation knows about.
+// https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	codeNotNullViolation     = "23502"
+	codeForeignKeyViolation  = "23503"
+	codeUniqueViolation      = "23505"
+	codeCheckViolation       = "23514"
+	codeSerializationFailure = "40001"
+	codeDeadlockDetected     = "40P01"
+	codeLockNotAvailable     = "55P03"
+	codeQueryCanceled        = "57014"
+	codeAdminShutdown        = "57P01"
+	codeCannotConnectNow     = "57P03"
+	codeTooManyConnections   = "53300"
+)
+
+var (
+	ErrNoRows               = errs.NewWithReason(errs.CodeNotFound, "DB_NO_ROWS", "record not found")
+	ErrUniqueViolation      = errs.NewWithReason(errs.CodeAlreadyExists, "DB_UNIQUE_VIOLATION", "record already exists")
+	ErrForeignKeyViolation  = errs.NewWithReason(errs.CodeFailedPrecondition, "DB_FOREIGN_KEY_VIOLATION", "a referenced record does not exist")
+	ErrCheckViolation       = errs.NewWithReason(errs.CodeInvalidArgument, "DB_CHECK_VIOLATION", "record breaks a data constraint")
+	ErrSerializationFailure = errs.NewWithReason(errs.CodeAborted, "DB_SERIALIZATION_FAILURE", "the change conflicted with a concurrent one; retry it")
+	ErrQueryCanceled        = errs.NewWithReason(errs.CodeDeadlineExceeded, "DB_QUERY_CANCELED", "the database query took too long")
+	ErrUnavailable          = errs.NewWithReason(errs.CodeUnavailable, "DB_UNAVAILABLE", "the database is unavailable")
+)
+
+// TranslateError turns a pgx error into the application error for its
+// SQLSTATE, wrapping the original so ConstraintName and errors.As still
+// see it. Errors it does not know, and application errors, are returned as
+// they are; repositories still map constraints to their own domain errors
+// where the generic one would say too little.
+func TranslateError(err error) error {
+	if err == nil || errs.IsErrorInternal(err) {
+		return err
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNoRows.WithCause(err)
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case codeUniqueViolation:
+			return ErrUniqueViolation.WithCause(err)
+		case codeForeignKeyViolation:
+			return ErrForeignKeyViolation.WithCause(err)
+		case codeNotNullViolation, codeCheckViolation:
+			return ErrCheckViolation.WithCause(err)
+		case codeSerializationFailure, codeDeadlockDetected, codeLockNotAvailable:
+			return ErrSerializationFailure.WithCause(err)
+		case codeQueryCanceled:
+			return ErrQueryCanceled.WithCause(err)
+		case codeAdminShutdown, codeCannotConnectNow, codeTooManyConnections:
+			return ErrUnavailable.WithCause(err)
+		}
+		return err
+	}
+
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) {
+		return ErrUnavailable.WithCause(err)
+	}
+	return err
+}
+
+// ConstraintName is the constraint a pgx error reports as violated, or ""
+// when there is none.
+func ConstraintName(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.ConstraintName
+	}
+	return ""
+}
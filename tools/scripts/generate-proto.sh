@@ -19,6 +19,8 @@ echo "Checking and installing Go proto plugins..."
 go_plugins_to_install=(
     "google.golang.org/protobuf/cmd/protoc-gen-go@latest"
     "google.golang.org/grpc/cmd/protoc-gen-go-grpc@latest"
+    "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway@latest"
+    "github.com/google/gnostic/cmd/protoc-gen-openapi@latest"
 )
 
 for plugin in "${go_plugins_to_install[@]}"; do
@@ -37,6 +39,8 @@ echo "Go proto plugins are ready."
 GOPATH_BIN="$(go env GOPATH)/bin"
 PROTOC_GEN_GO="${GOPATH_BIN}/protoc-gen-go"
 PROTOC_GEN_GRPC="${GOPATH_BIN}/protoc-gen-go-grpc"
+PROTOC_GEN_GATEWAY="${GOPATH_BIN}/protoc-gen-grpc-gateway"
+PROTOC_GEN_OPENAPI="${GOPATH_BIN}/protoc-gen-openapi"
 
 echo "Checking and installing protoc if not found..."
 
@@ -81,13 +85,52 @@ else
     echo "protoc is already installed."
 fi
 
+# buf.validate rules in the .proto files import protovalidate's validate.proto;
+# fetch the release the Go runtime in libs/grpc was generated from.
+PROTOVALIDATE_VERSION="v1.0.0"
+PROTO_DEPS_DIR="./tools/proto"
+VALIDATE_PROTO="${PROTO_DEPS_DIR}/buf/validate/validate.proto"
+
+if [ ! -f "${VALIDATE_PROTO}" ]; then
+    echo "Downloading protovalidate ${PROTOVALIDATE_VERSION}..."
+    mkdir -p "$(dirname "${VALIDATE_PROTO}")"
+    curl -sSL -o "${VALIDATE_PROTO}" \
+        "https://raw.githubusercontent.com/bufbuild/protovalidate/${PROTOVALIDATE_VERSION}/proto/protovalidate/buf/validate/validate.proto"
+fi
+
+# google.api.http rules, which the HTTP/JSON gateway is generated from.
+GOOGLEAPIS_VERSION="master"
+for api_proto in annotations.proto http.proto; do
+    if [ ! -f "${PROTO_DEPS_DIR}/google/api/${api_proto}" ]; then
+        echo "Downloading google/api/${api_proto}..."
+        mkdir -p "${PROTO_DEPS_DIR}/google/api"
+        curl -sSL -o "${PROTO_DEPS_DIR}/google/api/${api_proto}" \
+            "https://raw.githubusercontent.com/googleapis/googleapis/${GOOGLEAPIS_VERSION}/google/api/${api_proto}"
+    fi
+done
+
 echo "Generating proto for ${SERVICE_NAME} service..."
 
 # Use the full paths
-protoc --plugin=protoc-gen-go="${PROTOC_GEN_GO}" \
+protoc -I . -I "${PROTO_DEPS_DIR}" \
+    --plugin=protoc-gen-go="${PROTOC_GEN_GO}" \
     --go_out=. --go_opt=paths=source_relative \
     --plugin=protoc-gen-go-grpc="${PROTOC_GEN_GRPC}" \
     --go-grpc_out=. --go-grpc_opt=paths=source_relative \
+    --plugin=protoc-gen-grpc-gateway="${PROTOC_GEN_GATEWAY}" \
+    --grpc-gateway_out=. --grpc-gateway_opt=paths=source_relative \
     ./apps/${SERVICE_NAME}/api/proto/**/*.proto
 
+# Services with google.api.http rules are served as HTTP/JSON too; their
+# OpenAPI document is embedded from api/openapi.
+if grep -qs 'google/api/annotations.proto' ./apps/${SERVICE_NAME}/api/proto/**/*.proto; then
+    echo "Generating OpenAPI for ${SERVICE_NAME} service..."
+    mkdir -p ./apps/${SERVICE_NAME}/api/openapi
+    protoc -I . -I "${PROTO_DEPS_DIR}" \
+        --plugin=protoc-gen-openapi="${PROTOC_GEN_OPENAPI}" \
+        --openapi_out=./apps/${SERVICE_NAME}/api/openapi \
+        --openapi_opt=enum_type=string,default_response=false \
+        ./apps/${SERVICE_NAME}/api/proto/**/*.proto
+fi
+
 echo "proto: generated for ${SERVICE_NAME}"
\ No newline at end of file